@@ -2,16 +2,28 @@
 package main
 
 import (
+	"assignment-ptes-achmad-rifai/internal/account"
+	"assignment-ptes-achmad-rifai/internal/address"
 	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"assignment-ptes-achmad-rifai/internal/cart"
 	"assignment-ptes-achmad-rifai/internal/category"
 	"assignment-ptes-achmad-rifai/internal/customer"
 	"assignment-ptes-achmad-rifai/internal/dashboard"
+	"assignment-ptes-achmad-rifai/internal/media"
+	"assignment-ptes-achmad-rifai/internal/notification"
 	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/payment"
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"assignment-ptes-achmad-rifai/internal/pkg/storage"
 	"assignment-ptes-achmad-rifai/internal/product"
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/returns"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
-	"context"
-	"database/sql"
-	"fmt"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"assignment-ptes-achmad-rifai/internal/tax"
+	"assignment-ptes-achmad-rifai/internal/variant"
+	"assignment-ptes-achmad-rifai/internal/webhook"
 	"log"
 	"os"
 	"time"
@@ -19,7 +31,6 @@ import (
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql" // Driver diganti ke MySQL
 	"github.com/joho/godotenv"
-	"github.com/redis/go-redis/v9"
 
 	_ "assignment-ptes-achmad-rifai/docs"
 
@@ -29,60 +40,60 @@ import (
 
 // ControllerRegistry untuk mengelompokkan handler
 type ControllerRegistry struct {
-	Category  *category.Handler
-	Product   *product.Handler
-	Customer  *customer.Handler
-	Order     *order.Handler
-	Dashboard *dashboard.Handler
+	Category     *category.Handler
+	Product      *product.Handler
+	Variant      *variant.Handler
+	Media        *media.Handler
+	Customer     *customer.Handler
+	Order        *order.Handler
+	Cart         *cart.Handler
+	Payment      *payment.Handler
+	Returns      *returns.Handler
+	Promotion    *promotion.Handler
+	Tax          *tax.Handler
+	Dashboard    *dashboard.Handler
+	Webhook      *webhook.Handler
+	Notification *notification.Handler
+	Account      *account.Handler
+	Address      *address.Handler
+	Shipping     *shipping.Handler
 }
 
-func connectDBWithRetry(dsn string, maxRetries int) (*sql.DB, error) {
-	var db *sql.DB
-	var err error
-
-	for i := 1; i <= maxRetries; i++ {
-		db, err = sql.Open("mysql", dsn)
-		if err == nil {
-			err = db.Ping()
-			if err == nil {
-				log.Println("✅ Successfully connected to MySQL database")
-				return db, nil
-			}
-		}
-
-		log.Printf("⚠️  MySQL connection attempt %d/%d failed: %v", i, maxRetries, err)
-
-		if i < maxRetries {
-			time.Sleep(time.Second * 5)
-		}
+// newStorage memilih backend penyimpanan media: "local" (default) atau "s3" (S3/MinIO)
+func newStorage() storage.Storage {
+	if os.Getenv("STORAGE_DRIVER") == "s3" {
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			PublicURL: os.Getenv("S3_PUBLIC_URL"),
+		}, nil)
 	}
 
-	return nil, err
+	return storage.NewLocalStorage(localStorageDir(), "/media")
 }
 
-func connectRedisWithRetry(addr string, maxRetries int) (*redis.Client, error) {
-	rdb := redis.NewClient(&redis.Options{
-		Addr:     addr,
-		Password: "",
-		DB:       0,
-	})
-
-	for i := 1; i <= maxRetries; i++ {
-		ctx := context.Background()
-		_, err := rdb.Ping(ctx).Result()
-		if err == nil {
-			log.Println("✅ Successfully connected to Redis")
-			return rdb, nil
-		}
-
-		log.Printf("⚠️  Redis connection attempt %d/%d failed: %v", i, maxRetries, err)
-
-		if i < maxRetries {
-			time.Sleep(time.Second * 5)
-		}
+// newPaymentGateway memilih gateway pembayaran; saat ini hanya "fake" (default)
+// yang tersedia sehingga alur pembayaran bisa dijalankan tanpa akun gateway
+func newPaymentGateway() payment.PaymentGateway {
+	secret := bootstrap.RequireSecret("PAYMENT_WEBHOOK_SECRET", "dev-webhook-secret")
+
+	switch driver := os.Getenv("PAYMENT_GATEWAY"); driver {
+	case "", payment.FakeGatewayName:
+		return payment.NewFakeGateway(secret, os.Getenv("PAYMENT_CHECKOUT_URL"))
+	default:
+		log.Fatalf("❌ Unknown PAYMENT_GATEWAY %q", driver)
+		return nil
 	}
+}
 
-	return nil, fmt.Errorf("failed to connect to Redis after %d attempts", maxRetries)
+func localStorageDir() string {
+	if dir := os.Getenv("STORAGE_LOCAL_DIR"); dir != "" {
+		return dir
+	}
+	return "./uploads"
 }
 
 // @title           Assignment PTES API
@@ -99,13 +110,18 @@ func connectRedisWithRetry(addr string, maxRetries int) (*redis.Client, error) {
 
 // @host      localhost:3000
 // @BasePath  /api/v1
+
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Token sesi dari POST /auth/login, format: "Bearer <token>"
 func main() {
 	if err := godotenv.Load(); err != nil {
 		log.Println("Warning: .env file not found")
 	}
 
 	// Connect to MySQL with retry (max 10x, timeout 50s)
-	db, err := connectDBWithRetry(os.Getenv("DB_URL"), 10)
+	db, err := bootstrap.ConnectDBWithRetry(os.Getenv("DB_URL"), 10)
 	if err != nil {
 		log.Fatal("❌ Cannot connect to database after retries:", err)
 	}
@@ -114,7 +130,7 @@ func main() {
 	queries := dbgen.New(db)
 
 	// Connect to Redis with retry (max 10x, timeout 50s)
-	rdb, err := connectRedisWithRetry(os.Getenv("REDIS_URL"), 10)
+	rdb, err := bootstrap.ConnectRedisWithRetry(os.Getenv("REDIS_URL"), 10)
 	if err != nil {
 		log.Fatal("❌ Cannot connect to Redis after retries:", err)
 	}
@@ -129,46 +145,135 @@ func main() {
 	categoryHandler := category.NewHandler(categoryService)
 
 	productRepo := product.NewRepository(queries)
-	productService := product.NewService(productRepo, rdb)
+	productService := product.NewService(db, productRepo, rdb)
 	productHandler := product.NewHandler(productService)
 
-	customerRepo := customer.NewRepository(queries)
-	customerService := customer.NewService(customerRepo)
-	customerHandler := customer.NewHandler(customerService)
+	variantRepo := variant.NewRepository(queries)
+	variantService := variant.NewService(variantRepo)
+	variantHandler := variant.NewHandler(variantService)
+
+	mediaRepo := media.NewRepository(queries)
+	mediaService := media.NewService(db, mediaRepo, newStorage())
+	mediaHandler := media.NewHandler(mediaService)
+
+	addressRepo := address.NewRepository(queries)
+	addressService := address.NewService(db, addressRepo)
+	addressHandler := address.NewHandler(addressService)
 
 	orderRepo := order.NewRepository(queries)
 	orderService := order.NewService(db, orderRepo)
 	orderHandler := order.NewHandler(orderService)
 
+	// Ekspor & anonimisasi data customer dicatat di audit log
+	auditLogger := bootstrap.NewStdoutAuditLogger()
+
+	customerRepo := customer.NewRepository(queries)
+	customerService := customer.NewService(db, customerRepo, orderService, addressService, auditLogger)
+	customerHandler := customer.NewHandler(customerService)
+
+	cartRepo := cart.NewRepository(queries)
+	cartService := cart.NewService(cartRepo, cart.NewRedisStore(rdb, cart.DefaultTTL), orderService)
+	cartHandler := cart.NewHandler(cartService)
+
+	shippingRepo := shipping.NewRepository(queries)
+	shippingService := shipping.NewService(shippingRepo, cartService)
+	shippingHandler := shipping.NewHandler(shippingService)
+
+	paymentRepo := payment.NewRepository(queries)
+	paymentService := payment.NewService(db, paymentRepo, newPaymentGateway())
+	paymentHandler := payment.NewHandler(paymentService)
+
+	returnsRepo := returns.NewRepository(queries)
+	returnsService := returns.NewService(db, returnsRepo)
+	returnsHandler := returns.NewHandler(returnsService)
+
+	promotionRepo := promotion.NewRepository(queries)
+	promotionService := promotion.NewService(promotionRepo)
+	promotionHandler := promotion.NewHandler(promotionService)
+
+	taxRepo := tax.NewRepository(queries)
+	taxService := tax.NewService(taxRepo)
+	taxHandler := tax.NewHandler(taxService)
+
 	dashboardRepo := dashboard.NewRepository(queries)
 	dashboardService := dashboard.NewService(dashboardRepo, rdb)
 	dashboardHandler := dashboard.NewHandler(dashboardService)
 
+	webhookRepo := webhook.NewRepository(queries)
+	webhookService := webhook.NewService(db, webhookRepo, nil)
+	webhookHandler := webhook.NewHandler(webhookService)
+
+	// Email dikirim oleh cmd/worker; API hanya mengelola preferensi & mengantrekan job
+	notificationService := notification.NewService(notification.NewRepository(queries), nil, nil)
+	notificationHandler := notification.NewHandler(notificationService)
+
+	// Akun customer: email verifikasi & reset password diantrekan ke cmd/worker
+	accountRepo := account.NewRepository(queries)
+	accountService := account.NewService(db, accountRepo, jobs.NewClient(jobs.NewMySQLStore(db, queries)), account.Config{
+		PublicURL:     os.Getenv("APP_PUBLIC_URL"),
+		PayloadCipher: notification.MustPayloadCipherFromEnv(),
+	})
+	accountHandler := account.NewHandler(accountService)
+
 	registry := ControllerRegistry{
-		Category:  categoryHandler,
-		Product:   productHandler,
-		Customer:  customerHandler,
-		Order:     orderHandler,
-		Dashboard: dashboardHandler,
+		Category:     categoryHandler,
+		Product:      productHandler,
+		Variant:      variantHandler,
+		Media:        mediaHandler,
+		Customer:     customerHandler,
+		Order:        orderHandler,
+		Cart:         cartHandler,
+		Payment:      paymentHandler,
+		Returns:      returnsHandler,
+		Promotion:    promotionHandler,
+		Tax:          taxHandler,
+		Dashboard:    dashboardHandler,
+		Webhook:      webhookHandler,
+		Notification: notificationHandler,
+		Account:      accountHandler,
+		Address:      addressHandler,
+		Shipping:     shippingHandler,
 	}
 
 	// Router Setup
 	r := gin.Default()
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	if os.Getenv("STORAGE_DRIVER") != "s3" {
+		r.Static("/media", localStorageDir())
+	}
 	// API Grouping
 	api := r.Group("/api/v1")
 	{
 		category.RegisterRoutes(api, registry.Category)
 		product.RegisterRoutes(api, registry.Product)
+		variant.RegisterRoutes(api, registry.Variant)
+		media.RegisterRoutes(api, registry.Media)
 		customer.RegisterRoutes(api, registry.Customer)
 		order.RegisterRoutes(api, registry.Order)
+		cart.RegisterRoutes(api, registry.Cart)
+		payment.RegisterRoutes(api, registry.Payment)
+		if bootstrap.IsDevelopment() {
+			// Simulasi pembayaran hanya untuk development; di environment lain siapa pun bisa melunasi order
+			payment.RegisterDevRoutes(api, registry.Payment)
+		}
+		returns.RegisterRoutes(api, registry.Returns)
+		promotion.RegisterRoutes(api, registry.Promotion)
+		tax.RegisterRoutes(api, registry.Tax)
 		dashboard.RegisterRoutes(api, registry.Dashboard)
+		webhook.RegisterRoutes(api, registry.Webhook)
+		notification.RegisterRoutes(api, registry.Notification)
+		address.RegisterRoutes(api, registry.Address)
+		shipping.RegisterRoutes(api, registry.Shipping)
+
+		// Endpoint customer yang sudah login (/me)
+		requireCustomer := auth.RequireCustomer(accountService.Authenticate)
+		account.RegisterRoutes(api, registry.Account, requireCustomer)
+		order.RegisterMeRoutes(api, registry.Order, requireCustomer)
+		address.RegisterMeRoutes(api, registry.Address, requireCustomer)
 	}
 
-	// Audit logger & Server Config
-	auditLogger := bootstrap.NewStdoutAuditLogger()
-
+	// Server Config
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "3000"
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"assignment-ptes-achmad-rifai/internal/product"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/webhook"
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
+)
+
+// Jenis job yang dijalankan worker
+const (
+	JobApplyPriceSchedules = "product.apply_price_schedules"
+	JobPurgeCompletedJobs  = "jobs.purge_completed"
+)
+
+// completedJobRetention adalah umur job selesai sebelum dihapus oleh JobPurgeCompletedJobs
+const completedJobRetention = 7 * 24 * time.Hour
+
+const purgeBatchSize = 1000
+
+// registerJobs mendaftarkan handler dan jadwal cron
+func registerJobs(w *jobs.Worker, store jobs.Store, productService product.Service) {
+	jobs.Handle(w, JobApplyPriceSchedules, func(ctx context.Context, _ struct{}) error {
+		applied, err := productService.ApplyDuePriceSchedules(ctx, time.Now())
+		if applied > 0 {
+			log.Printf("price schedules: applied %d price change(s)", applied)
+		}
+		return err
+	})
+
+	jobs.Handle(w, JobPurgeCompletedJobs, func(ctx context.Context, _ struct{}) error {
+		before := time.Now().Add(-completedJobRetention)
+		for {
+			purged, err := store.PurgeCompleted(ctx, before, purgeBatchSize)
+			if err != nil || purged < purgeBatchSize {
+				return err
+			}
+		}
+	})
+
+	mustCron(w.Cron("price-schedules", "* * * * *", JobApplyPriceSchedules, struct{}{}))
+	mustCron(w.Cron("purge-completed-jobs", "30 3 * * *", JobPurgeCompletedJobs, struct{}{}))
+}
+
+// newMailer memilih pengirim email: "smtp" (default) atau "memory" untuk development tanpa SMTP
+func newMailer() notification.Mailer {
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "", "smtp":
+		port := envInt("SMTP_PORT")
+		if port == 0 {
+			port = 1025
+		}
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			host = "localhost"
+		}
+		mailer, err := notification.NewSMTPMailer(notification.SMTPConfig{
+			Host:     host,
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("MAIL_FROM"),
+		})
+		if err != nil {
+			log.Fatal("❌ Invalid SMTP configuration:", err)
+		}
+		return mailer
+	case "memory":
+		return notification.NewMemoryMailer()
+	default:
+		log.Fatalf("❌ Unknown MAIL_DRIVER %q", driver)
+		return nil
+	}
+}
+
+// newEventSink memilih tujuan publikasi event outbox: "redis" (default, Redis Streams)
+// atau "memory" untuk development tanpa konsumen
+func newEventSink(rdb *redis.Client) outbox.Sink {
+	switch driver := os.Getenv("EVENT_SINK"); driver {
+	case "", "redis":
+		return outbox.NewRedisStreamSink(rdb, os.Getenv("EVENT_STREAM"))
+	case "memory":
+		return outbox.NewMemorySink()
+	default:
+		log.Fatalf("❌ Unknown EVENT_SINK %q", driver)
+		return nil
+	}
+}
+
+func mustCron(err error) {
+	if err != nil {
+		log.Fatal("❌ Invalid cron schedule:", err)
+	}
+}
+
+func envInt(key string) int {
+	n, _ := strconv.Atoi(os.Getenv(key))
+	return n
+}
+
+func main() {
+	listDead := flag.Bool("dead", false, "print jobs in the dead-letter queue and exit")
+	requeue := flag.String("requeue", "", "move a dead-letter job back to the queue and exit")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	db, err := bootstrap.ConnectDBWithRetry(os.Getenv("DB_URL"), 10)
+	if err != nil {
+		log.Fatal("❌ Cannot connect to database after retries:", err)
+	}
+	defer db.Close()
+
+	queries := dbgen.New(db)
+	store := jobs.NewMySQLStore(db, queries)
+
+	// Perintah dead-letter untuk operator
+	switch {
+	case *listDead:
+		dead, err := store.ListDead(context.Background(), 100, 0)
+		if err != nil {
+			log.Fatal("❌ Cannot list dead-letter jobs:", err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(dead)
+		return
+	case *requeue != "":
+		if err := store.Requeue(context.Background(), *requeue, time.Now()); err != nil {
+			log.Fatal("❌ Cannot requeue job:", err)
+		}
+		log.Println("✅ Job requeued:", *requeue)
+		return
+	}
+
+	rdb, err := bootstrap.ConnectRedisWithRetry(os.Getenv("REDIS_URL"), 10)
+	if err != nil {
+		log.Fatal("❌ Cannot connect to Redis after retries:", err)
+	}
+	defer rdb.Close()
+
+	productService := product.NewService(db, product.NewRepository(queries), rdb)
+
+	worker := jobs.NewWorker(store, jobs.Config{
+		Concurrency:  envInt("WORKER_CONCURRENCY"),
+		PollInterval: time.Duration(envInt("WORKER_POLL_INTERVAL_MS")) * time.Millisecond,
+	})
+	registerJobs(worker, store, productService)
+	notification.RegisterJobs(worker, notification.NewService(notification.NewRepository(queries), newMailer(), notification.MustPayloadCipherFromEnv()))
+
+	// Loop background di luar antrean job: mempublikasikan event outbox (ke event sink,
+	// antrean webhook & antrean email) dan mengirim webhook; berhenti setelah worker selesai drain
+	loopCtx, stopLoops := context.WithCancel(context.Background())
+	defer stopLoops()
+	webhookService := webhook.NewService(db, webhook.NewRepository(queries), nil)
+	eventSink := outbox.NewFanoutSink(
+		newEventSink(rdb),
+		webhook.NewSink(webhookService),
+		notification.NewSink(jobs.NewClient(store)),
+	)
+	go outbox.NewRelay(db, outbox.NewRepository(queries), eventSink, outbox.DefaultRelayInterval).Run(loopCtx)
+	go webhook.NewDispatcher(webhookService, webhook.DefaultDispatchInterval).Run(loopCtx)
+
+	bootstrap.StartWorker(worker, bootstrap.WorkerConfig{
+		DrainTimeout: 30 * time.Second,
+	}, bootstrap.NewStdoutAuditLogger())
+}
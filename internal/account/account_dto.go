@@ -0,0 +1,49 @@
+package account
+
+import "time"
+
+// max=72 menghitung karakter; batas 72 byte bcrypt untuk password multibyte dicek di service (ErrPasswordTooLong)
+type RegisterRequest struct {
+	Name     string `json:"name" binding:"required,max=255"`
+	Email    string `json:"email" binding:"required,email,max=255"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type TokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type EmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
+
+// UpdateProfileRequest: mengganti email membuat email harus diverifikasi ulang
+type UpdateProfileRequest struct {
+	Name  string `json:"name" binding:"required,max=255"`
+	Email string `json:"email" binding:"required,email,max=255"`
+}
+
+type ProfileResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type LoginResponse struct {
+	Token     string          `json:"token"`
+	TokenType string          `json:"token_type"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Customer  ProfileResponse `json:"customer"`
+}
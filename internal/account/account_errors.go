@@ -0,0 +1,12 @@
+package account
+
+import "errors"
+
+var (
+	ErrCustomerNotFound   = errors.New("customer not found")
+	ErrEmailAlreadyExists = errors.New("email already exists")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrEmailNotVerified   = errors.New("email address has not been verified")
+	ErrInvalidToken       = errors.New("token is invalid or has expired")
+	ErrPasswordTooLong    = errors.New("password must not exceed 72 bytes")
+)
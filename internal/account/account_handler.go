@@ -0,0 +1,253 @@
+package account
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Register godoc
+// @Summary      Register a customer account
+// @Description  Create a customer with a password. A verification link is emailed; login is possible once the email is verified.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      RegisterRequest  true  "Registration"
+// @Success      201      {object}  ProfileResponse
+// @Failure      400      {object}  map[string]string "Invalid input"
+// @Failure      409      {object}  map[string]string "Email already registered"
+// @Router       /auth/register [post]
+func (h *Handler) Register(c *gin.Context) {
+	var req RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Register(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "REGISTER_ERROR", "Failed to register customer")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// Login godoc
+// @Summary      Log in
+// @Description  Exchange email and password for a bearer token used by the /me endpoints
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      LoginRequest  true  "Credentials"
+// @Success      200      {object}  LoginResponse
+// @Failure      401      {object}  map[string]string "Invalid email or password"
+// @Failure      403      {object}  map[string]string "Email not verified"
+// @Router       /auth/login [post]
+func (h *Handler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Login(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "LOGIN_ERROR", "Failed to log in")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Logout godoc
+// @Summary      Log out
+// @Description  Revoke the bearer token sent in the Authorization header
+// @Tags         auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  map[string]string
+// @Failure      401      {object}  map[string]string "Missing bearer token"
+// @Router       /auth/logout [post]
+func (h *Handler) Logout(c *gin.Context) {
+	token := auth.BearerToken(c)
+	if token == "" {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Missing bearer token", nil)
+		return
+	}
+
+	if err := h.service.Logout(c.Request.Context(), token); err != nil {
+		response.Error(c, http.StatusInternalServerError, "LOGOUT_ERROR", "Failed to log out", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "Logged out", nil)
+}
+
+// VerifyEmail godoc
+// @Summary      Verify email address
+// @Description  Confirm the email address with the token from the verification email
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      TokenRequest  true  "Verification token"
+// @Success      200      {object}  ProfileResponse
+// @Failure      400      {object}  map[string]string "Invalid or expired token"
+// @Router       /auth/verify-email [post]
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	var req TokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.VerifyEmail(c.Request.Context(), req.Token)
+	if err != nil {
+		handleError(c, err, "VERIFY_ERROR", "Failed to verify email")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ResendVerification godoc
+// @Summary      Resend verification email
+// @Description  Send a new verification link. Always accepted so the response does not reveal whether the email is registered.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      EmailRequest  true  "Email"
+// @Success      202      {object}  map[string]string
+// @Router       /auth/resend-verification [post]
+func (h *Handler) ResendVerification(c *gin.Context) {
+	var req EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.service.ResendVerification(c.Request.Context(), req.Email); err != nil {
+		response.Error(c, http.StatusInternalServerError, "RESEND_ERROR", "Failed to resend verification email", err.Error())
+		return
+	}
+	response.Success(c, http.StatusAccepted, "If the email is registered and unverified, a verification link has been sent", nil)
+}
+
+// ForgotPassword godoc
+// @Summary      Request a password reset
+// @Description  Email a password reset link valid for one hour. Always accepted so the response does not reveal whether the email is registered.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      EmailRequest  true  "Email"
+// @Success      202      {object}  map[string]string
+// @Router       /auth/forgot-password [post]
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var req EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.service.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		response.Error(c, http.StatusInternalServerError, "FORGOT_PASSWORD_ERROR", "Failed to request password reset", err.Error())
+		return
+	}
+	response.Success(c, http.StatusAccepted, "If the email is registered, a password reset link has been sent", nil)
+}
+
+// ResetPassword godoc
+// @Summary      Reset password
+// @Description  Set a new password with the token from the reset email. All existing sessions are logged out.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ResetPasswordRequest  true  "Reset token and new password"
+// @Success      200      {object}  map[string]string
+// @Failure      400      {object}  map[string]string "Invalid or expired token"
+// @Router       /auth/reset-password [post]
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.service.ResetPassword(c.Request.Context(), req); err != nil {
+		handleError(c, err, "RESET_PASSWORD_ERROR", "Failed to reset password")
+		return
+	}
+	response.Success(c, http.StatusOK, "Password has been reset", nil)
+}
+
+// GetProfile godoc
+// @Summary      Get my profile
+// @Description  Profile of the logged-in customer
+// @Tags         me
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200      {object}  ProfileResponse
+// @Failure      401      {object}  map[string]string "Missing or invalid token"
+// @Router       /me [get]
+func (h *Handler) GetProfile(c *gin.Context) {
+	res, err := h.service.GetProfile(c.Request.Context(), auth.CustomerID(c))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch profile")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// UpdateProfile godoc
+// @Summary      Update my profile
+// @Description  Change name and email of the logged-in customer. A new email must be verified again.
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      UpdateProfileRequest  true  "Profile"
+// @Success      200      {object}  ProfileResponse
+// @Failure      400      {object}  map[string]string "Invalid input"
+// @Failure      401      {object}  map[string]string "Missing or invalid token"
+// @Failure      409      {object}  map[string]string "Email already registered"
+// @Router       /me [put]
+func (h *Handler) UpdateProfile(c *gin.Context) {
+	var req UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.UpdateProfile(c.Request.Context(), auth.CustomerID(c), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update profile")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrCustomerNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrEmailAlreadyExists):
+		response.Error(c, http.StatusConflict, "EMAIL_ALREADY_EXISTS", err.Error(), nil)
+	case errors.Is(err, ErrInvalidCredentials):
+		response.Error(c, http.StatusUnauthorized, "INVALID_CREDENTIALS", err.Error(), nil)
+	case errors.Is(err, ErrEmailNotVerified):
+		response.Error(c, http.StatusForbidden, "EMAIL_NOT_VERIFIED", err.Error(), nil)
+	case errors.Is(err, ErrInvalidToken):
+		response.Error(c, http.StatusBadRequest, "INVALID_TOKEN", err.Error(), nil)
+	case errors.Is(err, ErrPasswordTooLong):
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
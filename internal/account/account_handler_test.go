@@ -0,0 +1,190 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/account"
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeAccountService struct {
+	RegisterFn           func(ctx context.Context, req account.RegisterRequest) (account.ProfileResponse, error)
+	LoginFn              func(ctx context.Context, req account.LoginRequest) (account.LoginResponse, error)
+	LogoutFn             func(ctx context.Context, token string) error
+	AuthenticateFn       func(ctx context.Context, token string) (string, error)
+	VerifyEmailFn        func(ctx context.Context, token string) (account.ProfileResponse, error)
+	ResendVerificationFn func(ctx context.Context, email string) error
+	ForgotPasswordFn     func(ctx context.Context, email string) error
+	ResetPasswordFn      func(ctx context.Context, req account.ResetPasswordRequest) error
+	GetProfileFn         func(ctx context.Context, customerID string) (account.ProfileResponse, error)
+	UpdateProfileFn      func(ctx context.Context, customerID string, req account.UpdateProfileRequest) (account.ProfileResponse, error)
+}
+
+func (f *fakeAccountService) Register(ctx context.Context, req account.RegisterRequest) (account.ProfileResponse, error) {
+	return f.RegisterFn(ctx, req)
+}
+
+func (f *fakeAccountService) Login(ctx context.Context, req account.LoginRequest) (account.LoginResponse, error) {
+	return f.LoginFn(ctx, req)
+}
+
+func (f *fakeAccountService) Logout(ctx context.Context, token string) error {
+	return f.LogoutFn(ctx, token)
+}
+
+func (f *fakeAccountService) Authenticate(ctx context.Context, token string) (string, error) {
+	return f.AuthenticateFn(ctx, token)
+}
+
+func (f *fakeAccountService) VerifyEmail(ctx context.Context, token string) (account.ProfileResponse, error) {
+	return f.VerifyEmailFn(ctx, token)
+}
+
+func (f *fakeAccountService) ResendVerification(ctx context.Context, email string) error {
+	return f.ResendVerificationFn(ctx, email)
+}
+
+func (f *fakeAccountService) ForgotPassword(ctx context.Context, email string) error {
+	return f.ForgotPasswordFn(ctx, email)
+}
+
+func (f *fakeAccountService) ResetPassword(ctx context.Context, req account.ResetPasswordRequest) error {
+	return f.ResetPasswordFn(ctx, req)
+}
+
+func (f *fakeAccountService) GetProfile(ctx context.Context, customerID string) (account.ProfileResponse, error) {
+	return f.GetProfileFn(ctx, customerID)
+}
+
+func (f *fakeAccountService) UpdateProfile(ctx context.Context, customerID string, req account.UpdateProfileRequest) (account.ProfileResponse, error) {
+	return f.UpdateProfileFn(ctx, customerID, req)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter(svc *fakeAccountService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	account.RegisterRoutes(r.Group(""), account.NewHandler(svc), auth.RequireCustomer(svc.Authenticate))
+	return r
+}
+
+func doJSON(r *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Register(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"name":"Budi","email":"budi@example.com","password":"rahasia123"}`, nil, http.StatusCreated},
+		{"password too short", `{"name":"Budi","email":"budi@example.com","password":"pendek"}`, nil, http.StatusBadRequest},
+		{"invalid email", `{"name":"Budi","email":"bukan-email","password":"rahasia123"}`, nil, http.StatusBadRequest},
+		{"email taken", `{"name":"Budi","email":"budi@example.com","password":"rahasia123"}`, account.ErrEmailAlreadyExists, http.StatusConflict},
+		{"multibyte password over 72 bytes", `{"name":"Budi","email":"budi@example.com","password":"` + strings.Repeat("é", 40) + `"}`, account.ErrPasswordTooLong, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeAccountService{
+				RegisterFn: func(ctx context.Context, req account.RegisterRequest) (account.ProfileResponse, error) {
+					return account.ProfileResponse{ID: "cust-1", Email: req.Email}, tc.err
+				},
+			}
+
+			w := doJSON(setupTestRouter(svc), http.MethodPost, "/auth/register", tc.body)
+
+			assert.Equal(t, tc.code, w.Code)
+			assert.NotContains(t, w.Body.String(), "rahasia123")
+		})
+	}
+}
+
+func TestHandler_Login(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusOK},
+		{"invalid credentials", account.ErrInvalidCredentials, http.StatusUnauthorized},
+		{"email not verified", account.ErrEmailNotVerified, http.StatusForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeAccountService{
+				LoginFn: func(ctx context.Context, req account.LoginRequest) (account.LoginResponse, error) {
+					return account.LoginResponse{Token: "token-1", TokenType: "Bearer"}, tc.err
+				},
+			}
+
+			w := doJSON(setupTestRouter(svc), http.MethodPost, "/auth/login", `{"email":"budi@example.com","password":"rahasia123"}`)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_ResetPassword_InvalidToken(t *testing.T) {
+	svc := &fakeAccountService{
+		ResetPasswordFn: func(ctx context.Context, req account.ResetPasswordRequest) error {
+			return account.ErrInvalidToken
+		},
+	}
+
+	w := doJSON(setupTestRouter(svc), http.MethodPost, "/auth/reset-password", `{"token":"bogus","password":"baru-12345"}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "INVALID_TOKEN")
+}
+
+func TestHandler_Me(t *testing.T) {
+	svc := &fakeAccountService{
+		AuthenticateFn: func(ctx context.Context, token string) (string, error) {
+			if token != "token-1" {
+				return "", account.ErrInvalidToken
+			}
+			return "cust-1", nil
+		},
+		GetProfileFn: func(ctx context.Context, customerID string) (account.ProfileResponse, error) {
+			return account.ProfileResponse{ID: customerID}, nil
+		},
+	}
+	r := setupTestRouter(svc)
+
+	t.Run("requires_token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/me", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("returns_own_profile", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer token-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"id":"cust-1"`)
+	})
+}
@@ -0,0 +1,96 @@
+package account
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=account_repo.go -destination=mocks/account_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	CreateCustomer(ctx context.Context, params dbgen.CreateCustomerAccountParams) error
+	GetCredentialsByEmail(ctx context.Context, email string) (dbgen.GetCustomerCredentialsByEmailRow, error)
+	GetProfile(ctx context.Context, id string) (dbgen.GetCustomerAccountByIDRow, error)
+	UpdateProfile(ctx context.Context, params dbgen.UpdateCustomerProfileParams) error
+	UpdatePassword(ctx context.Context, params dbgen.UpdateCustomerPasswordParams) error
+	MarkEmailVerified(ctx context.Context, params dbgen.MarkCustomerEmailVerifiedParams) error
+
+	// Token login, verifikasi email & reset password (disimpan sebagai hash)
+	CreateToken(ctx context.Context, params dbgen.CreateCustomerTokenParams) error
+	GetValidToken(ctx context.Context, params dbgen.GetValidCustomerTokenParams) (dbgen.CustomerToken, error)
+	UseToken(ctx context.Context, params dbgen.UseCustomerTokenParams) (int64, error)
+	DeleteToken(ctx context.Context, tokenHash string) error
+	DeleteTokens(ctx context.Context, params dbgen.DeleteCustomerTokensParams) error
+
+	// Outbox, ditulis di dalam transaksi yang sama dengan registrasi customer
+	CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) CreateCustomer(ctx context.Context, params dbgen.CreateCustomerAccountParams) error {
+	return r.q.CreateCustomerAccount(ctx, params)
+}
+
+func (r *repository) GetCredentialsByEmail(ctx context.Context, email string) (dbgen.GetCustomerCredentialsByEmailRow, error) {
+	return r.q.GetCustomerCredentialsByEmail(ctx, email)
+}
+
+func (r *repository) GetProfile(ctx context.Context, id string) (dbgen.GetCustomerAccountByIDRow, error) {
+	return r.q.GetCustomerAccountByID(ctx, id)
+}
+
+func (r *repository) UpdateProfile(ctx context.Context, params dbgen.UpdateCustomerProfileParams) error {
+	return r.q.UpdateCustomerProfile(ctx, params)
+}
+
+func (r *repository) UpdatePassword(ctx context.Context, params dbgen.UpdateCustomerPasswordParams) error {
+	return r.q.UpdateCustomerPassword(ctx, params)
+}
+
+func (r *repository) MarkEmailVerified(ctx context.Context, params dbgen.MarkCustomerEmailVerifiedParams) error {
+	return r.q.MarkCustomerEmailVerified(ctx, params)
+}
+
+func (r *repository) CreateToken(ctx context.Context, params dbgen.CreateCustomerTokenParams) error {
+	return r.q.CreateCustomerToken(ctx, params)
+}
+
+func (r *repository) GetValidToken(ctx context.Context, params dbgen.GetValidCustomerTokenParams) (dbgen.CustomerToken, error) {
+	return r.q.GetValidCustomerToken(ctx, params)
+}
+
+func (r *repository) UseToken(ctx context.Context, params dbgen.UseCustomerTokenParams) (int64, error) {
+	return r.q.UseCustomerToken(ctx, params)
+}
+
+func (r *repository) DeleteToken(ctx context.Context, tokenHash string) error {
+	return r.q.DeleteCustomerToken(ctx, tokenHash)
+}
+
+func (r *repository) DeleteTokens(ctx context.Context, params dbgen.DeleteCustomerTokensParams) error {
+	return r.q.DeleteCustomerTokens(ctx, params)
+}
+
+func (r *repository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	return r.q.CreateOutboxEvent(ctx, params)
+}
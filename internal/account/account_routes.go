@@ -0,0 +1,24 @@
+package account
+
+import "github.com/gin-gonic/gin"
+
+// RegisterRoutes: requireCustomer adalah middleware auth.RequireCustomer untuk endpoint /me
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler, requireCustomer gin.HandlerFunc) {
+	authGroup := r.Group("/auth")
+	{
+		authGroup.POST("/register", handler.Register)
+		authGroup.POST("/login", handler.Login)
+		authGroup.POST("/logout", handler.Logout)
+		authGroup.POST("/verify-email", handler.VerifyEmail)
+		authGroup.POST("/resend-verification", handler.ResendVerification)
+		authGroup.POST("/forgot-password", handler.ForgotPassword)
+		authGroup.POST("/reset-password", handler.ResetPassword)
+	}
+
+	// Self-service: hanya data milik customer yang sedang login
+	me := r.Group("/me", requireCustomer)
+	{
+		me.GET("", handler.GetProfile)
+		me.PUT("", handler.UpdateProfile)
+	}
+}
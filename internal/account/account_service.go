@@ -0,0 +1,429 @@
+package account
+
+import (
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+//go:generate mockgen -source=account_service.go -destination=mocks/account_service_mock.go -package=mock
+
+type Service interface {
+	Register(ctx context.Context, req RegisterRequest) (ProfileResponse, error)
+	Login(ctx context.Context, req LoginRequest) (LoginResponse, error)
+	Logout(ctx context.Context, token string) error
+	// Authenticate mengembalikan ID customer pemilik token sesi yang masih berlaku
+	Authenticate(ctx context.Context, token string) (string, error)
+
+	VerifyEmail(ctx context.Context, token string) (ProfileResponse, error)
+	// ResendVerification & ForgotPassword tidak memberi tahu apakah email terdaftar
+	ResendVerification(ctx context.Context, email string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+
+	GetProfile(ctx context.Context, customerID string) (ProfileResponse, error)
+	UpdateProfile(ctx context.Context, customerID string, req UpdateProfileRequest) (ProfileResponse, error)
+}
+
+// maxPasswordBytes adalah batas input bcrypt
+const maxPasswordBytes = 72
+
+// Path halaman frontend yang menerima token dari email
+const (
+	VerifyEmailPath   = "/verify-email"
+	ResetPasswordPath = "/reset-password"
+)
+
+type Config struct {
+	// PublicURL adalah base URL frontend untuk tautan di email, mis. https://toko.example.com
+	PublicURL string
+	// PasswordCost adalah cost bcrypt; 0 berarti bcrypt.DefaultCost
+	PasswordCost int
+	// PayloadCipher mengenkripsi tautan berisi token sebelum diantrekan; secret sama dengan worker
+	PayloadCipher *notification.PayloadCipher
+}
+
+// dummyHash dibandingkan saat email tidak ditemukan agar waktu respons login tidak membocorkan
+// apakah email terdaftar
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+type service struct {
+	db       *sql.DB
+	repo     Repository
+	enqueuer jobs.Enqueuer // Email verifikasi & reset dikirim lewat job queue
+	cfg      Config
+}
+
+func NewService(db *sql.DB, repo Repository, enqueuer jobs.Enqueuer, cfg Config) Service {
+	if cfg.PasswordCost == 0 {
+		cfg.PasswordCost = bcrypt.DefaultCost
+	}
+	cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+	return &service{db: db, repo: repo, enqueuer: enqueuer, cfg: cfg}
+}
+
+// hashPassword: validator max=72 menghitung karakter, sedangkan bcrypt membatasi 72 byte,
+// jadi password multibyte yang lolos validasi tetap ditolak di sini sebagai input yang salah
+func (s *service) hashPassword(password string) ([]byte, error) {
+	if len(password) > maxPasswordBytes {
+		return nil, ErrPasswordTooLong
+	}
+	return bcrypt.GenerateFromPassword([]byte(password), s.cfg.PasswordCost)
+}
+
+func (s *service) Register(ctx context.Context, req RegisterRequest) (ProfileResponse, error) {
+	req.Email = helper.NormalizeEmail(req.Email)
+	if _, err := s.repo.GetCredentialsByEmail(ctx, req.Email); err == nil {
+		return ProfileResponse{}, ErrEmailAlreadyExists
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return ProfileResponse{}, err
+	}
+
+	hash, err := s.hashPassword(req.Password)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+	id := newUUID.String()
+	now := time.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := txRepo.CreateCustomer(ctx, dbgen.CreateCustomerAccountParams{
+		ID:           id,
+		Name:         req.Name,
+		Email:        req.Email,
+		PasswordHash: sql.NullString{String: string(hash), Valid: true},
+		CreatedAt:    now,
+	}); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return ProfileResponse{}, ErrEmailAlreadyExists
+		}
+		return ProfileResponse{}, err
+	}
+
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateCustomer, id, outbox.EventCustomerRegistered, outbox.CustomerRegistered{
+		CustomerID: id,
+		Name:       req.Name,
+		Email:      req.Email,
+	}); err != nil {
+		return ProfileResponse{}, err
+	}
+
+	expiresAt := now.Add(EmailVerificationTTL)
+	token, err := issueToken(ctx, txRepo, id, TokenPurposeEmailVerification, expiresAt)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProfileResponse{}, err
+	}
+
+	s.sendEmail(ctx, notification.KindEmailVerification, id, VerifyEmailPath, token, expiresAt)
+
+	return ProfileResponse{
+		ID:        id,
+		Name:      req.Name,
+		Email:     req.Email,
+		CreatedAt: now,
+	}, nil
+}
+
+func (s *service) Login(ctx context.Context, req LoginRequest) (LoginResponse, error) {
+	c, err := s.repo.GetCredentialsByEmail(ctx, helper.NormalizeEmail(req.Email))
+	// Customer tanpa password (dibuat admin) harus memakai alur reset password terlebih dulu
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !c.PasswordHash.Valid) {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(req.Password))
+		return LoginResponse{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return LoginResponse{}, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(c.PasswordHash.String), []byte(req.Password)) != nil {
+		return LoginResponse{}, ErrInvalidCredentials
+	}
+	if !c.EmailVerifiedAt.Valid {
+		return LoginResponse{}, ErrEmailNotVerified
+	}
+
+	expiresAt := time.Now().Add(SessionTTL)
+	token, err := issueToken(ctx, s.repo, c.ID, TokenPurposeSession, expiresAt)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	return LoginResponse{
+		Token:     token,
+		TokenType: "Bearer",
+		ExpiresAt: expiresAt,
+		Customer: ProfileResponse{
+			ID:            c.ID,
+			Name:          c.Name,
+			Email:         c.Email,
+			EmailVerified: true,
+			CreatedAt:     c.CreatedAt,
+		},
+	}, nil
+}
+
+func (s *service) Logout(ctx context.Context, token string) error {
+	return s.repo.DeleteToken(ctx, hashToken(token))
+}
+
+func (s *service) Authenticate(ctx context.Context, token string) (string, error) {
+	t, err := s.repo.GetValidToken(ctx, dbgen.GetValidCustomerTokenParams{
+		TokenHash: hashToken(token),
+		Purpose:   TokenPurposeSession,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+	return t.CustomerID, nil
+}
+
+func (s *service) VerifyEmail(ctx context.Context, token string) (ProfileResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	now := time.Now()
+
+	customerID, err := useToken(ctx, txRepo, token, TokenPurposeEmailVerification, now)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+
+	if err := txRepo.MarkEmailVerified(ctx, dbgen.MarkCustomerEmailVerifiedParams{
+		EmailVerifiedAt: sql.NullTime{Time: now, Valid: true},
+		ID:              customerID,
+	}); err != nil {
+		return ProfileResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProfileResponse{}, err
+	}
+
+	return s.GetProfile(ctx, customerID)
+}
+
+func (s *service) ResendVerification(ctx context.Context, email string) error {
+	c, err := s.repo.GetCredentialsByEmail(ctx, helper.NormalizeEmail(email))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && c.EmailVerifiedAt.Valid) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.replaceToken(ctx, c.ID, TokenPurposeEmailVerification, EmailVerificationTTL)
+}
+
+func (s *service) ForgotPassword(ctx context.Context, email string) error {
+	c, err := s.repo.GetCredentialsByEmail(ctx, helper.NormalizeEmail(email))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.replaceToken(ctx, c.ID, TokenPurposePasswordReset, PasswordResetTTL)
+}
+
+// ResetPassword mengganti password, menandai email terverifikasi (customer terbukti menerima
+// email tersebut) dan mengakhiri semua sesi login yang ada
+func (s *service) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	hash, err := s.hashPassword(req.Password)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	now := time.Now()
+
+	customerID, err := useToken(ctx, txRepo, req.Token, TokenPurposePasswordReset, now)
+	if err != nil {
+		return err
+	}
+
+	if err := txRepo.UpdatePassword(ctx, dbgen.UpdateCustomerPasswordParams{
+		PasswordHash: sql.NullString{String: string(hash), Valid: true},
+		ID:           customerID,
+	}); err != nil {
+		return err
+	}
+	if err := txRepo.MarkEmailVerified(ctx, dbgen.MarkCustomerEmailVerifiedParams{
+		EmailVerifiedAt: sql.NullTime{Time: now, Valid: true},
+		ID:              customerID,
+	}); err != nil {
+		return err
+	}
+	for _, purpose := range []string{TokenPurposeSession, TokenPurposePasswordReset} {
+		if err := txRepo.DeleteTokens(ctx, dbgen.DeleteCustomerTokensParams{
+			CustomerID: customerID,
+			Purpose:    purpose,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *service) GetProfile(ctx context.Context, customerID string) (ProfileResponse, error) {
+	row, err := s.repo.GetProfile(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProfileResponse{}, ErrCustomerNotFound
+		}
+		return ProfileResponse{}, err
+	}
+
+	return ProfileResponse{
+		ID:            row.ID,
+		Name:          row.Name,
+		Email:         row.Email,
+		EmailVerified: row.EmailVerifiedAt.Valid,
+		CreatedAt:     row.CreatedAt,
+	}, nil
+}
+
+func (s *service) UpdateProfile(ctx context.Context, customerID string, req UpdateProfileRequest) (ProfileResponse, error) {
+	current, err := s.GetProfile(ctx, customerID)
+	if err != nil {
+		return ProfileResponse{}, err
+	}
+
+	req.Email = helper.NormalizeEmail(req.Email)
+	emailChanged := req.Email != current.Email
+	if emailChanged {
+		other, err := s.repo.GetCredentialsByEmail(ctx, req.Email)
+		if err == nil && other.ID != customerID {
+			return ProfileResponse{}, ErrEmailAlreadyExists
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return ProfileResponse{}, err
+		}
+	}
+
+	if err := s.repo.UpdateProfile(ctx, dbgen.UpdateCustomerProfileParams{
+		Email: req.Email,
+		Name:  req.Name,
+		ID:    customerID,
+	}); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return ProfileResponse{}, ErrEmailAlreadyExists
+		}
+		return ProfileResponse{}, err
+	}
+
+	// Alamat baru harus diverifikasi; token untuk alamat lama tidak berlaku lagi
+	if emailChanged {
+		if err := s.replaceToken(ctx, customerID, TokenPurposeEmailVerification, EmailVerificationTTL); err != nil {
+			return ProfileResponse{}, err
+		}
+	}
+
+	return s.GetProfile(ctx, customerID)
+}
+
+// useToken memakai token sekali pakai dan mengembalikan ID customer pemiliknya
+func useToken(ctx context.Context, repo Repository, token, purpose string, now time.Time) (string, error) {
+	hash := hashToken(token)
+	t, err := repo.GetValidToken(ctx, dbgen.GetValidCustomerTokenParams{
+		TokenHash: hash,
+		Purpose:   purpose,
+		Now:       now,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+
+	used, err := repo.UseToken(ctx, dbgen.UseCustomerTokenParams{Now: now, TokenHash: hash})
+	if err != nil {
+		return "", err
+	}
+	if used == 0 {
+		return "", ErrInvalidToken // sudah dipakai request lain
+	}
+	return t.CustomerID, nil
+}
+
+// replaceToken membatalkan token lama dengan tujuan yang sama, membuat token baru dan mengirim emailnya
+func (s *service) replaceToken(ctx context.Context, customerID, purpose string, ttl time.Duration) error {
+	if err := s.repo.DeleteTokens(ctx, dbgen.DeleteCustomerTokensParams{
+		CustomerID: customerID,
+		Purpose:    purpose,
+	}); err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	token, err := issueToken(ctx, s.repo, customerID, purpose, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	if purpose == TokenPurposePasswordReset {
+		s.sendEmail(ctx, notification.KindPasswordReset, customerID, ResetPasswordPath, token, expiresAt)
+	} else {
+		s.sendEmail(ctx, notification.KindEmailVerification, customerID, VerifyEmailPath, token, expiresAt)
+	}
+	return nil
+}
+
+// sendEmail mengantrekan email akun. Token mentah hanya masuk antrean dalam bentuk terenkripsi.
+// Gagal antre hanya dicatat: customer bisa meminta kirim ulang.
+func (s *service) sendEmail(ctx context.Context, kind, customerID, path, token string, expiresAt time.Time) {
+	sealed, err := s.cfg.PayloadCipher.Seal(s.cfg.PublicURL + path + "?token=" + url.QueryEscape(token))
+	if err == nil {
+		_, err = s.enqueuer.Enqueue(ctx, notification.JobSendAccountEmail, notification.AccountEmailJob{
+			Kind:            kind,
+			CustomerID:      customerID,
+			SealedActionURL: sealed,
+			ExpiresAt:       expiresAt,
+		}, jobs.EnqueueOptions{})
+	}
+	if err != nil {
+		log.Printf("account: failed to enqueue %s email for customer %s: %v", kind, customerID, err)
+	}
+}
@@ -0,0 +1,466 @@
+package account_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/account"
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	mockAccount "assignment-ptes-achmad-rifai/internal/account/mocks"
+)
+
+// fakeEnqueuer mencatat email akun yang diantrekan
+type fakeEnqueuer struct {
+	emails []notification.AccountEmailJob
+}
+
+func (f *fakeEnqueuer) Enqueue(ctx context.Context, jobType string, payload any, opts jobs.EnqueueOptions) (string, error) {
+	f.emails = append(f.emails, payload.(notification.AccountEmailJob))
+	return "job-1", nil
+}
+
+func setupServiceTest(t *testing.T) (account.Service, *mockAccount.MockRepository, sqlmock.Sqlmock, *fakeEnqueuer) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockAccount.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+	enqueuer := &fakeEnqueuer{}
+
+	svc := account.NewService(db, repo, enqueuer, account.Config{
+		PublicURL:     "https://toko.test/",
+		PasswordCost:  bcrypt.MinCost,
+		PayloadCipher: testCipher,
+	})
+	return svc, repo, mock, enqueuer
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+var testCipher, _ = notification.NewPayloadCipher("test-secret")
+
+// openActionURL membuka tautan terenkripsi di payload job
+func openActionURL(t *testing.T, job notification.AccountEmailJob) string {
+	actionURL, err := testCipher.Open(job.SealedActionURL)
+	assert.NoError(t, err)
+	return actionURL
+}
+
+// tokenFromURL mengambil token mentah dari tautan di email
+func tokenFromURL(t *testing.T, actionURL string) string {
+	u, err := url.Parse(actionURL)
+	assert.NoError(t, err)
+	return u.Query().Get("token")
+}
+
+func passwordHash(t *testing.T, password string) sql.NullString {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return sql.NullString{String: string(hash), Valid: true}
+}
+
+func verified() sql.NullTime {
+	return sql.NullTime{Time: time.Now(), Valid: true}
+}
+
+func TestService_Register(t *testing.T) {
+	ctx := context.Background()
+	req := account.RegisterRequest{Name: "Budi", Email: "budi@example.com", Password: "rahasia123"}
+
+	t.Run("success_sends_verification_email", func(t *testing.T) {
+		svc, repo, mock, enqueuer := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{}, sql.ErrNoRows)
+		repo.EXPECT().CreateCustomer(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerAccountParams) error {
+				assert.Equal(t, "budi@example.com", p.Email)
+				assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(p.PasswordHash.String), []byte("rahasia123")))
+				return nil
+			})
+		repo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+				assert.Equal(t, outbox.EventCustomerRegistered, p.EventType)
+				return nil
+			})
+		var stored dbgen.CreateCustomerTokenParams
+		repo.EXPECT().CreateToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerTokenParams) error {
+				stored = p
+				return nil
+			})
+
+		res, err := svc.Register(ctx, req)
+
+		assert.NoError(t, err)
+		assert.False(t, res.EmailVerified)
+		assert.Equal(t, res.ID, stored.CustomerID)
+		assert.Equal(t, account.TokenPurposeEmailVerification, stored.Purpose)
+
+		assert.Len(t, enqueuer.emails, 1)
+		email := enqueuer.emails[0]
+		assert.Equal(t, notification.KindEmailVerification, email.Kind)
+		actionURL := openActionURL(t, email)
+		assert.True(t, strings.HasPrefix(actionURL, "https://toko.test/verify-email?token="))
+		assert.Equal(t, stored.TokenHash, sha256Hex(tokenFromURL(t, actionURL)), "hanya hash token yang disimpan")
+		assert.NotContains(t, email.SealedActionURL, tokenFromURL(t, actionURL), "token mentah tidak masuk antrean")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_email_already_exists", func(t *testing.T) {
+		svc, repo, _, enqueuer := setupServiceTest(t)
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{ID: "cust-1"}, nil)
+
+		_, err := svc.Register(ctx, req)
+
+		assert.ErrorIs(t, err, account.ErrEmailAlreadyExists)
+		assert.Empty(t, enqueuer.emails)
+	})
+
+	t.Run("error_multibyte_password_over_72_bytes", func(t *testing.T) {
+		svc, repo, mock, enqueuer := setupServiceTest(t)
+
+		// 40 karakter (lolos max=72) tetapi 80 byte
+		long := req
+		long.Password = strings.Repeat("é", 40)
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").Return(dbgen.GetCustomerCredentialsByEmailRow{}, sql.ErrNoRows)
+
+		_, err := svc.Register(ctx, long)
+
+		assert.ErrorIs(t, err, account.ErrPasswordTooLong)
+		assert.Empty(t, enqueuer.emails)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestService_Login(t *testing.T) {
+	ctx := context.Background()
+	creds := dbgen.GetCustomerCredentialsByEmailRow{
+		ID:              "cust-1",
+		Name:            "Budi",
+		Email:           "budi@example.com",
+		PasswordHash:    passwordHash(t, "rahasia123"),
+		EmailVerifiedAt: verified(),
+	}
+
+	t.Run("success_issues_session_token", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").Return(creds, nil)
+		var stored dbgen.CreateCustomerTokenParams
+		repo.EXPECT().CreateToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerTokenParams) error {
+				stored = p
+				return nil
+			})
+
+		res, err := svc.Login(ctx, account.LoginRequest{Email: "budi@example.com", Password: "rahasia123"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer", res.TokenType)
+		assert.Equal(t, "cust-1", res.Customer.ID)
+		assert.Equal(t, account.TokenPurposeSession, stored.Purpose)
+		assert.Equal(t, sha256Hex(res.Token), stored.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(account.SessionTTL), stored.ExpiresAt, time.Minute)
+	})
+
+	t.Run("email_lookup_is_case_insensitive", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").Return(creds, nil)
+		repo.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := svc.Login(ctx, account.LoginRequest{Email: " Budi@Example.COM", Password: "rahasia123"})
+
+		assert.NoError(t, err)
+	})
+
+	unverified := creds
+	unverified.EmailVerifiedAt = sql.NullTime{}
+	withoutPassword := creds
+	withoutPassword.PasswordHash = sql.NullString{}
+
+	cases := []struct {
+		name     string
+		row      dbgen.GetCustomerCredentialsByEmailRow
+		rowErr   error
+		password string
+		wantErr  error
+	}{
+		{"wrong password", creds, nil, "salah-password", account.ErrInvalidCredentials},
+		{"unknown email", dbgen.GetCustomerCredentialsByEmailRow{}, sql.ErrNoRows, "rahasia123", account.ErrInvalidCredentials},
+		{"customer without password", withoutPassword, nil, "rahasia123", account.ErrInvalidCredentials},
+		{"email not verified", unverified, nil, "rahasia123", account.ErrEmailNotVerified},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, repo, _, _ := setupServiceTest(t)
+
+			repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").Return(tc.row, tc.rowErr)
+
+			_, err := svc.Login(ctx, account.LoginRequest{Email: "budi@example.com", Password: tc.password})
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid_session", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.GetValidCustomerTokenParams) (dbgen.CustomerToken, error) {
+				assert.Equal(t, sha256Hex("token-1"), p.TokenHash)
+				assert.Equal(t, account.TokenPurposeSession, p.Purpose)
+				return dbgen.CustomerToken{CustomerID: "cust-1"}, nil
+			})
+
+		customerID, err := svc.Authenticate(ctx, "token-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cust-1", customerID)
+	})
+
+	t.Run("expired_or_unknown", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).Return(dbgen.CustomerToken{}, sql.ErrNoRows)
+
+		_, err := svc.Authenticate(ctx, "token-1")
+
+		assert.ErrorIs(t, err, account.ErrInvalidToken)
+	})
+}
+
+func TestService_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).Return(dbgen.CustomerToken{CustomerID: "cust-1"}, nil)
+		repo.EXPECT().UseToken(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().MarkEmailVerified(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.MarkCustomerEmailVerifiedParams) error {
+				assert.Equal(t, "cust-1", p.ID)
+				assert.True(t, p.EmailVerifiedAt.Valid)
+				return nil
+			})
+		repo.EXPECT().GetProfile(gomock.Any(), "cust-1").Return(dbgen.GetCustomerAccountByIDRow{
+			ID:              "cust-1",
+			EmailVerifiedAt: verified(),
+		}, nil)
+
+		res, err := svc.VerifyEmail(ctx, "token-1")
+
+		assert.NoError(t, err)
+		assert.True(t, res.EmailVerified)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_token_used_concurrently", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).Return(dbgen.CustomerToken{CustomerID: "cust-1"}, nil)
+		repo.EXPECT().UseToken(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+		_, err := svc.VerifyEmail(ctx, "token-1")
+
+		assert.ErrorIs(t, err, account.ErrInvalidToken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_expired", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).Return(dbgen.CustomerToken{}, sql.ErrNoRows)
+
+		_, err := svc.VerifyEmail(ctx, "token-1")
+
+		assert.ErrorIs(t, err, account.ErrInvalidToken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestService_ForgotPassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown_email_is_silent", func(t *testing.T) {
+		svc, repo, _, enqueuer := setupServiceTest(t)
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "nobody@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{}, sql.ErrNoRows)
+
+		assert.NoError(t, svc.ForgotPassword(ctx, "nobody@example.com"))
+		assert.Empty(t, enqueuer.emails)
+	})
+
+	t.Run("replaces_previous_reset_token", func(t *testing.T) {
+		svc, repo, _, enqueuer := setupServiceTest(t)
+
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{ID: "cust-1"}, nil)
+		repo.EXPECT().DeleteTokens(gomock.Any(), dbgen.DeleteCustomerTokensParams{
+			CustomerID: "cust-1",
+			Purpose:    account.TokenPurposePasswordReset,
+		}).Return(nil)
+		repo.EXPECT().CreateToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerTokenParams) error {
+				assert.WithinDuration(t, time.Now().Add(account.PasswordResetTTL), p.ExpiresAt, time.Minute)
+				return nil
+			})
+
+		assert.NoError(t, svc.ForgotPassword(ctx, "budi@example.com"))
+		assert.Len(t, enqueuer.emails, 1)
+		assert.Equal(t, notification.KindPasswordReset, enqueuer.emails[0].Kind)
+		assert.True(t, strings.HasPrefix(openActionURL(t, enqueuer.emails[0]), "https://toko.test/reset-password?token="))
+	})
+}
+
+func TestService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_revokes_sessions", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.GetValidCustomerTokenParams) (dbgen.CustomerToken, error) {
+				assert.Equal(t, account.TokenPurposePasswordReset, p.Purpose)
+				return dbgen.CustomerToken{CustomerID: "cust-1"}, nil
+			})
+		repo.EXPECT().UseToken(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().UpdatePassword(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateCustomerPasswordParams) error {
+				assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(p.PasswordHash.String), []byte("baru-12345")))
+				return nil
+			})
+		repo.EXPECT().MarkEmailVerified(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DeleteTokens(gomock.Any(), dbgen.DeleteCustomerTokensParams{CustomerID: "cust-1", Purpose: account.TokenPurposeSession}).Return(nil)
+		repo.EXPECT().DeleteTokens(gomock.Any(), dbgen.DeleteCustomerTokensParams{CustomerID: "cust-1", Purpose: account.TokenPurposePasswordReset}).Return(nil)
+
+		err := svc.ResetPassword(ctx, account.ResetPasswordRequest{Token: "token-1", Password: "baru-12345"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_invalid_token", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().GetValidToken(gomock.Any(), gomock.Any()).Return(dbgen.CustomerToken{}, sql.ErrNoRows)
+
+		err := svc.ResetPassword(ctx, account.ResetPasswordRequest{Token: "bogus", Password: "baru-12345"})
+
+		assert.ErrorIs(t, err, account.ErrInvalidToken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_multibyte_password_over_72_bytes", func(t *testing.T) {
+		svc, _, mock, _ := setupServiceTest(t)
+
+		err := svc.ResetPassword(ctx, account.ResetPasswordRequest{Token: "tok", Password: strings.Repeat("密", 30)})
+
+		assert.ErrorIs(t, err, account.ErrPasswordTooLong)
+		assert.NoError(t, mock.ExpectationsWereMet(), "token tidak ikut terpakai")
+	})
+}
+
+func TestService_UpdateProfile(t *testing.T) {
+	ctx := context.Background()
+	current := dbgen.GetCustomerAccountByIDRow{
+		ID:              "cust-1",
+		Name:            "Budi",
+		Email:           "budi@example.com",
+		EmailVerifiedAt: verified(),
+	}
+
+	t.Run("error_email_taken", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetProfile(gomock.Any(), "cust-1").Return(current, nil)
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "siti@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{ID: "cust-2"}, nil)
+
+		_, err := svc.UpdateProfile(ctx, "cust-1", account.UpdateProfileRequest{Name: "Budi", Email: "siti@example.com"})
+
+		assert.ErrorIs(t, err, account.ErrEmailAlreadyExists)
+	})
+
+	t.Run("email_change_requires_new_verification", func(t *testing.T) {
+		svc, repo, _, enqueuer := setupServiceTest(t)
+
+		changed := current
+		changed.Email = "budi.baru@example.com"
+		changed.EmailVerifiedAt = sql.NullTime{}
+
+		repo.EXPECT().GetProfile(gomock.Any(), "cust-1").Return(current, nil)
+		repo.EXPECT().GetCredentialsByEmail(gomock.Any(), "budi.baru@example.com").
+			Return(dbgen.GetCustomerCredentialsByEmailRow{}, sql.ErrNoRows)
+		repo.EXPECT().UpdateProfile(gomock.Any(), dbgen.UpdateCustomerProfileParams{
+			Email: "budi.baru@example.com",
+			Name:  "Budi",
+			ID:    "cust-1",
+		}).Return(nil)
+		repo.EXPECT().DeleteTokens(gomock.Any(), dbgen.DeleteCustomerTokensParams{
+			CustomerID: "cust-1",
+			Purpose:    account.TokenPurposeEmailVerification,
+		}).Return(nil)
+		repo.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetProfile(gomock.Any(), "cust-1").Return(changed, nil)
+
+		res, err := svc.UpdateProfile(ctx, "cust-1", account.UpdateProfileRequest{Name: "Budi", Email: "budi.baru@example.com"})
+
+		assert.NoError(t, err)
+		assert.False(t, res.EmailVerified)
+		assert.Len(t, enqueuer.emails, 1)
+		assert.Equal(t, notification.KindEmailVerification, enqueuer.emails[0].Kind)
+	})
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+// Tujuan token di customer_tokens
+const (
+	TokenPurposeSession           = "session"
+	TokenPurposeEmailVerification = "email_verification"
+	TokenPurposePasswordReset     = "password_reset"
+)
+
+// Masa berlaku token
+const (
+	SessionTTL           = 7 * 24 * time.Hour
+	EmailVerificationTTL = 48 * time.Hour
+	PasswordResetTTL     = time.Hour
+)
+
+// newToken membuat token acak 256-bit (base64url) beserta hash yang disimpan di database
+func newToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+// hashToken: token sudah acak penuh, jadi SHA-256 cukup (tidak perlu bcrypt) dan bisa dicari langsung
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueToken menyimpan token baru untuk customer dan mengembalikan token mentahnya
+func issueToken(ctx context.Context, repo Repository, customerID, purpose string, expiresAt time.Time) (string, error) {
+	token, hash, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	if err := repo.CreateToken(ctx, dbgen.CreateCustomerTokenParams{
+		TokenHash:  hash,
+		CustomerID: customerID,
+		Purpose:    purpose,
+		ExpiresAt:  expiresAt,
+	}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
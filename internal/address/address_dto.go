@@ -0,0 +1,32 @@
+package address
+
+import "time"
+
+// Address adalah detail tujuan pengiriman; dipakai juga oleh order untuk alamat inline.
+// Lewatkan Normalize sebelum disimpan.
+type Address struct {
+	RecipientName string `json:"recipient_name" binding:"required,max=255"`
+	Phone         string `json:"phone" binding:"required,max=20"`
+	Street        string `json:"street" binding:"required,max=255"`
+	City          string `json:"city" binding:"required,max=100"`
+	Province      string `json:"province" binding:"required,max=50"`
+	PostalCode    string `json:"postal_code" binding:"required"`
+}
+
+// AddressRequest: is_default true menjadikan alamat ini default dan mencabut default sebelumnya.
+// Alamat pertama customer selalu menjadi default.
+type AddressRequest struct {
+	Label string `json:"label" binding:"required,max=50"`
+	Address
+	IsDefault bool `json:"is_default"`
+}
+
+type AddressResponse struct {
+	ID         string `json:"id"`
+	CustomerID string `json:"customer_id"`
+	Label      string `json:"label"`
+	Address
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
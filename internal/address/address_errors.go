@@ -0,0 +1,11 @@
+package address
+
+import "errors"
+
+var (
+	ErrCustomerNotFound    = errors.New("customer not found")
+	ErrAddressNotFound     = errors.New("address not found")
+	ErrInvalidAddress      = errors.New("invalid address")
+	ErrLabelAlreadyExists  = errors.New("address label already exists for this customer")
+	ErrAddressLimitReached = errors.New("address limit reached")
+)
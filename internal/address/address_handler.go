@@ -0,0 +1,170 @@
+package address
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// customerID: /me/addresses memakai customer yang login, /customers/:id/addresses memakai path
+func customerID(c *gin.Context) string {
+	if id := auth.CustomerID(c); id != "" {
+		return id
+	}
+	return c.Param("id")
+}
+
+// List godoc
+// @Summary      List customer addresses
+// @Description  Saved shipping addresses of a customer, default address first
+// @Tags         addresses
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {array}   AddressResponse
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Router       /customers/{id}/addresses [get]
+func (h *Handler) List(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context(), customerID(c))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch addresses")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get a customer address
+// @Tags         addresses
+// @Produce      json
+// @Param        id          path      string  true  "Customer ID"
+// @Param        address_id  path      string  true  "Address ID"
+// @Success      200         {object}  AddressResponse
+// @Failure      404         {object}  map[string]string "Address not found"
+// @Router       /customers/{id}/addresses/{address_id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), customerID(c), c.Param("address_id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch address")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Create godoc
+// @Summary      Add a customer address
+// @Description  Province must be one of the 38 Indonesian provinces, postal code 5 digits and phone start with 0, 62 or +62. The first address becomes the default.
+// @Tags         addresses
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string          true  "Customer ID"
+// @Param        request  body      AddressRequest  true  "Address"
+// @Success      201      {object}  AddressResponse
+// @Failure      400      {object}  map[string]string "Invalid address"
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Failure      409      {object}  map[string]string "Label already used or address limit reached"
+// @Router       /customers/{id}/addresses [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req AddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), customerID(c), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create address")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// Update godoc
+// @Summary      Update a customer address
+// @Description  Replace the address. is_default true makes it the default; the default cannot be unset directly, make another address the default instead.
+// @Tags         addresses
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string          true  "Customer ID"
+// @Param        address_id  path      string          true  "Address ID"
+// @Param        request     body      AddressRequest  true  "Address"
+// @Success      200         {object}  AddressResponse
+// @Failure      400         {object}  map[string]string "Invalid address"
+// @Failure      404         {object}  map[string]string "Address not found"
+// @Failure      409         {object}  map[string]string "Label already used"
+// @Router       /customers/{id}/addresses/{address_id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req AddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), customerID(c), c.Param("address_id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update address")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete a customer address
+// @Description  Deleting the default address makes the oldest remaining address the default. Existing orders keep their address snapshot.
+// @Tags         addresses
+// @Produce      json
+// @Param        id          path      string  true  "Customer ID"
+// @Param        address_id  path      string  true  "Address ID"
+// @Success      200         {object}  map[string]string
+// @Failure      404         {object}  map[string]string "Address not found"
+// @Router       /customers/{id}/addresses/{address_id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), customerID(c), c.Param("address_id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete address")
+		return
+	}
+	response.Success(c, http.StatusOK, "Address deleted successfully", nil)
+}
+
+// SetDefault godoc
+// @Summary      Make an address the default
+// @Tags         addresses
+// @Produce      json
+// @Param        id          path      string  true  "Customer ID"
+// @Param        address_id  path      string  true  "Address ID"
+// @Success      200         {object}  AddressResponse
+// @Failure      404         {object}  map[string]string "Address not found"
+// @Router       /customers/{id}/addresses/{address_id}/default [put]
+func (h *Handler) SetDefault(c *gin.Context) {
+	res, err := h.service.SetDefault(c.Request.Context(), customerID(c), c.Param("address_id"))
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to set default address")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrCustomerNotFound), errors.Is(err, ErrAddressNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrInvalidAddress):
+		response.Error(c, http.StatusBadRequest, "INVALID_ADDRESS", err.Error(), nil)
+	case errors.Is(err, ErrLabelAlreadyExists):
+		response.Error(c, http.StatusConflict, "LABEL_ALREADY_EXISTS", err.Error(), nil)
+	case errors.Is(err, ErrAddressLimitReached):
+		response.Error(c, http.StatusConflict, "ADDRESS_LIMIT_REACHED", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,149 @@
+package address_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeAddressService struct {
+	ListFn       func(ctx context.Context, customerID string) ([]address.AddressResponse, error)
+	GetByIDFn    func(ctx context.Context, customerID, id string) (address.AddressResponse, error)
+	CreateFn     func(ctx context.Context, customerID string, req address.AddressRequest) (address.AddressResponse, error)
+	UpdateFn     func(ctx context.Context, customerID, id string, req address.AddressRequest) (address.AddressResponse, error)
+	DeleteFn     func(ctx context.Context, customerID, id string) error
+	SetDefaultFn func(ctx context.Context, customerID, id string) (address.AddressResponse, error)
+}
+
+func (f *fakeAddressService) List(ctx context.Context, customerID string) ([]address.AddressResponse, error) {
+	return f.ListFn(ctx, customerID)
+}
+
+func (f *fakeAddressService) GetByID(ctx context.Context, customerID, id string) (address.AddressResponse, error) {
+	return f.GetByIDFn(ctx, customerID, id)
+}
+
+func (f *fakeAddressService) Create(ctx context.Context, customerID string, req address.AddressRequest) (address.AddressResponse, error) {
+	return f.CreateFn(ctx, customerID, req)
+}
+
+func (f *fakeAddressService) Update(ctx context.Context, customerID, id string, req address.AddressRequest) (address.AddressResponse, error) {
+	return f.UpdateFn(ctx, customerID, id, req)
+}
+
+func (f *fakeAddressService) Delete(ctx context.Context, customerID, id string) error {
+	return f.DeleteFn(ctx, customerID, id)
+}
+
+func (f *fakeAddressService) SetDefault(ctx context.Context, customerID, id string) (address.AddressResponse, error) {
+	return f.SetDefaultFn(ctx, customerID, id)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+const validBody = `{"label":"Rumah","recipient_name":"Budi","phone":"081234567890","street":"Jl. Merdeka 1",` +
+	`"city":"Bandung","province":"Jawa Barat","postal_code":"40111"}`
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", validBody, nil, http.StatusCreated},
+		{"missing fields", `{"label":"Rumah"}`, nil, http.StatusBadRequest},
+		{"invalid address", validBody, address.ErrInvalidAddress, http.StatusBadRequest},
+		{"customer not found", validBody, address.ErrCustomerNotFound, http.StatusNotFound},
+		{"duplicate label", validBody, address.ErrLabelAlreadyExists, http.StatusConflict},
+		{"limit reached", validBody, address.ErrAddressLimitReached, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeAddressService{
+				CreateFn: func(ctx context.Context, customerID string, req address.AddressRequest) (address.AddressResponse, error) {
+					assert.Equal(t, "cust-1", customerID)
+					assert.Equal(t, "Jawa Barat", req.Province)
+					return address.AddressResponse{ID: "addr-1", Label: req.Label}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			address.RegisterRoutes(r.Group(""), address.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/customers/cust-1/addresses", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_SetDefault_NotFound(t *testing.T) {
+	svc := &fakeAddressService{
+		SetDefaultFn: func(ctx context.Context, customerID, id string) (address.AddressResponse, error) {
+			assert.Equal(t, "addr-x", id)
+			return address.AddressResponse{}, address.ErrAddressNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	address.RegisterRoutes(r.Group(""), address.NewHandler(svc))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/customers/cust-1/addresses/addr-x/default", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_MeRoutes(t *testing.T) {
+	svc := &fakeAddressService{
+		ListFn: func(ctx context.Context, customerID string) ([]address.AddressResponse, error) {
+			assert.Equal(t, "cust-1", customerID, "customer diambil dari token, bukan path")
+			return []address.AddressResponse{{ID: "addr-1"}}, nil
+		},
+	}
+	requireCustomer := auth.RequireCustomer(func(ctx context.Context, token string) (string, error) {
+		return "cust-1", nil
+	})
+
+	r := setupTestRouter()
+	address.RegisterMeRoutes(r.Group(""), address.NewHandler(svc), requireCustomer)
+
+	t.Run("requires_token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/me/addresses", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("lists_own_addresses", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/me/addresses", nil)
+		req.Header.Set("Authorization", "Bearer token-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"id":"addr-1"`)
+	})
+}
@@ -0,0 +1,82 @@
+package address
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=address_repo.go -destination=mocks/address_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	CustomerExists(ctx context.Context, customerID string) (bool, error)
+	// LockCustomer mengunci baris customer (SELECT ... FOR UPDATE) selama transaksi
+	LockCustomer(ctx context.Context, customerID string) error
+
+	List(ctx context.Context, customerID string) ([]dbgen.CustomerAddress, error)
+	Get(ctx context.Context, params dbgen.GetCustomerAddressParams) (dbgen.CustomerAddress, error)
+	Count(ctx context.Context, customerID string) (int64, error)
+	Create(ctx context.Context, params dbgen.CreateCustomerAddressParams) error
+	Update(ctx context.Context, params dbgen.UpdateCustomerAddressParams) error
+	Delete(ctx context.Context, params dbgen.DeleteCustomerAddressParams) (int64, error)
+	ClearDefault(ctx context.Context, customerID string) error
+	PromoteDefault(ctx context.Context, customerID string) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{q: r.q.WithTx(sqlTx)}
+	}
+	return r
+}
+
+func (r *repository) CustomerExists(ctx context.Context, customerID string) (bool, error) {
+	return r.q.CustomerExists(ctx, customerID)
+}
+
+func (r *repository) LockCustomer(ctx context.Context, customerID string) error {
+	_, err := r.q.LockCustomer(ctx, customerID)
+	return err
+}
+
+func (r *repository) List(ctx context.Context, customerID string) ([]dbgen.CustomerAddress, error) {
+	return r.q.ListCustomerAddresses(ctx, customerID)
+}
+
+func (r *repository) Get(ctx context.Context, params dbgen.GetCustomerAddressParams) (dbgen.CustomerAddress, error) {
+	return r.q.GetCustomerAddress(ctx, params)
+}
+
+func (r *repository) Count(ctx context.Context, customerID string) (int64, error) {
+	return r.q.CountCustomerAddresses(ctx, customerID)
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateCustomerAddressParams) error {
+	return r.q.CreateCustomerAddress(ctx, params)
+}
+
+func (r *repository) Update(ctx context.Context, params dbgen.UpdateCustomerAddressParams) error {
+	return r.q.UpdateCustomerAddress(ctx, params)
+}
+
+func (r *repository) Delete(ctx context.Context, params dbgen.DeleteCustomerAddressParams) (int64, error) {
+	return r.q.DeleteCustomerAddress(ctx, params)
+}
+
+func (r *repository) ClearDefault(ctx context.Context, customerID string) error {
+	return r.q.ClearDefaultCustomerAddress(ctx, customerID)
+}
+
+func (r *repository) PromoteDefault(ctx context.Context, customerID string) error {
+	return r.q.PromoteDefaultCustomerAddress(ctx, customerID)
+}
@@ -0,0 +1,24 @@
+package address
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	// Sub-resource customer: alamat pengiriman
+	addresses := r.Group("/customers/:id/addresses")
+	registerAddressRoutes(addresses, handler)
+}
+
+// RegisterMeRoutes: alamat milik customer yang sedang login (/me/addresses)
+func RegisterMeRoutes(r *gin.RouterGroup, handler *Handler, requireCustomer gin.HandlerFunc) {
+	addresses := r.Group("/me/addresses", requireCustomer)
+	registerAddressRoutes(addresses, handler)
+}
+
+func registerAddressRoutes(addresses *gin.RouterGroup, handler *Handler) {
+	addresses.GET("", handler.List)
+	addresses.POST("", handler.Create)
+	addresses.GET("/:address_id", handler.GetByID)
+	addresses.PUT("/:address_id", handler.Update)
+	addresses.DELETE("/:address_id", handler.Delete)
+	addresses.PUT("/:address_id/default", handler.SetDefault)
+}
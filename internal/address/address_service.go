@@ -0,0 +1,328 @@
+package address
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=address_service.go -destination=mocks/address_service_mock.go -package=mock
+
+type Service interface {
+	List(ctx context.Context, customerID string) ([]AddressResponse, error)
+	GetByID(ctx context.Context, customerID, id string) (AddressResponse, error)
+	Create(ctx context.Context, customerID string, req AddressRequest) (AddressResponse, error)
+	Update(ctx context.Context, customerID, id string, req AddressRequest) (AddressResponse, error)
+	Delete(ctx context.Context, customerID, id string) error
+	SetDefault(ctx context.Context, customerID, id string) (AddressResponse, error)
+}
+
+// MaxAddressesPerCustomer membatasi jumlah alamat tersimpan per customer
+const MaxAddressesPerCustomer = 20
+
+type service struct {
+	db   *sql.DB // Perubahan default melibatkan beberapa baris, jadi dijalankan dalam transaksi
+	repo Repository
+}
+
+func NewService(db *sql.DB, repo Repository) Service {
+	return &service{db: db, repo: repo}
+}
+
+func (s *service) List(ctx context.Context, customerID string) ([]AddressResponse, error) {
+	exists, err := s.repo.CustomerExists(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrCustomerNotFound
+	}
+
+	rows, err := s.repo.List(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]AddressResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, mapToResponse(row))
+	}
+	return res, nil
+}
+
+func (s *service) GetByID(ctx context.Context, customerID, id string) (AddressResponse, error) {
+	row, err := getAddress(ctx, s.repo, customerID, id)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	return mapToResponse(row), nil
+}
+
+func (s *service) Create(ctx context.Context, customerID string, req AddressRequest) (AddressResponse, error) {
+	addr, err := Normalize(req.Address)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	now := time.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := lockCustomer(ctx, txRepo, customerID); err != nil {
+		return AddressResponse{}, err
+	}
+
+	count, err := txRepo.Count(ctx, customerID)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	if count >= MaxAddressesPerCustomer {
+		return AddressResponse{}, fmt.Errorf("%w: a customer can store at most %d addresses", ErrAddressLimitReached, MaxAddressesPerCustomer)
+	}
+
+	// Alamat pertama otomatis menjadi default
+	isDefault := req.IsDefault || count == 0
+	if isDefault && count > 0 {
+		if err := txRepo.ClearDefault(ctx, customerID); err != nil {
+			return AddressResponse{}, err
+		}
+	}
+
+	params := dbgen.CreateCustomerAddressParams{
+		ID:            newUUID.String(),
+		CustomerID:    customerID,
+		Label:         collapseSpaces(req.Label),
+		RecipientName: addr.RecipientName,
+		Phone:         addr.Phone,
+		Street:        addr.Street,
+		City:          addr.City,
+		Province:      addr.Province,
+		PostalCode:    addr.PostalCode,
+		IsDefault:     isDefault,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := txRepo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return AddressResponse{}, ErrLabelAlreadyExists
+		}
+		return AddressResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AddressResponse{}, err
+	}
+
+	return mapToResponse(dbgen.CustomerAddress(params)), nil
+}
+
+// Update mengganti seluruh isi alamat. Default tidak bisa dicabut lewat is_default false;
+// jadikan alamat lain default untuk memindahkannya.
+func (s *service) Update(ctx context.Context, customerID, id string, req AddressRequest) (AddressResponse, error) {
+	addr, err := Normalize(req.Address)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := lockCustomer(ctx, txRepo, customerID); err != nil {
+		return AddressResponse{}, err
+	}
+
+	current, err := getAddress(ctx, txRepo, customerID, id)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+
+	if req.IsDefault && !current.IsDefault {
+		if err := txRepo.ClearDefault(ctx, customerID); err != nil {
+			return AddressResponse{}, err
+		}
+	}
+
+	params := dbgen.UpdateCustomerAddressParams{
+		Label:         collapseSpaces(req.Label),
+		RecipientName: addr.RecipientName,
+		Phone:         addr.Phone,
+		Street:        addr.Street,
+		City:          addr.City,
+		Province:      addr.Province,
+		PostalCode:    addr.PostalCode,
+		IsDefault:     req.IsDefault || current.IsDefault,
+		UpdatedAt:     time.Now(),
+		ID:            id,
+		CustomerID:    customerID,
+	}
+	if err := txRepo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return AddressResponse{}, ErrLabelAlreadyExists
+		}
+		return AddressResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AddressResponse{}, err
+	}
+
+	return mapToResponse(dbgen.CustomerAddress{
+		ID:            id,
+		CustomerID:    customerID,
+		Label:         params.Label,
+		RecipientName: params.RecipientName,
+		Phone:         params.Phone,
+		Street:        params.Street,
+		City:          params.City,
+		Province:      params.Province,
+		PostalCode:    params.PostalCode,
+		IsDefault:     params.IsDefault,
+		CreatedAt:     current.CreatedAt,
+		UpdatedAt:     params.UpdatedAt,
+	}), nil
+}
+
+// Delete menghapus alamat; jika yang dihapus alamat default, alamat tertua menjadi default.
+// Order lama tidak terpengaruh karena alamatnya disalin saat order dibuat.
+func (s *service) Delete(ctx context.Context, customerID, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := lockCustomer(ctx, txRepo, customerID); err != nil {
+		return err
+	}
+
+	current, err := getAddress(ctx, txRepo, customerID, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := txRepo.Delete(ctx, dbgen.DeleteCustomerAddressParams{ID: id, CustomerID: customerID}); err != nil {
+		return err
+	}
+
+	if current.IsDefault {
+		if err := txRepo.PromoteDefault(ctx, customerID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *service) SetDefault(ctx context.Context, customerID, id string) (AddressResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := lockCustomer(ctx, txRepo, customerID); err != nil {
+		return AddressResponse{}, err
+	}
+
+	current, err := getAddress(ctx, txRepo, customerID, id)
+	if err != nil {
+		return AddressResponse{}, err
+	}
+	if current.IsDefault {
+		return mapToResponse(current), nil
+	}
+
+	if err := txRepo.ClearDefault(ctx, customerID); err != nil {
+		return AddressResponse{}, err
+	}
+
+	current.IsDefault = true
+	current.UpdatedAt = time.Now()
+	if err := txRepo.Update(ctx, dbgen.UpdateCustomerAddressParams{
+		Label:         current.Label,
+		RecipientName: current.RecipientName,
+		Phone:         current.Phone,
+		Street:        current.Street,
+		City:          current.City,
+		Province:      current.Province,
+		PostalCode:    current.PostalCode,
+		IsDefault:     true,
+		UpdatedAt:     current.UpdatedAt,
+		ID:            id,
+		CustomerID:    customerID,
+	}); err != nil {
+		return AddressResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AddressResponse{}, err
+	}
+	return mapToResponse(current), nil
+}
+
+// lockCustomer menyerialkan perubahan alamat per customer agar tidak ada dua alamat default
+func lockCustomer(ctx context.Context, repo Repository, customerID string) error {
+	if err := repo.LockCustomer(ctx, customerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCustomerNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func getAddress(ctx context.Context, repo Repository, customerID, id string) (dbgen.CustomerAddress, error) {
+	row, err := repo.Get(ctx, dbgen.GetCustomerAddressParams{ID: id, CustomerID: customerID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.CustomerAddress{}, ErrAddressNotFound
+		}
+		return dbgen.CustomerAddress{}, err
+	}
+	return row, nil
+}
+
+// FromModel mengubah baris customer_addresses menjadi Address
+func FromModel(row dbgen.CustomerAddress) Address {
+	return Address{
+		RecipientName: row.RecipientName,
+		Phone:         row.Phone,
+		Street:        row.Street,
+		City:          row.City,
+		Province:      row.Province,
+		PostalCode:    row.PostalCode,
+	}
+}
+
+func mapToResponse(row dbgen.CustomerAddress) AddressResponse {
+	return AddressResponse{
+		ID:         row.ID,
+		CustomerID: row.CustomerID,
+		Label:      row.Label,
+		Address:    FromModel(row),
+		IsDefault:  row.IsDefault,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
@@ -0,0 +1,287 @@
+package address_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockAddress "assignment-ptes-achmad-rifai/internal/address/mocks"
+)
+
+func setupServiceTest(t *testing.T) (address.Service, *mockAddress.MockRepository, sqlmock.Sqlmock) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockAddress.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	return address.NewService(db, repo), repo, mock
+}
+
+func addressRow(id string, isDefault bool) dbgen.CustomerAddress {
+	return dbgen.CustomerAddress{
+		ID:            id,
+		CustomerID:    "cust-1",
+		Label:         "Rumah",
+		RecipientName: "Budi Santoso",
+		Phone:         "+6281234567890",
+		Street:        "Jl. Merdeka No. 1",
+		City:          "Bandung",
+		Province:      "Jawa Barat",
+		PostalCode:    "40111",
+		IsDefault:     isDefault,
+		CreatedAt:     time.Now().Add(-time.Hour),
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	req := address.AddressRequest{Label: "Rumah", Address: validAddress()}
+
+	t.Run("first_address_becomes_default", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Count(gomock.Any(), "cust-1").Return(int64(0), nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateCustomerAddressParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerAddressParams) error {
+				assert.True(t, p.IsDefault)
+				assert.Equal(t, "+6281234567890", p.Phone)
+				return nil
+			})
+
+		res, err := svc.Create(ctx, "cust-1", req)
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsDefault)
+		assert.NotEmpty(t, res.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("new_default_replaces_previous", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		req := req
+		req.IsDefault = true
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Count(gomock.Any(), "cust-1").Return(int64(2), nil)
+		clear := repo.EXPECT().ClearDefault(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).After(clear)
+
+		res, err := svc.Create(ctx, "cust-1", req)
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsDefault)
+	})
+
+	t.Run("non_default_keeps_existing_default", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Count(gomock.Any(), "cust-1").Return(int64(1), nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := svc.Create(ctx, "cust-1", req)
+
+		assert.NoError(t, err)
+		assert.False(t, res.IsDefault)
+	})
+
+	t.Run("error_invalid_address", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		invalid := req
+		invalid.Province = "Atlantis"
+
+		_, err := svc.Create(ctx, "cust-1", invalid)
+
+		assert.ErrorIs(t, err, address.ErrInvalidAddress)
+	})
+
+	t.Run("error_customer_not_found", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(sql.ErrNoRows)
+
+		_, err := svc.Create(ctx, "cust-1", req)
+
+		assert.ErrorIs(t, err, address.ErrCustomerNotFound)
+	})
+
+	t.Run("error_limit_reached", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Count(gomock.Any(), "cust-1").Return(int64(address.MaxAddressesPerCustomer), nil)
+
+		_, err := svc.Create(ctx, "cust-1", req)
+
+		assert.ErrorIs(t, err, address.ErrAddressLimitReached)
+	})
+
+	t.Run("error_duplicate_label", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Count(gomock.Any(), "cust-1").Return(int64(1), nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+
+		_, err := svc.Create(ctx, "cust-1", req)
+
+		assert.ErrorIs(t, err, address.ErrLabelAlreadyExists)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default_cannot_be_unset", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Get(gomock.Any(), dbgen.GetCustomerAddressParams{ID: "addr-1", CustomerID: "cust-1"}).Return(addressRow("addr-1", true), nil)
+		repo.EXPECT().
+			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCustomerAddressParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateCustomerAddressParams) error {
+				assert.True(t, p.IsDefault)
+				assert.Equal(t, "Kantor", p.Label)
+				return nil
+			})
+
+		res, err := svc.Update(ctx, "cust-1", "addr-1", address.AddressRequest{Label: "Kantor", Address: validAddress()})
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsDefault)
+	})
+
+	t.Run("error_address_of_another_customer", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+		repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(dbgen.CustomerAddress{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, "cust-1", "addr-x", address.AddressRequest{Label: "Kantor", Address: validAddress()})
+
+		assert.ErrorIs(t, err, address.ErrAddressNotFound)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name      string
+		isDefault bool
+	}{
+		{"default_address_promotes_oldest", true},
+		{"other_address", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, repo, mock := setupServiceTest(t)
+
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+
+			repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+			repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(addressRow("addr-1", tc.isDefault), nil)
+			del := repo.EXPECT().Delete(gomock.Any(), dbgen.DeleteCustomerAddressParams{ID: "addr-1", CustomerID: "cust-1"}).Return(int64(1), nil)
+			if tc.isDefault {
+				repo.EXPECT().PromoteDefault(gomock.Any(), "cust-1").Return(nil).After(del)
+			}
+
+			assert.NoError(t, svc.Delete(ctx, "cust-1", "addr-1"))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestService_SetDefault(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, mock := setupServiceTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	repo.EXPECT().LockCustomer(gomock.Any(), "cust-1").Return(nil)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(addressRow("addr-2", false), nil)
+	clear := repo.EXPECT().ClearDefault(gomock.Any(), "cust-1").Return(nil)
+	repo.EXPECT().
+		Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCustomerAddressParams{})).
+		DoAndReturn(func(_ context.Context, p dbgen.UpdateCustomerAddressParams) error {
+			assert.True(t, p.IsDefault)
+			assert.Equal(t, "Jl. Merdeka No. 1", p.Street)
+			return nil
+		}).
+		After(clear)
+
+	res, err := svc.SetDefault(ctx, "cust-1", "addr-2")
+
+	assert.NoError(t, err)
+	assert.True(t, res.IsDefault)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("customer_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(gomock.Any(), "cust-x").Return(false, nil)
+
+		_, err := svc.List(ctx, "cust-x")
+
+		assert.ErrorIs(t, err, address.ErrCustomerNotFound)
+	})
+
+	t.Run("empty_list", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(true, nil)
+		repo.EXPECT().List(gomock.Any(), "cust-1").Return(nil, nil)
+
+		res, err := svc.List(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Empty(t, res)
+	})
+}
@@ -0,0 +1,148 @@
+package address
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Provinces adalah 38 provinsi Indonesia dengan penulisan baku yang disimpan
+var Provinces = []string{
+	"Aceh",
+	"Sumatera Utara",
+	"Sumatera Barat",
+	"Riau",
+	"Kepulauan Riau",
+	"Jambi",
+	"Sumatera Selatan",
+	"Kepulauan Bangka Belitung",
+	"Bengkulu",
+	"Lampung",
+	"DKI Jakarta",
+	"Jawa Barat",
+	"Banten",
+	"Jawa Tengah",
+	"DI Yogyakarta",
+	"Jawa Timur",
+	"Bali",
+	"Nusa Tenggara Barat",
+	"Nusa Tenggara Timur",
+	"Kalimantan Barat",
+	"Kalimantan Tengah",
+	"Kalimantan Selatan",
+	"Kalimantan Timur",
+	"Kalimantan Utara",
+	"Sulawesi Utara",
+	"Gorontalo",
+	"Sulawesi Tengah",
+	"Sulawesi Barat",
+	"Sulawesi Selatan",
+	"Sulawesi Tenggara",
+	"Maluku",
+	"Maluku Utara",
+	"Papua",
+	"Papua Barat",
+	"Papua Barat Daya",
+	"Papua Selatan",
+	"Papua Tengah",
+	"Papua Pegunungan",
+}
+
+// provinceAliases: penulisan lain yang umum dipakai customer
+var provinceAliases = map[string]string{
+	"jakarta":                       "DKI Jakarta",
+	"dki":                           "DKI Jakarta",
+	"yogyakarta":                    "DI Yogyakarta",
+	"diy":                           "DI Yogyakarta",
+	"daerah istimewa yogyakarta":    "DI Yogyakarta",
+	"bangka belitung":               "Kepulauan Bangka Belitung",
+	"babel":                         "Kepulauan Bangka Belitung",
+	"nad":                           "Aceh",
+	"nanggroe aceh darussalam":      "Aceh",
+	"ntb":                           "Nusa Tenggara Barat",
+	"ntt":                           "Nusa Tenggara Timur",
+	"daerah khusus ibukota jakarta": "DKI Jakarta",
+}
+
+var provinceIndex = func() map[string]string {
+	idx := make(map[string]string, len(Provinces)+len(provinceAliases))
+	for _, p := range Provinces {
+		idx[strings.ToLower(p)] = p
+	}
+	for alias, p := range provinceAliases {
+		idx[alias] = p
+	}
+	return idx
+}()
+
+var (
+	// Kode pos Indonesia: 5 digit, 10110 - 99999
+	postalCodePattern = regexp.MustCompile(`^[1-9][0-9]{4}$`)
+	// Nomor nasional tanpa awalan 0 / 62, mis. 81234567890 atau 215551234
+	phonePattern = regexp.MustCompile(`^[1-9][0-9]{7,12}$`)
+)
+
+// Normalize memvalidasi alamat dan mengembalikan bentuk bakunya: spasi dirapikan,
+// provinsi memakai penulisan di Provinces dan telepon dalam format +62.
+// Error dibungkus ErrInvalidAddress.
+func Normalize(a Address) (Address, error) {
+	a.RecipientName = collapseSpaces(a.RecipientName)
+	a.Street = collapseSpaces(a.Street)
+	a.City = collapseSpaces(a.City)
+	a.PostalCode = strings.TrimSpace(a.PostalCode)
+
+	// binding "required" meloloskan isi yang hanya spasi
+	for _, f := range []struct{ name, value string }{
+		{"recipient_name", a.RecipientName},
+		{"street", a.Street},
+		{"city", a.City},
+	} {
+		if f.value == "" {
+			return Address{}, fmt.Errorf("%w: %s is required", ErrInvalidAddress, f.name)
+		}
+	}
+
+	province, ok := provinceIndex[strings.ToLower(collapseSpaces(a.Province))]
+	if !ok {
+		return Address{}, fmt.Errorf("%w: %q is not an Indonesian province", ErrInvalidAddress, a.Province)
+	}
+	a.Province = province
+
+	if !postalCodePattern.MatchString(a.PostalCode) {
+		return Address{}, fmt.Errorf("%w: postal code must be 5 digits", ErrInvalidAddress)
+	}
+
+	phone, err := normalizePhone(a.Phone)
+	if err != nil {
+		return Address{}, err
+	}
+	a.Phone = phone
+
+	return a, nil
+}
+
+// normalizePhone menerima 08xx, 628xx atau +628xx (boleh dengan spasi / tanda hubung)
+// dan mengembalikan +62xx
+func normalizePhone(phone string) (string, error) {
+	digits := strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "").Replace(strings.TrimSpace(phone))
+
+	switch {
+	case strings.HasPrefix(digits, "+62"):
+		digits = digits[3:]
+	case strings.HasPrefix(digits, "62"):
+		digits = digits[2:]
+	case strings.HasPrefix(digits, "0"):
+		digits = digits[1:]
+	default:
+		return "", fmt.Errorf("%w: phone must start with 0, 62 or +62", ErrInvalidAddress)
+	}
+
+	if !phonePattern.MatchString(digits) {
+		return "", fmt.Errorf("%w: invalid phone number", ErrInvalidAddress)
+	}
+	return "+62" + digits, nil
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,79 @@
+package address_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validAddress() address.Address {
+	return address.Address{
+		RecipientName: "Budi Santoso",
+		Phone:         "081234567890",
+		Street:        "Jl. Merdeka No. 1",
+		City:          "Bandung",
+		Province:      "Jawa Barat",
+		PostalCode:    "40111",
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("normalizes_province_phone_and_spaces", func(t *testing.T) {
+		in := validAddress()
+		in.RecipientName = "  Budi   Santoso "
+		in.Province = "dki  jakarta"
+		in.Phone = "+62 812-3456-7890"
+
+		got, err := address.Normalize(in)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Budi Santoso", got.RecipientName)
+		assert.Equal(t, "DKI Jakarta", got.Province)
+		assert.Equal(t, "+6281234567890", got.Phone)
+	})
+
+	t.Run("province_aliases", func(t *testing.T) {
+		for alias, want := range map[string]string{
+			"Jakarta":                    "DKI Jakarta",
+			"Daerah Istimewa Yogyakarta": "DI Yogyakarta",
+			"NTB":                        "Nusa Tenggara Barat",
+			"papua barat daya":           "Papua Barat Daya",
+		} {
+			in := validAddress()
+			in.Province = alias
+
+			got, err := address.Normalize(in)
+
+			assert.NoError(t, err, alias)
+			assert.Equal(t, want, got.Province)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		modify func(a *address.Address)
+	}{
+		{"unknown province", func(a *address.Address) { a.Province = "Jawa Utara" }},
+		{"postal code too short", func(a *address.Address) { a.PostalCode = "4011" }},
+		{"postal code with letters", func(a *address.Address) { a.PostalCode = "40A11" }},
+		{"postal code starting with zero", func(a *address.Address) { a.PostalCode = "04011" }},
+		{"phone without country or trunk prefix", func(a *address.Address) { a.Phone = "81234567890" }},
+		{"phone too short", func(a *address.Address) { a.Phone = "0812" }},
+		{"blank street", func(a *address.Address) { a.Street = "   " }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := validAddress()
+			tc.modify(&in)
+
+			_, err := address.Normalize(in)
+
+			assert.ErrorIs(t, err, address.ErrInvalidAddress)
+		})
+	}
+}
+
+func TestProvinces(t *testing.T) {
+	assert.Len(t, address.Provinces, 38)
+}
@@ -0,0 +1,62 @@
+package bootstrap
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ConnectDBWithRetry membuka koneksi MySQL dan mencoba ulang setiap 5 detik
+func ConnectDBWithRetry(dsn string, maxRetries int) (*sql.DB, error) {
+	var db *sql.DB
+	var err error
+
+	for i := 1; i <= maxRetries; i++ {
+		db, err = sql.Open("mysql", dsn)
+		if err == nil {
+			err = db.Ping()
+			if err == nil {
+				log.Println("✅ Successfully connected to MySQL database")
+				return db, nil
+			}
+		}
+
+		log.Printf("⚠️  MySQL connection attempt %d/%d failed: %v", i, maxRetries, err)
+
+		if i < maxRetries {
+			time.Sleep(time.Second * 5)
+		}
+	}
+
+	return nil, err
+}
+
+// ConnectRedisWithRetry membuat client Redis dan mencoba ulang setiap 5 detik
+func ConnectRedisWithRetry(addr string, maxRetries int) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: "",
+		DB:       0,
+	})
+
+	for i := 1; i <= maxRetries; i++ {
+		ctx := context.Background()
+		_, err := rdb.Ping(ctx).Result()
+		if err == nil {
+			log.Println("✅ Successfully connected to Redis")
+			return rdb, nil
+		}
+
+		log.Printf("⚠️  Redis connection attempt %d/%d failed: %v", i, maxRetries, err)
+
+		if i < maxRetries {
+			time.Sleep(time.Second * 5)
+		}
+	}
+
+	return nil, fmt.Errorf("failed to connect to Redis after %d attempts", maxRetries)
+}
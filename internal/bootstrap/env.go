@@ -0,0 +1,24 @@
+package bootstrap
+
+import (
+	"log"
+	"os"
+)
+
+// IsDevelopment melaporkan apakah proses berjalan dengan APP_ENV=development
+func IsDevelopment() bool {
+	return os.Getenv("APP_ENV") == "development"
+}
+
+// RequireSecret membaca secret dari environment. Secret kosong hanya diizinkan saat development
+// (memakai devFallback yang tidak aman); di environment lain proses langsung berhenti.
+func RequireSecret(key, devFallback string) string {
+	if secret := os.Getenv(key); secret != "" {
+		return secret
+	}
+	if !IsDevelopment() {
+		log.Fatalf("❌ %s is required (set APP_ENV=development to use an insecure development secret)", key)
+	}
+	log.Printf("⚠️  %s is empty, using an insecure development secret", key)
+	return devFallback
+}
@@ -40,9 +40,7 @@ func StartHTTPServer(
 		}
 	}()
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	sig := <-quit
+	sig := WaitForShutdownSignal()
 
 	log.Println("🛑 Shutdown signal received:", sig.String())
 
@@ -64,3 +62,11 @@ func StartHTTPServer(
 		log.Println("✅ Server exited gracefully")
 	}
 }
+
+// WaitForShutdownSignal memblok sampai SIGINT atau SIGTERM diterima
+func WaitForShutdownSignal() os.Signal {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+	return <-quit
+}
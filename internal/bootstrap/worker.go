@@ -0,0 +1,55 @@
+package bootstrap
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// BackgroundWorker dipenuhi worker yang berjalan sampai Shutdown dipanggil (mis. jobs.Worker)
+type BackgroundWorker interface {
+	Run()
+	Shutdown(ctx context.Context) error
+}
+
+type WorkerConfig struct {
+	// DrainTimeout adalah batas waktu menunggu job yang sedang berjalan saat shutdown
+	DrainTimeout time.Duration
+}
+
+// StartWorker menjalankan worker dengan graceful drain, memakai penanganan sinyal yang sama dengan StartHTTPServer
+func StartWorker(
+	worker BackgroundWorker,
+	cfg WorkerConfig,
+	auditLogger AuditLogger,
+) {
+	if cfg.DrainTimeout <= 0 {
+		cfg.DrainTimeout = 30 * time.Second
+	}
+
+	go func() {
+		log.Println("🚀 Worker running")
+		worker.Run()
+	}()
+
+	sig := WaitForShutdownSignal()
+
+	log.Println("🛑 Shutdown signal received:", sig.String())
+
+	auditLogger.Log(context.Background(), AuditLog{
+		Action:  "WORKER_SHUTDOWN",
+		Message: "Worker is draining running jobs",
+		Meta: map[string]any{
+			"signal": sig.String(),
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DrainTimeout)
+	defer cancel()
+
+	if err := worker.Shutdown(ctx); err != nil {
+		log.Println("❌ Forced shutdown, running jobs were cancelled:", err)
+	} else {
+		log.Println("✅ Worker drained gracefully")
+	}
+}
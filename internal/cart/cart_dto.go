@@ -0,0 +1,55 @@
+package cart
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"time"
+)
+
+type AddItemRequest struct {
+	ProductID string  `json:"product_id" binding:"required"`
+	VariantID *string `json:"variant_id"`
+	Quantity  int     `json:"quantity" binding:"required,gt=0,lte=1000"`
+}
+
+type UpdateItemRequest struct {
+	Quantity int `json:"quantity" binding:"required,gt=0,lte=1000"`
+}
+
+// CheckoutRequest: tujuan pengiriman sama seperti CreateOrderRequest, alamat tersimpan atau inline.
+// shipping_method_id diambil dari hasil POST /shipping/quote.
+type CheckoutRequest struct {
+	CouponCode        *string          `json:"coupon_code" binding:"omitempty,max=64"`
+	ShippingAddressID *string          `json:"shipping_address_id"`
+	ShippingAddress   *address.Address `json:"shipping_address"`
+	ShippingMethodID  *string          `json:"shipping_method_id"`
+}
+
+// Kode masalah item keranjang, diisi saat validasi terhadap produk & stok terkini
+const (
+	IssueProductUnavailable = "product_unavailable"
+	IssueVariantUnavailable = "variant_unavailable"
+	IssueVariantRequired    = "variant_required"
+	IssueInsufficientStock  = "insufficient_stock"
+)
+
+type CartItemResponse struct {
+	ID             string   `json:"id"` // Dipakai di /cart/items/:item_id
+	ProductID      string   `json:"product_id"`
+	VariantID      string   `json:"variant_id,omitempty"`
+	ProductName    string   `json:"product_name,omitempty"`
+	Sku            string   `json:"sku,omitempty"`
+	Quantity       int      `json:"quantity"`
+	UnitPrice      float64  `json:"unit_price"` // Harga terkini, bukan harga saat item ditambahkan
+	LineTotal      float64  `json:"line_total"`
+	AvailableStock int32    `json:"available_stock"`
+	Issues         []string `json:"issues,omitempty"`
+}
+
+type CartResponse struct {
+	CustomerID    string             `json:"customer_id"`
+	Items         []CartItemResponse `json:"items"`
+	TotalQuantity int                `json:"total_quantity"`
+	Subtotal      float64            `json:"subtotal"` // Hanya item tanpa masalah
+	IsValid       bool               `json:"is_valid"` // true jika keranjang bisa di-checkout
+	ExpiresAt     *time.Time         `json:"expires_at,omitempty"`
+}
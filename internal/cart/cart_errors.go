@@ -0,0 +1,13 @@
+package cart
+
+import "errors"
+
+var (
+	ErrCustomerNotFound   = errors.New("customer not found")
+	ErrProductNotFound    = errors.New("product not found")
+	ErrVariantRequired    = errors.New("variant_id is required for products with variants")
+	ErrItemNotFound       = errors.New("cart item not found")
+	ErrCartEmpty          = errors.New("cart is empty")
+	ErrCartInvalid        = errors.New("cart contains unavailable items")
+	ErrCheckoutInProgress = errors.New("checkout already in progress for this cart")
+)
@@ -0,0 +1,190 @@
+package cart
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Get godoc
+// @Summary      Get cart
+// @Description  Retrieve the customer's cart. Every item is validated against the live product price and stock; problems are reported per item in `issues`
+// @Tags         cart
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  CartResponse
+// @Router       /customers/{id}/cart [get]
+func (h *Handler) Get(c *gin.Context) {
+	res, err := h.service.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get cart")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// AddItem godoc
+// @Summary      Add item to cart
+// @Description  Add a product (optionally a variant) to the cart; adding an existing item increases its quantity
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string          true  "Customer ID"
+// @Param        request  body      AddItemRequest  true  "Cart Item"
+// @Success      200      {object}  CartResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Customer or product not found"
+// @Router       /customers/{id}/cart/items [post]
+func (h *Handler) AddItem(c *gin.Context) {
+	var req AddItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.AddItem(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "ADD_ERROR", "Failed to add item to cart")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// UpdateItem godoc
+// @Summary      Update cart item quantity
+// @Description  Replace the quantity of an item in the cart
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string             true  "Customer ID"
+// @Param        item_id  path      string             true  "Cart item ID"
+// @Param        request  body      UpdateItemRequest  true  "Quantity"
+// @Success      200      {object}  CartResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Router       /customers/{id}/cart/items/{item_id} [put]
+func (h *Handler) UpdateItem(c *gin.Context) {
+	var req UpdateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.UpdateItem(c.Request.Context(), c.Param("id"), c.Param("item_id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update cart item")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// RemoveItem godoc
+// @Summary      Remove cart item
+// @Description  Remove an item from the cart
+// @Tags         cart
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Param        item_id  path      string  true  "Cart item ID"
+// @Success      200      {object}  CartResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /customers/{id}/cart/items/{item_id} [delete]
+func (h *Handler) RemoveItem(c *gin.Context) {
+	res, err := h.service.RemoveItem(c.Request.Context(), c.Param("id"), c.Param("item_id"))
+	if err != nil {
+		handleError(c, err, "REMOVE_ERROR", "Failed to remove cart item")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Clear godoc
+// @Summary      Clear cart
+// @Description  Remove all items from the cart
+// @Tags         cart
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  nil
+// @Router       /customers/{id}/cart [delete]
+func (h *Handler) Clear(c *gin.Context) {
+	if err := h.service.Clear(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err, "CLEAR_ERROR", "Failed to clear cart")
+		return
+	}
+	response.Success(c, http.StatusOK, "Cart cleared successfully", nil)
+}
+
+// Checkout godoc
+// @Summary      Checkout cart
+// @Description  Convert the cart into an order at live prices (optionally with a coupon, a shipping address and a shipping method) and clear the cart
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string           true   "Customer ID"
+// @Param        request  body      CheckoutRequest  false  "Checkout options"
+// @Success      201      {object}  order.OrderResponse
+// @Failure      400      {object}  map[string]string "Empty cart, invalid coupon, invalid address or shipping method not available"
+// @Failure      409      {object}  map[string]string "Unavailable items, insufficient stock, inactive variant, price changed during checkout or checkout in progress"
+// @Router       /customers/{id}/cart/checkout [post]
+func (h *Handler) Checkout(c *gin.Context) {
+	var req CheckoutRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	res, err := h.service.Checkout(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "CHECKOUT_ERROR", "Failed to checkout cart")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrCustomerNotFound), errors.Is(err, ErrProductNotFound),
+		errors.Is(err, ErrItemNotFound), errors.Is(err, order.ErrProductNotFound), errors.Is(err, order.ErrVariantNotFound),
+		errors.Is(err, address.ErrAddressNotFound), errors.Is(err, shipping.ErrMethodNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, address.ErrInvalidAddress):
+		response.Error(c, http.StatusBadRequest, "INVALID_ADDRESS", err.Error(), nil)
+	case errors.Is(err, shipping.ErrMethodUnavailable):
+		response.Error(c, http.StatusBadRequest, "SHIPPING_UNAVAILABLE", err.Error(), nil)
+	case errors.Is(err, ErrVariantRequired), errors.Is(err, order.ErrVariantRequired):
+		response.Error(c, http.StatusBadRequest, "VARIANT_REQUIRED", err.Error(), nil)
+	case errors.Is(err, ErrCartEmpty):
+		response.Error(c, http.StatusBadRequest, "CART_EMPTY", err.Error(), nil)
+	case errors.Is(err, promotion.ErrInvalidCoupon):
+		response.Error(c, http.StatusBadRequest, "INVALID_COUPON", err.Error(), nil)
+	case errors.Is(err, ErrCartInvalid):
+		response.Error(c, http.StatusConflict, "CART_INVALID", err.Error(), nil)
+	case errors.Is(err, order.ErrPriceMismatch):
+		response.Error(c, http.StatusConflict, "PRICE_MISMATCH", err.Error(), nil)
+	case errors.Is(err, order.ErrInsufficientStock):
+		response.Error(c, http.StatusConflict, "INSUFFICIENT_STOCK", err.Error(), nil)
+	case errors.Is(err, order.ErrVariantInactive):
+		response.Error(c, http.StatusConflict, "VARIANT_INACTIVE", err.Error(), nil)
+	case errors.Is(err, promotion.ErrCouponLimitReached):
+		response.Error(c, http.StatusConflict, "COUPON_LIMIT_REACHED", err.Error(), nil)
+	case errors.Is(err, ErrCheckoutInProgress):
+		response.Error(c, http.StatusConflict, "CHECKOUT_IN_PROGRESS", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,126 @@
+package cart_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/cart"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeCartService struct {
+	GetFn        func(ctx context.Context, customerID string) (cart.CartResponse, error)
+	AddItemFn    func(ctx context.Context, customerID string, req cart.AddItemRequest) (cart.CartResponse, error)
+	UpdateItemFn func(ctx context.Context, customerID, itemID string, req cart.UpdateItemRequest) (cart.CartResponse, error)
+	RemoveItemFn func(ctx context.Context, customerID, itemID string) (cart.CartResponse, error)
+	ClearFn      func(ctx context.Context, customerID string) error
+	CheckoutFn   func(ctx context.Context, customerID string, req cart.CheckoutRequest) (order.OrderResponse, error)
+}
+
+func (f *fakeCartService) Get(ctx context.Context, customerID string) (cart.CartResponse, error) {
+	return f.GetFn(ctx, customerID)
+}
+
+func (f *fakeCartService) AddItem(ctx context.Context, customerID string, req cart.AddItemRequest) (cart.CartResponse, error) {
+	return f.AddItemFn(ctx, customerID, req)
+}
+
+func (f *fakeCartService) UpdateItem(ctx context.Context, customerID, itemID string, req cart.UpdateItemRequest) (cart.CartResponse, error) {
+	return f.UpdateItemFn(ctx, customerID, itemID, req)
+}
+
+func (f *fakeCartService) RemoveItem(ctx context.Context, customerID, itemID string) (cart.CartResponse, error) {
+	return f.RemoveItemFn(ctx, customerID, itemID)
+}
+
+func (f *fakeCartService) Clear(ctx context.Context, customerID string) error {
+	return f.ClearFn(ctx, customerID)
+}
+
+func (f *fakeCartService) Checkout(ctx context.Context, customerID string, req cart.CheckoutRequest) (order.OrderResponse, error) {
+	return f.CheckoutFn(ctx, customerID, req)
+}
+
+func (f *fakeCartService) ShippingCart(ctx context.Context, customerID string) (shipping.Cart, error) {
+	return shipping.Cart{}, nil
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter(svc cart.Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	cart.RegisterRoutes(r.Group(""), cart.NewHandler(svc))
+	return r
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_AddItem(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeCartService{
+			AddItemFn: func(ctx context.Context, customerID string, req cart.AddItemRequest) (cart.CartResponse, error) {
+				assert.Equal(t, "cust-1", customerID)
+				assert.Equal(t, "p1", req.ProductID)
+				return cart.CartResponse{CustomerID: customerID, TotalQuantity: req.Quantity}, nil
+			},
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/customers/cust-1/cart/items", strings.NewReader(`{"product_id":"p1","quantity":2}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		setupTestRouter(svc).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/customers/cust-1/cart/items", strings.NewReader(`{"product_id":"p1","quantity":0}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		setupTestRouter(&fakeCartService{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandler_Checkout(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusCreated},
+		{"empty cart", cart.ErrCartEmpty, http.StatusBadRequest},
+		{"invalid cart", cart.ErrCartInvalid, http.StatusConflict},
+		{"insufficient stock", order.ErrInsufficientStock, http.StatusConflict},
+		{"inactive variant", order.ErrVariantInactive, http.StatusConflict},
+		{"in progress", cart.ErrCheckoutInProgress, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeCartService{
+				CheckoutFn: func(ctx context.Context, customerID string, req cart.CheckoutRequest) (order.OrderResponse, error) {
+					return order.OrderResponse{ID: "order-1"}, tc.err
+				},
+			}
+
+			// Body opsional: checkout tanpa kupon boleh tanpa body
+			req := httptest.NewRequest(http.MethodPost, "/customers/cust-1/cart/checkout", nil)
+			w := httptest.NewRecorder()
+			setupTestRouter(svc).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package cart
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=cart_repo.go -destination=mocks/cart_repo_mock.go -package=mock
+type Repository interface {
+	CustomerExists(ctx context.Context, id string) error
+	GetProduct(ctx context.Context, id string) (dbgen.GetProductByIDRow, error)
+	GetVariant(ctx context.Context, params dbgen.GetProductVariantByIDParams) (dbgen.GetProductVariantByIDRow, error)
+	HasActiveVariants(ctx context.Context, productID string) (bool, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+// CustomerExists mengembalikan sql.ErrNoRows jika customer tidak ditemukan
+func (r *repository) CustomerExists(ctx context.Context, id string) error {
+	_, err := r.q.GetCustomerByID(ctx, id)
+	return err
+}
+
+func (r *repository) GetProduct(ctx context.Context, id string) (dbgen.GetProductByIDRow, error) {
+	return r.q.GetProductByID(ctx, id)
+}
+
+func (r *repository) GetVariant(ctx context.Context, params dbgen.GetProductVariantByIDParams) (dbgen.GetProductVariantByIDRow, error) {
+	return r.q.GetProductVariantByID(ctx, params)
+}
+
+func (r *repository) HasActiveVariants(ctx context.Context, productID string) (bool, error) {
+	return r.q.ProductHasActiveVariants(ctx, productID)
+}
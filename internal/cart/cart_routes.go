@@ -0,0 +1,15 @@
+package cart
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	cart := r.Group("/customers/:id/cart")
+	{
+		cart.GET("", handler.Get)
+		cart.DELETE("", handler.Clear)
+		cart.POST("/items", handler.AddItem)
+		cart.PUT("/items/:item_id", handler.UpdateItem)
+		cart.DELETE("/items/:item_id", handler.RemoveItem)
+		cart.POST("/checkout", handler.Checkout)
+	}
+}
@@ -0,0 +1,297 @@
+package cart
+
+import (
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockgen -source=cart_service.go -destination=mocks/cart_service_mock.go -package=mock
+type Service interface {
+	Get(ctx context.Context, customerID string) (CartResponse, error)
+	AddItem(ctx context.Context, customerID string, req AddItemRequest) (CartResponse, error)
+	UpdateItem(ctx context.Context, customerID, itemID string, req UpdateItemRequest) (CartResponse, error)
+	RemoveItem(ctx context.Context, customerID, itemID string) (CartResponse, error)
+	Clear(ctx context.Context, customerID string) error
+	Checkout(ctx context.Context, customerID string, req CheckoutRequest) (order.OrderResponse, error)
+	ShippingCart(ctx context.Context, customerID string) (shipping.Cart, error)
+}
+
+type service struct {
+	repo   Repository
+	store  Store
+	orders order.Service // Checkout dibuat lewat order service agar stok, kupon & pajak tetap satu jalur
+}
+
+func NewService(repo Repository, store Store, orders order.Service) Service {
+	return &service{repo: repo, store: store, orders: orders}
+}
+
+// Get membaca keranjang dan memvalidasi setiap item terhadap harga & stok terkini
+func (s *service) Get(ctx context.Context, customerID string) (CartResponse, error) {
+	items, err := s.store.Items(ctx, customerID)
+	if err != nil {
+		return CartResponse{}, err
+	}
+
+	keys := make([]string, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := CartResponse{
+		CustomerID: customerID,
+		Items:      make([]CartItemResponse, 0, len(keys)),
+		IsValid:    len(keys) > 0,
+	}
+	subtotal := decimal.Zero
+
+	for _, key := range keys {
+		item, price, err := s.resolveItem(ctx, key, items[key])
+		if err != nil {
+			return CartResponse{}, err
+		}
+
+		res.TotalQuantity += item.Quantity
+		if len(item.Issues) > 0 {
+			res.IsValid = false
+		} else {
+			subtotal = subtotal.Add(price.Mul(decimal.NewFromInt(int64(item.Quantity))))
+		}
+		res.Items = append(res.Items, item)
+	}
+	res.Subtotal = helper.DecimalToFloat64(subtotal)
+
+	if len(keys) > 0 {
+		ttl, err := s.store.ExpiresIn(ctx, customerID)
+		if err != nil {
+			return CartResponse{}, err
+		}
+		expiresAt := time.Now().Add(ttl).UTC()
+		res.ExpiresAt = &expiresAt
+	}
+
+	return res, nil
+}
+
+func (s *service) AddItem(ctx context.Context, customerID string, req AddItemRequest) (CartResponse, error) {
+	if err := s.repo.CustomerExists(ctx, customerID); err != nil {
+		if err == sql.ErrNoRows {
+			return CartResponse{}, ErrCustomerNotFound
+		}
+		return CartResponse{}, err
+	}
+
+	// Stok tidak dicek di sini; kekurangan stok ditandai saat keranjang dibaca
+	key := itemKey(req.ProductID, req.VariantID)
+	item, _, err := s.resolveItem(ctx, key, req.Quantity)
+	if err != nil {
+		return CartResponse{}, err
+	}
+	for _, issue := range item.Issues {
+		if issue == IssueProductUnavailable || issue == IssueVariantUnavailable {
+			return CartResponse{}, ErrProductNotFound
+		}
+		if issue == IssueVariantRequired {
+			return CartResponse{}, ErrVariantRequired
+		}
+	}
+
+	if _, err := s.store.Add(ctx, customerID, key, req.Quantity); err != nil {
+		return CartResponse{}, err
+	}
+
+	return s.Get(ctx, customerID)
+}
+
+func (s *service) UpdateItem(ctx context.Context, customerID, itemID string, req UpdateItemRequest) (CartResponse, error) {
+	updated, err := s.store.Set(ctx, customerID, itemID, req.Quantity)
+	if err != nil {
+		return CartResponse{}, err
+	}
+	if !updated {
+		return CartResponse{}, ErrItemNotFound
+	}
+
+	return s.Get(ctx, customerID)
+}
+
+func (s *service) RemoveItem(ctx context.Context, customerID, itemID string) (CartResponse, error) {
+	removed, err := s.store.Remove(ctx, customerID, itemID)
+	if err != nil {
+		return CartResponse{}, err
+	}
+	if !removed {
+		return CartResponse{}, ErrItemNotFound
+	}
+
+	return s.Get(ctx, customerID)
+}
+
+func (s *service) Clear(ctx context.Context, customerID string) error {
+	return s.store.Clear(ctx, customerID)
+}
+
+// Checkout mengubah keranjang menjadi order dengan harga terkini. Pembuatan order
+// (stok, kupon, pajak) terjadi dalam satu transaksi di order service; keranjang
+// baru dikosongkan setelah order berhasil, dan lock mencegah checkout ganda.
+func (s *service) Checkout(ctx context.Context, customerID string, req CheckoutRequest) (order.OrderResponse, error) {
+	locked, err := s.store.Lock(ctx, customerID)
+	if err != nil {
+		return order.OrderResponse{}, err
+	}
+	if !locked {
+		return order.OrderResponse{}, ErrCheckoutInProgress
+	}
+	defer func() {
+		if err := s.store.Unlock(context.WithoutCancel(ctx), customerID); err != nil {
+			log.Printf("failed to release checkout lock for customer %s: %v", customerID, err)
+		}
+	}()
+
+	cart, err := s.Get(ctx, customerID)
+	if err != nil {
+		return order.OrderResponse{}, err
+	}
+	if len(cart.Items) == 0 {
+		return order.OrderResponse{}, ErrCartEmpty
+	}
+	if !cart.IsValid {
+		return order.OrderResponse{}, fmt.Errorf("%w: %s", ErrCartInvalid, describeIssues(cart.Items))
+	}
+
+	orderReq := order.CreateOrderRequest{
+		CustomerID:        customerID,
+		Items:             make([]order.OrderItemRequest, 0, len(cart.Items)),
+		CouponCode:        req.CouponCode,
+		ShippingAddressID: req.ShippingAddressID,
+		ShippingAddress:   req.ShippingAddress,
+		ShippingMethodID:  req.ShippingMethodID,
+	}
+	for _, item := range cart.Items {
+		line := order.OrderItemRequest{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice, // Harga yang dilihat customer; order menolak bila harga berubah
+		}
+		if item.VariantID != "" {
+			line.VariantID = &item.VariantID
+		}
+		orderReq.Items = append(orderReq.Items, line)
+	}
+
+	res, err := s.orders.Create(ctx, orderReq)
+	if err != nil {
+		return order.OrderResponse{}, err
+	}
+
+	// Order sudah tersimpan; kegagalan mengosongkan keranjang tidak membatalkan checkout
+	if err := s.store.Clear(context.WithoutCancel(ctx), customerID); err != nil {
+		log.Printf("failed to clear cart for customer %s after order %s: %v", customerID, res.ID, err)
+	}
+
+	return res, nil
+}
+
+// ShippingCart mengembalikan item keranjang tanpa masalah untuk dihitung ongkirnya
+// (lihat shipping.CartReader); subtotal sama dengan subtotal keranjang
+func (s *service) ShippingCart(ctx context.Context, customerID string) (shipping.Cart, error) {
+	cart, err := s.Get(ctx, customerID)
+	if err != nil {
+		return shipping.Cart{}, err
+	}
+
+	res := shipping.Cart{Subtotal: helper.Float64ToDecimal(cart.Subtotal)}
+	for _, item := range cart.Items {
+		if len(item.Issues) == 0 {
+			res.Items = append(res.Items, shipping.Item{ProductID: item.ProductID, Quantity: item.Quantity})
+		}
+	}
+	return res, nil
+}
+
+// resolveItem mengambil data produk/varian terkini untuk satu item keranjang.
+// Produk/varian yang hilang atau nonaktif tidak dianggap error, melainkan issue.
+func (s *service) resolveItem(ctx context.Context, key string, quantity int) (CartItemResponse, decimal.Decimal, error) {
+	productID, variantID := parseItemKey(key)
+	item := CartItemResponse{
+		ID:        key,
+		ProductID: productID,
+		VariantID: variantID,
+		Quantity:  quantity,
+	}
+
+	product, err := s.repo.GetProduct(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			item.Issues = append(item.Issues, IssueProductUnavailable)
+			return item, decimal.Zero, nil
+		}
+		return CartItemResponse{}, decimal.Zero, err
+	}
+
+	item.ProductName = product.Name
+	item.Sku = product.Sku.String
+	price := product.Price
+	stock := product.StockQuantity
+	if !product.IsActive {
+		item.Issues = append(item.Issues, IssueProductUnavailable)
+	}
+
+	if variantID == "" {
+		// Produk bervarian harus dipilih variannya; stok produk induk tidak dipakai
+		hasVariants, err := s.repo.HasActiveVariants(ctx, productID)
+		if err != nil {
+			return CartItemResponse{}, decimal.Zero, err
+		}
+		if hasVariants {
+			item.Issues = append(item.Issues, IssueVariantRequired)
+		}
+	} else {
+		variant, err := s.repo.GetVariant(ctx, dbgen.GetProductVariantByIDParams{ID: variantID, ProductID: productID})
+		switch {
+		case err == sql.ErrNoRows:
+			item.Issues = append(item.Issues, IssueVariantUnavailable)
+			stock = 0
+		case err != nil:
+			return CartItemResponse{}, decimal.Zero, err
+		default:
+			item.Sku = variant.Sku
+			price = variant.EffectivePrice
+			stock = variant.StockQuantity
+			if !variant.IsActive {
+				item.Issues = append(item.Issues, IssueVariantUnavailable)
+			}
+		}
+	}
+
+	item.UnitPrice = helper.DecimalToFloat64(price)
+	item.LineTotal = helper.DecimalToFloat64(price.Mul(decimal.NewFromInt(int64(quantity))))
+	item.AvailableStock = stock
+	if len(item.Issues) == 0 && int(stock) < quantity {
+		item.Issues = append(item.Issues, IssueInsufficientStock)
+	}
+
+	return item, price, nil
+}
+
+func describeIssues(items []CartItemResponse) string {
+	parts := make([]string, 0)
+	for _, item := range items {
+		if len(item.Issues) > 0 {
+			parts = append(parts, fmt.Sprintf("%s (%s)", item.ID, strings.Join(item.Issues, ", ")))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,283 @@
+package cart_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/cart"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockCart "assignment-ptes-achmad-rifai/internal/cart/mocks"
+	mockOrder "assignment-ptes-achmad-rifai/internal/order/mocks"
+)
+
+type serviceDeps struct {
+	repo   *mockCart.MockRepository
+	store  *mockCart.MockStore
+	orders *mockOrder.MockService
+}
+
+func setupServiceTest(t *testing.T) (cart.Service, serviceDeps) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	deps := serviceDeps{
+		repo:   mockCart.NewMockRepository(ctrl),
+		store:  mockCart.NewMockStore(ctrl),
+		orders: mockOrder.NewMockService(ctrl),
+	}
+
+	return cart.NewService(deps.repo, deps.store, deps.orders), deps
+}
+
+func product(id string, price int64, stock int32) dbgen.GetProductByIDRow {
+	return dbgen.GetProductByIDRow{
+		ID:            id,
+		Name:          "Product " + id,
+		Price:         decimal.NewFromInt(price),
+		StockQuantity: stock,
+		IsActive:      true,
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("validates_items_against_live_data", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{
+			"p1":    2,
+			"p2":    5,
+			"p3:v1": 1,
+			"p4":    1,
+		}, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 10), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p1").Return(false, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p2").Return(product("p2", 5000, 3), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p2").Return(false, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p3").Return(product("p3", 20000, 0), nil)
+		d.repo.EXPECT().
+			GetVariant(gomock.Any(), dbgen.GetProductVariantByIDParams{ID: "v1", ProductID: "p3"}).
+			Return(dbgen.GetProductVariantByIDRow{ID: "v1", Sku: "P3-RED", EffectivePrice: decimal.NewFromInt(25000), StockQuantity: 4, IsActive: true}, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p4").Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
+		d.store.EXPECT().ExpiresIn(gomock.Any(), "cust-1").Return(time.Hour, nil)
+
+		res, err := svc.Get(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.False(t, res.IsValid)
+		assert.Len(t, res.Items, 4)
+		assert.Equal(t, 9, res.TotalQuantity)
+		// Hanya p1 (2 x 10000) dan varian p3 (1 x 25000) yang dihitung
+		assert.Equal(t, float64(45000), res.Subtotal)
+		assert.NotNil(t, res.ExpiresAt)
+
+		assert.Empty(t, res.Items[0].Issues)
+		assert.Equal(t, []string{cart.IssueInsufficientStock}, res.Items[1].Issues)
+		assert.Equal(t, float64(25000), res.Items[2].UnitPrice)
+		assert.Equal(t, "P3-RED", res.Items[2].Sku)
+		assert.Equal(t, []string{cart.IssueProductUnavailable}, res.Items[3].Issues)
+	})
+
+	t.Run("empty_cart", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{}, nil)
+
+		res, err := svc.Get(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.False(t, res.IsValid)
+		assert.Empty(t, res.Items)
+		assert.Nil(t, res.ExpiresAt)
+	})
+}
+
+func TestService_AddItem(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error_customer_not_found", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.repo.EXPECT().CustomerExists(gomock.Any(), "missing").Return(sql.ErrNoRows)
+
+		_, err := svc.AddItem(ctx, "missing", cart.AddItemRequest{ProductID: "p1", Quantity: 1})
+
+		assert.ErrorIs(t, err, cart.ErrCustomerNotFound)
+	})
+
+	t.Run("error_product_not_found", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "missing").Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.AddItem(ctx, "cust-1", cart.AddItemRequest{ProductID: "missing", Quantity: 1})
+
+		assert.ErrorIs(t, err, cart.ErrProductNotFound)
+	})
+
+	t.Run("error_variant_required", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 10), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p1").Return(true, nil)
+		d.store.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.AddItem(ctx, "cust-1", cart.AddItemRequest{ProductID: "p1", Quantity: 1})
+
+		assert.ErrorIs(t, err, cart.ErrVariantRequired)
+	})
+
+	t.Run("success_with_variant", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+		variantID := "v1"
+		variant := dbgen.GetProductVariantByIDRow{ID: "v1", EffectivePrice: decimal.NewFromInt(12000), StockQuantity: 5, IsActive: true}
+
+		d.repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 0), nil).Times(2)
+		d.repo.EXPECT().GetVariant(gomock.Any(), gomock.Any()).Return(variant, nil).Times(2)
+		d.store.EXPECT().Add(gomock.Any(), "cust-1", "p1:v1", 2).Return(2, nil)
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{"p1:v1": 2}, nil)
+		d.store.EXPECT().ExpiresIn(gomock.Any(), "cust-1").Return(time.Hour, nil)
+
+		res, err := svc.AddItem(ctx, "cust-1", cart.AddItemRequest{ProductID: "p1", VariantID: &variantID, Quantity: 2})
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsValid)
+		assert.Equal(t, float64(24000), res.Subtotal)
+		assert.Equal(t, "v1", res.Items[0].VariantID)
+	})
+}
+
+func TestService_UpdateAndRemoveItem(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update_missing_item", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Set(gomock.Any(), "cust-1", "p9", 3).Return(false, nil)
+
+		_, err := svc.UpdateItem(ctx, "cust-1", "p9", cart.UpdateItemRequest{Quantity: 3})
+
+		assert.ErrorIs(t, err, cart.ErrItemNotFound)
+	})
+
+	t.Run("remove_missing_item", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Remove(gomock.Any(), "cust-1", "p9").Return(false, nil)
+
+		_, err := svc.RemoveItem(ctx, "cust-1", "p9")
+
+		assert.ErrorIs(t, err, cart.ErrItemNotFound)
+	})
+}
+
+func TestService_Checkout(t *testing.T) {
+	ctx := context.Background()
+	coupon := "HEMAT10"
+	addressID := "addr-1"
+
+	t.Run("success_creates_order_and_clears_cart", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(true, nil)
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{"p1": 2}, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 10), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p1").Return(false, nil)
+		d.store.EXPECT().ExpiresIn(gomock.Any(), "cust-1").Return(time.Hour, nil)
+		d.orders.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(order.CreateOrderRequest{})).
+			DoAndReturn(func(_ context.Context, req order.CreateOrderRequest) (order.OrderResponse, error) {
+				assert.Equal(t, "cust-1", req.CustomerID)
+				assert.Equal(t, &coupon, req.CouponCode)
+				assert.Equal(t, &addressID, req.ShippingAddressID)
+				assert.Len(t, req.Items, 1)
+				assert.Equal(t, float64(10000), req.Items[0].UnitPrice)
+				assert.Nil(t, req.Items[0].VariantID)
+				return order.OrderResponse{ID: "order-1", GrandTotal: 20000}, nil
+			})
+		clear := d.store.EXPECT().Clear(gomock.Any(), "cust-1").Return(nil)
+		d.store.EXPECT().Unlock(gomock.Any(), "cust-1").Return(nil).After(clear)
+
+		res, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{CouponCode: &coupon, ShippingAddressID: &addressID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "order-1", res.ID)
+	})
+
+	t.Run("order_failure_keeps_cart", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(true, nil)
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{"p1": 2}, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 10), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p1").Return(false, nil)
+		d.store.EXPECT().ExpiresIn(gomock.Any(), "cust-1").Return(time.Hour, nil)
+		d.orders.EXPECT().Create(gomock.Any(), gomock.Any()).Return(order.OrderResponse{}, order.ErrInsufficientStock)
+		d.store.EXPECT().Clear(gomock.Any(), gomock.Any()).Times(0)
+		d.store.EXPECT().Unlock(gomock.Any(), "cust-1").Return(nil)
+
+		_, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{})
+
+		assert.ErrorIs(t, err, order.ErrInsufficientStock)
+	})
+
+	t.Run("invalid_cart_is_rejected", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(true, nil)
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{"p1": 20}, nil)
+		d.repo.EXPECT().GetProduct(gomock.Any(), "p1").Return(product("p1", 10000, 10), nil)
+		d.repo.EXPECT().HasActiveVariants(gomock.Any(), "p1").Return(false, nil)
+		d.store.EXPECT().ExpiresIn(gomock.Any(), "cust-1").Return(time.Hour, nil)
+		d.store.EXPECT().Unlock(gomock.Any(), "cust-1").Return(nil)
+
+		_, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{})
+
+		assert.ErrorIs(t, err, cart.ErrCartInvalid)
+		assert.Contains(t, err.Error(), cart.IssueInsufficientStock)
+	})
+
+	t.Run("empty_cart", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(true, nil)
+		d.store.EXPECT().Items(gomock.Any(), "cust-1").Return(map[string]int{}, nil)
+		d.store.EXPECT().Unlock(gomock.Any(), "cust-1").Return(nil)
+
+		_, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{})
+
+		assert.ErrorIs(t, err, cart.ErrCartEmpty)
+	})
+
+	t.Run("concurrent_checkout", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(false, nil)
+
+		_, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{})
+
+		assert.ErrorIs(t, err, cart.ErrCheckoutInProgress)
+	})
+
+	t.Run("lock_error", func(t *testing.T) {
+		svc, d := setupServiceTest(t)
+
+		d.store.EXPECT().Lock(gomock.Any(), "cust-1").Return(false, errors.New("redis down"))
+
+		_, err := svc.Checkout(ctx, "cust-1", cart.CheckoutRequest{})
+
+		assert.EqualError(t, err, "redis down")
+	})
+}
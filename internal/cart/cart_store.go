@@ -0,0 +1,149 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultTTL adalah masa berlaku keranjang sejak perubahan terakhir
+const DefaultTTL = 7 * 24 * time.Hour
+
+// checkoutLockTTL membatasi lama lock checkout jika proses mati sebelum melepasnya
+const checkoutLockTTL = 30 * time.Second
+
+//go:generate mockgen -source=cart_store.go -destination=mocks/cart_store_mock.go -package=mock
+
+// Store menyimpan isi keranjang: item key -> quantity
+type Store interface {
+	Items(ctx context.Context, customerID string) (map[string]int, error)
+	Add(ctx context.Context, customerID, itemKey string, quantity int) (int, error)
+	Set(ctx context.Context, customerID, itemKey string, quantity int) (bool, error)
+	Remove(ctx context.Context, customerID, itemKey string) (bool, error)
+	Clear(ctx context.Context, customerID string) error
+	ExpiresIn(ctx context.Context, customerID string) (time.Duration, error)
+	Lock(ctx context.Context, customerID string) (bool, error)
+	Unlock(ctx context.Context, customerID string) error
+}
+
+// redisStore menyimpan keranjang sebagai hash "cart:{customer_id}"; setiap perubahan
+// memperpanjang TTL sehingga keranjang yang ditinggalkan hilang dengan sendirinya
+type redisStore struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+func NewRedisStore(rdb *redis.Client, ttl time.Duration) Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &redisStore{rdb: rdb, ttl: ttl}
+}
+
+func cartKey(customerID string) string {
+	return "cart:" + customerID
+}
+
+func lockKey(customerID string) string {
+	return "cart:" + customerID + ":checkout"
+}
+
+func (s *redisStore) Items(ctx context.Context, customerID string) (map[string]int, error) {
+	raw, err := s.rdb.HGetAll(ctx, cartKey(customerID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]int, len(raw))
+	for key, v := range raw {
+		qty, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity for cart item %s: %w", key, err)
+		}
+		items[key] = qty
+	}
+	return items, nil
+}
+
+// Add menambah quantity secara atomik (HINCRBY) dan mengembalikan quantity baru
+func (s *redisStore) Add(ctx context.Context, customerID, itemKey string, quantity int) (int, error) {
+	key := cartKey(customerID)
+	var incr *redis.IntCmd
+
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.HIncrBy(ctx, key, itemKey, int64(quantity))
+		pipe.Expire(ctx, key, s.ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(incr.Val()), nil
+}
+
+// Set mengganti quantity item yang sudah ada; false jika item tidak ada di keranjang
+func (s *redisStore) Set(ctx context.Context, customerID, itemKey string, quantity int) (bool, error) {
+	key := cartKey(customerID)
+
+	exists, err := s.rdb.HExists(ctx, key, itemKey).Result()
+	if err != nil || !exists {
+		return false, err
+	}
+
+	_, err = s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, itemKey, quantity)
+		pipe.Expire(ctx, key, s.ttl)
+		return nil
+	})
+	return err == nil, err
+}
+
+func (s *redisStore) Remove(ctx context.Context, customerID, itemKey string) (bool, error) {
+	removed, err := s.rdb.HDel(ctx, cartKey(customerID), itemKey).Result()
+	if err != nil {
+		return false, err
+	}
+	return removed > 0, nil
+}
+
+func (s *redisStore) Clear(ctx context.Context, customerID string) error {
+	return s.rdb.Del(ctx, cartKey(customerID)).Err()
+}
+
+// ExpiresIn mengembalikan sisa TTL; 0 jika keranjang tidak ada
+func (s *redisStore) ExpiresIn(ctx context.Context, customerID string) (time.Duration, error) {
+	ttl, err := s.rdb.TTL(ctx, cartKey(customerID)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Lock mencegah dua checkout berjalan bersamaan untuk keranjang yang sama
+func (s *redisStore) Lock(ctx context.Context, customerID string) (bool, error) {
+	return s.rdb.SetNX(ctx, lockKey(customerID), 1, checkoutLockTTL).Result()
+}
+
+func (s *redisStore) Unlock(ctx context.Context, customerID string) error {
+	return s.rdb.Del(ctx, lockKey(customerID)).Err()
+}
+
+// itemKey menggabungkan product & variant menjadi field hash ("product_id" atau "product_id:variant_id")
+func itemKey(productID string, variantID *string) string {
+	if variantID == nil || *variantID == "" {
+		return productID
+	}
+	return productID + ":" + *variantID
+}
+
+func parseItemKey(key string) (productID, variantID string) {
+	productID, variantID, _ = strings.Cut(key, ":")
+	return productID, variantID
+}
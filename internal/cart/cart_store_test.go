@@ -0,0 +1,69 @@
+package cart_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/cart"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	ttl := time.Hour
+
+	t.Run("add_increments_and_refreshes_ttl", func(t *testing.T) {
+		rdb, mock := redismock.NewClientMock()
+		store := cart.NewRedisStore(rdb, ttl)
+
+		mock.ExpectTxPipeline()
+		mock.ExpectHIncrBy("cart:cust-1", "p1", 2).SetVal(5)
+		mock.ExpectExpire("cart:cust-1", ttl).SetVal(true)
+		mock.ExpectTxPipelineExec()
+
+		qty, err := store.Add(ctx, "cust-1", "p1", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, qty)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("set_missing_item", func(t *testing.T) {
+		rdb, mock := redismock.NewClientMock()
+		store := cart.NewRedisStore(rdb, ttl)
+
+		mock.ExpectHExists("cart:cust-1", "p9").SetVal(false)
+
+		updated, err := store.Set(ctx, "cust-1", "p9", 1)
+
+		assert.NoError(t, err)
+		assert.False(t, updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("items", func(t *testing.T) {
+		rdb, mock := redismock.NewClientMock()
+		store := cart.NewRedisStore(rdb, ttl)
+
+		mock.ExpectHGetAll("cart:cust-1").SetVal(map[string]string{"p1": "2", "p2:v1": "1"})
+
+		items, err := store.Items(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"p1": 2, "p2:v1": 1}, items)
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		rdb, mock := redismock.NewClientMock()
+		store := cart.NewRedisStore(rdb, ttl)
+
+		mock.ExpectSetNX("cart:cust-1:checkout", 1, 30*time.Second).SetVal(false)
+
+		locked, err := store.Lock(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.False(t, locked)
+	})
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cart_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cart_repo.go -destination=mocks/cart_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CustomerExists mocks base method.
+func (m *MockRepository) CustomerExists(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomerExists", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomerExists indicates an expected call of CustomerExists.
+func (mr *MockRepositoryMockRecorder) CustomerExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerExists", reflect.TypeOf((*MockRepository)(nil).CustomerExists), ctx, id)
+}
+
+// GetProduct mocks base method.
+func (m *MockRepository) GetProduct(ctx context.Context, id string) (dbgen.GetProductByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", ctx, id)
+	ret0, _ := ret[0].(dbgen.GetProductByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockRepositoryMockRecorder) GetProduct(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockRepository)(nil).GetProduct), ctx, id)
+}
+
+// GetVariant mocks base method.
+func (m *MockRepository) GetVariant(ctx context.Context, params dbgen.GetProductVariantByIDParams) (dbgen.GetProductVariantByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", ctx, params)
+	ret0, _ := ret[0].(dbgen.GetProductVariantByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockRepositoryMockRecorder) GetVariant(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*MockRepository)(nil).GetVariant), ctx, params)
+}
+
+// HasActiveVariants mocks base method.
+func (m *MockRepository) HasActiveVariants(ctx context.Context, productID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActiveVariants", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasActiveVariants indicates an expected call of HasActiveVariants.
+func (mr *MockRepositoryMockRecorder) HasActiveVariants(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveVariants", reflect.TypeOf((*MockRepository)(nil).HasActiveVariants), ctx, productID)
+}
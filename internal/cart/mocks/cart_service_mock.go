@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cart_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cart_service.go -destination=mocks/cart_service_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	cart "assignment-ptes-achmad-rifai/internal/cart"
+	order "assignment-ptes-achmad-rifai/internal/order"
+	shipping "assignment-ptes-achmad-rifai/internal/shipping"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockService) AddItem(ctx context.Context, customerID string, req cart.AddItemRequest) (cart.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, customerID, req)
+	ret0, _ := ret[0].(cart.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockServiceMockRecorder) AddItem(ctx, customerID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockService)(nil).AddItem), ctx, customerID, req)
+}
+
+// Checkout mocks base method.
+func (m *MockService) Checkout(ctx context.Context, customerID string, req cart.CheckoutRequest) (order.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, customerID, req)
+	ret0, _ := ret[0].(order.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockServiceMockRecorder) Checkout(ctx, customerID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockService)(nil).Checkout), ctx, customerID, req)
+}
+
+// Clear mocks base method.
+func (m *MockService) Clear(ctx context.Context, customerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, customerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockServiceMockRecorder) Clear(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockService)(nil).Clear), ctx, customerID)
+}
+
+// Get mocks base method.
+func (m *MockService) Get(ctx context.Context, customerID string) (cart.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, customerID)
+	ret0, _ := ret[0].(cart.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceMockRecorder) Get(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, customerID)
+}
+
+// RemoveItem mocks base method.
+func (m *MockService) RemoveItem(ctx context.Context, customerID, itemID string) (cart.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, customerID, itemID)
+	ret0, _ := ret[0].(cart.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockServiceMockRecorder) RemoveItem(ctx, customerID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockService)(nil).RemoveItem), ctx, customerID, itemID)
+}
+
+// ShippingCart mocks base method.
+func (m *MockService) ShippingCart(ctx context.Context, customerID string) (shipping.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShippingCart", ctx, customerID)
+	ret0, _ := ret[0].(shipping.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShippingCart indicates an expected call of ShippingCart.
+func (mr *MockServiceMockRecorder) ShippingCart(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShippingCart", reflect.TypeOf((*MockService)(nil).ShippingCart), ctx, customerID)
+}
+
+// UpdateItem mocks base method.
+func (m *MockService) UpdateItem(ctx context.Context, customerID, itemID string, req cart.UpdateItemRequest) (cart.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, customerID, itemID, req)
+	ret0, _ := ret[0].(cart.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockServiceMockRecorder) UpdateItem(ctx, customerID, itemID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockService)(nil).UpdateItem), ctx, customerID, itemID, req)
+}
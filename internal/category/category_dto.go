@@ -1,19 +1,28 @@
 package category
 
+import "time"
+
 type CreateCategoryRequest struct {
 	Name        string  `json:"name" binding:"required"`
 	Description *string `json:"description"`
+	IsActive    *bool   `json:"is_active"`
 }
 
 type UpdateCategoryRequest struct {
 	Name        string  `json:"name" binding:"required"`
 	Description *string `json:"description"`
+	IsActive    *bool   `json:"is_active"`
 }
 
 type CategoryResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	IsActive           bool      `json:"is_active"`
+	ProductCount       int64     `json:"product_count"`
+	ActiveProductCount int64     `json:"active_product_count"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
 }
 
 type ListParams struct {
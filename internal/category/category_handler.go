@@ -165,6 +165,17 @@ func (h *Handler) Update(c *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, ErrCategoryNotFound) {
+			response.Error(
+				c,
+				http.StatusNotFound,
+				"NOT_FOUND",
+				err.Error(),
+				nil,
+			)
+			return
+		}
+
 		response.Error(
 			c,
 			http.StatusInternalServerError,
@@ -330,6 +330,33 @@ func TestHandler_Update(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
+	t.Run("category not found", func(t *testing.T) {
+		desc := "Test"
+		svc := &fakeCategoryService{
+			UpdateFn: func(ctx context.Context, id string, req category.UpdateCategoryRequest) (category.CategoryResponse, error) {
+				return category.CategoryResponse{}, category.ErrCategoryNotFound
+			},
+		}
+
+		r := setupTestRouter()
+		handler := category.NewHandler(svc)
+		r.PUT("/categories/:id", handler.Update)
+
+		reqBody := category.UpdateCategoryRequest{
+			Name:        "Updated",
+			Description: &desc,
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPut, "/categories/uuid-999", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
 	t.Run("service error", func(t *testing.T) {
 		desc := "Test"
 		svc := &fakeCategoryService{
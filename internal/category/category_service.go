@@ -4,7 +4,10 @@ import (
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
 	"context"
+	"database/sql"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -38,11 +41,16 @@ func (s *service) Create(
 		return CategoryResponse{}, err // Tangani error jika gagal generate
 	}
 	id := newUUID.String()
+	now := time.Now()
+	isActive := helper.BoolPtrValue(req.IsActive, true)
 
 	params := dbgen.CreateCategoryParams{
 		ID:          id,
 		Name:        req.Name,
 		Description: helper.StringToNull(req.Description),
+		IsActive:    isActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 
 	if err := s.repo.Create(ctx, params); err != nil {
@@ -53,6 +61,9 @@ func (s *service) Create(
 		ID:          id,
 		Name:        req.Name,
 		Description: helper.StringPtrValue(req.Description),
+		IsActive:    isActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}, nil
 }
 
@@ -78,7 +89,7 @@ func (s *service) List(ctx context.Context, p ListParams) ([]CategoryResponse, e
 
 	res := make([]CategoryResponse, 0, len(rows))
 	for _, row := range rows {
-		res = append(res, toResponse(row.ID, row.Name))
+		res = append(res, toResponse(row))
 	}
 
 	return res, nil
@@ -91,6 +102,9 @@ func (s *service) GetByID(
 
 	cat, err := s.repo.GetByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CategoryResponse{}, ErrCategoryNotFound
+		}
 		return CategoryResponse{}, err
 	}
 
@@ -103,20 +117,25 @@ func (s *service) Update(
 	req UpdateCategoryRequest,
 ) (CategoryResponse, error) {
 
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CategoryResponse{}, ErrCategoryNotFound
+		}
+		return CategoryResponse{}, err
+	}
+
+	// is_active yang tidak dikirim mempertahankan status saat ini
 	if err := s.repo.Update(ctx, dbgen.UpdateCategoryParams{
 		ID:          id,
 		Name:        req.Name,
 		Description: helper.StringToNull(req.Description),
+		IsActive:    helper.BoolPtrValue(req.IsActive, existing.IsActive),
 	}); err != nil {
 		return CategoryResponse{}, err
 	}
 
-	cat, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return CategoryResponse{}, err
-	}
-
-	return mapToResponse(cat), nil
+	return s.GetByID(ctx, id)
 }
 
 func (s *service) Delete(
@@ -132,14 +151,26 @@ Helper
 
 func mapToResponse(cat dbgen.GetCategoryByIDRow) CategoryResponse {
 	return CategoryResponse{
-		ID:   cat.ID,
-		Name: cat.Name,
+		ID:                 cat.ID,
+		Name:               cat.Name,
+		Description:        cat.Description.String,
+		IsActive:           cat.IsActive,
+		ProductCount:       cat.ProductCount,
+		ActiveProductCount: cat.ActiveProductCount,
+		CreatedAt:          cat.CreatedAt,
+		UpdatedAt:          cat.UpdatedAt,
 	}
 }
 
-func toResponse(id string, name string) CategoryResponse {
+func toResponse(row dbgen.GetCategoriesRow) CategoryResponse {
 	return CategoryResponse{
-		ID:   id,
-		Name: name,
+		ID:                 row.ID,
+		Name:               row.Name,
+		Description:        row.Description.String,
+		IsActive:           row.IsActive,
+		ProductCount:       row.ProductCount,
+		ActiveProductCount: row.ActiveProductCount,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
 	}
 }
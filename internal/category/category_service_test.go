@@ -5,6 +5,7 @@ import (
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -45,6 +46,8 @@ func TestService_Create(t *testing.T) {
 				assert.Equal(t, "Food", p.Name)
 				assert.True(t, p.Description.Valid)
 				assert.Equal(t, "Food Category", p.Description.String)
+				assert.True(t, p.IsActive)
+				assert.False(t, p.CreatedAt.IsZero())
 				return nil
 			})
 
@@ -52,6 +55,9 @@ func TestService_Create(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, "Food", res.Name)
+		assert.Equal(t, "Food Category", res.Description)
+		assert.True(t, res.IsActive)
+		assert.Zero(t, res.ProductCount)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -86,7 +92,7 @@ func TestService_List(t *testing.T) {
 		repo.EXPECT().
 			GetCategories(ctx, expectedRepoParams).
 			Return([]dbgen.GetCategoriesRow{
-				{ID: "1", Name: "Food"},
+				{ID: "1", Name: "Food", Description: sql.NullString{String: "Makanan", Valid: true}, ProductCount: 4, ActiveProductCount: 3},
 				{ID: "2", Name: "Drink"},
 			}, nil)
 
@@ -94,6 +100,9 @@ func TestService_List(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Len(t, res, 2)
+		assert.Equal(t, "Makanan", res[0].Description)
+		assert.Equal(t, int64(4), res[0].ProductCount)
+		assert.Equal(t, int64(3), res[0].ActiveProductCount)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -119,14 +128,34 @@ func TestService_GetByID(t *testing.T) {
 		repo.EXPECT().
 			GetByID(ctx, id).
 			Return(dbgen.GetCategoryByIDRow{
-				ID:   id,
-				Name: "Food",
+				ID:                 id,
+				Name:               "Food",
+				Description:        sql.NullString{String: "Makanan", Valid: true},
+				IsActive:           true,
+				ProductCount:       10,
+				ActiveProductCount: 7,
 			}, nil)
 
 		res, err := svc.GetByID(ctx, id)
 
 		assert.NoError(t, err)
 		assert.Equal(t, id, res.ID)
+		assert.Equal(t, "Makanan", res.Description)
+		assert.True(t, res.IsActive)
+		assert.Equal(t, int64(10), res.ProductCount)
+		assert.Equal(t, int64(7), res.ActiveProductCount)
+	})
+
+	t.Run("no rows maps to not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.GetCategoryByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.GetByID(ctx, id)
+
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -167,6 +196,10 @@ func TestService_Update(t *testing.T) {
 			Description: &desc,
 		}
 
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{ID: id, Name: "Old", IsActive: true}, nil)
+
 		// Expect Update
 		repo.EXPECT().
 			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCategoryParams{})).
@@ -175,6 +208,7 @@ func TestService_Update(t *testing.T) {
 				assert.Equal(t, "Updated", p.Name)
 				assert.True(t, p.Description.Valid)
 				assert.Equal(t, "Updated desc", p.Description.String)
+				assert.True(t, p.IsActive)
 				return nil
 			})
 
@@ -192,6 +226,7 @@ func TestService_Update(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, id, res.ID)
 		assert.Equal(t, "Updated", res.Name)
+		assert.Equal(t, "Updated desc", res.Description)
 	})
 
 	t.Run("update error", func(t *testing.T) {
@@ -203,6 +238,10 @@ func TestService_Update(t *testing.T) {
 			Description: &desc,
 		}
 
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{ID: id, Name: "Old", IsActive: true}, nil)
+
 		repo.EXPECT().
 			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCategoryParams{})).
 			Return(errors.New("db error"))
@@ -221,6 +260,10 @@ func TestService_Update(t *testing.T) {
 			Description: &desc,
 		}
 
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{ID: id, Name: "Old", IsActive: true}, nil)
+
 		repo.EXPECT().
 			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCategoryParams{})).
 			Return(nil)
@@ -233,6 +276,40 @@ func TestService_Update(t *testing.T) {
 
 		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
 	})
+
+	t.Run("omitted is_active keeps current status", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{ID: id, Name: "Old", IsActive: false}, nil)
+		repo.EXPECT().
+			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCategoryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateCategoryParams) error {
+				assert.False(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{ID: id, Name: "Updated", IsActive: false}, nil)
+
+		res, err := svc.Update(ctx, id, category.UpdateCategoryRequest{Name: "Updated"})
+
+		assert.NoError(t, err)
+		assert.False(t, res.IsActive)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(gomock.Any(), id).
+			Return(dbgen.GetCategoryByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, id, category.UpdateCategoryRequest{Name: "Updated"})
+
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+	})
 }
 
 func TestService_Delete(t *testing.T) {
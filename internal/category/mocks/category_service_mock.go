@@ -11,7 +11,6 @@ package mock
 
 import (
 	category "assignment-ptes-achmad-rifai/internal/category"
-	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	context "context"
 	reflect "reflect"
 
@@ -87,7 +86,7 @@ func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockService) List(ctx context.Context, params dbgen.GetCategoriesParams) ([]category.CategoryResponse, error) {
+func (m *MockService) List(ctx context.Context, params category.ListParams) ([]category.CategoryResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, params)
 	ret0, _ := ret[0].([]category.CategoryResponse)
@@ -1,6 +1,10 @@
 package customer
 
-import "time"
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"time"
+)
 
 type CreateCustomerRequest struct {
 	Name  string `json:"name" binding:"required"`
@@ -19,7 +23,84 @@ type CustomerResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Sort list customer yang didukung; default created_desc
+const (
+	SortCreatedDesc    = "created_desc"
+	SortCreatedAsc     = "created_asc"
+	SortNameAsc        = "name_asc"
+	SortNameDesc       = "name_desc"
+	SortTotalSpentDesc = "total_spent_desc"
+	SortTotalSpentAsc  = "total_spent_asc"
+	SortOrderCountDesc = "order_count_desc"
+	SortOrderCountAsc  = "order_count_asc"
+)
+
+// ListParams: filter nil berarti tidak difilter. Search mencocokkan awalan nama atau email,
+// CreatedFrom inklusif, CreatedTo eksklusif.
 type ListParams struct {
-	Page     int `form:"page" json:"page"`
-	PageSize int `form:"page_size" json:"page_size"`
+	Page        int        `form:"page" json:"page"`
+	PageSize    int        `form:"page_size" json:"page_size"`
+	Search      *string    `form:"search" json:"search"`
+	CreatedFrom *time.Time `form:"created_from" json:"created_from"`
+	CreatedTo   *time.Time `form:"created_to" json:"created_to"`
+	MinSpent    *float64   `form:"min_spent" json:"min_spent"`
+	MaxSpent    *float64   `form:"max_spent" json:"max_spent"`
+	Sort        *string    `form:"sort" json:"sort"`
+}
+
+// CustomerListItem: customer beserta agregat order-nya; total_spent sudah dikurangi refund retur
+type CustomerListItem struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	CreatedAt  time.Time `json:"created_at"`
+	OrderCount int64     `json:"order_count"`
+	TotalSpent float64   `json:"total_spent"`
+}
+
+// FavouriteCategory: kategori yang paling banyak dibeli customer (quantity bersih setelah retur),
+// berdasarkan nama kategori yang di-snapshot saat order dibuat
+type FavouriteCategory struct {
+	CategoryName string  `json:"category_name"`
+	Quantity     int64   `json:"quantity"`
+	TotalSpent   float64 `json:"total_spent"`
+	OrderCount   int64   `json:"order_count"`
+}
+
+// CustomerSummaryResponse: lifetime_spend sudah dikurangi refund retur,
+// average_order_value = lifetime_spend / order_count
+type CustomerSummaryResponse struct {
+	CustomerID          string              `json:"customer_id"`
+	OrderCount          int64               `json:"order_count"`
+	LifetimeSpend       float64             `json:"lifetime_spend"`
+	AverageOrderValue   float64             `json:"average_order_value"`
+	FirstOrderAt        *time.Time          `json:"first_order_at"`
+	LastOrderAt         *time.Time          `json:"last_order_at"`
+	FavouriteCategories []FavouriteCategory `json:"favourite_categories"`
+}
+
+// DataExportProfile: data profil customer apa adanya, termasuk status verifikasi & anonimisasi
+type DataExportProfile struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	AnonymisedAt    *time.Time `json:"anonymised_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// DataExportResponse: seluruh data pribadi customer untuk permintaan akses data (UU PDP)
+type DataExportResponse struct {
+	ExportedAt time.Time                 `json:"exported_at"`
+	Profile    DataExportProfile         `json:"profile"`
+	Addresses  []address.AddressResponse `json:"addresses"`
+	Orders     []order.OrderResponse     `json:"orders"`
+}
+
+// AnonymiseResponse: ringkasan penghapusan data pribadi; order tetap disimpan untuk akuntansi
+type AnonymiseResponse struct {
+	CustomerID       string    `json:"customer_id"`
+	AnonymisedAt     time.Time `json:"anonymised_at"`
+	AddressesDeleted int64     `json:"addresses_deleted"`
+	OrdersRetained   int64     `json:"orders_retained"`
 }
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrCustomerNotFound   = errors.New("customer not found")
 	ErrEmailAlreadyExists = errors.New("email already exists")
+	ErrCustomerAnonymised = errors.New("customer has been anonymised")
+	ErrCustomerHasOrders  = errors.New("customer has orders and cannot be deleted, anonymise them instead")
 )
@@ -2,8 +2,11 @@ package customer
 
 import (
 	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,7 +21,7 @@ func NewHandler(service Service) *Handler {
 
 // Create godoc
 // @Summary      Create a new customer
-// @Description  Register a new customer with a unique email address
+// @Description  Register a new customer. Emails are stored lowercased and trimmed, so uniqueness is case-insensitive.
 // @Tags         customers
 // @Accept       json
 // @Produce      json
@@ -36,7 +39,7 @@ func (h *Handler) Create(c *gin.Context) {
 
 	res, err := h.service.Create(c.Request.Context(), req)
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "CREATE_ERROR", "Failed to create customer", err.Error())
+		handleError(c, err, "CREATE_ERROR", "Failed to create customer")
 		return
 	}
 	response.Success(c, http.StatusCreated, res, nil)
@@ -44,26 +47,34 @@ func (h *Handler) Create(c *gin.Context) {
 
 // GetAll godoc
 // @Summary      List all customers
-// @Description  Retrieve a list of all registered customers
+// @Description  Search customers by name/email prefix, filter by registration date and total spend (net of refunds), sort and paginate
 // @Tags         customers
 // @Produce      json
-// @Param        query    query    ListParams  false  "Pagination Query"
-// @Success      200      {array}   CustomerResponse
+// @Param        page          query    int     false  "Page number"
+// @Param        page_size     query    int     false  "Items per page"
+// @Param        search        query    string  false  "Name or email prefix"
+// @Param        created_from  query    string  false  "Registered at or after (YYYY-MM-DD or RFC3339)"
+// @Param        created_to    query    string  false  "Registered before (YYYY-MM-DD includes the whole day)"
+// @Param        min_spent     query    number  false  "Minimum total spend"
+// @Param        max_spent     query    number  false  "Maximum total spend"
+// @Param        sort          query    string  false  "created_desc (default), created_asc, name_asc, name_desc, total_spent_desc, total_spent_asc, order_count_desc, order_count_asc"
+// @Success      200      {array}   CustomerListItem
+// @Failure      400      {object}  map[string]string "Invalid filter"
 // @Failure      500      {object}  map[string]string
 // @Router       /customers [get]
 func (h *Handler) GetAll(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	params := ListParams{
-		Page:     page,
-		PageSize: pageSize,
+	params, err := parseListParams(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid query parameters", err.Error())
+		return
 	}
-	res, err := h.service.List(c.Request.Context(), params)
+
+	res, total, err := h.service.List(c.Request.Context(), params)
 	if err != nil {
 		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch customers", err.Error())
 		return
 	}
-	response.Success(c, http.StatusOK, res, nil)
+	response.Success(c, http.StatusOK, res, paginationMeta(total, params))
 }
 
 // GetByID godoc
@@ -96,6 +107,7 @@ func (h *Handler) GetByID(c *gin.Context) {
 // @Success      200      {object}  CustomerResponse
 // @Failure      400      {object}  map[string]string
 // @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Email already exists or customer has been anonymised"
 // @Router       /customers/{id} [put]
 func (h *Handler) Update(c *gin.Context) {
 	id := c.Param("id")
@@ -107,7 +119,7 @@ func (h *Handler) Update(c *gin.Context) {
 
 	res, err := h.service.Update(c.Request.Context(), id, req)
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "UPDATE_ERROR", "Failed to update customer", err.Error())
+		handleError(c, err, "UPDATE_ERROR", "Failed to update customer")
 		return
 	}
 	response.Success(c, http.StatusOK, res, nil)
@@ -115,18 +127,186 @@ func (h *Handler) Update(c *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete a customer
-// @Description  Permanently remove a customer from the database
+// @Description  Permanently remove a customer from the database. Customers with orders cannot be deleted; use anonymise instead.
 // @Tags         customers
 // @Produce      json
 // @Param        id       path      string  true  "Customer ID"
 // @Success      204      {object}  nil
 // @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Customer has orders"
 // @Router       /customers/{id} [delete]
 func (h *Handler) Delete(c *gin.Context) {
 	id := c.Param("id")
 	if err := h.service.Delete(c.Request.Context(), id); err != nil {
-		response.Error(c, http.StatusInternalServerError, "DELETE_ERROR", "Failed to delete customer", err.Error())
+		handleError(c, err, "DELETE_ERROR", "Failed to delete customer")
 		return
 	}
 	response.Success(c, http.StatusOK, "Customer deleted successfully", nil)
 }
+
+// GetSummary godoc
+// @Summary      Get customer lifetime summary
+// @Description  Lifetime spend (net of refunds), order count, average order value, first/last order date and favourite categories
+// @Tags         customers
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  CustomerSummaryResponse
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Failure      500      {object}  map[string]string
+// @Router       /customers/{id}/summary [get]
+func (h *Handler) GetSummary(c *gin.Context) {
+	res, err := h.service.GetSummary(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, ErrCustomerNotFound) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", "Customer not found", err.Error())
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch customer summary", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ExportData godoc
+// @Summary      Export customer personal data
+// @Description  JSON bundle of the customer's profile, saved addresses and full order history for a data access request (UU PDP). The export is recorded in the audit log.
+// @Tags         customers
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  DataExportResponse
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Failure      500      {object}  map[string]string
+// @Router       /customers/{id}/data-export [get]
+func (h *Handler) ExportData(c *gin.Context) {
+	res, err := h.service.ExportData(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "EXPORT_ERROR", "Failed to export customer data")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Anonymise godoc
+// @Summary      Anonymise a customer
+// @Description  Erase the customer's personal data while keeping their orders for accounting: profile is replaced with an anonymous identity, sessions and saved addresses are removed, recipient/phone/street on order shipping addresses and name/email in event and webhook payloads are scrubbed, and queued emails are dropped. Recorded in the audit log.
+// @Tags         customers
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  AnonymiseResponse
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Failure      409      {object}  map[string]string "Customer already anonymised"
+// @Failure      500      {object}  map[string]string
+// @Router       /customers/{id}/anonymise [post]
+func (h *Handler) Anonymise(c *gin.Context) {
+	res, err := h.service.Anonymise(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "ANONYMISE_ERROR", "Failed to anonymise customer")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrCustomerNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", "Customer not found", err.Error())
+	case errors.Is(err, ErrEmailAlreadyExists):
+		response.Error(c, http.StatusConflict, "EMAIL_ALREADY_EXISTS", err.Error(), nil)
+	case errors.Is(err, ErrCustomerAnonymised):
+		response.Error(c, http.StatusConflict, "CUSTOMER_ANONYMISED", err.Error(), nil)
+	case errors.Is(err, ErrCustomerHasOrders):
+		response.Error(c, http.StatusConflict, "CUSTOMER_HAS_ORDERS", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
+
+func parseListParams(c *gin.Context) (ListParams, error) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	params := ListParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	if search := c.Query("search"); search != "" {
+		params.Search = &search
+	}
+
+	switch sort := c.DefaultQuery("sort", SortCreatedDesc); sort {
+	case SortCreatedDesc, SortCreatedAsc, SortNameAsc, SortNameDesc,
+		SortTotalSpentDesc, SortTotalSpentAsc, SortOrderCountDesc, SortOrderCountAsc:
+		params.Sort = &sort
+	default:
+		return ListParams{}, fmt.Errorf("unknown sort %q", sort)
+	}
+
+	var err error
+	if params.MinSpent, err = parseAmount(c, "min_spent"); err != nil {
+		return ListParams{}, err
+	}
+	if params.MaxSpent, err = parseAmount(c, "max_spent"); err != nil {
+		return ListParams{}, err
+	}
+
+	if v := c.Query("created_from"); v != "" {
+		t, _, err := parseDate(v)
+		if err != nil {
+			return ListParams{}, fmt.Errorf("created_from: %w", err)
+		}
+		params.CreatedFrom = &t
+	}
+	if v := c.Query("created_to"); v != "" {
+		t, dateOnly, err := parseDate(v)
+		if err != nil {
+			return ListParams{}, fmt.Errorf("created_to: %w", err)
+		}
+		// created_to=2024-01-31 berarti sampai akhir hari tersebut
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		params.CreatedTo = &t
+	}
+
+	return params, nil
+}
+
+func parseAmount(c *gin.Context, key string) (*float64, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return nil, fmt.Errorf("%s must be a non-negative number", key)
+	}
+	return &f, nil
+}
+
+// parseDate menerima YYYY-MM-DD atau RFC3339
+func parseDate(v string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, v); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("expected YYYY-MM-DD or RFC3339, got %q", v)
+	}
+	return t, false, nil
+}
+
+func paginationMeta(total int64, p ListParams) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalPages: int((total + int64(p.PageSize) - 1) / int64(p.PageSize)),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"assignment-ptes-achmad-rifai/internal/customer"
 
@@ -19,17 +20,21 @@ import (
 
 type fakeCustomerService struct {
 	CreateFn  func(ctx context.Context, req customer.CreateCustomerRequest) (customer.CustomerResponse, error)
-	ListFn    func(ctx context.Context, p customer.ListParams) ([]customer.CustomerResponse, error)
+	ListFn    func(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error)
 	GetByIDFn func(ctx context.Context, id string) (customer.CustomerResponse, error)
 	UpdateFn  func(ctx context.Context, id string, req customer.UpdateCustomerRequest) (customer.CustomerResponse, error)
 	DeleteFn  func(ctx context.Context, id string) error
+
+	GetSummaryFn func(ctx context.Context, id string) (customer.CustomerSummaryResponse, error)
+	ExportDataFn func(ctx context.Context, id string) (customer.DataExportResponse, error)
+	AnonymiseFn  func(ctx context.Context, id string) (customer.AnonymiseResponse, error)
 }
 
 func (f *fakeCustomerService) Create(ctx context.Context, req customer.CreateCustomerRequest) (customer.CustomerResponse, error) {
 	return f.CreateFn(ctx, req)
 }
 
-func (f *fakeCustomerService) List(ctx context.Context, p customer.ListParams) ([]customer.CustomerResponse, error) {
+func (f *fakeCustomerService) List(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error) {
 	return f.ListFn(ctx, p)
 }
 
@@ -45,6 +50,18 @@ func (f *fakeCustomerService) Delete(ctx context.Context, id string) error {
 	return f.DeleteFn(ctx, id)
 }
 
+func (f *fakeCustomerService) GetSummary(ctx context.Context, id string) (customer.CustomerSummaryResponse, error) {
+	return f.GetSummaryFn(ctx, id)
+}
+
+func (f *fakeCustomerService) ExportData(ctx context.Context, id string) (customer.DataExportResponse, error) {
+	return f.ExportDataFn(ctx, id)
+}
+
+func (f *fakeCustomerService) Anonymise(ctx context.Context, id string) (customer.AnonymiseResponse, error) {
+	return f.AnonymiseFn(ctx, id)
+}
+
 // ========== HELPERS ==========
 
 func setupTestRouter() *gin.Engine {
@@ -138,11 +155,12 @@ func TestHandler_Create(t *testing.T) {
 func TestHandler_GetAll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeCustomerService{
-			ListFn: func(ctx context.Context, p customer.ListParams) ([]customer.CustomerResponse, error) {
-				return []customer.CustomerResponse{
+			ListFn: func(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error) {
+				assert.Equal(t, customer.SortCreatedDesc, *p.Sort)
+				return []customer.CustomerListItem{
 					{ID: "uuid-1", Name: "John Doe", Email: "john@example.com"},
 					{ID: "uuid-2", Name: "Jane Doe", Email: "jane@example.com"},
-				}, nil
+				}, 2, nil
 			},
 		}
 
@@ -157,10 +175,58 @@ func TestHandler_GetAll(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("passes_filters_and_pagination", func(t *testing.T) {
+		svc := &fakeCustomerService{
+			ListFn: func(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error) {
+				assert.Equal(t, "jo", *p.Search)
+				assert.Equal(t, customer.SortOrderCountDesc, *p.Sort)
+				assert.Equal(t, 250000.0, *p.MinSpent)
+				assert.Nil(t, p.MaxSpent)
+				// created_to berupa tanggal mencakup seluruh hari tersebut
+				assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), *p.CreatedTo)
+				assert.Equal(t, 2, p.Page)
+				return []customer.CustomerListItem{{ID: "uuid-1"}}, 11, nil
+			},
+		}
+
+		r := setupTestRouter()
+		handler := customer.NewHandler(svc)
+		r.GET("/customers", handler.GetAll)
+
+		req := httptest.NewRequest(http.MethodGet, "/customers?search=jo&sort=order_count_desc&min_spent=250000&created_to=2024-01-31&page=2", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalPages":2`)
+	})
+
+	invalid := []struct {
+		name  string
+		query string
+	}{
+		{"unknown sort", "sort=email_asc"},
+		{"negative spend", "min_spent=-1"},
+		{"bad date", "created_from=31-01-2024"},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			r := setupTestRouter()
+			handler := customer.NewHandler(&fakeCustomerService{})
+			r.GET("/customers", handler.GetAll)
+
+			req := httptest.NewRequest(http.MethodGet, "/customers?"+tc.query, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
 	t.Run("service error", func(t *testing.T) {
 		svc := &fakeCustomerService{
-			ListFn: func(ctx context.Context, p customer.ListParams) ([]customer.CustomerResponse, error) {
-				return nil, errors.New("db error")
+			ListFn: func(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error) {
+				return nil, 0, errors.New("db error")
 			},
 		}
 
@@ -339,3 +405,137 @@ func TestHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestHandler_GetSummary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeCustomerService{
+			GetSummaryFn: func(ctx context.Context, id string) (customer.CustomerSummaryResponse, error) {
+				assert.Equal(t, "uuid-1", id)
+				return customer.CustomerSummaryResponse{CustomerID: id, OrderCount: 2, LifetimeSpend: 300000}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/customers/uuid-1/summary", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := &fakeCustomerService{
+			GetSummaryFn: func(ctx context.Context, id string) (customer.CustomerSummaryResponse, error) {
+				return customer.CustomerSummaryResponse{}, customer.ErrCustomerNotFound
+			},
+		}
+
+		r := setupTestRouter()
+		customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/customers/uuid-999/summary", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandler_Delete_HasOrders(t *testing.T) {
+	svc := &fakeCustomerService{
+		DeleteFn: func(ctx context.Context, id string) error {
+			return customer.ErrCustomerHasOrders
+		},
+	}
+
+	r := setupTestRouter()
+	customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/customers/uuid-1", nil))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_ExportData(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusOK},
+		{"customer not found", customer.ErrCustomerNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeCustomerService{
+				ExportDataFn: func(ctx context.Context, id string) (customer.DataExportResponse, error) {
+					assert.Equal(t, "uuid-1", id)
+					return customer.DataExportResponse{Profile: customer.DataExportProfile{ID: id}}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/customers/uuid-1/data-export", nil))
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Anonymise(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusOK},
+		{"customer not found", customer.ErrCustomerNotFound, http.StatusNotFound},
+		{"already anonymised", customer.ErrCustomerAnonymised, http.StatusConflict},
+		{"service error", errors.New("db error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeCustomerService{
+				AnonymiseFn: func(ctx context.Context, id string) (customer.AnonymiseResponse, error) {
+					assert.Equal(t, "uuid-1", id)
+					return customer.AnonymiseResponse{CustomerID: id}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/customers/uuid-1/anonymise", nil))
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Create_EmailAlreadyExists(t *testing.T) {
+	svc := &fakeCustomerService{
+		CreateFn: func(ctx context.Context, req customer.CreateCustomerRequest) (customer.CustomerResponse, error) {
+			return customer.CustomerResponse{}, customer.ErrEmailAlreadyExists
+		},
+	}
+
+	r := setupTestRouter()
+	customer.RegisterRoutes(r.Group(""), customer.NewHandler(svc))
+
+	req := httptest.NewRequest(http.MethodPost, "/customers", bytes.NewBufferString(`{"name":"John Doe","email":"John@Example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "EMAIL_ALREADY_EXISTS")
+}
@@ -0,0 +1,207 @@
+package customer
+
+import (
+	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Aksi audit log untuk permintaan data pribadi
+const (
+	AuditActionDataExport = "CUSTOMER_DATA_EXPORTED"
+	AuditActionAnonymise  = "CUSTOMER_ANONYMISED"
+)
+
+// AnonymisedName menggantikan nama customer (dan penerima di alamat order) saat dianonimkan
+const AnonymisedName = "Anonymised customer"
+
+// exportOrderPageSize adalah ukuran halaman saat membaca seluruh riwayat order untuk ekspor
+const exportOrderPageSize = 100
+
+// anonymisedEmail tetap unik per customer agar tidak bentrok dengan UNIQUE(email);
+// domain .invalid dijamin tidak pernah bisa menerima email
+func anonymisedEmail(id string) string {
+	return "anonymised+" + id + "@anonymised.invalid"
+}
+
+// ExportData mengumpulkan profil, alamat tersimpan & seluruh riwayat order customer
+func (s *service) ExportData(ctx context.Context, id string) (DataExportResponse, error) {
+	row, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return DataExportResponse{}, ErrCustomerNotFound
+		}
+		return DataExportResponse{}, err
+	}
+
+	addresses, err := s.addresses.List(ctx, id)
+	if err != nil {
+		return DataExportResponse{}, err
+	}
+
+	orders, err := s.listAllOrders(ctx, id)
+	if err != nil {
+		return DataExportResponse{}, err
+	}
+
+	s.audit.Log(ctx, bootstrap.AuditLog{
+		Action:  AuditActionDataExport,
+		Message: "Customer personal data exported",
+		Meta: map[string]any{
+			"customer_id":   id,
+			"address_count": len(addresses),
+			"order_count":   len(orders),
+		},
+	})
+
+	return DataExportResponse{
+		ExportedAt: time.Now(),
+		Profile: DataExportProfile{
+			ID:              row.ID,
+			Name:            row.Name,
+			Email:           row.Email,
+			EmailVerifiedAt: helper.NullTimeToPtr(row.EmailVerifiedAt),
+			AnonymisedAt:    helper.NullTimeToPtr(row.AnonymisedAt),
+			CreatedAt:       row.CreatedAt,
+		},
+		Addresses: addresses,
+		Orders:    orders,
+	}, nil
+}
+
+func (s *service) listAllOrders(ctx context.Context, id string) ([]order.OrderResponse, error) {
+	sort := order.SortCreatedAsc
+	all := []order.OrderResponse{}
+	for page := 1; ; page++ {
+		rows, total, err := s.orders.ListByCustomer(ctx, id, order.ListParams{
+			Page:     page,
+			PageSize: exportOrderPageSize,
+			Sort:     &sort,
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, rows...)
+		if len(rows) < exportOrderPageSize || int64(len(all)) >= total {
+			return all, nil
+		}
+	}
+}
+
+// Anonymise menghapus data pribadi customer tanpa menghapus order-nya: profil diganti identitas
+// anonim, sesi & token dicabut, alamat tersimpan dihapus, nama/telepon/jalan di alamat pengiriman
+// order, payload event customer di outbox & webhook delivery ikut dibersihkan, dan email yang masih
+// antre dihapus. Event CustomerAnonymised membuat relay menghapus entry customer di Redis Stream.
+func (s *service) Anonymise(ctx context.Context, id string) (AnonymiseResponse, error) {
+	row, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AnonymiseResponse{}, ErrCustomerNotFound
+		}
+		return AnonymiseResponse{}, err
+	}
+	if row.AnonymisedAt.Valid {
+		return AnonymiseResponse{}, ErrCustomerAnonymised
+	}
+
+	now := time.Now()
+	email := anonymisedEmail(id)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AnonymiseResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	affected, err := txRepo.Anonymise(ctx, dbgen.AnonymiseCustomerParams{
+		Name:         AnonymisedName,
+		Email:        email,
+		AnonymisedAt: sql.NullTime{Time: now, Valid: true},
+		ID:           id,
+	})
+	if err != nil {
+		return AnonymiseResponse{}, err
+	}
+	if affected == 0 {
+		// Dianonimkan oleh request lain setelah GetByID
+		return AnonymiseResponse{}, ErrCustomerAnonymised
+	}
+
+	if err := txRepo.DeleteAllTokens(ctx, id); err != nil {
+		return AnonymiseResponse{}, err
+	}
+	deleted, err := txRepo.DeleteAllAddresses(ctx, id)
+	if err != nil {
+		return AnonymiseResponse{}, err
+	}
+	if err := txRepo.AnonymiseShippingAddresses(ctx, dbgen.AnonymiseOrderShippingAddressesParams{
+		RecipientName: AnonymisedName,
+		CustomerID:    id,
+	}); err != nil {
+		return AnonymiseResponse{}, err
+	}
+	if err := txRepo.AnonymiseOutboxPayloads(ctx, dbgen.AnonymiseOutboxPayloadsParams{
+		Name:          AnonymisedName,
+		Email:         email,
+		AggregateType: outbox.AggregateCustomer,
+		AggregateID:   id,
+	}); err != nil {
+		return AnonymiseResponse{}, err
+	}
+	if err := txRepo.AnonymiseWebhookDeliveries(ctx, dbgen.AnonymiseWebhookDeliveryPayloadsParams{
+		Name:          AnonymisedName,
+		Email:         email,
+		AggregateType: outbox.AggregateCustomer,
+		AggregateID:   id,
+	}); err != nil {
+		return AnonymiseResponse{}, err
+	}
+	jobsDeleted, err := txRepo.DeleteEmailJobs(ctx, dbgen.DeleteCustomerEmailJobsParams{
+		AccountEmailType: notification.JobSendAccountEmail,
+		OrderEmailType:   notification.JobSendOrderEmail,
+		CustomerID:       id,
+	})
+	if err != nil {
+		return AnonymiseResponse{}, err
+	}
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateCustomer, id, outbox.EventCustomerAnonymised, outbox.CustomerAnonymised{
+		CustomerID: id,
+	}); err != nil {
+		return AnonymiseResponse{}, err
+	}
+
+	stats, err := txRepo.GetOrderStats(ctx, id)
+	if err != nil {
+		return AnonymiseResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AnonymiseResponse{}, err
+	}
+
+	s.audit.Log(ctx, bootstrap.AuditLog{
+		Action:  AuditActionAnonymise,
+		Message: "Customer personal data anonymised",
+		Meta: map[string]any{
+			"customer_id":        id,
+			"addresses_deleted":  deleted,
+			"email_jobs_deleted": jobsDeleted,
+			"orders_retained":    stats.OrderCount,
+		},
+	})
+
+	return AnonymiseResponse{
+		CustomerID:       id,
+		AnonymisedAt:     now,
+		AddressesDeleted: deleted,
+		OrdersRetained:   stats.OrderCount,
+	}, nil
+}
@@ -0,0 +1,237 @@
+package customer_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	mockAddress "assignment-ptes-achmad-rifai/internal/address/mocks"
+	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"assignment-ptes-achmad-rifai/internal/customer"
+	mockCustomer "assignment-ptes-achmad-rifai/internal/customer/mocks"
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/order"
+	mockOrder "assignment-ptes-achmad-rifai/internal/order/mocks"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type recordingAuditLogger struct {
+	entries []bootstrap.AuditLog
+}
+
+func (l *recordingAuditLogger) Log(ctx context.Context, entry bootstrap.AuditLog) {
+	l.entries = append(l.entries, entry)
+}
+
+type privacyTest struct {
+	svc       customer.Service
+	repo      *mockCustomer.MockRepository
+	orders    *mockOrder.MockService
+	addresses *mockAddress.MockService
+	audit     *recordingAuditLogger
+	mock      sqlmock.Sqlmock
+}
+
+func setupPrivacyTest(t *testing.T) privacyTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	pt := privacyTest{
+		repo:      mockCustomer.NewMockRepository(ctrl),
+		orders:    mockOrder.NewMockService(ctrl),
+		addresses: mockAddress.NewMockService(ctrl),
+		audit:     &recordingAuditLogger{},
+		mock:      mock,
+	}
+	pt.svc = customer.NewService(db, pt.repo, pt.orders, pt.addresses, pt.audit)
+	return pt
+}
+
+func TestService_ExportData(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	t.Run("bundles_profile_addresses_and_all_order_pages", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+
+		verified := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		pt.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{
+			ID:              id,
+			Name:            "Budi Santoso",
+			Email:           "budi@example.com",
+			EmailVerifiedAt: sql.NullTime{Time: verified, Valid: true},
+		}, nil)
+		pt.addresses.EXPECT().List(ctx, id).Return([]address.AddressResponse{{ID: "addr-1", CustomerID: id}}, nil)
+
+		// 101 order: halaman pertama penuh, halaman kedua berisi sisa satu order
+		firstPage := make([]order.OrderResponse, 100)
+		for i := range firstPage {
+			firstPage[i] = order.OrderResponse{ID: fmt.Sprintf("order-%d", i)}
+		}
+		pt.orders.EXPECT().
+			ListByCustomer(ctx, id, gomock.AssignableToTypeOf(order.ListParams{})).
+			DoAndReturn(func(_ context.Context, _ string, p order.ListParams) ([]order.OrderResponse, int64, error) {
+				assert.Equal(t, order.SortCreatedAsc, *p.Sort)
+				if p.Page == 1 {
+					return firstPage, 101, nil
+				}
+				assert.Equal(t, 2, p.Page)
+				return []order.OrderResponse{{ID: "order-100"}}, 101, nil
+			}).
+			Times(2)
+
+		res, err := pt.svc.ExportData(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "budi@example.com", res.Profile.Email)
+		assert.Equal(t, &verified, res.Profile.EmailVerifiedAt)
+		assert.Nil(t, res.Profile.AnonymisedAt)
+		assert.Len(t, res.Addresses, 1)
+		assert.Len(t, res.Orders, 101)
+		assert.Equal(t, "order-100", res.Orders[100].ID)
+
+		assert.Len(t, pt.audit.entries, 1)
+		assert.Equal(t, customer.AuditActionDataExport, pt.audit.entries[0].Action)
+		assert.Equal(t, id, pt.audit.entries[0].Meta["customer_id"])
+		assert.Equal(t, 101, pt.audit.entries[0].Meta["order_count"])
+	})
+
+	t.Run("customer_not_found", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+
+		pt.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{}, sql.ErrNoRows)
+
+		_, err := pt.svc.ExportData(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerNotFound)
+		assert.Empty(t, pt.audit.entries)
+	})
+}
+
+func TestService_Anonymise(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.NewString()
+	active := dbgen.GetCustomerByIDRow{ID: id, Name: "Budi Santoso", Email: "budi@example.com"}
+
+	t.Run("scrubs_personal_data_and_keeps_orders", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+		email := "anonymised+" + id + "@anonymised.invalid"
+
+		pt.mock.ExpectBegin()
+		pt.mock.ExpectCommit()
+
+		pt.repo.EXPECT().GetByID(ctx, id).Return(active, nil)
+		pt.repo.EXPECT().WithTx(gomock.Any()).Return(pt.repo)
+		pt.repo.EXPECT().
+			Anonymise(ctx, gomock.AssignableToTypeOf(dbgen.AnonymiseCustomerParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.AnonymiseCustomerParams) (int64, error) {
+				assert.Equal(t, id, p.ID)
+				assert.Equal(t, customer.AnonymisedName, p.Name)
+				assert.Equal(t, email, p.Email)
+				assert.True(t, p.AnonymisedAt.Valid)
+				return 1, nil
+			})
+		pt.repo.EXPECT().DeleteAllTokens(ctx, id).Return(nil)
+		pt.repo.EXPECT().DeleteAllAddresses(ctx, id).Return(int64(2), nil)
+		pt.repo.EXPECT().AnonymiseShippingAddresses(ctx, dbgen.AnonymiseOrderShippingAddressesParams{
+			RecipientName: customer.AnonymisedName,
+			CustomerID:    id,
+		}).Return(nil)
+		pt.repo.EXPECT().AnonymiseOutboxPayloads(ctx, dbgen.AnonymiseOutboxPayloadsParams{
+			Name:          customer.AnonymisedName,
+			Email:         email,
+			AggregateType: outbox.AggregateCustomer,
+			AggregateID:   id,
+		}).Return(nil)
+		pt.repo.EXPECT().AnonymiseWebhookDeliveries(ctx, dbgen.AnonymiseWebhookDeliveryPayloadsParams{
+			Name:          customer.AnonymisedName,
+			Email:         email,
+			AggregateType: outbox.AggregateCustomer,
+			AggregateID:   id,
+		}).Return(nil)
+		pt.repo.EXPECT().DeleteEmailJobs(ctx, dbgen.DeleteCustomerEmailJobsParams{
+			AccountEmailType: notification.JobSendAccountEmail,
+			OrderEmailType:   notification.JobSendOrderEmail,
+			CustomerID:       id,
+		}).Return(int64(3), nil)
+		var event dbgen.CreateOutboxEventParams
+		pt.repo.EXPECT().
+			CreateOutboxEvent(ctx, gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+				event = p
+				return nil
+			})
+		pt.repo.EXPECT().GetOrderStats(ctx, id).Return(dbgen.GetCustomerOrderStatsRow{OrderCount: 4}, nil)
+
+		res, err := pt.svc.Anonymise(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, outbox.EventCustomerAnonymised, event.EventType)
+		assert.Equal(t, outbox.AggregateCustomer, event.AggregateType)
+		assert.Equal(t, id, event.AggregateID)
+		assert.JSONEq(t, `{"customer_id":"`+id+`"}`, string(event.Payload))
+		assert.Equal(t, int64(2), res.AddressesDeleted)
+		assert.Equal(t, int64(4), res.OrdersRetained)
+		assert.Len(t, pt.audit.entries, 1)
+		assert.Equal(t, customer.AuditActionAnonymise, pt.audit.entries[0].Action)
+		assert.Equal(t, id, pt.audit.entries[0].Meta["customer_id"])
+		assert.Equal(t, int64(3), pt.audit.entries[0].Meta["email_jobs_deleted"])
+		assert.NoError(t, pt.mock.ExpectationsWereMet())
+	})
+
+	t.Run("already_anonymised", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+
+		anonymised := active
+		anonymised.AnonymisedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		pt.repo.EXPECT().GetByID(ctx, id).Return(anonymised, nil)
+
+		_, err := pt.svc.Anonymise(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerAnonymised)
+		assert.Empty(t, pt.audit.entries)
+	})
+
+	t.Run("concurrent_anonymise_rolls_back", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+
+		pt.mock.ExpectBegin()
+		pt.mock.ExpectRollback()
+
+		pt.repo.EXPECT().GetByID(ctx, id).Return(active, nil)
+		pt.repo.EXPECT().WithTx(gomock.Any()).Return(pt.repo)
+		pt.repo.EXPECT().Anonymise(ctx, gomock.Any()).Return(int64(0), nil)
+
+		_, err := pt.svc.Anonymise(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerAnonymised)
+		assert.Empty(t, pt.audit.entries)
+		assert.NoError(t, pt.mock.ExpectationsWereMet())
+	})
+
+	t.Run("customer_not_found", func(t *testing.T) {
+		pt := setupPrivacyTest(t)
+
+		pt.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{}, sql.ErrNoRows)
+
+		_, err := pt.svc.Anonymise(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerNotFound)
+	})
+}
@@ -3,15 +3,37 @@ package customer
 import (
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"context"
+	"database/sql"
 )
 
 //go:generate mockgen -source=customer_repo.go -destination=mocks/customer_repo_mock.go -package=mock
 type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
 	Create(ctx context.Context, params dbgen.CreateCustomerParams) error
 	GetCustomers(ctx context.Context, params dbgen.GetCustomersParams) ([]dbgen.GetCustomersRow, error)
+	CountCustomers(ctx context.Context, params dbgen.CountCustomersParams) (int64, error)
 	GetByID(ctx context.Context, id string) (dbgen.GetCustomerByIDRow, error)
+	GetIDByEmail(ctx context.Context, email string) (string, error)
 	Update(ctx context.Context, params dbgen.UpdateCustomerParams) error
 	Delete(ctx context.Context, id string) error
+
+	// Order history aggregates untuk ringkasan customer
+	GetOrderStats(ctx context.Context, customerID string) (dbgen.GetCustomerOrderStatsRow, error)
+	GetFavouriteCategories(ctx context.Context, params dbgen.GetCustomerFavouriteCategoriesParams) ([]dbgen.GetCustomerFavouriteCategoriesRow, error)
+
+	// Penghapusan data pribadi, dijalankan dalam satu transaksi
+	Anonymise(ctx context.Context, params dbgen.AnonymiseCustomerParams) (int64, error)
+	DeleteAllTokens(ctx context.Context, customerID string) error
+	DeleteAllAddresses(ctx context.Context, customerID string) (int64, error)
+	AnonymiseShippingAddresses(ctx context.Context, params dbgen.AnonymiseOrderShippingAddressesParams) error
+	AnonymiseOutboxPayloads(ctx context.Context, params dbgen.AnonymiseOutboxPayloadsParams) error
+	AnonymiseWebhookDeliveries(ctx context.Context, params dbgen.AnonymiseWebhookDeliveryPayloadsParams) error
+	DeleteEmailJobs(ctx context.Context, params dbgen.DeleteCustomerEmailJobsParams) (int64, error)
+
+	// Outbox, ditulis di dalam transaksi yang sama dengan registrasi & anonimisasi customer
+	CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error
 }
 
 type repository struct {
@@ -25,6 +47,16 @@ func NewRepository(q *dbgen.Queries) Repository {
 	}
 }
 
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
 func (r *repository) Create(ctx context.Context, params dbgen.CreateCustomerParams) error {
 	return r.q.CreateCustomer(ctx, params)
 }
@@ -33,10 +65,18 @@ func (r *repository) GetCustomers(ctx context.Context, params dbgen.GetCustomers
 	return r.q.GetCustomers(ctx, params)
 }
 
+func (r *repository) CountCustomers(ctx context.Context, params dbgen.CountCustomersParams) (int64, error) {
+	return r.q.CountCustomers(ctx, params)
+}
+
 func (r *repository) GetByID(ctx context.Context, id string) (dbgen.GetCustomerByIDRow, error) {
 	return r.q.GetCustomerByID(ctx, id)
 }
 
+func (r *repository) GetIDByEmail(ctx context.Context, email string) (string, error) {
+	return r.q.GetCustomerIDByEmail(ctx, email)
+}
+
 func (r *repository) Update(ctx context.Context, params dbgen.UpdateCustomerParams) error {
 	return r.q.UpdateCustomer(ctx, params)
 }
@@ -44,3 +84,43 @@ func (r *repository) Update(ctx context.Context, params dbgen.UpdateCustomerPara
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.q.DeleteCustomer(ctx, id)
 }
+
+func (r *repository) GetOrderStats(ctx context.Context, customerID string) (dbgen.GetCustomerOrderStatsRow, error) {
+	return r.q.GetCustomerOrderStats(ctx, customerID)
+}
+
+func (r *repository) GetFavouriteCategories(ctx context.Context, params dbgen.GetCustomerFavouriteCategoriesParams) ([]dbgen.GetCustomerFavouriteCategoriesRow, error) {
+	return r.q.GetCustomerFavouriteCategories(ctx, params)
+}
+
+func (r *repository) Anonymise(ctx context.Context, params dbgen.AnonymiseCustomerParams) (int64, error) {
+	return r.q.AnonymiseCustomer(ctx, params)
+}
+
+func (r *repository) DeleteAllTokens(ctx context.Context, customerID string) error {
+	return r.q.DeleteAllCustomerTokens(ctx, customerID)
+}
+
+func (r *repository) DeleteAllAddresses(ctx context.Context, customerID string) (int64, error) {
+	return r.q.DeleteAllCustomerAddresses(ctx, customerID)
+}
+
+func (r *repository) AnonymiseShippingAddresses(ctx context.Context, params dbgen.AnonymiseOrderShippingAddressesParams) error {
+	return r.q.AnonymiseOrderShippingAddresses(ctx, params)
+}
+
+func (r *repository) AnonymiseOutboxPayloads(ctx context.Context, params dbgen.AnonymiseOutboxPayloadsParams) error {
+	return r.q.AnonymiseOutboxPayloads(ctx, params)
+}
+
+func (r *repository) AnonymiseWebhookDeliveries(ctx context.Context, params dbgen.AnonymiseWebhookDeliveryPayloadsParams) error {
+	return r.q.AnonymiseWebhookDeliveryPayloads(ctx, params)
+}
+
+func (r *repository) DeleteEmailJobs(ctx context.Context, params dbgen.DeleteCustomerEmailJobsParams) (int64, error) {
+	return r.q.DeleteCustomerEmailJobs(ctx, params)
+}
+
+func (r *repository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	return r.q.CreateOutboxEvent(ctx, params)
+}
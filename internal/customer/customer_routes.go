@@ -10,5 +10,8 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 		customers.GET("/:id", handler.GetByID)
 		customers.PUT("/:id", handler.Update)
 		customers.DELETE("/:id", handler.Delete)
+		customers.GET("/:id/summary", handler.GetSummary)
+		customers.GET("/:id/data-export", handler.ExportData)
+		customers.POST("/:id/anonymise", handler.Anonymise)
 	}
 }
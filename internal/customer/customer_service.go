@@ -1,31 +1,59 @@
 package customer
 
 import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/outbox"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 //go:generate mockgen -source=customer_service.go -destination=mocks/customer_service_mock.go -package=mock
 type Service interface {
 	Create(ctx context.Context, req CreateCustomerRequest) (CustomerResponse, error)
-	List(ctx context.Context, p ListParams) ([]CustomerResponse, error)
+	List(ctx context.Context, p ListParams) ([]CustomerListItem, int64, error)
 	GetByID(ctx context.Context, id string) (CustomerResponse, error)
 	Update(ctx context.Context, id string, req UpdateCustomerRequest) (CustomerResponse, error)
 	Delete(ctx context.Context, id string) error
+	GetSummary(ctx context.Context, id string) (CustomerSummaryResponse, error)
+
+	// Hak subjek data (UU PDP): ekspor & penghapusan data pribadi, keduanya dicatat di audit log
+	ExportData(ctx context.Context, id string) (DataExportResponse, error)
+	Anonymise(ctx context.Context, id string) (AnonymiseResponse, error)
 }
 
+// FavouriteCategoryLimit adalah jumlah kategori favorit yang ditampilkan di ringkasan
+const FavouriteCategoryLimit = 3
+
 type service struct {
+	db   *sql.DB // Diperlukan untuk menulis event CustomerRegistered dalam transaksi yang sama
 	repo Repository
+
+	// Sumber data ekspor; riwayat order & alamat dibaca lewat service pemiliknya
+	orders    order.Service
+	addresses address.Service
+	audit     bootstrap.AuditLogger
 }
 
-func NewService(repo Repository) Service {
-	return &service{repo: repo}
+func NewService(db *sql.DB, repo Repository, orders order.Service, addresses address.Service, audit bootstrap.AuditLogger) Service {
+	return &service{db: db, repo: repo, orders: orders, addresses: addresses, audit: audit}
 }
 
 func (s *service) Create(ctx context.Context, req CreateCustomerRequest) (CustomerResponse, error) {
+	req.Email = helper.NormalizeEmail(req.Email)
+	if err := s.checkEmailAvailable(ctx, req.Email, ""); err != nil {
+		return CustomerResponse{}, err
+	}
+
 	newUUID, err := uuid.NewV7()
 	if err != nil {
 		return CustomerResponse{}, err
@@ -41,7 +69,30 @@ func (s *service) Create(ctx context.Context, req CreateCustomerRequest) (Custom
 		CreatedAt: now,
 	}
 
-	if err := s.repo.Create(ctx, params); err != nil {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return CustomerResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := txRepo.Create(ctx, params); err != nil {
+		// Pre-check di atas tidak menutup race antar request; UNIQUE(email) yang menentukan
+		if helper.IsDuplicateKeyError(err) {
+			return CustomerResponse{}, ErrEmailAlreadyExists
+		}
+		return CustomerResponse{}, err
+	}
+
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateCustomer, id, outbox.EventCustomerRegistered, outbox.CustomerRegistered{
+		CustomerID: id,
+		Name:       req.Name,
+		Email:      req.Email,
+	}); err != nil {
+		return CustomerResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return CustomerResponse{}, err
 	}
 
@@ -53,7 +104,7 @@ func (s *service) Create(ctx context.Context, req CreateCustomerRequest) (Custom
 	}, nil
 }
 
-func (s *service) List(ctx context.Context, p ListParams) ([]CustomerResponse, error) {
+func (s *service) List(ctx context.Context, p ListParams) ([]CustomerListItem, int64, error) {
 	if p.Page <= 0 {
 		p.Page = 1
 	}
@@ -61,21 +112,46 @@ func (s *service) List(ctx context.Context, p ListParams) ([]CustomerResponse, e
 		p.PageSize = 10
 	}
 
-	limit := int32(p.PageSize)
-	offset := int32((p.Page - 1) * p.PageSize)
+	filter := toCountCustomersParams(p)
 	rows, err := s.repo.GetCustomers(ctx, dbgen.GetCustomersParams{
-		Limit:  limit,
-		Offset: offset,
+		Search:      filter.Search,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		MinSpent:    filter.MinSpent,
+		MaxSpent:    filter.MaxSpent,
+		OrderBy:     helper.StringPtrValue(p.Sort),
+		Limit:       int32(p.PageSize),
+		Offset:      int32((p.Page - 1) * p.PageSize),
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	res := make([]CustomerResponse, 0, len(rows))
+	total, err := s.repo.CountCustomers(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]CustomerListItem, 0, len(rows))
 	for _, row := range rows {
-		res = append(res, mapToListResponse(row))
+		res = append(res, mapToListItem(row))
+	}
+	return res, total, nil
+}
+
+// likeEscaper meng-escape wildcard LIKE agar search selalu dicocokkan sebagai awalan literal
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// toCountCustomersParams: search kosong atau parameter NULL berarti filter tidak dipakai;
+// min_spent/max_spent = 0 tetap difilter (mis. max_spent=0 → customer yang belum pernah belanja)
+func toCountCustomersParams(p ListParams) dbgen.CountCustomersParams {
+	return dbgen.CountCustomersParams{
+		Search:      likeEscaper.Replace(strings.TrimSpace(helper.StringPtrValue(p.Search))),
+		CreatedFrom: helper.TimeToNull(p.CreatedFrom),
+		CreatedTo:   helper.TimeToNull(p.CreatedTo),
+		MinSpent:    helper.Float64ToNullDecimal(p.MinSpent),
+		MaxSpent:    helper.Float64ToNullDecimal(p.MaxSpent),
 	}
-	return res, nil
 }
 
 func (s *service) GetByID(ctx context.Context, id string) (CustomerResponse, error) {
@@ -92,6 +168,16 @@ func (s *service) Update(ctx context.Context, id string, req UpdateCustomerReque
 	if err != nil {
 		return CustomerResponse{}, ErrCustomerNotFound
 	}
+	if existing.AnonymisedAt.Valid {
+		return CustomerResponse{}, ErrCustomerAnonymised
+	}
+
+	req.Email = helper.NormalizeEmail(req.Email)
+	if req.Email != existing.Email {
+		if err := s.checkEmailAvailable(ctx, req.Email, id); err != nil {
+			return CustomerResponse{}, err
+		}
+	}
 
 	params := dbgen.UpdateCustomerParams{
 		ID:    id,
@@ -100,6 +186,9 @@ func (s *service) Update(ctx context.Context, id string, req UpdateCustomerReque
 	}
 
 	if err := s.repo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return CustomerResponse{}, ErrEmailAlreadyExists
+		}
 		return CustomerResponse{}, err
 	}
 
@@ -111,16 +200,87 @@ func (s *service) Update(ctx context.Context, id string, req UpdateCustomerReque
 	}, nil
 }
 
+// checkEmailAvailable memastikan email (sudah dinormalisasi) belum dipakai customer lain selain selfID
+func (s *service) checkEmailAvailable(ctx context.Context, email, selfID string) error {
+	ownerID, err := s.repo.GetIDByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ownerID != selfID {
+		return ErrEmailAlreadyExists
+	}
+	return nil
+}
+
 func (s *service) Delete(ctx context.Context, id string) error {
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		// orders.customer_id memakai foreign key RESTRICT
+		if helper.IsRowReferencedError(err) {
+			return ErrCustomerHasOrders
+		}
+		return err
+	}
+	return nil
 }
 
-func mapToListResponse(row dbgen.GetCustomersRow) CustomerResponse {
-	return CustomerResponse{
-		ID:        row.ID,
-		Name:      row.Name,
-		Email:     row.Email,
-		CreatedAt: row.CreatedAt,
+func (s *service) GetSummary(ctx context.Context, id string) (CustomerSummaryResponse, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CustomerSummaryResponse{}, ErrCustomerNotFound
+		}
+		return CustomerSummaryResponse{}, err
+	}
+
+	stats, err := s.repo.GetOrderStats(ctx, id)
+	if err != nil {
+		return CustomerSummaryResponse{}, err
+	}
+
+	rows, err := s.repo.GetFavouriteCategories(ctx, dbgen.GetCustomerFavouriteCategoriesParams{
+		CustomerID: id,
+		Limit:      FavouriteCategoryLimit,
+	})
+	if err != nil {
+		return CustomerSummaryResponse{}, err
+	}
+
+	aov := decimal.Zero
+	if stats.OrderCount > 0 {
+		aov = stats.LifetimeSpend.Div(decimal.NewFromInt(stats.OrderCount)).Round(2)
+	}
+
+	favourites := make([]FavouriteCategory, 0, len(rows))
+	for _, r := range rows {
+		favourites = append(favourites, FavouriteCategory{
+			CategoryName: r.CategoryName,
+			Quantity:     r.TotalQuantity,
+			TotalSpent:   helper.DecimalToFloat64(r.TotalSpent),
+			OrderCount:   r.OrderCount,
+		})
+	}
+
+	return CustomerSummaryResponse{
+		CustomerID:          id,
+		OrderCount:          stats.OrderCount,
+		LifetimeSpend:       helper.DecimalToFloat64(stats.LifetimeSpend),
+		AverageOrderValue:   helper.DecimalToFloat64(aov),
+		FirstOrderAt:        helper.NullTimeToPtr(stats.FirstOrderAt),
+		LastOrderAt:         helper.NullTimeToPtr(stats.LastOrderAt),
+		FavouriteCategories: favourites,
+	}, nil
+}
+
+func mapToListItem(row dbgen.GetCustomersRow) CustomerListItem {
+	return CustomerListItem{
+		ID:         row.ID,
+		Name:       row.Name,
+		Email:      row.Email,
+		CreatedAt:  row.CreatedAt,
+		OrderCount: row.OrderCount,
+		TotalSpent: helper.DecimalToFloat64(row.TotalSpent),
 	}
 }
 
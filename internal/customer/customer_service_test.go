@@ -2,13 +2,18 @@ package customer_test
 
 import (
 	"assignment-ptes-achmad-rifai/internal/customer"
+	"assignment-ptes-achmad-rifai/internal/outbox"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -16,27 +21,46 @@ import (
 )
 
 func setupServiceTest(t *testing.T) (customer.Service, *mockCustomer.MockRepository) {
+	svc, repo, _ := setupServiceTestWithDB(t)
+	return svc, repo
+}
+
+func setupServiceTestWithDB(t *testing.T) (customer.Service, *mockCustomer.MockRepository, sqlmock.Sqlmock) {
 	ctrl := gomock.NewController(t)
 	t.Cleanup(ctrl.Finish)
 
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
 	repo := mockCustomer.NewMockRepository(ctrl)
 
-	svc := customer.NewService(repo)
+	// Dependensi ekspor data hanya dipakai di customer_privacy_test.go
+	svc := customer.NewService(db, repo, nil, nil, nil)
 
-	return svc, repo
+	return svc, repo, mock
 }
 
 func TestService_Create(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
-		svc, repo := setupServiceTest(t)
+		svc, repo, mock := setupServiceTestWithDB(t)
 
 		req := customer.CreateCustomerRequest{
 			Name:  "John Doe",
 			Email: "john@example.com",
 		}
 
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetIDByEmail(ctx, "john@example.com").Return("", sql.ErrNoRows)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		// Expect Create called with matching params
 		repo.EXPECT().
 			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateCustomerParams{})).
@@ -47,6 +71,13 @@ func TestService_Create(t *testing.T) {
 				assert.WithinDuration(t, time.Now(), p.CreatedAt, time.Second*5)
 				return nil
 			})
+		var event dbgen.CreateOutboxEventParams
+		repo.EXPECT().
+			CreateOutboxEvent(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+				event = p
+				return nil
+			})
 
 		res, err := svc.Create(ctx, req)
 
@@ -55,16 +86,26 @@ func TestService_Create(t *testing.T) {
 		assert.Equal(t, "john@example.com", res.Email)
 		assert.NotEmpty(t, res.ID)
 		assert.WithinDuration(t, time.Now(), res.CreatedAt, time.Second*5)
+
+		assert.Equal(t, outbox.EventCustomerRegistered, event.EventType)
+		assert.Equal(t, res.ID, event.AggregateID)
+		assert.JSONEq(t, `{"customer_id":"`+res.ID+`","name":"John Doe","email":"john@example.com"}`, string(event.Payload))
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("repo error", func(t *testing.T) {
-		svc, repo := setupServiceTest(t)
+		svc, repo, mock := setupServiceTestWithDB(t)
 
 		req := customer.CreateCustomerRequest{
 			Name:  "John Doe",
 			Email: "john@example.com",
 		}
 
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().GetIDByEmail(ctx, "john@example.com").Return("", sql.ErrNoRows)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		repo.EXPECT().
 			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateCustomerParams{})).
 			Return(errors.New("db error"))
@@ -72,6 +113,60 @@ func TestService_Create(t *testing.T) {
 		_, err := svc.Create(ctx, req)
 
 		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("normalises email", func(t *testing.T) {
+		svc, repo, mock := setupServiceTestWithDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetIDByEmail(ctx, "john.doe@example.com").Return("", sql.ErrNoRows)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateCustomerParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateCustomerParams) error {
+				assert.Equal(t, "john.doe@example.com", p.Email)
+				return nil
+			})
+		repo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := svc.Create(ctx, customer.CreateCustomerRequest{Name: "John Doe", Email: " John.Doe@Example.COM "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "john.doe@example.com", res.Email)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("email already exists", func(t *testing.T) {
+		svc, repo, mock := setupServiceTestWithDB(t)
+
+		repo.EXPECT().GetIDByEmail(ctx, "john@example.com").Return(uuid.NewString(), nil)
+
+		_, err := svc.Create(ctx, customer.CreateCustomerRequest{Name: "John Doe", Email: "JOHN@example.com"})
+
+		assert.ErrorIs(t, err, customer.ErrEmailAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicate key on insert", func(t *testing.T) {
+		svc, repo, mock := setupServiceTestWithDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		// Request lain mendaftarkan email yang sama setelah pre-check
+		repo.EXPECT().GetIDByEmail(ctx, "john@example.com").Return("", sql.ErrNoRows)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Return(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'john@example.com' for key 'customers.email'"})
+
+		_, err := svc.Create(ctx, customer.CreateCustomerRequest{Name: "John Doe", Email: "john@example.com"})
+
+		assert.ErrorIs(t, err, customer.ErrEmailAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -79,18 +174,21 @@ func TestService_List(t *testing.T) {
 	ctx := context.Background()
 	p := customer.ListParams{Page: 1, PageSize: 10}
 	expectedRepoParams := dbgen.GetCustomersParams{
-		Limit:  10,
-		Offset: 0,
+		OrderBy: "",
+		Limit:   10,
+		Offset:  0,
 	}
 	t.Run("success", func(t *testing.T) {
 		svc, repo := setupServiceTest(t)
 
 		rows := []dbgen.GetCustomersRow{
 			{
-				ID:        uuid.NewString(),
-				Name:      "User 1",
-				Email:     "user1@example.com",
-				CreatedAt: time.Now(),
+				ID:         uuid.NewString(),
+				Name:       "User 1",
+				Email:      "user1@example.com",
+				CreatedAt:  time.Now(),
+				OrderCount: 3,
+				TotalSpent: decimal.NewFromInt(450000),
 			},
 			{
 				ID:        uuid.NewString(),
@@ -103,15 +201,90 @@ func TestService_List(t *testing.T) {
 		repo.EXPECT().
 			GetCustomers(ctx, expectedRepoParams).
 			Return(rows, nil)
+		repo.EXPECT().
+			CountCustomers(ctx, dbgen.CountCustomersParams{}).
+			Return(int64(12), nil)
 
-		res, err := svc.List(ctx, p)
+		res, total, err := svc.List(ctx, p)
 
 		assert.NoError(t, err)
+		assert.Equal(t, int64(12), total)
 		assert.Len(t, res, 2)
 		assert.Equal(t, "User 1", res[0].Name)
+		assert.Equal(t, int64(3), res[0].OrderCount)
+		assert.Equal(t, float64(450000), res[0].TotalSpent)
 		assert.Equal(t, "User 2", res[1].Name)
 	})
 
+	t.Run("filters_and_sort", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		search := " budi_50% "
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		minSpent := 100000.0
+		sort := customer.SortTotalSpentDesc
+
+		filter := dbgen.CountCustomersParams{
+			Search:      `budi\_50\%`,
+			CreatedFrom: sql.NullTime{Time: from, Valid: true},
+			MinSpent:    decimal.NewNullDecimal(decimal.NewFromInt(100000)),
+		}
+		repo.EXPECT().
+			GetCustomers(ctx, gomock.AssignableToTypeOf(dbgen.GetCustomersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.GetCustomersParams) ([]dbgen.GetCustomersRow, error) {
+				assert.Equal(t, filter.Search, arg.Search)
+				assert.Equal(t, filter.CreatedFrom, arg.CreatedFrom)
+				assert.False(t, arg.CreatedTo.Valid)
+				assert.True(t, arg.MinSpent.Valid)
+				assert.True(t, filter.MinSpent.Decimal.Equal(arg.MinSpent.Decimal))
+				assert.False(t, arg.MaxSpent.Valid)
+				assert.Equal(t, customer.SortTotalSpentDesc, arg.OrderBy)
+				assert.Equal(t, int32(20), arg.Limit)
+				assert.Equal(t, int32(20), arg.Offset)
+				return nil, nil
+			})
+		repo.EXPECT().
+			CountCustomers(ctx, gomock.AssignableToTypeOf(dbgen.CountCustomersParams{})).
+			Return(int64(0), nil)
+
+		res, total, err := svc.List(ctx, customer.ListParams{
+			Page:        2,
+			PageSize:    20,
+			Search:      &search,
+			CreatedFrom: &from,
+			MinSpent:    &minSpent,
+			Sort:        &sort,
+		})
+
+		assert.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, res)
+	})
+
+	t.Run("zero_max_spent_is_still_a_filter", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		maxSpent := 0.0
+		repo.EXPECT().
+			GetCustomers(ctx, gomock.AssignableToTypeOf(dbgen.GetCustomersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.GetCustomersParams) ([]dbgen.GetCustomersRow, error) {
+				assert.False(t, arg.MinSpent.Valid)
+				assert.True(t, arg.MaxSpent.Valid)
+				assert.True(t, arg.MaxSpent.Decimal.IsZero())
+				return nil, nil
+			})
+		repo.EXPECT().
+			CountCustomers(ctx, gomock.AssignableToTypeOf(dbgen.CountCustomersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.CountCustomersParams) (int64, error) {
+				assert.True(t, arg.MaxSpent.Valid)
+				return 0, nil
+			})
+
+		_, _, err := svc.List(ctx, customer.ListParams{Page: 1, PageSize: 10, MaxSpent: &maxSpent})
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("repo error", func(t *testing.T) {
 		svc, repo := setupServiceTest(t)
 		p := customer.ListParams{Page: 1, PageSize: 10}
@@ -120,7 +293,7 @@ func TestService_List(t *testing.T) {
 			GetCustomers(ctx, expectedRepoParams).
 			Return(nil, errors.New("db error"))
 
-		_, err := svc.List(ctx, p)
+		_, _, err := svc.List(ctx, p)
 
 		assert.Error(t, err)
 	})
@@ -200,6 +373,9 @@ func TestService_Update(t *testing.T) {
 		repo.EXPECT().
 			GetByID(ctx, id).
 			Return(existing, nil)
+		repo.EXPECT().
+			GetIDByEmail(ctx, "updated@example.com").
+			Return("", sql.ErrNoRows)
 
 		// Expect Update call
 		repo.EXPECT().
@@ -255,6 +431,9 @@ func TestService_Update(t *testing.T) {
 		repo.EXPECT().
 			GetByID(ctx, id).
 			Return(existing, nil)
+		repo.EXPECT().
+			GetIDByEmail(ctx, "updated@example.com").
+			Return("", sql.ErrNoRows)
 
 		repo.EXPECT().
 			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateCustomerParams{})).
@@ -264,6 +443,67 @@ func TestService_Update(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("email owned by another customer", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.GetCustomerByIDRow{ID: id, Email: "old@example.com"}, nil)
+		repo.EXPECT().
+			GetIDByEmail(ctx, "taken@example.com").
+			Return(uuid.NewString(), nil)
+
+		_, err := svc.Update(ctx, id, customer.UpdateCustomerRequest{Name: "Budi", Email: "Taken@Example.com"})
+
+		assert.ErrorIs(t, err, customer.ErrEmailAlreadyExists)
+	})
+
+	t.Run("case-only change skips pre-check", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.GetCustomerByIDRow{ID: id, Email: "budi@example.com"}, nil)
+		repo.EXPECT().
+			Update(gomock.Any(), dbgen.UpdateCustomerParams{ID: id, Name: "Budi", Email: "budi@example.com"}).
+			Return(nil)
+
+		res, err := svc.Update(ctx, id, customer.UpdateCustomerRequest{Name: "Budi", Email: "Budi@Example.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "budi@example.com", res.Email)
+	})
+
+	t.Run("duplicate key on update", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.GetCustomerByIDRow{ID: id, Email: "old@example.com"}, nil)
+		repo.EXPECT().
+			GetIDByEmail(ctx, "new@example.com").
+			Return("", sql.ErrNoRows)
+		repo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			Return(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+
+		_, err := svc.Update(ctx, id, customer.UpdateCustomerRequest{Name: "Budi", Email: "new@example.com"})
+
+		assert.ErrorIs(t, err, customer.ErrEmailAlreadyExists)
+	})
+
+	t.Run("anonymised customer", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.GetCustomerByIDRow{ID: id, AnonymisedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+
+		_, err := svc.Update(ctx, id, customer.UpdateCustomerRequest{Name: "Budi", Email: "budi@example.com"})
+
+		assert.ErrorIs(t, err, customer.ErrCustomerAnonymised)
+	})
 }
 
 func TestService_Delete(t *testing.T) {
@@ -293,4 +533,78 @@ func TestService_Delete(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("has orders", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			Delete(ctx, id).
+			Return(&mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row"})
+
+		err := svc.Delete(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerHasOrders)
+	})
+}
+
+func TestService_GetSummary(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		first := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+		last := time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{ID: id}, nil)
+		repo.EXPECT().GetOrderStats(ctx, id).Return(dbgen.GetCustomerOrderStatsRow{
+			OrderCount:    3,
+			LifetimeSpend: decimal.NewFromInt(100000),
+			FirstOrderAt:  sql.NullTime{Time: first, Valid: true},
+			LastOrderAt:   sql.NullTime{Time: last, Valid: true},
+		}, nil)
+		repo.EXPECT().
+			GetFavouriteCategories(ctx, dbgen.GetCustomerFavouriteCategoriesParams{CustomerID: id, Limit: customer.FavouriteCategoryLimit}).
+			Return([]dbgen.GetCustomerFavouriteCategoriesRow{
+				{CategoryName: "Pakaian", TotalQuantity: 4, TotalSpent: decimal.NewFromInt(80000), OrderCount: 2},
+			}, nil)
+
+		res, err := svc.GetSummary(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), res.OrderCount)
+		assert.Equal(t, float64(100000), res.LifetimeSpend)
+		assert.Equal(t, 33333.33, res.AverageOrderValue)
+		assert.Equal(t, first, *res.FirstOrderAt)
+		assert.Equal(t, last, *res.LastOrderAt)
+		assert.Len(t, res.FavouriteCategories, 1)
+		assert.Equal(t, "Pakaian", res.FavouriteCategories[0].CategoryName)
+	})
+
+	t.Run("no orders yet", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{ID: id}, nil)
+		repo.EXPECT().GetOrderStats(ctx, id).Return(dbgen.GetCustomerOrderStatsRow{LifetimeSpend: decimal.Zero}, nil)
+		repo.EXPECT().GetFavouriteCategories(ctx, gomock.Any()).Return(nil, nil)
+
+		res, err := svc.GetSummary(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Zero(t, res.AverageOrderValue)
+		assert.Nil(t, res.FirstOrderAt)
+		assert.NotNil(t, res.FavouriteCategories)
+		assert.Empty(t, res.FavouriteCategories)
+	})
+
+	t.Run("customer not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetCustomerByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.GetSummary(ctx, id)
+
+		assert.ErrorIs(t, err, customer.ErrCustomerNotFound)
+	})
 }
@@ -10,6 +10,7 @@
 package mock
 
 import (
+	customer "assignment-ptes-achmad-rifai/internal/customer"
 	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	context "context"
 	reflect "reflect"
@@ -41,6 +42,78 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Anonymise mocks base method.
+func (m *MockRepository) Anonymise(ctx context.Context, params dbgen.AnonymiseCustomerParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymise", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Anonymise indicates an expected call of Anonymise.
+func (mr *MockRepositoryMockRecorder) Anonymise(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymise", reflect.TypeOf((*MockRepository)(nil).Anonymise), ctx, params)
+}
+
+// AnonymiseOutboxPayloads mocks base method.
+func (m *MockRepository) AnonymiseOutboxPayloads(ctx context.Context, params dbgen.AnonymiseOutboxPayloadsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymiseOutboxPayloads", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnonymiseOutboxPayloads indicates an expected call of AnonymiseOutboxPayloads.
+func (mr *MockRepositoryMockRecorder) AnonymiseOutboxPayloads(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymiseOutboxPayloads", reflect.TypeOf((*MockRepository)(nil).AnonymiseOutboxPayloads), ctx, params)
+}
+
+// AnonymiseShippingAddresses mocks base method.
+func (m *MockRepository) AnonymiseShippingAddresses(ctx context.Context, params dbgen.AnonymiseOrderShippingAddressesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymiseShippingAddresses", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnonymiseShippingAddresses indicates an expected call of AnonymiseShippingAddresses.
+func (mr *MockRepositoryMockRecorder) AnonymiseShippingAddresses(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymiseShippingAddresses", reflect.TypeOf((*MockRepository)(nil).AnonymiseShippingAddresses), ctx, params)
+}
+
+// AnonymiseWebhookDeliveries mocks base method.
+func (m *MockRepository) AnonymiseWebhookDeliveries(ctx context.Context, params dbgen.AnonymiseWebhookDeliveryPayloadsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymiseWebhookDeliveries", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnonymiseWebhookDeliveries indicates an expected call of AnonymiseWebhookDeliveries.
+func (mr *MockRepositoryMockRecorder) AnonymiseWebhookDeliveries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymiseWebhookDeliveries", reflect.TypeOf((*MockRepository)(nil).AnonymiseWebhookDeliveries), ctx, params)
+}
+
+// CountCustomers mocks base method.
+func (m *MockRepository) CountCustomers(ctx context.Context, params dbgen.CountCustomersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomers", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomers indicates an expected call of CountCustomers.
+func (mr *MockRepositoryMockRecorder) CountCustomers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomers", reflect.TypeOf((*MockRepository)(nil).CountCustomers), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, params dbgen.CreateCustomerParams) error {
 	m.ctrl.T.Helper()
@@ -55,6 +128,20 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, params)
 }
 
+// CreateOutboxEvent mocks base method.
+func (m *MockRepository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEvent), ctx, params)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -69,6 +156,50 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteAllAddresses mocks base method.
+func (m *MockRepository) DeleteAllAddresses(ctx context.Context, customerID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllAddresses", ctx, customerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAllAddresses indicates an expected call of DeleteAllAddresses.
+func (mr *MockRepositoryMockRecorder) DeleteAllAddresses(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllAddresses", reflect.TypeOf((*MockRepository)(nil).DeleteAllAddresses), ctx, customerID)
+}
+
+// DeleteAllTokens mocks base method.
+func (m *MockRepository) DeleteAllTokens(ctx context.Context, customerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllTokens", ctx, customerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllTokens indicates an expected call of DeleteAllTokens.
+func (mr *MockRepositoryMockRecorder) DeleteAllTokens(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllTokens", reflect.TypeOf((*MockRepository)(nil).DeleteAllTokens), ctx, customerID)
+}
+
+// DeleteEmailJobs mocks base method.
+func (m *MockRepository) DeleteEmailJobs(ctx context.Context, params dbgen.DeleteCustomerEmailJobsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailJobs", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmailJobs indicates an expected call of DeleteEmailJobs.
+func (mr *MockRepositoryMockRecorder) DeleteEmailJobs(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailJobs", reflect.TypeOf((*MockRepository)(nil).DeleteEmailJobs), ctx, params)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id string) (dbgen.GetCustomerByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +230,51 @@ func (mr *MockRepositoryMockRecorder) GetCustomers(ctx, params any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomers", reflect.TypeOf((*MockRepository)(nil).GetCustomers), ctx, params)
 }
 
+// GetFavouriteCategories mocks base method.
+func (m *MockRepository) GetFavouriteCategories(ctx context.Context, params dbgen.GetCustomerFavouriteCategoriesParams) ([]dbgen.GetCustomerFavouriteCategoriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavouriteCategories", ctx, params)
+	ret0, _ := ret[0].([]dbgen.GetCustomerFavouriteCategoriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavouriteCategories indicates an expected call of GetFavouriteCategories.
+func (mr *MockRepositoryMockRecorder) GetFavouriteCategories(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavouriteCategories", reflect.TypeOf((*MockRepository)(nil).GetFavouriteCategories), ctx, params)
+}
+
+// GetIDByEmail mocks base method.
+func (m *MockRepository) GetIDByEmail(ctx context.Context, email string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDByEmail", ctx, email)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDByEmail indicates an expected call of GetIDByEmail.
+func (mr *MockRepositoryMockRecorder) GetIDByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDByEmail", reflect.TypeOf((*MockRepository)(nil).GetIDByEmail), ctx, email)
+}
+
+// GetOrderStats mocks base method.
+func (m *MockRepository) GetOrderStats(ctx context.Context, customerID string) (dbgen.GetCustomerOrderStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStats", ctx, customerID)
+	ret0, _ := ret[0].(dbgen.GetCustomerOrderStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStats indicates an expected call of GetOrderStats.
+func (mr *MockRepositoryMockRecorder) GetOrderStats(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStats", reflect.TypeOf((*MockRepository)(nil).GetOrderStats), ctx, customerID)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, params dbgen.UpdateCustomerParams) error {
 	m.ctrl.T.Helper()
@@ -112,3 +288,17 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, params)
 }
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) customer.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(customer.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
@@ -41,6 +41,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Anonymise mocks base method.
+func (m *MockService) Anonymise(ctx context.Context, id string) (customer.AnonymiseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymise", ctx, id)
+	ret0, _ := ret[0].(customer.AnonymiseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Anonymise indicates an expected call of Anonymise.
+func (mr *MockServiceMockRecorder) Anonymise(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymise", reflect.TypeOf((*MockService)(nil).Anonymise), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req customer.CreateCustomerRequest) (customer.CustomerResponse, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,21 @@ func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
 }
 
+// ExportData mocks base method.
+func (m *MockService) ExportData(ctx context.Context, id string) (customer.DataExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportData", ctx, id)
+	ret0, _ := ret[0].(customer.DataExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportData indicates an expected call of ExportData.
+func (mr *MockServiceMockRecorder) ExportData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportData", reflect.TypeOf((*MockService)(nil).ExportData), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id string) (customer.CustomerResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,15 +115,31 @@ func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
 }
 
-// List mocks base method.
-func (m *MockService) List(ctx context.Context, p customer.ListParams) ([]customer.CustomerResponse, error) {
+// GetSummary mocks base method.
+func (m *MockService) GetSummary(ctx context.Context, id string) (customer.CustomerSummaryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, p)
-	ret0, _ := ret[0].([]customer.CustomerResponse)
+	ret := m.ctrl.Call(m, "GetSummary", ctx, id)
+	ret0, _ := ret[0].(customer.CustomerSummaryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockServiceMockRecorder) GetSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockService)(nil).GetSummary), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, p customer.ListParams) ([]customer.CustomerListItem, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, p)
+	ret0, _ := ret[0].([]customer.CustomerListItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // List indicates an expected call of List.
 func (mr *MockServiceMockRecorder) List(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -24,10 +24,18 @@ type TopCustomerResponse struct {
 	ID          string  `json:"id"`
 	Name        string  `json:"name"`
 	Email       string  `json:"email"`
-	TotalSpent  float64 `json:"total_spent"`
+	TotalSpent  float64 `json:"total_spent"` // Sudah dikurangi refund retur
 	TotalOrders int64   `json:"total_orders"`
 }
 
+// RevenueReportResponse: net_revenue = gross_revenue - total_refunds (refund dari retur)
+type RevenueReportResponse struct {
+	TotalOrders  int64   `json:"total_orders"`
+	GrossRevenue float64 `json:"gross_revenue"`
+	TotalRefunds float64 `json:"total_refunds"`
+	NetRevenue   float64 `json:"net_revenue"`
+}
+
 type DashboardReportResponse struct {
 	ProductReport ProductReportResponse `json:"product_report"`
 	TopCustomers  []TopCustomerResponse `json:"top_customers"`
@@ -93,3 +93,21 @@ func (h *Handler) GetFullDashboard(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, res, nil)
 }
+
+// GetRevenueReport godoc
+// @Summary      Get revenue report
+// @Description  Retrieve gross revenue, refunds from order returns, and net revenue
+// @Tags         dashboard
+// @Produce      json
+// @Success      200      {object}  RevenueReportResponse
+// @Failure      500      {object}  map[string]string
+// @Router       /dashboard/revenue [get]
+func (h *Handler) GetRevenueReport(c *gin.Context) {
+	res, err := h.service.GetRevenueReport(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "DASHBOARD_ERROR", "Failed to fetch revenue report", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
@@ -20,6 +20,7 @@ type fakeDashboardService struct {
 	GetProductDashboardFn  func(ctx context.Context) (dashboard.ProductReportResponse, error)
 	GetTopCustomersFn      func(ctx context.Context, limit int32) ([]dashboard.TopCustomerResponse, error)
 	GetCompleteDashboardFn func(ctx context.Context, limit int32) (dashboard.DashboardReportResponse, error)
+	GetRevenueReportFn     func(ctx context.Context) (dashboard.RevenueReportResponse, error)
 }
 
 func (f *fakeDashboardService) GetProductDashboard(ctx context.Context) (dashboard.ProductReportResponse, error) {
@@ -34,6 +35,10 @@ func (f *fakeDashboardService) GetCompleteDashboard(ctx context.Context, limit i
 	return f.GetCompleteDashboardFn(ctx, limit)
 }
 
+func (f *fakeDashboardService) GetRevenueReport(ctx context.Context) (dashboard.RevenueReportResponse, error) {
+	return f.GetRevenueReportFn(ctx)
+}
+
 // ========== HELPERS ==========
 
 func setupTestRouter() *gin.Engine {
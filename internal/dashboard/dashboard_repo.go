@@ -11,6 +11,8 @@ type Repository interface {
 	GetRecentProducts(ctx context.Context, limit int32) ([]dbgen.GetRecentProductsRow, error)
 
 	GetTopCustomers(ctx context.Context, limit int32) ([]dbgen.GetTopCustomersRow, error)
+
+	GetRevenueReport(ctx context.Context) (dbgen.GetRevenueReportRow, error)
 }
 
 type repository struct {
@@ -32,3 +34,7 @@ func (r *repository) GetRecentProducts(ctx context.Context, limit int32) ([]dbge
 func (r *repository) GetTopCustomers(ctx context.Context, limit int32) ([]dbgen.GetTopCustomersRow, error) {
 	return r.q.GetTopCustomers(ctx, limit)
 }
+
+func (r *repository) GetRevenueReport(ctx context.Context) (dbgen.GetRevenueReportRow, error) {
+	return r.q.GetRevenueReport(ctx)
+}
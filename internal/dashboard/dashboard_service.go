@@ -22,6 +22,7 @@ type Service interface {
 	GetProductDashboard(ctx context.Context) (ProductReportResponse, error)
 	GetTopCustomers(ctx context.Context, limit int32) ([]TopCustomerResponse, error)
 	GetCompleteDashboard(ctx context.Context, limit int32) (DashboardReportResponse, error)
+	GetRevenueReport(ctx context.Context) (RevenueReportResponse, error)
 }
 
 type service struct {
@@ -177,3 +178,22 @@ func (s *service) GetCompleteDashboard(ctx context.Context, topCustomerLimit int
 		TopCustomers:  topCustomers,
 	}, nil
 }
+
+// GetRevenueReport tidak di-cache agar refund dari retur langsung terlihat
+func (s *service) GetRevenueReport(ctx context.Context) (RevenueReportResponse, error) {
+	r, err := s.repo.GetRevenueReport(ctx)
+	if err != nil {
+		return RevenueReportResponse{}, err
+	}
+
+	gross, _ := r.GrossRevenue.Float64()
+	refunds, _ := r.TotalRefunds.Float64()
+	net, _ := r.NetRevenue.Float64()
+
+	return RevenueReportResponse{
+		TotalOrders:  r.TotalOrders,
+		GrossRevenue: gross,
+		TotalRefunds: refunds,
+		NetRevenue:   net,
+	}, nil
+}
@@ -126,6 +126,38 @@ func TestService_GetTopCustomers(t *testing.T) {
 	})
 }
 
+func TestService_GetRevenueReport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Net Revenue Setelah Refund", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetRevenueReport(ctx).Return(dbgen.GetRevenueReportRow{
+			TotalOrders:  3,
+			GrossRevenue: decimal.NewFromInt(300000),
+			TotalRefunds: decimal.NewFromInt(45000),
+			NetRevenue:   decimal.NewFromInt(255000),
+		}, nil)
+
+		result, err := svc.GetRevenueReport(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), result.TotalOrders)
+		assert.Equal(t, 45000.0, result.TotalRefunds)
+		assert.Equal(t, 255000.0, result.NetRevenue)
+	})
+
+	t.Run("Negative - Database Error", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetRevenueReport(ctx).Return(dbgen.GetRevenueReportRow{}, errors.New("database down"))
+
+		_, err := svc.GetRevenueReport(ctx)
+
+		assert.Error(t, err)
+	})
+}
+
 func TestService_GetCompleteDashboard(t *testing.T) {
 	ctx := context.Background()
 	cacheKey := dashboard.ProductReportKey
@@ -71,6 +71,21 @@ func (mr *MockRepositoryMockRecorder) GetRecentProducts(ctx, limit any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentProducts", reflect.TypeOf((*MockRepository)(nil).GetRecentProducts), ctx, limit)
 }
 
+// GetRevenueReport mocks base method.
+func (m *MockRepository) GetRevenueReport(ctx context.Context) (dbgen.GetRevenueReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevenueReport", ctx)
+	ret0, _ := ret[0].(dbgen.GetRevenueReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevenueReport indicates an expected call of GetRevenueReport.
+func (mr *MockRepositoryMockRecorder) GetRevenueReport(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevenueReport", reflect.TypeOf((*MockRepository)(nil).GetRevenueReport), ctx)
+}
+
 // GetTopCustomers mocks base method.
 func (m *MockRepository) GetTopCustomers(ctx context.Context, limit int32) ([]dbgen.GetTopCustomersRow, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +71,21 @@ func (mr *MockServiceMockRecorder) GetProductDashboard(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDashboard", reflect.TypeOf((*MockService)(nil).GetProductDashboard), ctx)
 }
 
+// GetRevenueReport mocks base method.
+func (m *MockService) GetRevenueReport(ctx context.Context) (dashboard.RevenueReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevenueReport", ctx)
+	ret0, _ := ret[0].(dashboard.RevenueReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevenueReport indicates an expected call of GetRevenueReport.
+func (mr *MockServiceMockRecorder) GetRevenueReport(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevenueReport", reflect.TypeOf((*MockService)(nil).GetRevenueReport), ctx)
+}
+
 // GetTopCustomers mocks base method.
 func (m *MockService) GetTopCustomers(ctx context.Context, limit int32) ([]dashboard.TopCustomerResponse, error) {
 	m.ctrl.T.Helper()
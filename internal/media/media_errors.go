@@ -0,0 +1,12 @@
+package media
+
+import "errors"
+
+var (
+	ErrProductNotFound         = errors.New("product not found")
+	ErrImageNotFound           = errors.New("image not found")
+	ErrImageTooLarge           = errors.New("image exceeds maximum upload size")
+	ErrImageDimensionsTooLarge = errors.New("image exceeds maximum pixel dimensions")
+	ErrUnsupportedImage        = errors.New("unsupported image format, use jpeg, png or gif")
+	ErrInvalidImageOrder       = errors.New("image_ids must list every image of the product exactly once")
+)
@@ -0,0 +1,163 @@
+package media
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Upload godoc
+// @Summary      Upload a product image
+// @Description  Upload a jpeg/png/gif image (max 5 MB, max 40 megapixels); a thumbnail is generated automatically. The first image becomes primary.
+// @Tags         product-images
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id          path      string  true   "Product ID"
+// @Param        file        formData  file    true   "Image file"
+// @Param        is_primary  formData  bool    false  "Mark as primary image"
+// @Success      201      {object}  ImageResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Product not found"
+// @Failure      413      {object}  map[string]string "Image file or dimensions too large"
+// @Failure      415      {object}  map[string]string "Unsupported image format"
+// @Router       /products/{id}/images [post]
+func (h *Handler) Upload(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Field 'file' is required", err.Error())
+		return
+	}
+
+	isPrimary := false
+	if v := c.PostForm("is_primary"); v != "" {
+		isPrimary, err = strconv.ParseBool(v)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid is_primary value", err.Error())
+			return
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Failed to read uploaded file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	res, err := h.service.Upload(c.Request.Context(), c.Param("id"), file, isPrimary)
+	if err != nil {
+		handleError(c, err, "UPLOAD_ERROR", "Failed to upload image")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List product images
+// @Description  Retrieve images of a product ordered by position
+// @Tags         product-images
+// @Produce      json
+// @Param        id       path      string  true  "Product ID"
+// @Success      200      {array}   ImageResponse
+// @Failure      404      {object}  map[string]string "Product not found"
+// @Router       /products/{id}/images [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch images")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Reorder godoc
+// @Summary      Reorder product images
+// @Description  Set image order; image_ids must contain every image of the product exactly once
+// @Tags         product-images
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                true  "Product ID"
+// @Param        request  body      ReorderImagesRequest  true  "New order"
+// @Success      200      {array}   ImageResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Product not found"
+// @Router       /products/{id}/images [patch]
+func (h *Handler) Reorder(c *gin.Context) {
+	var req ReorderImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Reorder(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to reorder images")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// SetPrimary godoc
+// @Summary      Set primary product image
+// @Description  Mark an image as the primary image of the product
+// @Tags         product-images
+// @Produce      json
+// @Param        id        path      string  true  "Product ID"
+// @Param        image_id  path      string  true  "Image ID"
+// @Success      200      {object}  ImageResponse
+// @Failure      404      {object}  map[string]string "Image not found"
+// @Router       /products/{id}/images/{image_id}/primary [put]
+func (h *Handler) SetPrimary(c *gin.Context) {
+	res, err := h.service.SetPrimary(c.Request.Context(), c.Param("id"), c.Param("image_id"))
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to set primary image")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete product image
+// @Description  Remove an image and its stored files
+// @Tags         product-images
+// @Produce      json
+// @Param        id        path      string  true  "Product ID"
+// @Param        image_id  path      string  true  "Image ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string "Image not found"
+// @Router       /products/{id}/images/{image_id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id"), c.Param("image_id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete image")
+		return
+	}
+	response.Success(c, http.StatusOK, "Image deleted successfully", nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrProductNotFound), errors.Is(err, ErrImageNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrImageTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, "IMAGE_TOO_LARGE", err.Error(), nil)
+	case errors.Is(err, ErrImageDimensionsTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, "IMAGE_DIMENSIONS_TOO_LARGE", err.Error(), nil)
+	case errors.Is(err, ErrUnsupportedImage):
+		response.Error(c, http.StatusUnsupportedMediaType, "UNSUPPORTED_IMAGE", err.Error(), nil)
+	case errors.Is(err, ErrInvalidImageOrder):
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,155 @@
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/media"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeMediaService struct {
+	UploadFn     func(ctx context.Context, productID string, file io.Reader, isPrimary bool) (media.ImageResponse, error)
+	ListFn       func(ctx context.Context, productID string) ([]media.ImageResponse, error)
+	SetPrimaryFn func(ctx context.Context, productID, id string) (media.ImageResponse, error)
+	ReorderFn    func(ctx context.Context, productID string, req media.ReorderImagesRequest) ([]media.ImageResponse, error)
+	DeleteFn     func(ctx context.Context, productID, id string) error
+}
+
+func (f *fakeMediaService) Upload(ctx context.Context, productID string, file io.Reader, isPrimary bool) (media.ImageResponse, error) {
+	return f.UploadFn(ctx, productID, file, isPrimary)
+}
+func (f *fakeMediaService) List(ctx context.Context, productID string) ([]media.ImageResponse, error) {
+	return f.ListFn(ctx, productID)
+}
+func (f *fakeMediaService) SetPrimary(ctx context.Context, productID, id string) (media.ImageResponse, error) {
+	return f.SetPrimaryFn(ctx, productID, id)
+}
+func (f *fakeMediaService) Reorder(ctx context.Context, productID string, req media.ReorderImagesRequest) ([]media.ImageResponse, error) {
+	return f.ReorderFn(ctx, productID, req)
+}
+func (f *fakeMediaService) Delete(ctx context.Context, productID, id string) error {
+	return f.DeleteFn(ctx, productID, id)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter(h *media.Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	media.RegisterRoutes(r.Group(""), h)
+	return r
+}
+
+func multipartBody(t *testing.T, fields map[string]string, file []byte) (*bytes.Buffer, string) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		w.WriteField(k, v)
+	}
+	if file != nil {
+		part, err := w.CreateFormFile("file", "photo.png")
+		assert.NoError(t, err)
+		part.Write(file)
+	}
+	w.Close()
+	return &buf, w.FormDataContentType()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Upload(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeMediaService{
+			UploadFn: func(ctx context.Context, productID string, file io.Reader, isPrimary bool) (media.ImageResponse, error) {
+				data, _ := io.ReadAll(file)
+				assert.Equal(t, "prod-1", productID)
+				assert.Equal(t, "img-bytes", string(data))
+				assert.True(t, isPrimary)
+				return media.ImageResponse{ID: "img-1", IsPrimary: true}, nil
+			},
+		}
+		r := setupTestRouter(media.NewHandler(svc))
+
+		body, contentType := multipartBody(t, map[string]string{"is_primary": "true"}, []byte("img-bytes"))
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/images", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		r := setupTestRouter(media.NewHandler(&fakeMediaService{}))
+
+		body, contentType := multipartBody(t, nil, nil)
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/images", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("unsupported image", func(t *testing.T) {
+		svc := &fakeMediaService{
+			UploadFn: func(ctx context.Context, productID string, file io.Reader, isPrimary bool) (media.ImageResponse, error) {
+				return media.ImageResponse{}, media.ErrUnsupportedImage
+			},
+		}
+		r := setupTestRouter(media.NewHandler(svc))
+
+		body, contentType := multipartBody(t, nil, []byte("text"))
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/images", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+}
+
+func TestHandler_Reorder(t *testing.T) {
+	t.Run("invalid order", func(t *testing.T) {
+		svc := &fakeMediaService{
+			ReorderFn: func(ctx context.Context, productID string, req media.ReorderImagesRequest) ([]media.ImageResponse, error) {
+				return nil, media.ErrInvalidImageOrder
+			},
+		}
+		r := setupTestRouter(media.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPatch, "/products/prod-1/images", bytes.NewReader([]byte(`{"image_ids":["a"]}`)))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandler_SetPrimary(t *testing.T) {
+	t.Run("image not found", func(t *testing.T) {
+		svc := &fakeMediaService{
+			SetPrimaryFn: func(ctx context.Context, productID, id string) (media.ImageResponse, error) {
+				assert.Equal(t, "img-9", id)
+				return media.ImageResponse{}, media.ErrImageNotFound
+			},
+		}
+		r := setupTestRouter(media.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPut, "/products/prod-1/images/img-9/primary", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,77 @@
+package media
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=media_repo.go -destination=mocks/media_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	ProductExists(ctx context.Context, productID string) (bool, error)
+	Create(ctx context.Context, params dbgen.CreateProductImageParams) error
+	GetByID(ctx context.Context, params dbgen.GetProductImageByIDParams) (dbgen.ProductImage, error)
+	ListByProductID(ctx context.Context, productID string) ([]dbgen.ProductImage, error)
+	NextPosition(ctx context.Context, productID string) (int64, error)
+	UpdatePosition(ctx context.Context, params dbgen.UpdateProductImagePositionParams) error
+	ClearPrimary(ctx context.Context, productID string) error
+	SetPrimary(ctx context.Context, params dbgen.SetPrimaryProductImageParams) error
+	Delete(ctx context.Context, params dbgen.DeleteProductImageParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) ProductExists(ctx context.Context, productID string) (bool, error) {
+	return r.q.ProductExists(ctx, productID)
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateProductImageParams) error {
+	return r.q.CreateProductImage(ctx, params)
+}
+
+func (r *repository) GetByID(ctx context.Context, params dbgen.GetProductImageByIDParams) (dbgen.ProductImage, error) {
+	return r.q.GetProductImageByID(ctx, params)
+}
+
+func (r *repository) ListByProductID(ctx context.Context, productID string) ([]dbgen.ProductImage, error) {
+	return r.q.ListProductImagesByProductID(ctx, productID)
+}
+
+func (r *repository) NextPosition(ctx context.Context, productID string) (int64, error) {
+	return r.q.GetNextProductImagePosition(ctx, productID)
+}
+
+func (r *repository) UpdatePosition(ctx context.Context, params dbgen.UpdateProductImagePositionParams) error {
+	return r.q.UpdateProductImagePosition(ctx, params)
+}
+
+func (r *repository) ClearPrimary(ctx context.Context, productID string) error {
+	return r.q.ClearPrimaryProductImage(ctx, productID)
+}
+
+func (r *repository) SetPrimary(ctx context.Context, params dbgen.SetPrimaryProductImageParams) error {
+	return r.q.SetPrimaryProductImage(ctx, params)
+}
+
+func (r *repository) Delete(ctx context.Context, params dbgen.DeleteProductImageParams) error {
+	return r.q.DeleteProductImage(ctx, params)
+}
@@ -0,0 +1,14 @@
+package media
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	images := r.Group("/products/:id/images")
+	{
+		images.POST("", handler.Upload)                      // Multipart upload (field "file")
+		images.GET("", handler.GetAll)                       // Ordered list of images
+		images.PATCH("", handler.Reorder)                    // Reorder images
+		images.PUT("/:image_id/primary", handler.SetPrimary) // Set primary image
+		images.DELETE("/:image_id", handler.Delete)          // Delete image + files
+	}
+}
@@ -0,0 +1,367 @@
+package media
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/storage"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"time"
+
+	// Registrasi decoder untuk image.Decode
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/google/uuid"
+)
+
+// MaxImageSize adalah batas ukuran file upload (5 MB)
+const MaxImageSize = 5 << 20
+
+// MaxImagePixels membatasi lebar x tinggi gambar (40 MP). File kecil bisa mengklaim
+// dimensi raksasa (decompression bomb), jadi dicek dari header sebelum di-decode.
+const MaxImagePixels = 40_000_000
+
+//go:generate mockgen -source=media_service.go -destination=mocks/media_service_mock.go -package=mock
+type Service interface {
+	Upload(ctx context.Context, productID string, file io.Reader, isPrimary bool) (ImageResponse, error)
+	List(ctx context.Context, productID string) ([]ImageResponse, error)
+	SetPrimary(ctx context.Context, productID, id string) (ImageResponse, error)
+	Reorder(ctx context.Context, productID string, req ReorderImagesRequest) ([]ImageResponse, error)
+	Delete(ctx context.Context, productID, id string) error
+}
+
+type service struct {
+	db      *sql.DB // Diperlukan untuk memulai transaksi
+	repo    Repository
+	storage storage.Storage
+}
+
+func NewService(db *sql.DB, repo Repository, store storage.Storage) Service {
+	return &service{
+		db:      db,
+		repo:    repo,
+		storage: store,
+	}
+}
+
+func (s *service) Upload(
+	ctx context.Context,
+	productID string,
+	file io.Reader,
+	isPrimary bool,
+) (ImageResponse, error) {
+	if err := s.ensureProduct(ctx, productID); err != nil {
+		return ImageResponse{}, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxImageSize+1))
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	if len(data) > MaxImageSize {
+		return ImageResponse{}, ErrImageTooLarge
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return ImageResponse{}, ErrUnsupportedImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxImagePixels {
+		return ImageResponse{}, ErrImageDimensionsTooLarge
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return ImageResponse{}, ErrUnsupportedImage
+	}
+
+	thumb, err := encodeImage(makeThumbnail(img, ThumbnailSize), format)
+	if err != nil {
+		return ImageResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	id := newUUID.String()
+
+	ext := format
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+	contentType := "image/" + format
+	key := fmt.Sprintf("products/%s/%s.%s", productID, id, ext)
+	thumbKey := fmt.Sprintf("products/%s/%s_thumb.%s", productID, id, ext)
+
+	if err := s.storage.Put(ctx, key, bytes.NewReader(data), contentType); err != nil {
+		return ImageResponse{}, err
+	}
+	if err := s.storage.Put(ctx, thumbKey, bytes.NewReader(thumb), contentType); err != nil {
+		s.removeObjects(ctx, key)
+		return ImageResponse{}, err
+	}
+
+	params := dbgen.CreateProductImageParams{
+		ID:           id,
+		ProductID:    productID,
+		StorageKey:   key,
+		ThumbnailKey: thumbKey,
+		Url:          s.storage.URL(key),
+		ThumbnailUrl: s.storage.URL(thumbKey),
+		ContentType:  contentType,
+		SizeBytes:    int64(len(data)),
+		Width:        int32(img.Bounds().Dx()),
+		Height:       int32(img.Bounds().Dy()),
+		IsPrimary:    isPrimary,
+	}
+
+	if err := s.createImage(ctx, &params); err != nil {
+		// File sudah terlanjur di-upload, bersihkan supaya tidak jadi orphan
+		s.removeObjects(ctx, key, thumbKey)
+		return ImageResponse{}, err
+	}
+
+	return ImageResponse{
+		ID:           id,
+		ProductID:    productID,
+		URL:          params.Url,
+		ThumbnailURL: params.ThumbnailUrl,
+		ContentType:  contentType,
+		SizeBytes:    params.SizeBytes,
+		Width:        int(params.Width),
+		Height:       int(params.Height),
+		Position:     int(params.Position),
+		IsPrimary:    params.IsPrimary,
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// createImage menaruh gambar di posisi terakhir; gambar pertama otomatis jadi primary
+func (s *service) createImage(ctx context.Context, params *dbgen.CreateProductImageParams) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	position, err := txRepo.NextPosition(ctx, params.ProductID)
+	if err != nil {
+		return err
+	}
+	params.Position = int32(position)
+	params.IsPrimary = params.IsPrimary || position == 0
+
+	if params.IsPrimary {
+		if err := txRepo.ClearPrimary(ctx, params.ProductID); err != nil {
+			return err
+		}
+	}
+
+	if err := txRepo.Create(ctx, *params); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *service) List(ctx context.Context, productID string) ([]ImageResponse, error) {
+	if err := s.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ImageResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapToResponse(r))
+	}
+	return res, nil
+}
+
+func (s *service) SetPrimary(ctx context.Context, productID, id string) (ImageResponse, error) {
+	img, err := s.getImage(ctx, productID, id)
+	if err != nil {
+		return ImageResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ImageResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := txRepo.ClearPrimary(ctx, productID); err != nil {
+		return ImageResponse{}, err
+	}
+	if err := txRepo.SetPrimary(ctx, dbgen.SetPrimaryProductImageParams{ID: id, ProductID: productID}); err != nil {
+		return ImageResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ImageResponse{}, err
+	}
+
+	img.IsPrimary = true
+	return mapToResponse(img), nil
+}
+
+func (s *service) Reorder(
+	ctx context.Context,
+	productID string,
+	req ReorderImagesRequest,
+) ([]ImageResponse, error) {
+	if err := s.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// image_ids harus berisi semua gambar produk tepat satu kali
+	byID := make(map[string]dbgen.ProductImage, len(rows))
+	for _, r := range rows {
+		byID[r.ID] = r
+	}
+	if len(req.ImageIDs) != len(rows) {
+		return nil, ErrInvalidImageOrder
+	}
+	seen := make(map[string]bool, len(req.ImageIDs))
+	for _, id := range req.ImageIDs {
+		if _, ok := byID[id]; !ok || seen[id] {
+			return nil, ErrInvalidImageOrder
+		}
+		seen[id] = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	res := make([]ImageResponse, 0, len(req.ImageIDs))
+	for i, id := range req.ImageIDs {
+		if err := txRepo.UpdatePosition(ctx, dbgen.UpdateProductImagePositionParams{
+			Position:  int32(i),
+			ID:        id,
+			ProductID: productID,
+		}); err != nil {
+			return nil, err
+		}
+
+		img := byID[id]
+		img.Position = int32(i)
+		res = append(res, mapToResponse(img))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *service) Delete(ctx context.Context, productID, id string) error {
+	img, err := s.getImage(ctx, productID, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	if err := txRepo.Delete(ctx, dbgen.DeleteProductImageParams{ID: id, ProductID: productID}); err != nil {
+		return err
+	}
+
+	// Primary dihapus: gambar berikutnya (urutan teratas) naik jadi primary
+	if img.IsPrimary {
+		remaining, err := txRepo.ListByProductID(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 {
+			if err := txRepo.SetPrimary(ctx, dbgen.SetPrimaryProductImageParams{
+				ID:        remaining[0].ID,
+				ProductID: productID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.removeObjects(ctx, img.StorageKey, img.ThumbnailKey)
+	return nil
+}
+
+func (s *service) getImage(ctx context.Context, productID, id string) (dbgen.ProductImage, error) {
+	img, err := s.repo.GetByID(ctx, dbgen.GetProductImageByIDParams{ID: id, ProductID: productID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.ProductImage{}, ErrImageNotFound
+		}
+		return dbgen.ProductImage{}, err
+	}
+	return img, nil
+}
+
+func (s *service) ensureProduct(ctx context.Context, productID string) error {
+	exists, err := s.repo.ProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProductNotFound
+	}
+	return nil
+}
+
+// removeObjects menghapus file dari storage secara best-effort
+func (s *service) removeObjects(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete stored object %s: %v", key, err)
+		}
+	}
+}
+
+func mapToResponse(r dbgen.ProductImage) ImageResponse {
+	return ImageResponse{
+		ID:           r.ID,
+		ProductID:    r.ProductID,
+		URL:          r.Url,
+		ThumbnailURL: r.ThumbnailUrl,
+		ContentType:  r.ContentType,
+		SizeBytes:    r.SizeBytes,
+		Width:        int(r.Width),
+		Height:       int(r.Height),
+		Position:     int(r.Position),
+		IsPrimary:    r.IsPrimary,
+		CreatedAt:    r.CreatedAt,
+	}
+}
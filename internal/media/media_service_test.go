@@ -0,0 +1,281 @@
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/media"
+	mockMedia "assignment-ptes-achmad-rifai/internal/media/mocks"
+	"assignment-ptes-achmad-rifai/internal/pkg/storage"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupServiceTest(t *testing.T) (media.Service, *mockMedia.MockRepository, sqlmock.Sqlmock, string) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	dir := t.TempDir()
+	repo := mockMedia.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	svc := media.NewService(db, repo, storage.NewLocalStorage(dir, "/media"))
+
+	return svc, repo, mock, dir
+}
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 30, B: 30, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestService_Upload(t *testing.T) {
+	ctx := context.Background()
+	productID := "prod-1"
+
+	t.Run("success - first image becomes primary with thumbnail", func(t *testing.T) {
+		svc, repo, mock, dir := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().NextPosition(ctx, productID).Return(int64(0), nil)
+		repo.EXPECT().ClearPrimary(ctx, productID).Return(nil)
+		repo.EXPECT().
+			Create(ctx, gomock.AssignableToTypeOf(dbgen.CreateProductImageParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductImageParams) error {
+				assert.True(t, p.IsPrimary)
+				assert.Equal(t, int32(800), p.Width)
+				assert.Equal(t, int32(400), p.Height)
+				assert.Equal(t, "image/png", p.ContentType)
+				assert.Equal(t, "/media/"+p.StorageKey, p.Url)
+				return nil
+			})
+
+		res, err := svc.Upload(ctx, productID, bytes.NewReader(pngBytes(t, 800, 400)), false)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsPrimary)
+		assert.True(t, strings.HasSuffix(res.ThumbnailURL, "_thumb.png"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		f, err := os.Open(filepath.Join(dir, strings.TrimPrefix(res.ThumbnailURL, "/media/")))
+		require.NoError(t, err)
+		defer f.Close()
+		cfg, _, err := image.DecodeConfig(f)
+		require.NoError(t, err)
+		assert.Equal(t, media.ThumbnailSize, cfg.Width)
+		assert.Equal(t, media.ThumbnailSize/2, cfg.Height)
+	})
+
+	t.Run("subsequent image keeps existing primary", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().NextPosition(ctx, productID).Return(int64(2), nil)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		res, err := svc.Upload(ctx, productID, bytes.NewReader(pngBytes(t, 10, 10)), false)
+
+		require.NoError(t, err)
+		assert.False(t, res.IsPrimary)
+		assert.Equal(t, 2, res.Position)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+
+		_, err := svc.Upload(ctx, productID, strings.NewReader("not an image"), false)
+
+		assert.ErrorIs(t, err, media.ErrUnsupportedImage)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+
+		_, err := svc.Upload(ctx, productID, bytes.NewReader(make([]byte, media.MaxImageSize+1)), false)
+
+		assert.ErrorIs(t, err, media.ErrImageTooLarge)
+	})
+
+	t.Run("too many pixels", func(t *testing.T) {
+		svc, repo, _, dir := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+
+		// GIF kecil yang header-nya mengklaim 50000x50000 piksel
+		var buf bytes.Buffer
+		require.NoError(t, gif.Encode(&buf, image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.Black}), nil))
+		data := buf.Bytes()
+		binary.LittleEndian.PutUint16(data[6:8], 50000)
+		binary.LittleEndian.PutUint16(data[8:10], 50000)
+
+		_, err := svc.Upload(ctx, productID, bytes.NewReader(data), false)
+
+		assert.ErrorIs(t, err, media.ErrImageDimensionsTooLarge)
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("db error removes stored files", func(t *testing.T) {
+		svc, repo, mock, dir := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().NextPosition(ctx, productID).Return(int64(1), nil)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db error"))
+
+		_, err := svc.Upload(ctx, productID, bytes.NewReader(pngBytes(t, 10, 10)), false)
+
+		assert.Error(t, err)
+		entries, _ := os.ReadDir(filepath.Join(dir, "products", productID))
+		assert.Empty(t, entries)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(false, nil)
+
+		_, err := svc.Upload(ctx, productID, bytes.NewReader(pngBytes(t, 10, 10)), false)
+
+		assert.ErrorIs(t, err, media.ErrProductNotFound)
+	})
+}
+
+func TestService_Reorder(t *testing.T) {
+	ctx := context.Background()
+	productID := "prod-1"
+	images := []dbgen.ProductImage{
+		{ID: "img-1", ProductID: productID, Position: 0},
+		{ID: "img-2", ProductID: productID, Position: 1},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().ListByProductID(ctx, productID).Return(images, nil)
+		repo.EXPECT().UpdatePosition(ctx, dbgen.UpdateProductImagePositionParams{Position: 0, ID: "img-2", ProductID: productID}).Return(nil)
+		repo.EXPECT().UpdatePosition(ctx, dbgen.UpdateProductImagePositionParams{Position: 1, ID: "img-1", ProductID: productID}).Return(nil)
+
+		res, err := svc.Reorder(ctx, productID, media.ReorderImagesRequest{ImageIDs: []string{"img-2", "img-1"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, "img-2", res[0].ID)
+		assert.Equal(t, 1, res[1].Position)
+	})
+
+	t.Run("incomplete list", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().ListByProductID(ctx, productID).Return(images, nil)
+
+		_, err := svc.Reorder(ctx, productID, media.ReorderImagesRequest{ImageIDs: []string{"img-2", "img-2"}})
+
+		assert.ErrorIs(t, err, media.ErrInvalidImageOrder)
+	})
+}
+
+func TestService_SetPrimary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.ProductImage{}, sql.ErrNoRows)
+
+		_, err := svc.SetPrimary(ctx, "prod-1", "img-x")
+
+		assert.ErrorIs(t, err, media.ErrImageNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, mock, _ := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.ProductImage{ID: "img-2", ProductID: "prod-1"}, nil)
+		repo.EXPECT().ClearPrimary(ctx, "prod-1").Return(nil)
+		repo.EXPECT().SetPrimary(ctx, dbgen.SetPrimaryProductImageParams{ID: "img-2", ProductID: "prod-1"}).Return(nil)
+
+		res, err := svc.SetPrimary(ctx, "prod-1", "img-2")
+
+		require.NoError(t, err)
+		assert.True(t, res.IsPrimary)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deleting primary promotes next image", func(t *testing.T) {
+		svc, repo, mock, dir := setupServiceTest(t)
+
+		key := "products/prod-1/img-1.png"
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "products", "prod-1"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, key), []byte("x"), 0o644))
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.ProductImage{
+			ID: "img-1", ProductID: "prod-1", IsPrimary: true,
+			StorageKey: key, ThumbnailKey: "products/prod-1/img-1_thumb.png",
+		}, nil)
+		repo.EXPECT().Delete(ctx, dbgen.DeleteProductImageParams{ID: "img-1", ProductID: "prod-1"}).Return(nil)
+		repo.EXPECT().ListByProductID(ctx, "prod-1").Return([]dbgen.ProductImage{{ID: "img-2"}}, nil)
+		repo.EXPECT().SetPrimary(ctx, dbgen.SetPrimaryProductImageParams{ID: "img-2", ProductID: "prod-1"}).Return(nil)
+
+		err := svc.Delete(ctx, "prod-1", "img-1")
+
+		require.NoError(t, err)
+		_, statErr := os.Stat(filepath.Join(dir, key))
+		assert.True(t, os.IsNotExist(statErr))
+	})
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_service.go -destination=mocks/notification_service_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	notification "assignment-ptes-achmad-rifai/internal/notification"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPreferences mocks base method.
+func (m *MockService) GetPreferences(ctx context.Context, customerID string) (notification.PreferencesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, customerID)
+	ret0, _ := ret[0].(notification.PreferencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockServiceMockRecorder) GetPreferences(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockService)(nil).GetPreferences), ctx, customerID)
+}
+
+// SendAccountEmail mocks base method.
+func (m *MockService) SendAccountEmail(ctx context.Context, job notification.AccountEmailJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAccountEmail", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAccountEmail indicates an expected call of SendAccountEmail.
+func (mr *MockServiceMockRecorder) SendAccountEmail(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAccountEmail", reflect.TypeOf((*MockService)(nil).SendAccountEmail), ctx, job)
+}
+
+// SendOrderEmail mocks base method.
+func (m *MockService) SendOrderEmail(ctx context.Context, job notification.OrderEmailJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOrderEmail", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOrderEmail indicates an expected call of SendOrderEmail.
+func (mr *MockServiceMockRecorder) SendOrderEmail(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderEmail", reflect.TypeOf((*MockService)(nil).SendOrderEmail), ctx, job)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockService) UpdatePreferences(ctx context.Context, customerID string, req notification.UpdatePreferencesRequest) (notification.PreferencesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, customerID, req)
+	ret0, _ := ret[0].(notification.PreferencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockServiceMockRecorder) UpdatePreferences(ctx, customerID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockService)(nil).UpdatePreferences), ctx, customerID, req)
+}
@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"assignment-ptes-achmad-rifai/internal/bootstrap"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log"
+)
+
+// PayloadSecretEnv adalah variabel environment secret PayloadCipher
+const PayloadSecretEnv = "JOB_PAYLOAD_SECRET"
+
+// PayloadCipher mengenkripsi bagian rahasia payload job (tautan berisi token mentah)
+// dengan AES-GCM sehingga tabel jobs tidak pernah menyimpan token dalam bentuk terbaca.
+// API dan worker harus memakai secret yang sama.
+type PayloadCipher struct {
+	aead cipher.AEAD
+}
+
+// NewPayloadCipher menurunkan kunci AES-256 dari secret
+func NewPayloadCipher(secret string) (*PayloadCipher, error) {
+	if secret == "" {
+		return nil, errors.New("payload secret is empty")
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &PayloadCipher{aead: aead}, nil
+}
+
+// MustPayloadCipherFromEnv membuat cipher dari JOB_PAYLOAD_SECRET dan menghentikan proses jika secret
+// tidak diisi (kecuali APP_ENV=development). Dipakai API & worker agar keduanya selalu memakai kunci yang sama.
+func MustPayloadCipherFromEnv() *PayloadCipher {
+	c, err := NewPayloadCipher(bootstrap.RequireSecret(PayloadSecretEnv, "dev-job-payload-secret"))
+	if err != nil {
+		log.Fatalf("❌ Invalid %s: %v", PayloadSecretEnv, err)
+	}
+	return c
+}
+
+// Seal mengembalikan base64(nonce || ciphertext)
+func (c *PayloadCipher) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Open membuka hasil Seal; secret yang berbeda atau data yang diubah menghasilkan ErrInvalidPayload
+func (c *PayloadCipher) Open(sealed string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil || len(data) < c.aead.NonceSize() {
+		return "", ErrInvalidPayload
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidPayload
+	}
+	return string(plaintext), nil
+}
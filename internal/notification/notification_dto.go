@@ -0,0 +1,48 @@
+package notification
+
+import "time"
+
+// Jenis email order; sama dengan kolom preferensi di notification_preferences
+const (
+	KindOrderConfirmation = "order_confirmation"
+	KindOrderShipped      = "order_shipped"
+	KindOrderCancelled    = "order_cancelled"
+)
+
+// Jenis email akun; transaksional sehingga tidak bisa dinonaktifkan lewat preferensi
+const (
+	KindEmailVerification = "email_verification"
+	KindPasswordReset     = "password_reset"
+)
+
+// UpdatePreferencesRequest: field nil berarti tidak diubah
+type UpdatePreferencesRequest struct {
+	OrderConfirmation *bool `json:"order_confirmation"`
+	OrderShipped      *bool `json:"order_shipped"`
+	OrderCancelled    *bool `json:"order_cancelled"`
+}
+
+// PreferencesResponse: customer yang belum pernah mengatur preferensi menerima semua email
+type PreferencesResponse struct {
+	CustomerID        string     `json:"customer_id"`
+	OrderConfirmation bool       `json:"order_confirmation"`
+	OrderShipped      bool       `json:"order_shipped"`
+	OrderCancelled    bool       `json:"order_cancelled"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// OrderEmailJob adalah payload job JobSendOrderEmail
+type OrderEmailJob struct {
+	Kind    string `json:"kind"`
+	OrderID string `json:"order_id"`
+	EventID string `json:"event_id,omitempty"`
+}
+
+// AccountEmailJob adalah payload job JobSendAccountEmail. Tautan berisi token mentah
+// sehingga hanya disimpan terenkripsi (PayloadCipher.Seal) dan hanya berlaku sampai ExpiresAt.
+type AccountEmailJob struct {
+	Kind            string    `json:"kind"`
+	CustomerID      string    `json:"customer_id"`
+	SealedActionURL string    `json:"sealed_action_url"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
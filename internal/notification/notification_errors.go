@@ -0,0 +1,10 @@
+package notification
+
+import "errors"
+
+var (
+	ErrCustomerNotFound = errors.New("customer not found")
+	ErrOrderNotFound    = errors.New("order not found")
+	ErrUnknownKind      = errors.New("unknown notification kind")
+	ErrInvalidPayload   = errors.New("job payload cannot be decrypted")
+)
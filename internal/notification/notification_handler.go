@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// GetPreferences godoc
+// @Summary      Get notification preferences
+// @Description  Which order emails the customer receives. Customers who never changed their preferences receive all of them.
+// @Tags         customers
+// @Produce      json
+// @Param        id       path      string  true  "Customer ID"
+// @Success      200      {object}  PreferencesResponse
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Router       /customers/{id}/notification-preferences [get]
+func (h *Handler) GetPreferences(c *gin.Context) {
+	res, err := h.service.GetPreferences(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch notification preferences")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// UpdatePreferences godoc
+// @Summary      Update notification preferences
+// @Description  Opt in or out of order confirmation, shipping and cancellation emails. Omitted fields are left unchanged.
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                    true  "Customer ID"
+// @Param        request  body      UpdatePreferencesRequest  true  "Preferences"
+// @Success      200      {object}  PreferencesResponse
+// @Failure      400      {object}  map[string]string "Invalid input"
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Router       /customers/{id}/notification-preferences [put]
+func (h *Handler) UpdatePreferences(c *gin.Context) {
+	var req UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.UpdatePreferences(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update notification preferences")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrCustomerNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,95 @@
+package notification_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakePreferencesService struct {
+	GetPreferencesFn    func(ctx context.Context, customerID string) (notification.PreferencesResponse, error)
+	UpdatePreferencesFn func(ctx context.Context, customerID string, req notification.UpdatePreferencesRequest) (notification.PreferencesResponse, error)
+}
+
+func (f *fakePreferencesService) GetPreferences(ctx context.Context, customerID string) (notification.PreferencesResponse, error) {
+	return f.GetPreferencesFn(ctx, customerID)
+}
+
+func (f *fakePreferencesService) UpdatePreferences(ctx context.Context, customerID string, req notification.UpdatePreferencesRequest) (notification.PreferencesResponse, error) {
+	return f.UpdatePreferencesFn(ctx, customerID, req)
+}
+
+func (f *fakePreferencesService) SendOrderEmail(ctx context.Context, job notification.OrderEmailJob) error {
+	return nil
+}
+
+func (f *fakePreferencesService) SendAccountEmail(ctx context.Context, job notification.AccountEmailJob) error {
+	return nil
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_GetPreferences_NotFound(t *testing.T) {
+	svc := &fakePreferencesService{
+		GetPreferencesFn: func(ctx context.Context, customerID string) (notification.PreferencesResponse, error) {
+			return notification.PreferencesResponse{}, notification.ErrCustomerNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	notification.RegisterRoutes(r.Group(""), notification.NewHandler(svc))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/customers/missing/notification-preferences", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_UpdatePreferences(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"success", `{"order_shipped":false}`, http.StatusOK},
+		{"invalid type", `{"order_shipped":"no"}`, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakePreferencesService{
+				UpdatePreferencesFn: func(ctx context.Context, customerID string, req notification.UpdatePreferencesRequest) (notification.PreferencesResponse, error) {
+					assert.Equal(t, "cust-1", customerID)
+					assert.Nil(t, req.OrderConfirmation)
+					assert.False(t, *req.OrderShipped)
+					return notification.PreferencesResponse{CustomerID: customerID, OrderConfirmation: true}, nil
+				},
+			}
+
+			r := setupTestRouter()
+			notification.RegisterRoutes(r.Group(""), notification.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPut, "/customers/cust-1/notification-preferences", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"context"
+	"errors"
+)
+
+// Jenis job email
+const (
+	// JobSendOrderEmail mengirim satu email order; payload OrderEmailJob
+	JobSendOrderEmail = "notification.send_order_email"
+	// JobSendAccountEmail mengirim email verifikasi / reset password; payload AccountEmailJob
+	JobSendAccountEmail = "notification.send_account_email"
+)
+
+// RegisterJobs mendaftarkan handler email ke worker. Order/customer/jenis email yang tidak ada
+// dan payload yang tidak bisa didekripsi tidak akan berhasil jika dicoba ulang, jadi langsung masuk dead-letter.
+func RegisterJobs(w *jobs.Worker, service Service) {
+	jobs.Handle(w, JobSendOrderEmail, func(ctx context.Context, job OrderEmailJob) error {
+		return permanentIfNotFound(service.SendOrderEmail(ctx, job))
+	})
+	jobs.Handle(w, JobSendAccountEmail, func(ctx context.Context, job AccountEmailJob) error {
+		return permanentIfNotFound(service.SendAccountEmail(ctx, job))
+	})
+}
+
+func permanentIfNotFound(err error) error {
+	if errors.Is(err, ErrOrderNotFound) || errors.Is(err, ErrCustomerNotFound) ||
+		errors.Is(err, ErrUnknownKind) || errors.Is(err, ErrInvalidPayload) {
+		return jobs.Permanent(err)
+	}
+	return err
+}
+
+// eventKinds memetakan event outbox ke jenis email yang dikirim
+var eventKinds = map[string]string{
+	outbox.EventOrderPlaced:    KindOrderConfirmation,
+	outbox.EventOrderShipped:   KindOrderShipped,
+	outbox.EventOrderCancelled: KindOrderCancelled,
+}
+
+type sink struct {
+	enqueuer jobs.Enqueuer
+}
+
+// NewSink membuat outbox.Sink yang mengantrekan email untuk event order.
+// Unique key per event membuat relay yang mengirim ulang event tidak menggandakan email.
+func NewSink(enqueuer jobs.Enqueuer) outbox.Sink {
+	return &sink{enqueuer: enqueuer}
+}
+
+func (s *sink) Publish(ctx context.Context, msg outbox.Message) error {
+	kind, ok := eventKinds[msg.EventType]
+	if !ok {
+		return nil
+	}
+
+	_, err := s.enqueuer.Enqueue(ctx, JobSendOrderEmail, OrderEmailJob{
+		Kind:    kind,
+		OrderID: msg.AggregateID,
+		EventID: msg.ID,
+	}, jobs.EnqueueOptions{UniqueKey: "notification:" + msg.ID})
+	if errors.Is(err, jobs.ErrDuplicateJob) {
+		return nil
+	}
+	return err
+}
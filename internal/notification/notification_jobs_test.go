@@ -0,0 +1,91 @@
+package notification_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeNotificationService hanya mengimplementasikan SendOrderEmail untuk test job
+type fakeNotificationService struct {
+	notification.Service
+	SendOrderEmailFn func(ctx context.Context, job notification.OrderEmailJob) error
+}
+
+func (f *fakeNotificationService) SendOrderEmail(ctx context.Context, job notification.OrderEmailJob) error {
+	return f.SendOrderEmailFn(ctx, job)
+}
+
+type recordingEnqueuer struct {
+	jobs.Enqueuer
+	payloads []any
+	keys     []string
+}
+
+func (e *recordingEnqueuer) Enqueue(ctx context.Context, jobType string, payload any, opts jobs.EnqueueOptions) (string, error) {
+	e.payloads = append(e.payloads, payload)
+	e.keys = append(e.keys, opts.UniqueKey)
+	return e.Enqueuer.Enqueue(ctx, jobType, payload, opts)
+}
+
+func TestSink_EnqueuesOrderEmails(t *testing.T) {
+	ctx := context.Background()
+	enqueuer := &recordingEnqueuer{Enqueuer: jobs.NewClient(jobs.NewMemoryStore())}
+	sink := notification.NewSink(enqueuer)
+
+	shipped := outbox.Message{ID: "evt-2", AggregateID: "order-1", EventType: outbox.EventOrderShipped}
+	assert.NoError(t, sink.Publish(ctx, outbox.Message{ID: "evt-1", AggregateID: "order-1", EventType: outbox.EventOrderPlaced}))
+	assert.NoError(t, sink.Publish(ctx, shipped))
+	assert.NoError(t, sink.Publish(ctx, shipped), "event yang dikirim ulang relay tidak dianggap error")
+	assert.NoError(t, sink.Publish(ctx, outbox.Message{ID: "evt-3", AggregateID: "p-1", EventType: outbox.EventStockDepleted}))
+
+	assert.Equal(t, []any{
+		notification.OrderEmailJob{Kind: notification.KindOrderConfirmation, OrderID: "order-1", EventID: "evt-1"},
+		notification.OrderEmailJob{Kind: notification.KindOrderShipped, OrderID: "order-1", EventID: "evt-2"},
+		notification.OrderEmailJob{Kind: notification.KindOrderShipped, OrderID: "order-1", EventID: "evt-2"},
+	}, enqueuer.payloads)
+	assert.Equal(t, []string{"notification:evt-1", "notification:evt-2", "notification:evt-2"}, enqueuer.keys)
+}
+
+func TestRegisterJobs(t *testing.T) {
+	cases := []struct {
+		name       string
+		err        error
+		wantStatus string
+	}{
+		{"sent", nil, jobs.StatusCompleted},
+		{"order not found is permanent", notification.ErrOrderNotFound, jobs.StatusDead},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := jobs.NewMemoryStore()
+			w := jobs.NewWorker(store, jobs.Config{PollInterval: 10 * time.Millisecond, JobTimeout: time.Second})
+
+			var got notification.OrderEmailJob
+			notification.RegisterJobs(w, &fakeNotificationService{
+				SendOrderEmailFn: func(ctx context.Context, job notification.OrderEmailJob) error {
+					got = job
+					return tc.err
+				},
+			})
+
+			payload := notification.OrderEmailJob{Kind: notification.KindOrderCancelled, OrderID: "order-1"}
+			id, err := jobs.NewClient(store).Enqueue(context.Background(), notification.JobSendOrderEmail, payload, jobs.EnqueueOptions{})
+			assert.NoError(t, err)
+
+			go w.Run()
+			assert.Eventually(t, func() bool {
+				status, _, _ := store.Status(id)
+				return status == tc.wantStatus
+			}, 2*time.Second, 5*time.Millisecond)
+			assert.NoError(t, w.Shutdown(context.Background()))
+			assert.Equal(t, payload, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package notification
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=notification_repo.go -destination=mocks/notification_repo_mock.go -package=mock
+type Repository interface {
+	CustomerExists(ctx context.Context, id string) (bool, error)
+	GetCustomer(ctx context.Context, id string) (dbgen.GetCustomerByIDRow, error)
+	GetPreferences(ctx context.Context, customerID string) (dbgen.NotificationPreference, error)
+	UpsertPreferences(ctx context.Context, params dbgen.UpsertNotificationPreferencesParams) error
+
+	// Data order untuk isi email
+	GetOrder(ctx context.Context, id string) (dbgen.GetOrderByIDRow, error)
+	GetShipment(ctx context.Context, orderID string) (dbgen.OrderShipment, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) CustomerExists(ctx context.Context, id string) (bool, error) {
+	return r.q.CustomerExists(ctx, id)
+}
+
+func (r *repository) GetCustomer(ctx context.Context, id string) (dbgen.GetCustomerByIDRow, error) {
+	return r.q.GetCustomerByID(ctx, id)
+}
+
+func (r *repository) GetPreferences(ctx context.Context, customerID string) (dbgen.NotificationPreference, error) {
+	return r.q.GetNotificationPreferences(ctx, customerID)
+}
+
+func (r *repository) UpsertPreferences(ctx context.Context, params dbgen.UpsertNotificationPreferencesParams) error {
+	return r.q.UpsertNotificationPreferences(ctx, params)
+}
+
+func (r *repository) GetOrder(ctx context.Context, id string) (dbgen.GetOrderByIDRow, error) {
+	return r.q.GetOrderByID(ctx, id)
+}
+
+func (r *repository) GetShipment(ctx context.Context, orderID string) (dbgen.OrderShipment, error) {
+	return r.q.GetOrderShipment(ctx, orderID)
+}
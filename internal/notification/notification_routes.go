@@ -0,0 +1,9 @@
+package notification
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	// Sub-resource customer: preferensi email order
+	r.GET("/customers/:id/notification-preferences", handler.GetPreferences)
+	r.PUT("/customers/:id/notification-preferences", handler.UpdatePreferences)
+}
@@ -0,0 +1,221 @@
+package notification
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"slices"
+)
+
+//go:generate mockgen -source=notification_service.go -destination=mocks/notification_service_mock.go -package=mock
+
+type Service interface {
+	GetPreferences(ctx context.Context, customerID string) (PreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, customerID string, req UpdatePreferencesRequest) (PreferencesResponse, error)
+	// SendOrderEmail merender & mengirim email order, kecuali customer menonaktifkan jenis email tersebut
+	SendOrderEmail(ctx context.Context, job OrderEmailJob) error
+	// SendAccountEmail mengirim email verifikasi / reset password; selalu dikirim tanpa melihat preferensi
+	SendAccountEmail(ctx context.Context, job AccountEmailJob) error
+}
+
+type service struct {
+	repo   Repository
+	mailer Mailer
+	cipher *PayloadCipher // Membuka tautan terenkripsi di AccountEmailJob
+}
+
+// NewService: mailer & cipher boleh nil jika service hanya dipakai untuk preferensi (mis. di API)
+func NewService(repo Repository, mailer Mailer, cipher *PayloadCipher) Service {
+	return &service{
+		repo:   repo,
+		mailer: mailer,
+		cipher: cipher,
+	}
+}
+
+func (s *service) GetPreferences(ctx context.Context, customerID string) (PreferencesResponse, error) {
+	exists, err := s.repo.CustomerExists(ctx, customerID)
+	if err != nil {
+		return PreferencesResponse{}, err
+	}
+	if !exists {
+		return PreferencesResponse{}, ErrCustomerNotFound
+	}
+	return s.preferences(ctx, customerID)
+}
+
+func (s *service) UpdatePreferences(ctx context.Context, customerID string, req UpdatePreferencesRequest) (PreferencesResponse, error) {
+	current, err := s.GetPreferences(ctx, customerID)
+	if err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	params := dbgen.UpsertNotificationPreferencesParams{
+		CustomerID:        customerID,
+		OrderConfirmation: current.OrderConfirmation,
+		OrderShipped:      current.OrderShipped,
+		OrderCancelled:    current.OrderCancelled,
+	}
+	if req.OrderConfirmation != nil {
+		params.OrderConfirmation = *req.OrderConfirmation
+	}
+	if req.OrderShipped != nil {
+		params.OrderShipped = *req.OrderShipped
+	}
+	if req.OrderCancelled != nil {
+		params.OrderCancelled = *req.OrderCancelled
+	}
+
+	if err := s.repo.UpsertPreferences(ctx, params); err != nil {
+		return PreferencesResponse{}, err
+	}
+	return s.preferences(ctx, customerID)
+}
+
+// preferences mengembalikan preferensi tersimpan; tanpa baris berarti semua email aktif
+func (s *service) preferences(ctx context.Context, customerID string) (PreferencesResponse, error) {
+	p, err := s.repo.GetPreferences(ctx, customerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return PreferencesResponse{
+			CustomerID:        customerID,
+			OrderConfirmation: true,
+			OrderShipped:      true,
+			OrderCancelled:    true,
+		}, nil
+	}
+	if err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	return PreferencesResponse{
+		CustomerID:        p.CustomerID,
+		OrderConfirmation: p.OrderConfirmation,
+		OrderShipped:      p.OrderShipped,
+		OrderCancelled:    p.OrderCancelled,
+		UpdatedAt:         &p.UpdatedAt,
+	}, nil
+}
+
+func (p PreferencesResponse) allows(kind string) bool {
+	switch kind {
+	case KindOrderConfirmation:
+		return p.OrderConfirmation
+	case KindOrderShipped:
+		return p.OrderShipped
+	case KindOrderCancelled:
+		return p.OrderCancelled
+	}
+	return false
+}
+
+func (s *service) SendOrderEmail(ctx context.Context, job OrderEmailJob) error {
+	if !slices.Contains(orderKinds, job.Kind) {
+		return fmt.Errorf("%w: %q", ErrUnknownKind, job.Kind)
+	}
+
+	o, err := s.repo.GetOrder(ctx, job.OrderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	// Job yang diantrekan sebelum customer dianonimkan tidak boleh terus dicoba ke alamat .invalid
+	c, err := s.repo.GetCustomer(ctx, o.CustomerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCustomerNotFound
+		}
+		return err
+	}
+	if c.AnonymisedAt.Valid {
+		log.Printf("notification: %s for order %s skipped, customer %s is anonymised", job.Kind, o.ID, o.CustomerID)
+		return nil
+	}
+
+	prefs, err := s.preferences(ctx, o.CustomerID)
+	if err != nil {
+		return err
+	}
+	if !prefs.allows(job.Kind) {
+		log.Printf("notification: %s for order %s skipped, customer %s opted out", job.Kind, o.ID, o.CustomerID)
+		return nil
+	}
+
+	data := orderEmailData{
+		emailBase:     emailBase{CustomerName: o.CustomerName, OptOut: true},
+		OrderID:       o.ID,
+		CreatedAt:     o.CreatedAt,
+		Subtotal:      o.Subtotal,
+		DiscountTotal: o.DiscountTotal,
+		TaxTotal:      o.TaxTotal,
+		ShippingTotal: o.ShippingTotal,
+		GrandTotal:    o.TotalPrice,
+		CouponCode:    o.CouponCode.String,
+	}
+	if err := json.Unmarshal(o.Items, &data.Items); err != nil {
+		return fmt.Errorf("decode order items: %w", err)
+	}
+
+	if job.Kind == KindOrderShipped {
+		shipment, err := s.repo.GetShipment(ctx, o.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: order %s has no shipment", ErrOrderNotFound, o.ID)
+			}
+			return err
+		}
+		data.Carrier = shipment.Carrier
+		data.TrackingNumber = shipment.TrackingNumber
+		data.ShippedAt = shipment.ShippedAt
+	}
+
+	msg, err := render(job.Kind, &data)
+	if err != nil {
+		return err
+	}
+	msg.To = (&mail.Address{Name: o.CustomerName, Address: o.CustomerEmail}).String()
+
+	return s.mailer.Send(ctx, msg)
+}
+
+func (s *service) SendAccountEmail(ctx context.Context, job AccountEmailJob) error {
+	if !slices.Contains(accountKinds, job.Kind) {
+		return fmt.Errorf("%w: %q", ErrUnknownKind, job.Kind)
+	}
+
+	c, err := s.repo.GetCustomer(ctx, job.CustomerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCustomerNotFound
+		}
+		return err
+	}
+	if c.AnonymisedAt.Valid {
+		log.Printf("notification: %s skipped, customer %s is anonymised", job.Kind, c.ID)
+		return nil
+	}
+
+	actionURL, err := s.cipher.Open(job.SealedActionURL)
+	if err != nil {
+		return err
+	}
+
+	msg, err := render(job.Kind, &accountEmailData{
+		emailBase: emailBase{CustomerName: c.Name},
+		ActionURL: actionURL,
+		ExpiresAt: job.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	msg.To = (&mail.Address{Name: c.Name, Address: c.Email}).String()
+
+	return s.mailer.Send(ctx, msg)
+}
@@ -0,0 +1,317 @@
+package notification_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockNotification "assignment-ptes-achmad-rifai/internal/notification/mocks"
+)
+
+func setupServiceTest(t *testing.T) (notification.Service, *mockNotification.MockRepository, *notification.MemoryMailer) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockNotification.NewMockRepository(ctrl)
+	mailer := notification.NewMemoryMailer()
+
+	return notification.NewService(repo, mailer, testCipher), repo, mailer
+}
+
+var testCipher, _ = notification.NewPayloadCipher("test-secret")
+
+func seal(t *testing.T, s string) string {
+	sealed, err := testCipher.Seal(s)
+	assert.NoError(t, err)
+	return sealed
+}
+
+func testOrder() dbgen.GetOrderByIDRow {
+	return dbgen.GetOrderByIDRow{
+		ID:            "order-1",
+		Status:        "pending",
+		Subtotal:      decimal.NewFromInt(1250000),
+		DiscountTotal: decimal.NewFromInt(50000),
+		TaxTotal:      decimal.NewFromInt(132000),
+		ShippingTotal: decimal.Zero,
+		TotalPrice:    decimal.NewFromInt(1332000),
+		CouponCode:    sql.NullString{String: "HEMAT", Valid: true},
+		CreatedAt:     time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC),
+		CustomerID:    "cust-1",
+		CustomerName:  "Budi Santoso",
+		CustomerEmail: "budi@example.com",
+		Items: json.RawMessage(`[{"product_name":"Sepatu Lari","sku":"SPT-42","quantity":2,` +
+			`"unit_price":"625000.00","line_total":"1250000.00"}]`),
+	}
+}
+
+func testCustomer() dbgen.GetCustomerByIDRow {
+	return dbgen.GetCustomerByIDRow{ID: "cust-1", Name: "Budi Santoso", Email: "budi@example.com"}
+}
+
+func TestService_GetPreferences(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults_to_all_enabled", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(true, nil)
+		repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{}, sql.ErrNoRows)
+
+		res, err := svc.GetPreferences(ctx, "cust-1")
+
+		assert.NoError(t, err)
+		assert.True(t, res.OrderConfirmation)
+		assert.True(t, res.OrderShipped)
+		assert.True(t, res.OrderCancelled)
+		assert.Nil(t, res.UpdatedAt)
+	})
+
+	t.Run("error_customer_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(gomock.Any(), "missing").Return(false, nil)
+
+		_, err := svc.GetPreferences(ctx, "missing")
+
+		assert.ErrorIs(t, err, notification.ErrCustomerNotFound)
+	})
+}
+
+func TestService_UpdatePreferences_KeepsOmittedFields(t *testing.T) {
+	svc, repo, _ := setupServiceTest(t)
+	ctx := context.Background()
+	off := false
+
+	repo.EXPECT().CustomerExists(gomock.Any(), "cust-1").Return(true, nil)
+	repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{
+		CustomerID:        "cust-1",
+		OrderConfirmation: true,
+		OrderShipped:      false,
+		OrderCancelled:    true,
+	}, nil)
+	repo.EXPECT().UpsertPreferences(gomock.Any(), dbgen.UpsertNotificationPreferencesParams{
+		CustomerID:        "cust-1",
+		OrderConfirmation: true,
+		OrderShipped:      false,
+		OrderCancelled:    false,
+	}).Return(nil)
+	repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{
+		CustomerID:        "cust-1",
+		OrderConfirmation: true,
+		UpdatedAt:         time.Now(),
+	}, nil)
+
+	res, err := svc.UpdatePreferences(ctx, "cust-1", notification.UpdatePreferencesRequest{OrderCancelled: &off})
+
+	assert.NoError(t, err)
+	assert.True(t, res.OrderConfirmation)
+	assert.False(t, res.OrderCancelled)
+	assert.NotNil(t, res.UpdatedAt)
+}
+
+func TestService_SendOrderEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("order_confirmation", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		repo.EXPECT().GetOrder(gomock.Any(), "order-1").Return(testOrder(), nil)
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(testCustomer(), nil)
+		repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{}, sql.ErrNoRows)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderConfirmation, OrderID: "order-1"})
+
+		assert.NoError(t, err)
+		sent := mailer.Sent()
+		assert.Len(t, sent, 1)
+		assert.Equal(t, `"Budi Santoso" <budi@example.com>`, sent[0].To)
+		assert.Equal(t, "Pesanan order-1 sudah kami terima", sent[0].Subject)
+		assert.Contains(t, sent[0].Text, "Halo Budi Santoso")
+		assert.Contains(t, sent[0].Text, "Sepatu Lari (SPT-42) x2 @ Rp625.000 = Rp1.250.000")
+		assert.Contains(t, sent[0].Text, "-Rp50.000 (HEMAT)")
+		assert.Contains(t, sent[0].Text, "Rp1.332.000")
+		assert.Contains(t, sent[0].Text, "preferensi notifikasi")
+		assert.Contains(t, sent[0].HTML, "<title>Pesanan order-1 sudah kami terima</title>")
+		assert.Contains(t, sent[0].HTML, "Rp1.250.000")
+	})
+
+	t.Run("order_shipped_includes_tracking", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		repo.EXPECT().GetOrder(gomock.Any(), "order-1").Return(testOrder(), nil)
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(testCustomer(), nil)
+		repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{}, sql.ErrNoRows)
+		repo.EXPECT().GetShipment(gomock.Any(), "order-1").Return(dbgen.OrderShipment{
+			OrderID:        "order-1",
+			Carrier:        "JNE",
+			TrackingNumber: "JNE0012345",
+			ShippedAt:      time.Date(2025, 3, 2, 8, 0, 0, 0, time.UTC),
+		}, nil)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderShipped, OrderID: "order-1"})
+
+		assert.NoError(t, err)
+		sent := mailer.Sent()
+		assert.Len(t, sent, 1)
+		assert.Contains(t, sent[0].Text, "Nomor resi : JNE0012345")
+		assert.Contains(t, sent[0].HTML, "<strong>JNE0012345</strong>")
+	})
+
+	t.Run("skipped_when_opted_out", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		repo.EXPECT().GetOrder(gomock.Any(), "order-1").Return(testOrder(), nil)
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(testCustomer(), nil)
+		repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{
+			CustomerID:        "cust-1",
+			OrderConfirmation: true,
+			OrderShipped:      true,
+			OrderCancelled:    false,
+		}, nil)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderCancelled, OrderID: "order-1"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, mailer.Sent())
+	})
+
+	t.Run("html_escapes_customer_data", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		o := testOrder()
+		o.CustomerName = "<script>alert(1)</script>"
+		repo.EXPECT().GetOrder(gomock.Any(), "order-1").Return(o, nil)
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(testCustomer(), nil)
+		repo.EXPECT().GetPreferences(gomock.Any(), "cust-1").Return(dbgen.NotificationPreference{}, sql.ErrNoRows)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderCancelled, OrderID: "order-1"})
+
+		assert.NoError(t, err)
+		assert.NotContains(t, mailer.Sent()[0].HTML, "<script>")
+	})
+
+	t.Run("skipped_when_customer_anonymised", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		c := testCustomer()
+		c.Email = "anonymised+cust-1@anonymised.invalid"
+		c.AnonymisedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		repo.EXPECT().GetOrder(gomock.Any(), "order-1").Return(testOrder(), nil)
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(c, nil)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderShipped, OrderID: "order-1"})
+
+		assert.NoError(t, err, "job selesai tanpa retry")
+		assert.Empty(t, mailer.Sent())
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetOrder(gomock.Any(), "missing").Return(dbgen.GetOrderByIDRow{}, sql.ErrNoRows)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: notification.KindOrderConfirmation, OrderID: "missing"})
+
+		assert.ErrorIs(t, err, notification.ErrOrderNotFound)
+	})
+
+	t.Run("error_unknown_kind", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		err := svc.SendOrderEmail(ctx, notification.OrderEmailJob{Kind: "newsletter", OrderID: "order-1"})
+
+		assert.ErrorIs(t, err, notification.ErrUnknownKind)
+	})
+}
+
+func TestService_SendAccountEmail(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Date(2025, 3, 1, 11, 30, 0, 0, time.UTC)
+
+	t.Run("password_reset_ignores_preferences", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(dbgen.GetCustomerByIDRow{
+			ID:    "cust-1",
+			Name:  "Budi Santoso",
+			Email: "budi@example.com",
+		}, nil)
+
+		err := svc.SendAccountEmail(ctx, notification.AccountEmailJob{
+			Kind:            notification.KindPasswordReset,
+			CustomerID:      "cust-1",
+			SealedActionURL: seal(t, "https://toko.test/reset-password?token=abc&x=1"),
+			ExpiresAt:       expiresAt,
+		})
+
+		assert.NoError(t, err)
+		sent := mailer.Sent()
+		assert.Len(t, sent, 1)
+		assert.Equal(t, "Atur ulang password Anda", sent[0].Subject)
+		assert.Contains(t, sent[0].Text, "https://toko.test/reset-password?token=abc&x=1")
+		assert.Contains(t, sent[0].Text, "01/03/2025 11:30")
+		assert.NotContains(t, sent[0].Text, "preferensi notifikasi")
+		assert.Contains(t, sent[0].HTML, `href="https://toko.test/reset-password?token=abc&amp;x=1"`)
+	})
+
+	t.Run("error_payload_sealed_with_other_secret", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		other, _ := notification.NewPayloadCipher("other-secret")
+		sealed, _ := other.Seal("https://toko.test/reset-password?token=abc")
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(dbgen.GetCustomerByIDRow{ID: "cust-1", Email: "budi@example.com"}, nil)
+
+		err := svc.SendAccountEmail(ctx, notification.AccountEmailJob{
+			Kind:            notification.KindPasswordReset,
+			CustomerID:      "cust-1",
+			SealedActionURL: sealed,
+		})
+
+		assert.ErrorIs(t, err, notification.ErrInvalidPayload)
+		assert.Empty(t, mailer.Sent())
+	})
+
+	t.Run("skipped_when_customer_anonymised", func(t *testing.T) {
+		svc, repo, mailer := setupServiceTest(t)
+
+		c := testCustomer()
+		c.AnonymisedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		repo.EXPECT().GetCustomer(gomock.Any(), "cust-1").Return(c, nil)
+
+		err := svc.SendAccountEmail(ctx, notification.AccountEmailJob{
+			Kind:            notification.KindPasswordReset,
+			CustomerID:      "cust-1",
+			SealedActionURL: seal(t, "https://toko.test/reset-password?token=abc"),
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, mailer.Sent())
+	})
+
+	t.Run("error_customer_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetCustomer(gomock.Any(), "missing").Return(dbgen.GetCustomerByIDRow{}, sql.ErrNoRows)
+
+		err := svc.SendAccountEmail(ctx, notification.AccountEmailJob{Kind: notification.KindEmailVerification, CustomerID: "missing"})
+
+		assert.ErrorIs(t, err, notification.ErrCustomerNotFound)
+	})
+
+	t.Run("error_order_kind", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		err := svc.SendAccountEmail(ctx, notification.AccountEmailJob{Kind: notification.KindOrderShipped, CustomerID: "cust-1"})
+
+		assert.ErrorIs(t, err, notification.ErrUnknownKind)
+	})
+}
@@ -0,0 +1,162 @@
+package notification_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/notification"
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// smtpCatcher adalah server SMTP minimal (tanpa STARTTLS & AUTH) yang menyimpan email yang diterima
+type smtpCatcher struct {
+	ln       net.Listener
+	received chan caughtMail
+}
+
+// caughtMail berisi perintah SMTP sebelum DATA dan isi email mentah
+type caughtMail struct {
+	commands []string
+	data     string
+}
+
+func newSMTPCatcher(t *testing.T) *smtpCatcher {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &smtpCatcher{ln: ln, received: make(chan caughtMail, 1)}
+	go s.serve()
+	return s
+}
+
+func (s *smtpCatcher) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpCatcher) serve() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var commands []string
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 localhost ESMTP catcher")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		commands = append(commands, cmd)
+
+		switch verb := strings.ToUpper(strings.SplitN(cmd, " ", 2)[0]); verb {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 8BITMIME")
+		case "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.received <- caughtMail{commands: commands, data: data.String()}
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	catcher := newSMTPCatcher(t)
+
+	mailer, err := notification.NewSMTPMailer(notification.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: catcher.port(),
+		From: "Toko <noreply@toko.test>",
+	})
+	assert.NoError(t, err)
+
+	err = mailer.Send(context.Background(), notification.Message{
+		To:      `"Budi Santoso" <budi@example.com>`,
+		Subject: "Pesanan order-1 sudah dikirim",
+		Text:    "Halo Budi, total Rp1.250.000",
+		HTML:    "<p>Halo Budi, total <strong>Rp1.250.000</strong></p>",
+	})
+	assert.NoError(t, err)
+
+	caught := <-catcher.received
+	assert.Contains(t, caught.commands, "MAIL FROM:<noreply@toko.test> BODY=8BITMIME")
+	assert.Contains(t, caught.commands, "RCPT TO:<budi@example.com>")
+
+	msg, err := mail.ReadMessage(strings.NewReader(caught.data))
+	assert.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Pesanan order-1 sudah dikirim", subject)
+	assert.Equal(t, `"Toko" <noreply@toko.test>`, msg.Header.Get("From"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	var parts []string
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart() // quoted-printable di-decode otomatis oleh multipart.Reader
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(p)
+		parts = append(parts, p.Header.Get("Content-Type")+"|"+string(body))
+	}
+	assert.Equal(t, []string{
+		"text/plain; charset=utf-8|Halo Budi, total Rp1.250.000",
+		"text/html; charset=utf-8|<p>Halo Budi, total <strong>Rp1.250.000</strong></p>",
+	}, parts)
+}
+
+func TestSMTPMailer_InvalidAddress(t *testing.T) {
+	_, err := notification.NewSMTPMailer(notification.SMTPConfig{Host: "localhost", Port: 25, From: "not an address"})
+	assert.Error(t, err)
+
+	mailer, err := notification.NewSMTPMailer(notification.SMTPConfig{Host: "localhost", Port: 25, From: "noreply@toko.test"})
+	assert.NoError(t, err)
+	assert.Error(t, mailer.Send(context.Background(), notification.Message{To: "budi"}))
+}
+
+func TestSMTPMailer_ConnectionRefused(t *testing.T) {
+	ln, _ := net.Listen("tcp", "127.0.0.1:0")
+	port := ln.Addr().(*net.TCPAddr).Port
+	ln.Close()
+
+	mailer, _ := notification.NewSMTPMailer(notification.SMTPConfig{Host: "127.0.0.1", Port: port, From: "noreply@toko.test"})
+	err := mailer.Send(context.Background(), notification.Message{To: "budi@example.com", Text: "x"})
+	assert.Error(t, err, "gagal kirim dikembalikan agar job dicoba ulang")
+}
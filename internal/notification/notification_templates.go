@@ -0,0 +1,137 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Setiap jenis email punya <kind>.txt (mendefinisikan "subject" & "content") dan <kind>.html ("content");
+// keduanya dirender di dalam "layout" dari layout.txt / layout.html
+var (
+	orderKinds   = []string{KindOrderConfirmation, KindOrderShipped, KindOrderCancelled}
+	accountKinds = []string{KindEmailVerification, KindPasswordReset}
+)
+
+var templateFuncs = map[string]any{
+	"rupiah": formatRupiah,
+	"date":   func(t time.Time) string { return t.Format("02/01/2006 15:04") },
+}
+
+var (
+	textTemplates = map[string]*texttemplate.Template{}
+	htmlTemplates = map[string]*htmltemplate.Template{}
+)
+
+func init() {
+	for _, kind := range append(orderKinds, accountKinds...) {
+		textTemplates[kind] = texttemplate.Must(texttemplate.New(kind).Funcs(templateFuncs).
+			ParseFS(templateFS, "templates/layout.txt", "templates/"+kind+".txt"))
+		htmlTemplates[kind] = htmltemplate.Must(htmltemplate.New(kind).Funcs(templateFuncs).
+			ParseFS(templateFS, "templates/layout.html", "templates/"+kind+".html"))
+	}
+}
+
+type orderEmailItem struct {
+	ProductName string          `json:"product_name"`
+	SKU         string          `json:"sku"`
+	Quantity    int             `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	LineTotal   decimal.Decimal `json:"line_total"`
+}
+
+// emailBase adalah data yang dipakai layout
+type emailBase struct {
+	Subject      string
+	CustomerName string
+	// OptOut menampilkan catatan preferensi di footer; false untuk email transaksional akun
+	OptOut bool
+}
+
+func (b *emailBase) base() *emailBase { return b }
+
+type emailData interface {
+	base() *emailBase
+}
+
+// orderEmailData adalah data yang tersedia di template email order
+type orderEmailData struct {
+	emailBase
+	OrderID       string
+	CreatedAt     time.Time
+	Items         []orderEmailItem
+	Subtotal      decimal.Decimal
+	DiscountTotal decimal.Decimal
+	TaxTotal      decimal.Decimal
+	ShippingTotal decimal.Decimal
+	GrandTotal    decimal.Decimal
+	CouponCode    string
+
+	// Hanya untuk KindOrderShipped
+	Carrier        string
+	TrackingNumber string
+	ShippedAt      time.Time
+}
+
+// accountEmailData adalah data template email verifikasi & reset password
+type accountEmailData struct {
+	emailBase
+	ActionURL string
+	ExpiresAt time.Time
+}
+
+// render menghasilkan subject, body teks & body HTML untuk satu jenis email
+func render(kind string, data emailData) (Message, error) {
+	text, ok := textTemplates[kind]
+	if !ok {
+		return Message{}, ErrUnknownKind
+	}
+
+	var subject, body, html bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	data.base().Subject = strings.TrimSpace(subject.String())
+
+	if err := text.ExecuteTemplate(&body, "layout", data); err != nil {
+		return Message{}, err
+	}
+	if err := htmlTemplates[kind].ExecuteTemplate(&html, "layout", data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{Subject: data.base().Subject, Text: body.String(), HTML: html.String()}, nil
+}
+
+// formatRupiah memformat nominal dengan pemisah ribuan titik, mis. Rp1.250.000 atau Rp1.250,50
+func formatRupiah(d decimal.Decimal) string {
+	d = d.Round(2)
+	sign := ""
+	if d.IsNegative() {
+		sign = "-"
+		d = d.Neg()
+	}
+
+	whole := d.Truncate(0).String()
+	var b strings.Builder
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(r)
+	}
+
+	s := sign + "Rp" + b.String()
+	if frac := d.Sub(d.Truncate(0)); !frac.IsZero() {
+		s += "," + frac.StringFixed(2)[2:]
+	}
+	return s
+}
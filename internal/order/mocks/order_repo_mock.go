@@ -42,6 +42,65 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CancelPendingPaymentIntents mocks base method.
+func (m *MockRepository) CancelPendingPaymentIntents(ctx context.Context, params dbgen.CancelPendingPaymentIntentsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingPaymentIntents", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPendingPaymentIntents indicates an expected call of CancelPendingPaymentIntents.
+func (mr *MockRepositoryMockRecorder) CancelPendingPaymentIntents(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingPaymentIntents", reflect.TypeOf((*MockRepository)(nil).CancelPendingPaymentIntents), ctx, params)
+}
+
+// ClearCoupon mocks base method.
+func (m *MockRepository) ClearCoupon(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCoupon", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCoupon indicates an expected call of ClearCoupon.
+func (mr *MockRepositoryMockRecorder) ClearCoupon(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCoupon", reflect.TypeOf((*MockRepository)(nil).ClearCoupon), ctx, orderID)
+}
+
+// CountCustomerRedemptions mocks base method.
+func (m *MockRepository) CountCustomerRedemptions(ctx context.Context, params dbgen.CountCustomerPromotionRedemptionsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomerRedemptions", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomerRedemptions indicates an expected call of CountCustomerRedemptions.
+func (mr *MockRepositoryMockRecorder) CountCustomerRedemptions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerRedemptions", reflect.TypeOf((*MockRepository)(nil).CountCustomerRedemptions), ctx, params)
+}
+
+// CountOrders mocks base method.
+func (m *MockRepository) CountOrders(ctx context.Context, params dbgen.CountOrdersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrders", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrders indicates an expected call of CountOrders.
+func (mr *MockRepositoryMockRecorder) CountOrders(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrders", reflect.TypeOf((*MockRepository)(nil).CountOrders), ctx, params)
+}
+
 // CreateOrder mocks base method.
 func (m *MockRepository) CreateOrder(ctx context.Context, params dbgen.CreateOrderParams) error {
 	m.ctrl.T.Helper()
@@ -70,6 +129,135 @@ func (mr *MockRepositoryMockRecorder) CreateOrderItem(ctx, params any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockRepository)(nil).CreateOrderItem), ctx, params)
 }
 
+// CreateOutboxEvent mocks base method.
+func (m *MockRepository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEvent), ctx, params)
+}
+
+// CreatePromotionRedemption mocks base method.
+func (m *MockRepository) CreatePromotionRedemption(ctx context.Context, params dbgen.CreatePromotionRedemptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotionRedemption", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotionRedemption indicates an expected call of CreatePromotionRedemption.
+func (mr *MockRepositoryMockRecorder) CreatePromotionRedemption(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotionRedemption", reflect.TypeOf((*MockRepository)(nil).CreatePromotionRedemption), ctx, params)
+}
+
+// CreateRevision mocks base method.
+func (m *MockRepository) CreateRevision(ctx context.Context, params dbgen.CreateOrderRevisionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevision indicates an expected call of CreateRevision.
+func (mr *MockRepositoryMockRecorder) CreateRevision(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockRepository)(nil).CreateRevision), ctx, params)
+}
+
+// CreateShipment mocks base method.
+func (m *MockRepository) CreateShipment(ctx context.Context, params dbgen.CreateOrderShipmentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockRepositoryMockRecorder) CreateShipment(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockRepository)(nil).CreateShipment), ctx, params)
+}
+
+// CreateShippingAddress mocks base method.
+func (m *MockRepository) CreateShippingAddress(ctx context.Context, params dbgen.CreateOrderShippingAddressParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShippingAddress", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShippingAddress indicates an expected call of CreateShippingAddress.
+func (mr *MockRepositoryMockRecorder) CreateShippingAddress(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShippingAddress", reflect.TypeOf((*MockRepository)(nil).CreateShippingAddress), ctx, params)
+}
+
+// CustomerExists mocks base method.
+func (m *MockRepository) CustomerExists(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomerExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomerExists indicates an expected call of CustomerExists.
+func (mr *MockRepositoryMockRecorder) CustomerExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerExists", reflect.TypeOf((*MockRepository)(nil).CustomerExists), ctx, id)
+}
+
+// DecrementProductStock mocks base method.
+func (m *MockRepository) DecrementProductStock(ctx context.Context, params dbgen.DecrementProductStockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementProductStock", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecrementProductStock indicates an expected call of DecrementProductStock.
+func (mr *MockRepositoryMockRecorder) DecrementProductStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementProductStock", reflect.TypeOf((*MockRepository)(nil).DecrementProductStock), ctx, params)
+}
+
+// DecrementPromotionUsage mocks base method.
+func (m *MockRepository) DecrementPromotionUsage(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementPromotionUsage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecrementPromotionUsage indicates an expected call of DecrementPromotionUsage.
+func (mr *MockRepositoryMockRecorder) DecrementPromotionUsage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementPromotionUsage", reflect.TypeOf((*MockRepository)(nil).DecrementPromotionUsage), ctx, id)
+}
+
+// DecrementVariantStock mocks base method.
+func (m *MockRepository) DecrementVariantStock(ctx context.Context, params dbgen.DecrementProductVariantStockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementVariantStock", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecrementVariantStock indicates an expected call of DecrementVariantStock.
+func (mr *MockRepositoryMockRecorder) DecrementVariantStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementVariantStock", reflect.TypeOf((*MockRepository)(nil).DecrementVariantStock), ctx, params)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -84,6 +272,34 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteOrderItem mocks base method.
+func (m *MockRepository) DeleteOrderItem(ctx context.Context, params dbgen.DeleteOrderItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrderItem", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrderItem indicates an expected call of DeleteOrderItem.
+func (mr *MockRepositoryMockRecorder) DeleteOrderItem(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderItem", reflect.TypeOf((*MockRepository)(nil).DeleteOrderItem), ctx, params)
+}
+
+// DeleteRedemption mocks base method.
+func (m *MockRepository) DeleteRedemption(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRedemption", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRedemption indicates an expected call of DeleteRedemption.
+func (mr *MockRepositoryMockRecorder) DeleteRedemption(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedemption", reflect.TypeOf((*MockRepository)(nil).DeleteRedemption), ctx, orderID)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id string) (dbgen.GetOrderByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +315,36 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetCustomerAddress mocks base method.
+func (m *MockRepository) GetCustomerAddress(ctx context.Context, params dbgen.GetCustomerAddressParams) (dbgen.CustomerAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerAddress", ctx, params)
+	ret0, _ := ret[0].(dbgen.CustomerAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerAddress indicates an expected call of GetCustomerAddress.
+func (mr *MockRepositoryMockRecorder) GetCustomerAddress(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerAddress", reflect.TypeOf((*MockRepository)(nil).GetCustomerAddress), ctx, params)
+}
+
+// GetItemSnapshot mocks base method.
+func (m *MockRepository) GetItemSnapshot(ctx context.Context, params dbgen.GetOrderItemSnapshotParams) (dbgen.GetOrderItemSnapshotRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemSnapshot", ctx, params)
+	ret0, _ := ret[0].(dbgen.GetOrderItemSnapshotRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemSnapshot indicates an expected call of GetItemSnapshot.
+func (mr *MockRepositoryMockRecorder) GetItemSnapshot(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemSnapshot", reflect.TypeOf((*MockRepository)(nil).GetItemSnapshot), ctx, params)
+}
+
 // GetItemsByOrderID mocks base method.
 func (m *MockRepository) GetItemsByOrderID(ctx context.Context, orderID string) ([]dbgen.OrderItem, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +360,36 @@ func (mr *MockRepositoryMockRecorder) GetItemsByOrderID(ctx, orderID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByOrderID", reflect.TypeOf((*MockRepository)(nil).GetItemsByOrderID), ctx, orderID)
 }
 
+// GetNextRevision mocks base method.
+func (m *MockRepository) GetNextRevision(ctx context.Context, orderID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextRevision", ctx, orderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextRevision indicates an expected call of GetNextRevision.
+func (mr *MockRepositoryMockRecorder) GetNextRevision(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextRevision", reflect.TypeOf((*MockRepository)(nil).GetNextRevision), ctx, orderID)
+}
+
+// GetOrderForUpdate mocks base method.
+func (m *MockRepository) GetOrderForUpdate(ctx context.Context, id string) (dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderForUpdate", ctx, id)
+	ret0, _ := ret[0].(dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderForUpdate indicates an expected call of GetOrderForUpdate.
+func (mr *MockRepositoryMockRecorder) GetOrderForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderForUpdate", reflect.TypeOf((*MockRepository)(nil).GetOrderForUpdate), ctx, id)
+}
+
 // GetOrders mocks base method.
 func (m *MockRepository) GetOrders(ctx context.Context, params dbgen.GetOrdersParams) ([]dbgen.GetOrdersRow, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +405,255 @@ func (mr *MockRepositoryMockRecorder) GetOrders(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockRepository)(nil).GetOrders), ctx, params)
 }
 
+// GetProductCategoryID mocks base method.
+func (m *MockRepository) GetProductCategoryID(ctx context.Context, productID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCategoryID", ctx, productID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCategoryID indicates an expected call of GetProductCategoryID.
+func (mr *MockRepositoryMockRecorder) GetProductCategoryID(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategoryID", reflect.TypeOf((*MockRepository)(nil).GetProductCategoryID), ctx, productID)
+}
+
+// GetProductDimensions mocks base method.
+func (m *MockRepository) GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDimensions", ctx, productID)
+	ret0, _ := ret[0].(dbgen.GetProductDimensionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDimensions indicates an expected call of GetProductDimensions.
+func (mr *MockRepositoryMockRecorder) GetProductDimensions(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDimensions", reflect.TypeOf((*MockRepository)(nil).GetProductDimensions), ctx, productID)
+}
+
+// GetProductStock mocks base method.
+func (m *MockRepository) GetProductStock(ctx context.Context, id string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductStock", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductStock indicates an expected call of GetProductStock.
+func (mr *MockRepositoryMockRecorder) GetProductStock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductStock", reflect.TypeOf((*MockRepository)(nil).GetProductStock), ctx, id)
+}
+
+// GetPromotionByCodeForUpdate mocks base method.
+func (m *MockRepository) GetPromotionByCodeForUpdate(ctx context.Context, code string) (dbgen.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByCodeForUpdate", ctx, code)
+	ret0, _ := ret[0].(dbgen.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByCodeForUpdate indicates an expected call of GetPromotionByCodeForUpdate.
+func (mr *MockRepositoryMockRecorder) GetPromotionByCodeForUpdate(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByCodeForUpdate", reflect.TypeOf((*MockRepository)(nil).GetPromotionByCodeForUpdate), ctx, code)
+}
+
+// GetPromotionByID mocks base method.
+func (m *MockRepository) GetPromotionByID(ctx context.Context, id string) (dbgen.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByID", ctx, id)
+	ret0, _ := ret[0].(dbgen.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByID indicates an expected call of GetPromotionByID.
+func (mr *MockRepositoryMockRecorder) GetPromotionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByID", reflect.TypeOf((*MockRepository)(nil).GetPromotionByID), ctx, id)
+}
+
+// GetShippingMethod mocks base method.
+func (m *MockRepository) GetShippingMethod(ctx context.Context, id string) (dbgen.ShippingMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShippingMethod", ctx, id)
+	ret0, _ := ret[0].(dbgen.ShippingMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShippingMethod indicates an expected call of GetShippingMethod.
+func (mr *MockRepositoryMockRecorder) GetShippingMethod(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShippingMethod", reflect.TypeOf((*MockRepository)(nil).GetShippingMethod), ctx, id)
+}
+
+// GetVariantStock mocks base method.
+func (m *MockRepository) GetVariantStock(ctx context.Context, id string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantStock", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantStock indicates an expected call of GetVariantStock.
+func (mr *MockRepositoryMockRecorder) GetVariantStock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantStock", reflect.TypeOf((*MockRepository)(nil).GetVariantStock), ctx, id)
+}
+
+// IncrementProductStock mocks base method.
+func (m *MockRepository) IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementProductStock", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementProductStock indicates an expected call of IncrementProductStock.
+func (mr *MockRepositoryMockRecorder) IncrementProductStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductStock", reflect.TypeOf((*MockRepository)(nil).IncrementProductStock), ctx, params)
+}
+
+// IncrementPromotionUsage mocks base method.
+func (m *MockRepository) IncrementPromotionUsage(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementPromotionUsage", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementPromotionUsage indicates an expected call of IncrementPromotionUsage.
+func (mr *MockRepositoryMockRecorder) IncrementPromotionUsage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementPromotionUsage", reflect.TypeOf((*MockRepository)(nil).IncrementPromotionUsage), ctx, id)
+}
+
+// IncrementVariantStock mocks base method.
+func (m *MockRepository) IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementVariantStock", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementVariantStock indicates an expected call of IncrementVariantStock.
+func (mr *MockRepositoryMockRecorder) IncrementVariantStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementVariantStock", reflect.TypeOf((*MockRepository)(nil).IncrementVariantStock), ctx, params)
+}
+
+// IsVariantActive mocks base method.
+func (m *MockRepository) IsVariantActive(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVariantActive", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVariantActive indicates an expected call of IsVariantActive.
+func (mr *MockRepositoryMockRecorder) IsVariantActive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVariantActive", reflect.TypeOf((*MockRepository)(nil).IsVariantActive), ctx, id)
+}
+
+// ListActiveTaxRules mocks base method.
+func (m *MockRepository) ListActiveTaxRules(ctx context.Context) ([]dbgen.TaxRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveTaxRules", ctx)
+	ret0, _ := ret[0].([]dbgen.TaxRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveTaxRules indicates an expected call of ListActiveTaxRules.
+func (mr *MockRepositoryMockRecorder) ListActiveTaxRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveTaxRules", reflect.TypeOf((*MockRepository)(nil).ListActiveTaxRules), ctx)
+}
+
+// ListRevisions mocks base method.
+func (m *MockRepository) ListRevisions(ctx context.Context, orderID string) ([]dbgen.OrderRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, orderID)
+	ret0, _ := ret[0].([]dbgen.OrderRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockRepositoryMockRecorder) ListRevisions(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockRepository)(nil).ListRevisions), ctx, orderID)
+}
+
+// UpdateOrderItem mocks base method.
+func (m *MockRepository) UpdateOrderItem(ctx context.Context, params dbgen.UpdateOrderItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderItem", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderItem indicates an expected call of UpdateOrderItem.
+func (mr *MockRepositoryMockRecorder) UpdateOrderItem(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderItem", reflect.TypeOf((*MockRepository)(nil).UpdateOrderItem), ctx, params)
+}
+
+// UpdateOrderTotals mocks base method.
+func (m *MockRepository) UpdateOrderTotals(ctx context.Context, params dbgen.UpdateOrderTotalsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderTotals", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderTotals indicates an expected call of UpdateOrderTotals.
+func (mr *MockRepositoryMockRecorder) UpdateOrderTotals(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderTotals", reflect.TypeOf((*MockRepository)(nil).UpdateOrderTotals), ctx, params)
+}
+
+// UpdateRedemptionDiscount mocks base method.
+func (m *MockRepository) UpdateRedemptionDiscount(ctx context.Context, params dbgen.UpdatePromotionRedemptionDiscountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedemptionDiscount", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRedemptionDiscount indicates an expected call of UpdateRedemptionDiscount.
+func (mr *MockRepositoryMockRecorder) UpdateRedemptionDiscount(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedemptionDiscount", reflect.TypeOf((*MockRepository)(nil).UpdateRedemptionDiscount), ctx, params)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockRepository) UpdateStatus(ctx context.Context, params dbgen.UpdateOrderStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockRepositoryMockRecorder) UpdateStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockRepository)(nil).UpdateStatus), ctx, params)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) order.Repository {
 	m.ctrl.T.Helper()
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// resolveShippingAddress menentukan alamat tujuan order dari shipping_address_id (harus milik
+// customer pemesan) atau shipping_address inline. nil berarti order tanpa alamat.
+func resolveShippingAddress(ctx context.Context, repo Repository, req CreateOrderRequest) (*ShippingAddressResponse, error) {
+	switch {
+	case req.ShippingAddressID != nil && req.ShippingAddress != nil:
+		return nil, fmt.Errorf("%w: use either shipping_address_id or shipping_address, not both", address.ErrInvalidAddress)
+
+	case req.ShippingAddressID != nil:
+		row, err := repo.GetCustomerAddress(ctx, dbgen.GetCustomerAddressParams{
+			ID:         *req.ShippingAddressID,
+			CustomerID: req.CustomerID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("%w: %s", address.ErrAddressNotFound, *req.ShippingAddressID)
+			}
+			return nil, err
+		}
+		return &ShippingAddressResponse{AddressID: row.ID, Address: address.FromModel(row)}, nil
+
+	case req.ShippingAddress != nil:
+		addr, err := address.Normalize(*req.ShippingAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &ShippingAddressResponse{Address: addr}, nil
+	}
+
+	return nil, nil
+}
+
+// saveShippingAddress menyimpan snapshot alamat tujuan order
+func saveShippingAddress(ctx context.Context, repo Repository, orderID string, addr *ShippingAddressResponse) error {
+	if addr == nil {
+		return nil
+	}
+
+	params := dbgen.CreateOrderShippingAddressParams{
+		OrderID:       orderID,
+		RecipientName: addr.RecipientName,
+		Phone:         addr.Phone,
+		Street:        addr.Street,
+		City:          addr.City,
+		Province:      addr.Province,
+		PostalCode:    addr.PostalCode,
+	}
+	if addr.AddressID != "" {
+		params.AddressID = sql.NullString{String: addr.AddressID, Valid: true}
+	}
+	return repo.CreateShippingAddress(ctx, params)
+}
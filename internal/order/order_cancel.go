@@ -0,0 +1,79 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// Cancel membatalkan order yang masih pending & belum dibayar. Stok seluruh item
+// dikembalikan, payment intent yang masih pending dibatalkan, dan event OrderCancelled
+// ditulis ke outbox di transaksi yang sama.
+// Kuota kupon yang sudah ditebus tidak dikembalikan.
+func (s *service) Cancel(ctx context.Context, id string) (OrderResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	current, err := txRepo.GetOrderForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, ErrOrderNotFound
+		}
+		return OrderResponse{}, err
+	}
+
+	items, err := txRepo.GetItemsByOrderID(ctx, id)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	// Syarat pembatalan sama dengan syarat edit
+	if !isEditable(current, items) {
+		return OrderResponse{}, ErrOrderNotCancellable
+	}
+
+	for _, it := range items {
+		if !restockable(it) {
+			continue
+		}
+		item := OrderItemRequest{ProductID: it.ProductID.String, VariantID: helper.NullStringToPtr(it.VariantID)}
+		if err := incrementStock(ctx, txRepo, item, int(it.Quantity)); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	if err := txRepo.UpdateStatus(ctx, dbgen.UpdateOrderStatusParams{
+		Status: OrderStatusCancelled,
+		ID:     id,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	// Intent yang masih pending tidak boleh lagi melunasi order ini
+	if _, err := txRepo.CancelPendingPaymentIntents(ctx, dbgen.CancelPendingPaymentIntentsParams{
+		Reason:  sql.NullString{String: "order cancelled", Valid: true},
+		OrderID: id,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateOrder, id, outbox.EventOrderCancelled, outbox.OrderCancelled{
+		OrderID:    id,
+		CustomerID: current.CustomerID,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return OrderResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
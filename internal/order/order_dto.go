@@ -1,39 +1,175 @@
 package order
 
-import "time"
+import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"time"
+)
 
+// OrderItemRequest: harga item selalu diambil dari harga efektif varian/produk saat ini.
+// unit_price opsional sebagai harga yang dilihat client; bila dikirim dan berbeda, order ditolak.
 type OrderItemRequest struct {
 	ProductID string  `json:"product_id" binding:"required"`
+	VariantID *string `json:"variant_id"` // Opsional: SKU varian (ukuran/warna) yang dipesan
 	Quantity  int     `json:"quantity" binding:"required,gt=0"`
-	UnitPrice float64 `json:"unit_price" binding:"required,gt=0"`
+	UnitPrice float64 `json:"unit_price" binding:"gte=0"`
 }
 
 type CreateOrderRequest struct {
 	CustomerID string             `json:"customer_id" binding:"required"`
 	Items      []OrderItemRequest `json:"items" binding:"required,gt=0,dive"` //gt=0 slice validation
+	CouponCode *string            `json:"coupon_code" binding:"omitempty,max=64"`
+
+	// Tujuan pengiriman (opsional): alamat tersimpan milik customer atau alamat inline, bukan keduanya.
+	// Alamat disalin ke order sehingga perubahan alamat customer setelahnya tidak mengubah order.
+	ShippingAddressID *string          `json:"shipping_address_id"`
+	ShippingAddress   *address.Address `json:"shipping_address"`
+
+	// Metode pengiriman (opsional); ongkir dihitung dari berat item dan masuk ke grand total
+	ShippingMethodID *string `json:"shipping_method_id"`
+}
+
+// UpdateOrderItemRequest mengubah item yang ada (order_item_id, quantity 0 = hapus)
+// atau menambah item baru (product_id & quantity wajib; unit_price opsional seperti OrderItemRequest)
+type UpdateOrderItemRequest struct {
+	OrderItemID *string `json:"order_item_id"`
+	ProductID   string  `json:"product_id"`
+	VariantID   *string `json:"variant_id"`
+	Quantity    int     `json:"quantity" binding:"gte=0"`
+	UnitPrice   float64 `json:"unit_price" binding:"gte=0"`
+}
+
+type UpdateOrderRequest struct {
+	Items []UpdateOrderItemRequest `json:"items" binding:"required,gt=0,dive"`
+	Note  *string                  `json:"note" binding:"omitempty,max=255"`
+}
+
+type ShipOrderRequest struct {
+	Carrier        string `json:"carrier" binding:"required,max=50"`
+	TrackingNumber string `json:"tracking_number" binding:"required,max=100"`
+}
+
+// RevisionChange adalah perubahan quantity satu baris; old_quantity 0 = item baru, new_quantity 0 = item dihapus
+type RevisionChange struct {
+	OrderItemID string `json:"order_item_id"`
+	ProductID   string `json:"product_id"`
+	VariantID   string `json:"variant_id,omitempty"`
+	OldQuantity int    `json:"old_quantity"`
+	NewQuantity int    `json:"new_quantity"`
 }
 
+type OrderRevisionResponse struct {
+	ID            string           `json:"id"`
+	OrderID       string           `json:"order_id"`
+	Revision      int32            `json:"revision"`
+	Changes       []RevisionChange `json:"changes"`
+	PreviousTotal float64          `json:"previous_total"`
+	NewTotal      float64          `json:"new_total"`
+	Note          *string          `json:"note,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// Sort order list yang didukung; default created_desc
+const (
+	SortCreatedDesc = "created_desc"
+	SortCreatedAsc  = "created_asc"
+	SortTotalDesc   = "total_desc"
+	SortTotalAsc    = "total_asc"
+)
+
+// ListParams: filter nil berarti tidak difilter. CreatedFrom inklusif, CreatedTo eksklusif.
 type ListParams struct {
-	Page     int
-	PageSize int
+	Page          int        `form:"page"`
+	PageSize      int        `form:"page_size"`
+	CustomerID    *string    `form:"customer_id"`
+	ProductID     *string    `form:"product_id"`
+	Status        *string    `form:"status"`
+	PaymentStatus *string    `form:"payment_status"`
+	CreatedFrom   *time.Time `form:"created_from"`
+	CreatedTo     *time.Time `form:"created_to"`
+	MinTotal      *float64   `form:"min_total"`
+	MaxTotal      *float64   `form:"max_total"`
+	Sort          *string    `form:"sort"`
+}
+
+// CurrentProductResponse adalah data produk live saat ini, untuk dibandingkan dengan snapshot item
+type CurrentProductResponse struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	SKU          string  `json:"sku,omitempty"`
+	Price        float64 `json:"price"`
+	CategoryName string  `json:"category_name"`
+	IsActive     bool    `json:"is_active"`
 }
 
+// OrderItemResponse: product_name, sku, category_name dan unit_price adalah snapshot saat order dibuat;
+// product_id kosong bila produknya sudah dihapus
 type OrderItemResponse struct {
 	ID           string  `json:"id"`
 	ProductID    string  `json:"product_id"`
 	ProductName  string  `json:"product_name,omitempty"`
+	SKU          string  `json:"sku,omitempty"`
+	VariantID    string  `json:"variant_id,omitempty"`
 	Quantity     int     `json:"quantity"`
 	UnitPrice    float64 `json:"unit_price"`
 	CategoryName string  `json:"category_name,omitempty"`
+
+	// Diskon yang dialokasikan ke baris ini dan promosi asalnya
+	DiscountAmount float64 `json:"discount_amount"`
+	PromotionID    string  `json:"promotion_id,omitempty"`
+
+	// Pajak baris (dihitung dari nilai setelah diskon) dan jumlah yang dibayar untuk baris ini
+	TaxRate      float64 `json:"tax_rate"`
+	TaxAmount    float64 `json:"tax_amount"`
+	TaxInclusive bool    `json:"tax_inclusive"`
+	LineTotal    float64 `json:"line_total"`
+
+	// Jumlah unit yang sudah diretur dari baris ini
+	ReturnedQuantity int `json:"returned_quantity"`
+
+	// Data produk live; kosong bila produk sudah tidak ada
+	CurrentProduct *CurrentProductResponse `json:"current_product,omitempty"`
+}
+
+// ShippingAddressResponse adalah snapshot alamat tujuan; address_id kosong untuk alamat inline
+type ShippingAddressResponse struct {
+	AddressID string `json:"address_id,omitempty"`
+	address.Address
+}
+
+// ShippingMethodResponse adalah metode pengiriman order; id kosong jika metodenya sudah dihapus
+type ShippingMethodResponse struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
 }
 
 type OrderResponse struct {
 	ID            string              `json:"id"`
 	CustomerID    string              `json:"customer_id"`
+	Status        string              `json:"status"`
 	CustomerName  string              `json:"customer_name,omitempty"`
 	CustomerEmail string              `json:"customer_email,omitempty"`
 	TotalQuantity int32               `json:"total_quantity"`
-	TotalPrice    float64             `json:"total_price"`
+	Subtotal      float64             `json:"subtotal"`
+	DiscountTotal float64             `json:"discount_total"`
+	TaxTotal      float64             `json:"tax_total"` // Termasuk pajak inklusif yang sudah ada di harga
+	ShippingTotal float64             `json:"shipping_total"`
+	GrandTotal    float64             `json:"grand_total"`  // subtotal - discount_total + pajak eksklusif + shipping_total
+	TotalPrice    float64             `json:"total_price"`  // Sama dengan grand_total, dipertahankan untuk klien lama
+	RefundTotal   float64             `json:"refund_total"` // Akumulasi refund dari retur; net revenue = total_price - refund_total
+	CouponCode    string              `json:"coupon_code,omitempty"`
+	PaymentStatus string              `json:"payment_status"` // unpaid atau paid, diubah oleh webhook payment
+	PaidAt        *time.Time          `json:"paid_at,omitempty"`
 	CreatedAt     time.Time           `json:"created_at"`
-	Items         []OrderItemResponse `json:"items,omitempty"`
+	Items         []OrderItemResponse `json:"items"`
+
+	ShippingMethod  *ShippingMethodResponse  `json:"shipping_method,omitempty"`
+	ShippingAddress *ShippingAddressResponse `json:"shipping_address,omitempty"`
+
+	// Hanya diisi pada respons edit bila kupon dilepas karena syaratnya tidak lagi terpenuhi
+	CouponRemoved *RemovedCouponResponse `json:"coupon_removed,omitempty"`
+}
+
+type RemovedCouponResponse struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
 }
@@ -0,0 +1,474 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"assignment-ptes-achmad-rifai/internal/tax"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// editLine adalah satu baris order setelah edit diterapkan.
+// existing nil berarti item baru; item.Quantity 0 berarti item dihapus.
+type editLine struct {
+	existing *dbgen.OrderItem
+	snapshot itemSnapshot // hanya untuk item baru
+	item     OrderItemRequest
+	oldQty   int
+}
+
+// Update mengubah item order selama order masih bisa diedit. Stok disesuaikan
+// sebesar selisih quantity, total dihitung ulang, dan perubahan dicatat sebagai revisi.
+// Jika total berubah, payment intent yang masih pending dibatalkan.
+func (s *service) Update(ctx context.Context, id string, req UpdateOrderRequest) (OrderResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	current, err := txRepo.GetOrderForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, ErrOrderNotFound
+		}
+		return OrderResponse{}, err
+	}
+
+	existing, err := txRepo.GetItemsByOrderID(ctx, id)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	if !isEditable(current, existing) {
+		return OrderResponse{}, ErrOrderNotEditable
+	}
+	// Item tanpa produk tidak bisa dihargai ulang (kategori, pajak, promosi)
+	for _, it := range existing {
+		if !it.ProductID.Valid {
+			return OrderResponse{}, fmt.Errorf("%w: item %s refers to a deleted product, cancel the order instead", ErrInvalidOrderEdit, it.ID)
+		}
+	}
+
+	plan, err := planEdit(existing, req.Items)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	categories := newCategoryLookup(txRepo)
+
+	// Item baru mendapat snapshot & harga produk saat ini; item lama tetap memakai snapshot saat order dibuat
+	for i := range plan {
+		if plan[i].existing == nil {
+			if plan[i].snapshot, err = loadSnapshot(ctx, txRepo, categories, &plan[i].item); err != nil {
+				return OrderResponse{}, err
+			}
+		}
+	}
+
+	var kept []OrderItemRequest
+	var changes []RevisionChange
+	totalQty := 0
+	for _, l := range plan {
+		if l.item.Quantity > 0 {
+			kept = append(kept, l.item)
+			totalQty += l.item.Quantity
+		}
+		if l.item.Quantity != l.oldQty {
+			changes = append(changes, RevisionChange{
+				OrderItemID: l.orderItemID(),
+				ProductID:   l.item.ProductID,
+				VariantID:   helper.StringPtrValue(l.item.VariantID),
+				OldQuantity: l.oldQty,
+				NewQuantity: l.item.Quantity,
+			})
+		}
+	}
+	if len(kept) == 0 {
+		return OrderResponse{}, fmt.Errorf("%w: order must keep at least one item, delete the order instead", ErrInvalidOrderEdit)
+	}
+	if len(changes) == 0 {
+		return OrderResponse{}, fmt.Errorf("%w: no changes", ErrInvalidOrderEdit)
+	}
+
+	for _, l := range plan {
+		if err := adjustStock(ctx, txRepo, l); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	rules, err := txRepo.ListActiveTaxRules(ctx)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	coupon, removed, err := reapplyCoupon(ctx, txRepo, categories, current, kept)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	lines, totals, err := priceItems(ctx, categories, tax.NewResolver(rules), coupon, kept)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	// Ongkir dihitung ulang bila order punya metode pengiriman, jika tidak dipertahankan
+	shippingCost := current.ShippingTotal
+	method, err := reloadShippingMethod(ctx, txRepo, current)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	if method != nil {
+		if shippingCost, err = quoteShipping(ctx, txRepo, method, kept, totals.subtotal); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+	totals = totals.withShipping(shippingCost)
+
+	i := 0
+	for _, l := range plan {
+		if l.item.Quantity == 0 {
+			if l.existing != nil {
+				if err := txRepo.DeleteOrderItem(ctx, dbgen.DeleteOrderItemParams{ID: l.existing.ID, OrderID: id}); err != nil {
+					return OrderResponse{}, err
+				}
+			}
+			continue
+		}
+
+		if err := saveEditLine(ctx, txRepo, id, l, lines[i]); err != nil {
+			return OrderResponse{}, err
+		}
+		i++
+	}
+
+	if err := txRepo.UpdateOrderTotals(ctx, dbgen.UpdateOrderTotalsParams{
+		TotalQuantity: int32(totalQty),
+		Subtotal:      totals.subtotal,
+		DiscountTotal: totals.discount,
+		TaxTotal:      totals.tax,
+		ShippingTotal: totals.shipping,
+		TotalPrice:    totals.grand,
+		ID:            id,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	// Intent pending masih bernilai total lama; klien harus membuat intent baru
+	if !totals.grand.Equal(current.TotalPrice) {
+		if _, err := txRepo.CancelPendingPaymentIntents(ctx, dbgen.CancelPendingPaymentIntentsParams{
+			Reason:  sql.NullString{String: "order total changed by an edit", Valid: true},
+			OrderID: id,
+		}); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	if coupon != nil {
+		if err := txRepo.UpdateRedemptionDiscount(ctx, dbgen.UpdatePromotionRedemptionDiscountParams{
+			DiscountAmount: coupon.result.Discount,
+			OrderID:        id,
+		}); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+	if removed != nil {
+		if err := dropCoupon(ctx, txRepo, current); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	if err := recordRevision(ctx, txRepo, current, changes, totals, req.Note); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return OrderResponse{}, err
+	}
+
+	res, err := s.GetByID(ctx, id)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	res.CouponRemoved = removed
+	return res, nil
+}
+
+func (s *service) ListRevisions(ctx context.Context, id string) ([]OrderRevisionResponse, error) {
+	rows, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order tanpa revisi tetap valid; pastikan order-nya memang ada
+	if len(rows) == 0 {
+		if _, err := s.repo.GetByID(ctx, id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrOrderNotFound
+			}
+			return nil, err
+		}
+	}
+
+	res := make([]OrderRevisionResponse, 0, len(rows))
+	for _, r := range rows {
+		var changes []RevisionChange
+		if err := json.Unmarshal(r.Changes, &changes); err != nil {
+			return nil, err
+		}
+
+		res = append(res, OrderRevisionResponse{
+			ID:            r.ID,
+			OrderID:       r.OrderID,
+			Revision:      r.Revision,
+			Changes:       changes,
+			PreviousTotal: helper.DecimalToFloat64(r.PreviousTotal),
+			NewTotal:      helper.DecimalToFloat64(r.NewTotal),
+			Note:          helper.NullStringToPtr(r.Note),
+			CreatedAt:     r.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// isEditable: order masih pending, belum dibayar, dan belum ada item yang diretur
+func isEditable(o dbgen.Order, items []dbgen.OrderItem) bool {
+	if o.Status != OrderStatusPending || o.PaymentStatus != PaymentStatusUnpaid || !o.RefundTotal.IsZero() {
+		return false
+	}
+	for _, it := range items {
+		if it.ReturnedQuantity > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// planEdit menerapkan perubahan request ke item yang ada; item yang tidak disebut tetap
+func planEdit(existing []dbgen.OrderItem, changes []UpdateOrderItemRequest) ([]editLine, error) {
+	plan := make([]editLine, 0, len(existing)+len(changes))
+	index := make(map[string]int, len(existing))
+	for i := range existing {
+		it := &existing[i]
+		index[it.ID] = len(plan)
+		plan = append(plan, editLine{
+			existing: it,
+			item: OrderItemRequest{
+				ProductID: it.ProductID.String,
+				VariantID: helper.NullStringToPtr(it.VariantID),
+				Quantity:  int(it.Quantity),
+				UnitPrice: helper.DecimalToFloat64(it.UnitPrice),
+			},
+			oldQty: int(it.Quantity),
+		})
+	}
+
+	seen := make(map[string]bool, len(changes))
+	for _, c := range changes {
+		if c.OrderItemID != nil {
+			i, ok := index[*c.OrderItemID]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrOrderItemNotFound, *c.OrderItemID)
+			}
+			if seen[*c.OrderItemID] {
+				return nil, fmt.Errorf("%w: item %s listed more than once", ErrInvalidOrderEdit, *c.OrderItemID)
+			}
+			seen[*c.OrderItemID] = true
+			plan[i].item.Quantity = c.Quantity
+			continue
+		}
+
+		if c.ProductID == "" || c.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: new items need product_id and quantity", ErrInvalidOrderEdit)
+		}
+		plan = append(plan, editLine{
+			item: OrderItemRequest{
+				ProductID: c.ProductID,
+				VariantID: c.VariantID,
+				Quantity:  c.Quantity,
+				UnitPrice: c.UnitPrice,
+			},
+		})
+	}
+
+	return plan, nil
+}
+
+func (l editLine) orderItemID() string {
+	if l.existing == nil {
+		return ""
+	}
+	return l.existing.ID
+}
+
+// adjustStock mengurangi stok untuk tambahan quantity dan mengembalikan stok untuk pengurangan
+func adjustStock(ctx context.Context, repo Repository, l editLine) error {
+	delta := l.item.Quantity - l.oldQty
+	switch {
+	case delta > 0:
+		if l.existing != nil && variantDeleted(*l.existing) {
+			return fmt.Errorf("%w: %s", ErrVariantNotFound, l.existing.VariantSku.String)
+		}
+		item := l.item
+		item.Quantity = delta
+		return decrementStock(ctx, repo, item)
+	case delta < 0:
+		if l.existing != nil && !restockable(*l.existing) {
+			return nil
+		}
+		return incrementStock(ctx, repo, l.item, -delta)
+	}
+	return nil
+}
+
+// variantDeleted: item dipesan dengan varian (variant_sku terisi) tetapi variannya
+// sudah dihapus sehingga variant_id di-SET NULL
+func variantDeleted(it dbgen.OrderItem) bool {
+	return it.VariantSku.Valid && !it.VariantID.Valid
+}
+
+// restockable: stok hanya dikembalikan bila produk dan varian yang dipesan masih ada;
+// tanpa pengecekan ini stok varian yang terhapus akan masuk ke stok produk
+func restockable(it dbgen.OrderItem) bool {
+	if !it.ProductID.Valid || variantDeleted(it) {
+		log.Printf("skip restock for order item %s: product or variant no longer exists", it.ID)
+		return false
+	}
+	return true
+}
+
+func incrementStock(ctx context.Context, repo Repository, item OrderItemRequest, qty int) error {
+	if item.VariantID != nil {
+		return repo.IncrementVariantStock(ctx, dbgen.IncrementProductVariantStockParams{
+			StockQuantity: int32(qty),
+			ID:            *item.VariantID,
+			ProductID:     item.ProductID,
+		})
+	}
+
+	return repo.IncrementProductStock(ctx, dbgen.IncrementProductStockParams{
+		StockQuantity: int32(qty),
+		ID:            item.ProductID,
+	})
+}
+
+// reapplyCoupon menghitung ulang diskon kupon yang sudah ditebus saat order dibuat.
+// Kuota tidak dihitung ulang dan periode berlaku dievaluasi pada waktu order dibuat;
+// yang dicek ulang hanya aturan nilai (minimal subtotal, kategori). Bila aturan itu tidak
+// lagi terpenuhi, kupon dilepas (diskon 0) dan alasannya dikembalikan, bukan error.
+func reapplyCoupon(
+	ctx context.Context,
+	repo Repository,
+	categories *categoryLookup,
+	o dbgen.Order,
+	items []OrderItemRequest,
+) (*appliedCoupon, *RemovedCouponResponse, error) {
+	if !o.PromotionID.Valid {
+		return nil, nil, nil
+	}
+
+	promo, err := repo.GetPromotionByID(ctx, o.PromotionID.String)
+	if err != nil {
+		return nil, nil, err
+	}
+	promo.IsActive = true
+
+	lines, err := promotionLines(ctx, categories, promo, items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := promotion.Calculate(promo, lines, o.CreatedAt)
+	if err != nil {
+		if errors.Is(err, promotion.ErrInvalidCoupon) {
+			return nil, &RemovedCouponResponse{Code: o.CouponCode.String, Reason: err.Error()}, nil
+		}
+		return nil, nil, err
+	}
+
+	return &appliedCoupon{promotion: promo, result: result}, nil, nil
+}
+
+// saveEditLine memperbarui baris yang sudah ada atau membuat baris baru dengan harga terbaru
+func saveEditLine(ctx context.Context, repo Repository, orderID string, l editLine, priced pricedLine) error {
+	if l.existing != nil {
+		return repo.UpdateOrderItem(ctx, dbgen.UpdateOrderItemParams{
+			Quantity:       int32(l.item.Quantity),
+			DiscountAmount: priced.discount,
+			PromotionID:    priced.promotionID,
+			TaxRate:        priced.tax.Rate,
+			TaxAmount:      priced.tax.Tax,
+			TaxInclusive:   priced.tax.Inclusive,
+			LineTotal:      priced.tax.Total,
+			ID:             l.existing.ID,
+			OrderID:        orderID,
+		})
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return repo.CreateOrderItem(ctx, dbgen.CreateOrderItemParams{
+		ID:             newUUID.String(),
+		OrderID:        orderID,
+		ProductID:      sql.NullString{String: l.item.ProductID, Valid: true},
+		VariantID:      helper.StringToNull(l.item.VariantID),
+		VariantSku:     l.snapshot.variantSku,
+		ProductName:    l.snapshot.productName,
+		Sku:            l.snapshot.sku,
+		CategoryName:   l.snapshot.categoryName,
+		Quantity:       int32(l.item.Quantity),
+		UnitPrice:      l.snapshot.unitPrice,
+		DiscountAmount: priced.discount,
+		PromotionID:    priced.promotionID,
+		TaxRate:        priced.tax.Rate,
+		TaxAmount:      priced.tax.Tax,
+		TaxInclusive:   priced.tax.Inclusive,
+		LineTotal:      priced.tax.Total,
+	})
+}
+
+func recordRevision(
+	ctx context.Context,
+	repo Repository,
+	o dbgen.Order,
+	changes []RevisionChange,
+	totals orderTotals,
+	note *string,
+) error {
+	next, err := repo.GetNextRevision(ctx, o.ID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return repo.CreateRevision(ctx, dbgen.CreateOrderRevisionParams{
+		ID:            newUUID.String(),
+		OrderID:       o.ID,
+		Revision:      int32(next),
+		Changes:       payload,
+		PreviousTotal: o.TotalPrice,
+		NewTotal:      totals.grand,
+		Note:          helper.StringToNull(note),
+	})
+}
@@ -0,0 +1,20 @@
+package order
+
+import "errors"
+
+var (
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrVariantRequired     = errors.New("variant_id is required for products with variants")
+	ErrVariantNotFound     = errors.New("variant not found for this product")
+	ErrVariantInactive     = errors.New("variant is inactive")
+	ErrPriceMismatch       = errors.New("unit_price does not match the current price")
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderItemNotFound   = errors.New("order item not found in this order")
+	ErrOrderNotEditable    = errors.New("order can no longer be edited")
+	ErrOrderNotCancellable = errors.New("order can no longer be cancelled")
+	ErrOrderNotShippable   = errors.New("order must be pending and paid to be shipped")
+	ErrOrderNotDeletable   = errors.New("only cancelled orders can be deleted, cancel the order first")
+	ErrInvalidOrderEdit    = errors.New("invalid order edit")
+	ErrCustomerNotFound    = errors.New("customer not found")
+)
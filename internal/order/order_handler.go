@@ -1,10 +1,16 @@
 package order
 
 import (
+	"assignment-ptes-achmad-rifai/internal/address"
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
 	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,14 +25,15 @@ func NewHandler(service Service) *Handler {
 
 // Create godoc
 // @Summary      Create a new order
-// @Description  Place a new order with multiple items. Calculates total price and quantity automatically.
+// @Description  Place a new order with multiple items. Item prices always come from the current product or variant price; an optional unit_price is treated as the expected price and rejected if it differs. Calculates subtotal, coupon discount (optional coupon_code), shipping (optional shipping_method_id) and total automatically. The destination is either a saved address (shipping_address_id) or an inline shipping_address, copied onto the order.
 // @Tags         orders
 // @Accept       json
 // @Produce      json
 // @Param        request body      CreateOrderRequest  true  "Order Request Body"
 // @Success      201      {object}  OrderResponse
-// @Failure      400      {object}  map[string]string "Invalid input or empty items"
-// @Failure      404      {object}  map[string]string "Customer or Product not found"
+// @Failure      400      {object}  map[string]string "Invalid input, empty items, missing variant_id, invalid coupon, invalid address or shipping method not available"
+// @Failure      404      {object}  map[string]string "Customer, Product, Variant, Address or Shipping method not found"
+// @Failure      409      {object}  map[string]string "Insufficient stock, inactive variant, coupon usage limit reached or unit_price differs from the current price"
 // @Router       /orders [post]
 func (h *Handler) Create(c *gin.Context) {
 	var req CreateOrderRequest
@@ -37,7 +44,29 @@ func (h *Handler) Create(c *gin.Context) {
 
 	res, err := h.service.Create(c.Request.Context(), req)
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "CREATE_ERROR", "Failed to create order", err.Error())
+		switch {
+		case errors.Is(err, ErrInsufficientStock):
+			response.Error(c, http.StatusConflict, "INSUFFICIENT_STOCK", err.Error(), nil)
+		case errors.Is(err, ErrVariantInactive):
+			response.Error(c, http.StatusConflict, "VARIANT_INACTIVE", err.Error(), nil)
+		case errors.Is(err, promotion.ErrCouponLimitReached):
+			response.Error(c, http.StatusConflict, "COUPON_LIMIT_REACHED", err.Error(), nil)
+		case errors.Is(err, promotion.ErrInvalidCoupon):
+			response.Error(c, http.StatusBadRequest, "INVALID_COUPON", err.Error(), nil)
+		case errors.Is(err, address.ErrInvalidAddress):
+			response.Error(c, http.StatusBadRequest, "INVALID_ADDRESS", err.Error(), nil)
+		case errors.Is(err, shipping.ErrMethodUnavailable):
+			response.Error(c, http.StatusBadRequest, "SHIPPING_UNAVAILABLE", err.Error(), nil)
+		case errors.Is(err, ErrVariantRequired):
+			response.Error(c, http.StatusBadRequest, "VARIANT_REQUIRED", err.Error(), nil)
+		case errors.Is(err, ErrPriceMismatch):
+			response.Error(c, http.StatusConflict, "PRICE_MISMATCH", err.Error(), nil)
+		case errors.Is(err, ErrProductNotFound), errors.Is(err, ErrVariantNotFound),
+			errors.Is(err, address.ErrAddressNotFound), errors.Is(err, shipping.ErrMethodNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "CREATE_ERROR", "Failed to create order", err.Error())
+		}
 		return
 	}
 	response.Success(c, http.StatusCreated, res, nil)
@@ -45,26 +74,131 @@ func (h *Handler) Create(c *gin.Context) {
 
 // GetAll godoc
 // @Summary      List all orders
-// @Description  Retrieve a paginated list of orders with basic customer info
+// @Description  Retrieve a paginated list of orders with basic customer info. Dates accept YYYY-MM-DD or RFC3339; a date-only created_to includes that whole day.
 // @Tags         orders
 // @Produce      json
-// @Param        page       query    int  false  "Page number"
-// @Param        page_size  query    int  false  "Items per page"
+// @Param        page            query    int     false  "Page number"
+// @Param        page_size       query    int     false  "Items per page"
+// @Param        customer_id     query    string  false  "Filter by customer"
+// @Param        product_id      query    string  false  "Only orders containing this product"
+// @Param        status          query    string  false  "Filter by order status"
+// @Param        payment_status  query    string  false  "Filter by payment status (unpaid, paid)"
+// @Param        created_from    query    string  false  "Created at or after"
+// @Param        created_to      query    string  false  "Created before"
+// @Param        min_total       query    number  false  "Minimum total price"
+// @Param        max_total       query    number  false  "Maximum total price"
+// @Param        sort            query    string  false  "created_desc (default), created_asc, total_desc, total_asc"
 // @Success      200      {array}   OrderResponse
+// @Failure      400      {object}  map[string]string "Invalid filter"
 // @Failure      500      {object}  map[string]string
 // @Router       /orders [get]
 func (h *Handler) GetAll(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	params := ListParams{
-		Page:     page,
-		PageSize: pageSize,
+	params, err := parseListParams(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid query parameters", err.Error())
+		return
+	}
+
+	res, total, err := h.service.List(c.Request.Context(), params)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch orders", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, paginationMeta(total, params))
+}
+
+// GetByCustomer godoc
+// @Summary      List a customer's orders
+// @Description  Order history of a single customer, using the same filters, sort and pagination as the order list
+// @Tags         customers
+// @Produce      json
+// @Param        id              path     string  true   "Customer ID"
+// @Param        page            query    int     false  "Page number"
+// @Param        page_size       query    int     false  "Items per page"
+// @Param        product_id      query    string  false  "Only orders containing this product"
+// @Param        status          query    string  false  "Filter by order status"
+// @Param        payment_status  query    string  false  "Filter by payment status (unpaid, paid)"
+// @Param        created_from    query    string  false  "Created at or after"
+// @Param        created_to      query    string  false  "Created before"
+// @Param        min_total       query    number  false  "Minimum total price"
+// @Param        max_total       query    number  false  "Maximum total price"
+// @Param        sort            query    string  false  "created_desc (default), created_asc, total_desc, total_asc"
+// @Success      200      {array}   OrderResponse
+// @Failure      400      {object}  map[string]string "Invalid filter"
+// @Failure      404      {object}  map[string]string "Customer not found"
+// @Router       /customers/{id}/orders [get]
+func (h *Handler) GetByCustomer(c *gin.Context) {
+	params, err := parseListParams(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid query parameters", err.Error())
+		return
+	}
+
+	res, total, err := h.service.ListByCustomer(c.Request.Context(), c.Param("id"), params)
+	if err != nil {
+		if errors.Is(err, ErrCustomerNotFound) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch customer orders", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, paginationMeta(total, params))
+}
+
+// GetMine godoc
+// @Summary      List my orders
+// @Description  Order history of the logged-in customer, using the same filters, sort and pagination as the order list
+// @Tags         me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page            query    int     false  "Page number"
+// @Param        page_size       query    int     false  "Items per page"
+// @Param        product_id      query    string  false  "Only orders containing this product"
+// @Param        status          query    string  false  "Filter by order status"
+// @Param        payment_status  query    string  false  "Filter by payment status (unpaid, paid)"
+// @Param        created_from    query    string  false  "Created at or after"
+// @Param        created_to      query    string  false  "Created before"
+// @Param        min_total       query    number  false  "Minimum total price"
+// @Param        max_total       query    number  false  "Maximum total price"
+// @Param        sort            query    string  false  "created_desc (default), created_asc, total_desc, total_asc"
+// @Success      200      {array}   OrderResponse
+// @Failure      400      {object}  map[string]string "Invalid filter"
+// @Failure      401      {object}  map[string]string "Missing or invalid token"
+// @Router       /me/orders [get]
+func (h *Handler) GetMine(c *gin.Context) {
+	params, err := parseListParams(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid query parameters", err.Error())
+		return
 	}
-	res, err := h.service.List(c.Request.Context(), params)
+
+	res, total, err := h.service.ListByCustomer(c.Request.Context(), auth.CustomerID(c), params)
 	if err != nil {
 		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch orders", err.Error())
 		return
 	}
+	response.Success(c, http.StatusOK, res, paginationMeta(total, params))
+}
+
+// GetMineByID godoc
+// @Summary      Get my order
+// @Description  Order details, only when the order belongs to the logged-in customer
+// @Tags         me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string  true  "Order ID"
+// @Success      200      {object}  OrderResponse
+// @Failure      401      {object}  map[string]string "Missing or invalid token"
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Router       /me/orders/{id} [get]
+func (h *Handler) GetMineByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	// Order milik customer lain dilaporkan sebagai tidak ditemukan agar ID-nya tidak bocor
+	if err != nil || res.CustomerID != auth.CustomerID(c) {
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", "Order not found", nil)
+		return
+	}
 	response.Success(c, http.StatusOK, res, nil)
 }
 
@@ -90,18 +224,258 @@ func (h *Handler) GetByID(c *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete an order
-// @Description  Remove an order record and its associated items
+// @Description  Remove a cancelled order record and its associated items. Cancel the order first so its stock is restored.
 // @Tags         orders
 // @Produce      json
 // @Param        id       path      string  true  "Order ID"
 // @Success      204      {object}  nil
 // @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Order is not cancelled"
 // @Router       /orders/{id} [delete]
 func (h *Handler) Delete(c *gin.Context) {
 	id := c.Param("id")
 	if err := h.service.Delete(c.Request.Context(), id); err != nil {
-		response.Error(c, http.StatusInternalServerError, "DELETE_ERROR", "Failed to delete order", err.Error())
+		switch {
+		case errors.Is(err, ErrOrderNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrOrderNotDeletable):
+			response.Error(c, http.StatusConflict, "ORDER_NOT_DELETABLE", err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "DELETE_ERROR", "Failed to delete order", err.Error())
+		}
 		return
 	}
 	response.Success(c, http.StatusOK, "Order deleted successfully", nil)
 }
+
+// Update godoc
+// @Summary      Edit order items
+// @Description  Add, remove (quantity 0) or change item quantities while the order is still pending and unpaid. Stock is adjusted by the difference, totals (including shipping for orders with a shipping method) are recomputed and a revision is recorded. A coupon whose minimum subtotal or category rule no longer holds is removed and reported in coupon_removed. If the total changes, pending payment intents are cancelled and a new intent must be created.
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string              true  "Order ID"
+// @Param        request  body      UpdateOrderRequest  true  "Item changes"
+// @Success      200      {object}  OrderResponse
+// @Failure      400      {object}  map[string]string "Invalid input, invalid edit, missing variant_id or shipping method no longer available"
+// @Failure      404      {object}  map[string]string "Order, order item, product or variant not found"
+// @Failure      409      {object}  map[string]string "Order not editable, insufficient stock, inactive variant or unit_price differs from the current price"
+// @Router       /orders/{id} [patch]
+func (h *Handler) Update(c *gin.Context) {
+	var req UpdateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOrderNotFound), errors.Is(err, ErrOrderItemNotFound),
+			errors.Is(err, ErrProductNotFound), errors.Is(err, ErrVariantNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrPriceMismatch):
+			response.Error(c, http.StatusConflict, "PRICE_MISMATCH", err.Error(), nil)
+		case errors.Is(err, ErrOrderNotEditable):
+			response.Error(c, http.StatusConflict, "ORDER_NOT_EDITABLE", err.Error(), nil)
+		case errors.Is(err, ErrInsufficientStock):
+			response.Error(c, http.StatusConflict, "INSUFFICIENT_STOCK", err.Error(), nil)
+		case errors.Is(err, ErrVariantInactive):
+			response.Error(c, http.StatusConflict, "VARIANT_INACTIVE", err.Error(), nil)
+		case errors.Is(err, ErrInvalidOrderEdit):
+			response.Error(c, http.StatusBadRequest, "INVALID_EDIT", err.Error(), nil)
+		case errors.Is(err, promotion.ErrInvalidCoupon):
+			response.Error(c, http.StatusBadRequest, "INVALID_COUPON", err.Error(), nil)
+		case errors.Is(err, shipping.ErrMethodUnavailable):
+			response.Error(c, http.StatusBadRequest, "SHIPPING_UNAVAILABLE", err.Error(), nil)
+		case errors.Is(err, ErrVariantRequired):
+			response.Error(c, http.StatusBadRequest, "VARIANT_REQUIRED", err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "UPDATE_ERROR", "Failed to update order", err.Error())
+		}
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Cancel godoc
+// @Summary      Cancel order
+// @Description  Cancel an order that is still pending and unpaid. Stock of every item is restored and pending payment intents are cancelled.
+// @Tags         orders
+// @Produce      json
+// @Param        id       path      string  true  "Order ID"
+// @Success      200      {object}  OrderResponse
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Failure      409      {object}  map[string]string "Order can no longer be cancelled"
+// @Router       /orders/{id}/cancel [post]
+func (h *Handler) Cancel(c *gin.Context) {
+	res, err := h.service.Cancel(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOrderNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrOrderNotCancellable):
+			response.Error(c, http.StatusConflict, "ORDER_NOT_CANCELLABLE", err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "CANCEL_ERROR", "Failed to cancel order", err.Error())
+		}
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Ship godoc
+// @Summary      Ship order
+// @Description  Mark a paid, pending order as shipped with its carrier and tracking number
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string            true  "Order ID"
+// @Param        request  body      ShipOrderRequest  true  "Shipment details"
+// @Success      200      {object}  OrderResponse
+// @Failure      400      {object}  map[string]string "Invalid input"
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Failure      409      {object}  map[string]string "Order is not paid or no longer pending"
+// @Router       /orders/{id}/ship [post]
+func (h *Handler) Ship(c *gin.Context) {
+	var req ShipOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Ship(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOrderNotFound):
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrOrderNotShippable):
+			response.Error(c, http.StatusConflict, "ORDER_NOT_SHIPPABLE", err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "SHIP_ERROR", "Failed to ship order", err.Error())
+		}
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ListRevisions godoc
+// @Summary      List order revisions
+// @Description  Retrieve the edit history of an order, oldest first
+// @Tags         orders
+// @Produce      json
+// @Param        id       path      string  true  "Order ID"
+// @Success      200      {array}   OrderRevisionResponse
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Router       /orders/{id}/revisions [get]
+func (h *Handler) ListRevisions(c *gin.Context) {
+	res, err := h.service.ListRevisions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, ErrOrderNotFound) {
+			response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch order revisions", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func parseListParams(c *gin.Context) (ListParams, error) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	params := ListParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Tangkap filter dari query params; kosong = tidak difilter
+	if customerID := c.Query("customer_id"); customerID != "" {
+		params.CustomerID = &customerID
+	}
+	if productID := c.Query("product_id"); productID != "" {
+		params.ProductID = &productID
+	}
+	if status := c.Query("status"); status != "" {
+		params.Status = &status
+	}
+	if paymentStatus := c.Query("payment_status"); paymentStatus != "" {
+		params.PaymentStatus = &paymentStatus
+	}
+
+	switch sort := c.DefaultQuery("sort", SortCreatedDesc); sort {
+	case SortCreatedDesc, SortCreatedAsc, SortTotalDesc, SortTotalAsc:
+		params.Sort = &sort
+	default:
+		return ListParams{}, fmt.Errorf("unknown sort %q", sort)
+	}
+
+	var err error
+	if params.MinTotal, err = parseAmount(c, "min_total"); err != nil {
+		return ListParams{}, err
+	}
+	if params.MaxTotal, err = parseAmount(c, "max_total"); err != nil {
+		return ListParams{}, err
+	}
+
+	if v := c.Query("created_from"); v != "" {
+		t, _, err := parseDate(v)
+		if err != nil {
+			return ListParams{}, fmt.Errorf("created_from: %w", err)
+		}
+		params.CreatedFrom = &t
+	}
+	if v := c.Query("created_to"); v != "" {
+		t, dateOnly, err := parseDate(v)
+		if err != nil {
+			return ListParams{}, fmt.Errorf("created_to: %w", err)
+		}
+		// created_to=2024-01-31 berarti sampai akhir hari tersebut
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		params.CreatedTo = &t
+	}
+
+	return params, nil
+}
+
+func parseAmount(c *gin.Context, key string) (*float64, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return nil, fmt.Errorf("%s must be a non-negative number", key)
+	}
+	return &f, nil
+}
+
+// parseDate menerima YYYY-MM-DD atau RFC3339
+func parseDate(v string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, v); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("expected YYYY-MM-DD or RFC3339, got %q", v)
+	}
+	return t, false, nil
+}
+
+func paginationMeta(total int64, p ListParams) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalPages: int((total + int64(p.PageSize) - 1) / int64(p.PageSize)),
+	}
+}
@@ -5,11 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"assignment-ptes-achmad-rifai/internal/address"
 	"assignment-ptes-achmad-rifai/internal/order"
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+	"assignment-ptes-achmad-rifai/internal/promotion"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -19,23 +25,45 @@ import (
 
 type fakeOrderService struct {
 	CreateFn  func(ctx context.Context, req order.CreateOrderRequest) (order.OrderResponse, error)
-	ListFn    func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, error)
+	ListFn    func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, int64, error)
 	GetByIDFn func(ctx context.Context, id string) (order.OrderResponse, error)
 	DeleteFn  func(ctx context.Context, id string) error
+
+	ListByCustomerFn func(ctx context.Context, customerID string, p order.ListParams) ([]order.OrderResponse, int64, error)
+
+	UpdateFn        func(ctx context.Context, id string, req order.UpdateOrderRequest) (order.OrderResponse, error)
+	ListRevisionsFn func(ctx context.Context, id string) ([]order.OrderRevisionResponse, error)
+	CancelFn        func(ctx context.Context, id string) (order.OrderResponse, error)
+	ShipFn          func(ctx context.Context, id string, req order.ShipOrderRequest) (order.OrderResponse, error)
 }
 
 func (f *fakeOrderService) Create(ctx context.Context, req order.CreateOrderRequest) (order.OrderResponse, error) {
 	return f.CreateFn(ctx, req)
 }
-func (f *fakeOrderService) List(ctx context.Context, p order.ListParams) ([]order.OrderResponse, error) {
+func (f *fakeOrderService) List(ctx context.Context, p order.ListParams) ([]order.OrderResponse, int64, error) {
 	return f.ListFn(ctx, p)
 }
+func (f *fakeOrderService) ListByCustomer(ctx context.Context, customerID string, p order.ListParams) ([]order.OrderResponse, int64, error) {
+	return f.ListByCustomerFn(ctx, customerID, p)
+}
 func (f *fakeOrderService) GetByID(ctx context.Context, id string) (order.OrderResponse, error) {
 	return f.GetByIDFn(ctx, id)
 }
 func (f *fakeOrderService) Delete(ctx context.Context, id string) error {
 	return f.DeleteFn(ctx, id)
 }
+func (f *fakeOrderService) Update(ctx context.Context, id string, req order.UpdateOrderRequest) (order.OrderResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+func (f *fakeOrderService) ListRevisions(ctx context.Context, id string) ([]order.OrderRevisionResponse, error) {
+	return f.ListRevisionsFn(ctx, id)
+}
+func (f *fakeOrderService) Cancel(ctx context.Context, id string) (order.OrderResponse, error) {
+	return f.CancelFn(ctx, id)
+}
+func (f *fakeOrderService) Ship(ctx context.Context, id string, req order.ShipOrderRequest) (order.OrderResponse, error) {
+	return f.ShipFn(ctx, id, req)
+}
 
 // ========== HELPERS ==========
 
@@ -100,12 +128,107 @@ func TestHandler_Create(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+
+	t.Run("insufficient stock", func(t *testing.T) {
+		svc := &fakeOrderService{
+			CreateFn: func(ctx context.Context, req order.CreateOrderRequest) (order.OrderResponse, error) {
+				return order.OrderResponse{}, order.ErrInsufficientStock
+			},
+		}
+
+		r := setupTestRouter()
+		handler := order.NewHandler(svc)
+		r.POST("/orders", handler.Create)
+
+		reqBody := order.CreateOrderRequest{
+			CustomerID: "cust-1",
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 100, UnitPrice: 100}},
+		}
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("coupon errors", func(t *testing.T) {
+		cases := []struct {
+			name string
+			err  error
+			code int
+		}{
+			{"invalid coupon", fmt.Errorf("%w: coupon has expired", promotion.ErrInvalidCoupon), http.StatusBadRequest},
+			{"limit reached", promotion.ErrCouponLimitReached, http.StatusConflict},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				svc := &fakeOrderService{
+					CreateFn: func(ctx context.Context, req order.CreateOrderRequest) (order.OrderResponse, error) {
+						assert.Equal(t, "HEMAT10", *req.CouponCode)
+						return order.OrderResponse{}, tc.err
+					},
+				}
+
+				r := setupTestRouter()
+				r.POST("/orders", order.NewHandler(svc).Create)
+
+				body := `{"customer_id":"cust-1","coupon_code":"HEMAT10","items":[{"product_id":"p1","quantity":1,"unit_price":100}]}`
+				req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				assert.Equal(t, tc.code, w.Code)
+			})
+		}
+	})
+
+	t.Run("shipping address", func(t *testing.T) {
+		cases := []struct {
+			name string
+			body string
+			err  error
+			code int
+		}{
+			{"saved address", `"shipping_address_id":"addr-1"`, nil, http.StatusCreated},
+			{"inline address missing fields", `"shipping_address":{"recipient_name":"Siti"}`, nil, http.StatusBadRequest},
+			{"invalid province", `"shipping_address_id":"addr-1"`, fmt.Errorf("%w: unknown province", address.ErrInvalidAddress), http.StatusBadRequest},
+			{"address not found", `"shipping_address_id":"addr-x"`, address.ErrAddressNotFound, http.StatusNotFound},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				svc := &fakeOrderService{
+					CreateFn: func(ctx context.Context, req order.CreateOrderRequest) (order.OrderResponse, error) {
+						return order.OrderResponse{ID: "order-1"}, tc.err
+					},
+				}
+
+				r := setupTestRouter()
+				r.POST("/orders", order.NewHandler(svc).Create)
+
+				body := `{"customer_id":"cust-1","items":[{"product_id":"p1","quantity":1,"unit_price":100}],` + tc.body + `}`
+				req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				assert.Equal(t, tc.code, w.Code)
+			})
+		}
+	})
 }
 
 func TestHandler_GetAll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeOrderService{
-			ListFn: func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, error) {
+			ListFn: func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, int64, error) {
 				return []order.OrderResponse{
 					{
 						ID:            "order-1",
@@ -119,7 +242,7 @@ func TestHandler_GetAll(t *testing.T) {
 						TotalQuantity: 1,
 						TotalPrice:    50000,
 					},
-				}, nil
+				}, 2, nil
 			},
 		}
 
@@ -140,10 +263,62 @@ func TestHandler_GetAll(t *testing.T) {
 		assert.Len(t, data, 2)
 	})
 
+	t.Run("parses filters and sort", func(t *testing.T) {
+		svc := &fakeOrderService{
+			ListFn: func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, int64, error) {
+				assert.Equal(t, 2, p.Page)
+				assert.Equal(t, "cust-1", *p.CustomerID)
+				assert.Equal(t, "prod-1", *p.ProductID)
+				assert.Equal(t, "paid", *p.PaymentStatus)
+				assert.Equal(t, float64(50000), *p.MinTotal)
+				assert.Nil(t, p.MaxTotal)
+				assert.Equal(t, order.SortTotalDesc, *p.Sort)
+				assert.Equal(t, "2024-01-01T00:00:00Z", p.CreatedFrom.Format(time.RFC3339))
+				// created_to tanpa jam mencakup seluruh hari tersebut
+				assert.Equal(t, "2024-02-01T00:00:00Z", p.CreatedTo.Format(time.RFC3339))
+				return []order.OrderResponse{}, 25, nil
+			},
+		}
+
+		r := setupTestRouter()
+		order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/orders?page=2&customer_id=cust-1&product_id=prod-1&payment_status=paid&min_total=50000"+
+				"&created_from=2024-01-01&created_to=2024-01-31&sort=total_desc", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var body struct {
+			Meta struct {
+				Total      int64 `json:"total"`
+				TotalPages int   `json:"totalPages"`
+			} `json:"meta"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, int64(25), body.Meta.Total)
+		assert.Equal(t, 3, body.Meta.TotalPages)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		for _, query := range []string{"sort=price", "created_from=yesterday", "min_total=abc"} {
+			r := setupTestRouter()
+			order.RegisterRoutes(r.Group(""), order.NewHandler(&fakeOrderService{}))
+
+			req := httptest.NewRequest(http.MethodGet, "/orders?"+query, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+
 	t.Run("service error", func(t *testing.T) {
 		svc := &fakeOrderService{
-			ListFn: func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, error) {
-				return nil, errors.New("database connection lost")
+			ListFn: func(ctx context.Context, p order.ListParams) ([]order.OrderResponse, int64, error) {
+				return nil, 0, errors.New("database connection lost")
 			},
 		}
 
@@ -159,6 +334,44 @@ func TestHandler_GetAll(t *testing.T) {
 	})
 }
 
+func TestHandler_GetByCustomer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeOrderService{
+			ListByCustomerFn: func(ctx context.Context, customerID string, p order.ListParams) ([]order.OrderResponse, int64, error) {
+				assert.Equal(t, "cust-1", customerID)
+				assert.Equal(t, "paid", *p.PaymentStatus)
+				return []order.OrderResponse{{ID: "order-1", CustomerID: customerID}}, 1, nil
+			},
+		}
+
+		r := setupTestRouter()
+		order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/customers/cust-1/orders?payment_status=paid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("customer not found", func(t *testing.T) {
+		svc := &fakeOrderService{
+			ListByCustomerFn: func(ctx context.Context, customerID string, p order.ListParams) ([]order.OrderResponse, int64, error) {
+				return nil, 0, order.ErrCustomerNotFound
+			},
+		}
+
+		r := setupTestRouter()
+		order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/customers/cust-404/orders", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestHandler_GetByID(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeOrderService{
@@ -196,3 +409,233 @@ func TestHandler_GetByID(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
 }
+
+func TestHandler_Update(t *testing.T) {
+	itemID := "item-1"
+	body := `{"items":[{"order_item_id":"item-1","quantity":2}]}`
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", body: body, wantStatus: http.StatusOK},
+		{name: "validation error - empty items", body: `{"items":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "order not found", body: body, err: order.ErrOrderNotFound, wantStatus: http.StatusNotFound},
+		{name: "not editable", body: body, err: order.ErrOrderNotEditable, wantStatus: http.StatusConflict},
+		{name: "insufficient stock", body: body, err: fmt.Errorf("%w: p1", order.ErrInsufficientStock), wantStatus: http.StatusConflict},
+		{name: "inactive variant", body: body, err: fmt.Errorf("%w: v1", order.ErrVariantInactive), wantStatus: http.StatusConflict},
+		{name: "invalid edit", body: body, err: order.ErrInvalidOrderEdit, wantStatus: http.StatusBadRequest},
+		{name: "price mismatch", body: body, err: fmt.Errorf("%w: p1 costs 100.00", order.ErrPriceMismatch), wantStatus: http.StatusConflict},
+		{name: "variant not found", body: body, err: fmt.Errorf("%w: v1", order.ErrVariantNotFound), wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeOrderService{
+				UpdateFn: func(ctx context.Context, id string, req order.UpdateOrderRequest) (order.OrderResponse, error) {
+					assert.Equal(t, "order-1", id)
+					assert.Equal(t, itemID, *req.Items[0].OrderItemID)
+					return order.OrderResponse{ID: id}, tt.err
+				},
+			}
+
+			r := setupTestRouter()
+			order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPatch, "/orders/order-1", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "order not found", err: order.ErrOrderNotFound, wantStatus: http.StatusNotFound},
+		{name: "not cancelled", err: order.ErrOrderNotDeletable, wantStatus: http.StatusConflict},
+		{name: "internal error", err: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeOrderService{
+				DeleteFn: func(ctx context.Context, id string) error {
+					assert.Equal(t, "order-1", id)
+					return tt.err
+				},
+			}
+
+			r := setupTestRouter()
+			order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodDelete, "/orders/order-1", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_Cancel(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "order not found", err: order.ErrOrderNotFound, wantStatus: http.StatusNotFound},
+		{name: "not cancellable", err: order.ErrOrderNotCancellable, wantStatus: http.StatusConflict},
+		{name: "internal error", err: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeOrderService{
+				CancelFn: func(ctx context.Context, id string) (order.OrderResponse, error) {
+					assert.Equal(t, "order-1", id)
+					return order.OrderResponse{ID: id, Status: order.OrderStatusCancelled}, tt.err
+				},
+			}
+
+			r := setupTestRouter()
+			order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/order-1/cancel", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_Ship(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", body: `{"carrier":"JNE","tracking_number":"JNE123"}`, wantStatus: http.StatusOK},
+		{name: "missing tracking number", body: `{"carrier":"JNE"}`, wantStatus: http.StatusBadRequest},
+		{name: "order not found", body: `{"carrier":"JNE","tracking_number":"JNE123"}`, err: order.ErrOrderNotFound, wantStatus: http.StatusNotFound},
+		{name: "not shippable", body: `{"carrier":"JNE","tracking_number":"JNE123"}`, err: order.ErrOrderNotShippable, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeOrderService{
+				ShipFn: func(ctx context.Context, id string, req order.ShipOrderRequest) (order.OrderResponse, error) {
+					assert.Equal(t, "order-1", id)
+					assert.Equal(t, "JNE123", req.TrackingNumber)
+					return order.OrderResponse{ID: id, Status: order.OrderStatusShipped}, tt.err
+				},
+			}
+
+			r := setupTestRouter()
+			order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/order-1/ship", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_MeRoutes(t *testing.T) {
+	svc := &fakeOrderService{
+		ListByCustomerFn: func(ctx context.Context, customerID string, p order.ListParams) ([]order.OrderResponse, int64, error) {
+			assert.Equal(t, "cust-1", customerID)
+			return []order.OrderResponse{{ID: "order-1", CustomerID: customerID}}, 1, nil
+		},
+		GetByIDFn: func(ctx context.Context, id string) (order.OrderResponse, error) {
+			owner := map[string]string{"order-1": "cust-1", "order-2": "cust-2"}[id]
+			return order.OrderResponse{ID: id, CustomerID: owner}, nil
+		},
+	}
+	requireCustomer := auth.RequireCustomer(func(ctx context.Context, token string) (string, error) {
+		if token == "token-1" {
+			return "cust-1", nil
+		}
+		return "", errors.New("invalid token")
+	})
+
+	r := setupTestRouter()
+	order.RegisterMeRoutes(r.Group(""), order.NewHandler(svc), requireCustomer)
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{name: "list own orders", path: "/me/orders", token: "token-1", wantStatus: http.StatusOK},
+		{name: "own order", path: "/me/orders/order-1", token: "token-1", wantStatus: http.StatusOK},
+		{name: "order of another customer", path: "/me/orders/order-2", token: "token-1", wantStatus: http.StatusNotFound},
+		{name: "missing token", path: "/me/orders", wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", path: "/me/orders/order-1", token: "other", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_ListRevisions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeOrderService{
+			ListRevisionsFn: func(ctx context.Context, id string) ([]order.OrderRevisionResponse, error) {
+				return []order.OrderRevisionResponse{{OrderID: id, Revision: 1}}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/orders/order-1/revisions", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("order not found", func(t *testing.T) {
+		svc := &fakeOrderService{
+			ListRevisionsFn: func(ctx context.Context, id string) ([]order.OrderRevisionResponse, error) {
+				return nil, order.ErrOrderNotFound
+			},
+		}
+
+		r := setupTestRouter()
+		order.RegisterRoutes(r.Group(""), order.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/orders/order-1/revisions", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
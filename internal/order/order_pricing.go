@@ -0,0 +1,109 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"assignment-ptes-achmad-rifai/internal/tax"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// categoryLookup menyimpan kategori produk yang sudah dicari selama satu order,
+// dipakai bersama oleh perhitungan kupon dan pajak
+type categoryLookup struct {
+	repo  Repository
+	cache map[string]string
+}
+
+func newCategoryLookup(repo Repository) *categoryLookup {
+	return &categoryLookup{repo: repo, cache: map[string]string{}}
+}
+
+func (l *categoryLookup) get(ctx context.Context, productID string) (string, error) {
+	if categoryID, ok := l.cache[productID]; ok {
+		return categoryID, nil
+	}
+
+	categoryID, err := l.repo.GetProductCategoryID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%w: %s", ErrProductNotFound, productID)
+		}
+		return "", err
+	}
+
+	l.cache[productID] = categoryID
+	return categoryID, nil
+}
+
+// pricedLine adalah rincian harga satu item order setelah diskon & pajak
+type pricedLine struct {
+	discount    decimal.Decimal
+	promotionID sql.NullString
+	tax         tax.Line
+}
+
+// orderTotals adalah rincian total order; grand = subtotal - discount + pajak eksklusif + shipping
+type orderTotals struct {
+	subtotal decimal.Decimal
+	discount decimal.Decimal
+	tax      decimal.Decimal
+	shipping decimal.Decimal
+	grand    decimal.Decimal
+}
+
+// withShipping menambahkan ongkir ke total order
+func (t orderTotals) withShipping(cost decimal.Decimal) orderTotals {
+	t.shipping = cost
+	t.grand = t.grand.Add(cost)
+	return t
+}
+
+// priceItems menghitung diskon & pajak per baris lalu menjumlahkannya menjadi total order
+// tanpa ongkir. Pajak dihitung dari nilai baris setelah diskon.
+func priceItems(
+	ctx context.Context,
+	categories *categoryLookup,
+	taxes tax.Resolver,
+	coupon *appliedCoupon,
+	items []OrderItemRequest,
+) ([]pricedLine, orderTotals, error) {
+	lines := make([]pricedLine, 0, len(items))
+	totals := orderTotals{
+		subtotal: decimal.Zero,
+		discount: decimal.Zero,
+		tax:      decimal.Zero,
+		shipping: decimal.Zero,
+		grand:    decimal.Zero,
+	}
+
+	for i, item := range items {
+		gross := helper.Float64ToDecimal(item.UnitPrice).Mul(decimal.NewFromInt(int64(item.Quantity)))
+		discount, promotionID := coupon.lineDiscount(i)
+
+		categoryID := ""
+		if taxes.NeedsCategory() {
+			var err error
+			categoryID, err = categories.get(ctx, item.ProductID)
+			if err != nil {
+				return nil, orderTotals{}, err
+			}
+		}
+		taxLine := tax.Compute(gross.Sub(discount), taxes.RuleFor(categoryID))
+
+		totals.subtotal = totals.subtotal.Add(gross)
+		totals.discount = totals.discount.Add(discount)
+		totals.tax = totals.tax.Add(taxLine.Tax)
+		totals.grand = totals.grand.Add(taxLine.Total)
+
+		lines = append(lines, pricedLine{
+			discount:    discount,
+			promotionID: promotionID,
+			tax:         taxLine,
+		})
+	}
+
+	return lines, totals, nil
+}
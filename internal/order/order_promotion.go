@@ -0,0 +1,141 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// appliedCoupon adalah hasil validasi kupon untuk satu order
+type appliedCoupon struct {
+	promotion dbgen.Promotion
+	result    promotion.Result
+}
+
+// applyCoupon mengunci baris promosi (FOR UPDATE) sehingga pengecekan kuota
+// global & per customer konsisten dengan pencatatan redemption di transaksi yang sama.
+func applyCoupon(
+	ctx context.Context,
+	repo Repository,
+	categories *categoryLookup,
+	customerID string,
+	code string,
+	items []OrderItemRequest,
+	now time.Time,
+) (*appliedCoupon, error) {
+	promo, err := repo.GetPromotionByCodeForUpdate(ctx, promotion.NormalizeCode(code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: coupon code not found", promotion.ErrInvalidCoupon)
+		}
+		return nil, err
+	}
+
+	if promo.UsageLimit.Valid && promo.UsedCount >= promo.UsageLimit.Int32 {
+		return nil, promotion.ErrCouponLimitReached
+	}
+	if promo.UsageLimitPerCustomer.Valid {
+		used, err := repo.CountCustomerRedemptions(ctx, dbgen.CountCustomerPromotionRedemptionsParams{
+			PromotionID: promo.ID,
+			CustomerID:  customerID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if used >= int64(promo.UsageLimitPerCustomer.Int32) {
+			return nil, fmt.Errorf("%w for this customer", promotion.ErrCouponLimitReached)
+		}
+	}
+
+	lines, err := promotionLines(ctx, categories, promo, items)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := promotion.Calculate(promo, lines, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appliedCoupon{promotion: promo, result: result}, nil
+}
+
+// promotionLines hanya mengambil kategori produk jika promosi dibatasi per kategori
+func promotionLines(
+	ctx context.Context,
+	categories *categoryLookup,
+	promo dbgen.Promotion,
+	items []OrderItemRequest,
+) ([]promotion.Line, error) {
+	lines := make([]promotion.Line, 0, len(items))
+
+	for _, item := range items {
+		line := promotion.Line{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: helper.Float64ToDecimal(item.UnitPrice),
+		}
+
+		if promo.CategoryID.Valid {
+			categoryID, err := categories.get(ctx, item.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			line.CategoryID = categoryID
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// redeemCoupon menaikkan kuota global dan mencatat redemption setelah order tersimpan
+func redeemCoupon(ctx context.Context, repo Repository, coupon *appliedCoupon, customerID, orderID string) error {
+	affected, err := repo.IncrementPromotionUsage(ctx, coupon.promotion.ID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return promotion.ErrCouponLimitReached
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return repo.CreatePromotionRedemption(ctx, dbgen.CreatePromotionRedemptionParams{
+		ID:             newUUID.String(),
+		PromotionID:    coupon.promotion.ID,
+		CustomerID:     customerID,
+		OrderID:        orderID,
+		DiscountAmount: coupon.result.Discount,
+	})
+}
+
+// dropCoupon melepas kupon dari order: redemption dihapus, kuota dikembalikan, dan kode kupon dikosongkan
+func dropCoupon(ctx context.Context, repo Repository, o dbgen.Order) error {
+	if err := repo.DeleteRedemption(ctx, o.ID); err != nil {
+		return err
+	}
+	if err := repo.DecrementPromotionUsage(ctx, o.PromotionID.String); err != nil {
+		return err
+	}
+	return repo.ClearCoupon(ctx, o.ID)
+}
+
+// lineDiscount mengembalikan diskon baris ke-i beserta promosi asalnya (kosong jika tidak ada)
+func (c *appliedCoupon) lineDiscount(i int) (decimal.Decimal, sql.NullString) {
+	if c == nil || !c.result.LineDiscounts[i].IsPositive() {
+		return decimal.Zero, sql.NullString{}
+	}
+	return c.result.LineDiscounts[i], sql.NullString{String: c.promotion.ID, Valid: true}
+}
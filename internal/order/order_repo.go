@@ -14,9 +14,59 @@ type Repository interface {
 	CreateOrder(ctx context.Context, params dbgen.CreateOrderParams) error
 	CreateOrderItem(ctx context.Context, params dbgen.CreateOrderItemParams) error
 	GetOrders(ctx context.Context, params dbgen.GetOrdersParams) ([]dbgen.GetOrdersRow, error)
+	CountOrders(ctx context.Context, params dbgen.CountOrdersParams) (int64, error)
+	CustomerExists(ctx context.Context, id string) (bool, error)
 	GetByID(ctx context.Context, id string) (dbgen.GetOrderByIDRow, error)
 	GetItemsByOrderID(ctx context.Context, orderID string) ([]dbgen.OrderItem, error)
 	Delete(ctx context.Context, id string) error
+
+	// Edit helpers, dipanggil di dalam transaksi edit order
+	GetOrderForUpdate(ctx context.Context, id string) (dbgen.Order, error)
+	UpdateOrderTotals(ctx context.Context, params dbgen.UpdateOrderTotalsParams) error
+	UpdateStatus(ctx context.Context, params dbgen.UpdateOrderStatusParams) error
+	UpdateOrderItem(ctx context.Context, params dbgen.UpdateOrderItemParams) error
+	DeleteOrderItem(ctx context.Context, params dbgen.DeleteOrderItemParams) error
+	GetNextRevision(ctx context.Context, orderID string) (int64, error)
+	CreateRevision(ctx context.Context, params dbgen.CreateOrderRevisionParams) error
+	ListRevisions(ctx context.Context, orderID string) ([]dbgen.OrderRevision, error)
+	CreateShipment(ctx context.Context, params dbgen.CreateOrderShipmentParams) error
+	CancelPendingPaymentIntents(ctx context.Context, params dbgen.CancelPendingPaymentIntentsParams) (int64, error)
+
+	// Stock helpers, mengembalikan jumlah baris yang ter-update
+	DecrementProductStock(ctx context.Context, params dbgen.DecrementProductStockParams) (int64, error)
+	DecrementVariantStock(ctx context.Context, params dbgen.DecrementProductVariantStockParams) (int64, error)
+	IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error
+	IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error
+	GetProductStock(ctx context.Context, id string) (int32, error)
+	GetVariantStock(ctx context.Context, id string) (int32, error)
+	IsVariantActive(ctx context.Context, id string) (bool, error)
+
+	// Promotion helpers, dipanggil di dalam transaksi order
+	GetPromotionByCodeForUpdate(ctx context.Context, code string) (dbgen.Promotion, error)
+	CountCustomerRedemptions(ctx context.Context, params dbgen.CountCustomerPromotionRedemptionsParams) (int64, error)
+	IncrementPromotionUsage(ctx context.Context, id string) (int64, error)
+	CreatePromotionRedemption(ctx context.Context, params dbgen.CreatePromotionRedemptionParams) error
+	GetPromotionByID(ctx context.Context, id string) (dbgen.Promotion, error)
+	UpdateRedemptionDiscount(ctx context.Context, params dbgen.UpdatePromotionRedemptionDiscountParams) error
+	DeleteRedemption(ctx context.Context, orderID string) error
+	DecrementPromotionUsage(ctx context.Context, id string) error
+	ClearCoupon(ctx context.Context, orderID string) error
+	GetProductCategoryID(ctx context.Context, productID string) (string, error)
+	GetItemSnapshot(ctx context.Context, params dbgen.GetOrderItemSnapshotParams) (dbgen.GetOrderItemSnapshotRow, error)
+
+	// Alamat pengiriman: alamat tersimpan customer & snapshot-nya di order
+	GetCustomerAddress(ctx context.Context, params dbgen.GetCustomerAddressParams) (dbgen.CustomerAddress, error)
+	CreateShippingAddress(ctx context.Context, params dbgen.CreateOrderShippingAddressParams) error
+
+	// Metode pengiriman & dimensi produk untuk menghitung ongkir
+	GetShippingMethod(ctx context.Context, id string) (dbgen.ShippingMethod, error)
+	GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error)
+
+	// Tax helpers
+	ListActiveTaxRules(ctx context.Context) ([]dbgen.TaxRule, error)
+
+	// Outbox, ditulis di dalam transaksi yang sama dengan perubahan order
+	CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error
 }
 
 type repository struct {
@@ -51,6 +101,14 @@ func (r *repository) GetOrders(ctx context.Context, params dbgen.GetOrdersParams
 	return r.q.GetOrders(ctx, params)
 }
 
+func (r *repository) CountOrders(ctx context.Context, params dbgen.CountOrdersParams) (int64, error) {
+	return r.q.CountOrders(ctx, params)
+}
+
+func (r *repository) CustomerExists(ctx context.Context, id string) (bool, error) {
+	return r.q.CustomerExists(ctx, id)
+}
+
 func (r *repository) GetByID(ctx context.Context, id string) (dbgen.GetOrderByIDRow, error) {
 	return r.q.GetOrderByID(ctx, id)
 }
@@ -62,3 +120,142 @@ func (r *repository) GetItemsByOrderID(ctx context.Context, orderID string) ([]d
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.q.DeleteOrder(ctx, id)
 }
+
+func (r *repository) GetOrderForUpdate(ctx context.Context, id string) (dbgen.Order, error) {
+	return r.q.GetOrderForUpdate(ctx, id)
+}
+
+func (r *repository) UpdateOrderTotals(ctx context.Context, params dbgen.UpdateOrderTotalsParams) error {
+	return r.q.UpdateOrderTotals(ctx, params)
+}
+
+func (r *repository) UpdateStatus(ctx context.Context, params dbgen.UpdateOrderStatusParams) error {
+	return r.q.UpdateOrderStatus(ctx, params)
+}
+
+func (r *repository) CancelPendingPaymentIntents(ctx context.Context, params dbgen.CancelPendingPaymentIntentsParams) (int64, error) {
+	return r.q.CancelPendingPaymentIntents(ctx, params)
+}
+
+func (r *repository) UpdateOrderItem(ctx context.Context, params dbgen.UpdateOrderItemParams) error {
+	return r.q.UpdateOrderItem(ctx, params)
+}
+
+func (r *repository) DeleteOrderItem(ctx context.Context, params dbgen.DeleteOrderItemParams) error {
+	return r.q.DeleteOrderItem(ctx, params)
+}
+
+func (r *repository) GetNextRevision(ctx context.Context, orderID string) (int64, error) {
+	return r.q.GetNextOrderRevision(ctx, orderID)
+}
+
+func (r *repository) CreateRevision(ctx context.Context, params dbgen.CreateOrderRevisionParams) error {
+	return r.q.CreateOrderRevision(ctx, params)
+}
+
+func (r *repository) ListRevisions(ctx context.Context, orderID string) ([]dbgen.OrderRevision, error) {
+	return r.q.ListOrderRevisions(ctx, orderID)
+}
+
+func (r *repository) CreateShipment(ctx context.Context, params dbgen.CreateOrderShipmentParams) error {
+	return r.q.CreateOrderShipment(ctx, params)
+}
+
+func (r *repository) DecrementProductStock(ctx context.Context, params dbgen.DecrementProductStockParams) (int64, error) {
+	return r.q.DecrementProductStock(ctx, params)
+}
+
+func (r *repository) DecrementVariantStock(ctx context.Context, params dbgen.DecrementProductVariantStockParams) (int64, error) {
+	return r.q.DecrementProductVariantStock(ctx, params)
+}
+
+func (r *repository) IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error {
+	return r.q.IncrementProductStock(ctx, params)
+}
+
+func (r *repository) IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error {
+	return r.q.IncrementProductVariantStock(ctx, params)
+}
+
+func (r *repository) GetProductStock(ctx context.Context, id string) (int32, error) {
+	return r.q.GetProductStock(ctx, id)
+}
+
+func (r *repository) GetVariantStock(ctx context.Context, id string) (int32, error) {
+	return r.q.GetProductVariantStock(ctx, id)
+}
+
+func (r *repository) IsVariantActive(ctx context.Context, id string) (bool, error) {
+	return r.q.GetProductVariantIsActive(ctx, id)
+}
+
+func (r *repository) GetPromotionByCodeForUpdate(ctx context.Context, code string) (dbgen.Promotion, error) {
+	return r.q.GetPromotionByCodeForUpdate(ctx, code)
+}
+
+func (r *repository) CountCustomerRedemptions(
+	ctx context.Context,
+	params dbgen.CountCustomerPromotionRedemptionsParams,
+) (int64, error) {
+	return r.q.CountCustomerPromotionRedemptions(ctx, params)
+}
+
+func (r *repository) IncrementPromotionUsage(ctx context.Context, id string) (int64, error) {
+	return r.q.IncrementPromotionUsage(ctx, id)
+}
+
+func (r *repository) CreatePromotionRedemption(ctx context.Context, params dbgen.CreatePromotionRedemptionParams) error {
+	return r.q.CreatePromotionRedemption(ctx, params)
+}
+
+func (r *repository) GetPromotionByID(ctx context.Context, id string) (dbgen.Promotion, error) {
+	return r.q.GetPromotionByID(ctx, id)
+}
+
+func (r *repository) UpdateRedemptionDiscount(ctx context.Context, params dbgen.UpdatePromotionRedemptionDiscountParams) error {
+	return r.q.UpdatePromotionRedemptionDiscount(ctx, params)
+}
+
+func (r *repository) DeleteRedemption(ctx context.Context, orderID string) error {
+	return r.q.DeletePromotionRedemptionByOrder(ctx, orderID)
+}
+
+func (r *repository) DecrementPromotionUsage(ctx context.Context, id string) error {
+	return r.q.DecrementPromotionUsage(ctx, id)
+}
+
+func (r *repository) ClearCoupon(ctx context.Context, orderID string) error {
+	return r.q.ClearOrderCoupon(ctx, orderID)
+}
+
+func (r *repository) GetProductCategoryID(ctx context.Context, productID string) (string, error) {
+	return r.q.GetProductCategoryID(ctx, productID)
+}
+
+func (r *repository) GetCustomerAddress(ctx context.Context, params dbgen.GetCustomerAddressParams) (dbgen.CustomerAddress, error) {
+	return r.q.GetCustomerAddress(ctx, params)
+}
+
+func (r *repository) CreateShippingAddress(ctx context.Context, params dbgen.CreateOrderShippingAddressParams) error {
+	return r.q.CreateOrderShippingAddress(ctx, params)
+}
+
+func (r *repository) GetShippingMethod(ctx context.Context, id string) (dbgen.ShippingMethod, error) {
+	return r.q.GetShippingMethodByID(ctx, id)
+}
+
+func (r *repository) GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error) {
+	return r.q.GetProductDimensions(ctx, productID)
+}
+
+func (r *repository) GetItemSnapshot(ctx context.Context, params dbgen.GetOrderItemSnapshotParams) (dbgen.GetOrderItemSnapshotRow, error) {
+	return r.q.GetOrderItemSnapshot(ctx, params)
+}
+
+func (r *repository) ListActiveTaxRules(ctx context.Context) ([]dbgen.TaxRule, error) {
+	return r.q.ListActiveTaxRules(ctx)
+}
+
+func (r *repository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	return r.q.CreateOutboxEvent(ctx, params)
+}
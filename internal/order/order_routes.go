@@ -8,6 +8,22 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 		orders.POST("", handler.Create)
 		orders.GET("", handler.GetAll)
 		orders.GET("/:id", handler.GetByID)
+		orders.PATCH("/:id", handler.Update)
+		orders.POST("/:id/cancel", handler.Cancel)
+		orders.POST("/:id/ship", handler.Ship)
+		orders.GET("/:id/revisions", handler.ListRevisions)
 		orders.DELETE("/:id", handler.Delete)
 	}
+
+	// Sub-resource customer: riwayat order customer, memakai filter listing order
+	r.GET("/customers/:id/orders", handler.GetByCustomer)
+}
+
+// RegisterMeRoutes: riwayat order customer yang sedang login; requireCustomer adalah auth.RequireCustomer
+func RegisterMeRoutes(r *gin.RouterGroup, handler *Handler, requireCustomer gin.HandlerFunc) {
+	me := r.Group("/me/orders", requireCustomer)
+	{
+		me.GET("", handler.GetMine)
+		me.GET("/:id", handler.GetMineByID)
+	}
 }
@@ -1,11 +1,14 @@
 package order
 
 import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"assignment-ptes-achmad-rifai/internal/tax"
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -17,11 +20,29 @@ import (
 
 type Service interface {
 	Create(ctx context.Context, req CreateOrderRequest) (OrderResponse, error)
-	List(ctx context.Context, params ListParams) ([]OrderResponse, error)
+	List(ctx context.Context, params ListParams) ([]OrderResponse, int64, error)
+	ListByCustomer(ctx context.Context, customerID string, params ListParams) ([]OrderResponse, int64, error)
 	GetByID(ctx context.Context, id string) (OrderResponse, error)
 	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, id string, req UpdateOrderRequest) (OrderResponse, error)
+	Cancel(ctx context.Context, id string) (OrderResponse, error)
+	Ship(ctx context.Context, id string, req ShipOrderRequest) (OrderResponse, error)
+	ListRevisions(ctx context.Context, id string) ([]OrderRevisionResponse, error)
 }
 
+// Status fulfilment order; default kolom status adalah pending
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusShipped   = "shipped"
+	OrderStatusCancelled = "cancelled"
+)
+
+// Status pembayaran order; default kolom payment_status adalah unpaid
+const (
+	PaymentStatusUnpaid = "unpaid"
+	PaymentStatusPaid   = "paid"
+)
+
 type service struct {
 	db   *sql.DB // Diperlukan untuk memulai transaksi
 	repo Repository
@@ -50,27 +71,85 @@ func (s *service) Create(ctx context.Context, req CreateOrderRequest) (OrderResp
 	orderID := newUUID.String()
 	now := time.Now()
 	var totalQty int
-	var totalPrice float64
 
 	for _, item := range req.Items {
 		totalQty += item.Quantity
-		totalPrice += float64(item.Quantity) * item.UnitPrice
 	}
 
+	rules, err := txRepo.ListActiveTaxRules(ctx)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	categories := newCategoryLookup(txRepo)
+
+	snapshots, err := loadSnapshots(ctx, txRepo, categories, req.Items)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	shippingAddress, err := resolveShippingAddress(ctx, txRepo, req)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	method, err := loadShippingMethod(ctx, txRepo, req.ShippingMethodID)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	// Kupon divalidasi & dikunci di dalam transaksi yang sama dengan order
+	var coupon *appliedCoupon
+	if code := helper.StringPtrValue(req.CouponCode); code != "" {
+		coupon, err = applyCoupon(ctx, txRepo, categories, req.CustomerID, code, req.Items, now.UTC())
+		if err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	lines, totals, err := priceItems(ctx, categories, tax.NewResolver(rules), coupon, req.Items)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	shippingCost, err := quoteShipping(ctx, txRepo, method, req.Items, totals.subtotal)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	totals = totals.withShipping(shippingCost)
+
 	orderParams := dbgen.CreateOrderParams{
 		ID:            orderID,
 		CustomerID:    req.CustomerID,
 		TotalQuantity: int32(totalQty),
-		TotalPrice:    helper.Float64ToDecimal(totalPrice),
+		Subtotal:      totals.subtotal,
+		DiscountTotal: totals.discount,
+		TaxTotal:      totals.tax,
+		ShippingTotal: totals.shipping,
+		TotalPrice:    totals.grand,
 		CreatedAt:     now,
 	}
+	if coupon != nil {
+		orderParams.PromotionID = sql.NullString{String: coupon.promotion.ID, Valid: true}
+		orderParams.CouponCode = sql.NullString{String: coupon.promotion.Code, Valid: true}
+	}
+	var shippingMethod *ShippingMethodResponse
+	if method != nil {
+		orderParams.ShippingMethodID = sql.NullString{String: method.ID, Valid: true}
+		orderParams.ShippingMethodName = sql.NullString{String: method.Name, Valid: true}
+		shippingMethod = &ShippingMethodResponse{ID: method.ID, Name: method.Name}
+	}
 
 	if err := txRepo.CreateOrder(ctx, orderParams); err != nil {
 		return OrderResponse{}, err
 	}
 
+	if err := saveShippingAddress(ctx, txRepo, orderID, shippingAddress); err != nil {
+		return OrderResponse{}, err
+	}
+
 	itemResponses := make([]OrderItemResponse, 0)
-	for _, item := range req.Items {
+	placedItems := make([]outbox.OrderPlacedItem, 0, len(req.Items))
+	for i, item := range req.Items {
 
 		newUUID, err := uuid.NewV7()
 		if err != nil {
@@ -78,24 +157,66 @@ func (s *service) Create(ctx context.Context, req CreateOrderRequest) (OrderResp
 		}
 
 		itemID := newUUID.String()
+		line := lines[i]
+		snap := snapshots[i]
 
 		itemParams := dbgen.CreateOrderItemParams{
-			ID:        itemID,
-			OrderID:   orderID,
-			ProductID: item.ProductID,
-			Quantity:  int32(item.Quantity),
-			UnitPrice: helper.Float64ToDecimal(item.UnitPrice),
+			ID:             itemID,
+			OrderID:        orderID,
+			ProductID:      sql.NullString{String: item.ProductID, Valid: true},
+			VariantID:      helper.StringToNull(item.VariantID),
+			VariantSku:     snap.variantSku,
+			ProductName:    snap.productName,
+			Sku:            snap.sku,
+			CategoryName:   snap.categoryName,
+			Quantity:       int32(item.Quantity),
+			UnitPrice:      snap.unitPrice,
+			DiscountAmount: line.discount,
+			PromotionID:    line.promotionID,
+			TaxRate:        line.tax.Rate,
+			TaxAmount:      line.tax.Tax,
+			TaxInclusive:   line.tax.Inclusive,
+			LineTotal:      line.tax.Total,
 		}
 
 		if err := txRepo.CreateOrderItem(ctx, itemParams); err != nil {
 			return OrderResponse{}, err
 		}
 
+		if err := decrementStock(ctx, txRepo, item); err != nil {
+			return OrderResponse{}, err
+		}
+
 		itemResponses = append(itemResponses, OrderItemResponse{
-			ID: itemID, ProductID: item.ProductID, Quantity: item.Quantity, UnitPrice: item.UnitPrice,
+			ID: itemID, ProductID: item.ProductID, VariantID: helper.StringPtrValue(item.VariantID), Quantity: item.Quantity, UnitPrice: item.UnitPrice,
+			ProductName: snap.productName, SKU: snap.sku.String, CategoryName: snap.categoryName,
+			DiscountAmount: helper.DecimalToFloat64(line.discount), PromotionID: line.promotionID.String,
+			TaxRate: helper.DecimalToFloat64(line.tax.Rate), TaxAmount: helper.DecimalToFloat64(line.tax.Tax),
+			TaxInclusive: line.tax.Inclusive, LineTotal: helper.DecimalToFloat64(line.tax.Total),
+		})
+		placedItems = append(placedItems, outbox.OrderPlacedItem{
+			ProductID: item.ProductID, VariantID: helper.StringPtrValue(item.VariantID), Quantity: item.Quantity,
+			UnitPrice: item.UnitPrice, LineTotal: helper.DecimalToFloat64(line.tax.Total),
 		})
 	}
 
+	if coupon != nil {
+		if err := redeemCoupon(ctx, txRepo, coupon, req.CustomerID, orderID); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateOrder, orderID, outbox.EventOrderPlaced, outbox.OrderPlaced{
+		OrderID:       orderID,
+		CustomerID:    req.CustomerID,
+		TotalQuantity: totalQty,
+		GrandTotal:    helper.DecimalToFloat64(totals.grand),
+		CouponCode:    orderParams.CouponCode.String,
+		Items:         placedItems,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return OrderResponse{}, err
 	}
@@ -103,47 +224,81 @@ func (s *service) Create(ctx context.Context, req CreateOrderRequest) (OrderResp
 	return OrderResponse{
 		ID:            orderID,
 		CustomerID:    req.CustomerID,
+		Status:        OrderStatusPending,
 		TotalQuantity: int32(totalQty),
-		TotalPrice:    totalPrice,
+		Subtotal:      helper.DecimalToFloat64(totals.subtotal),
+		DiscountTotal: helper.DecimalToFloat64(totals.discount),
+		TaxTotal:      helper.DecimalToFloat64(totals.tax),
+		ShippingTotal: helper.DecimalToFloat64(totals.shipping),
+		GrandTotal:    helper.DecimalToFloat64(totals.grand),
+		TotalPrice:    helper.DecimalToFloat64(totals.grand),
+		CouponCode:    orderParams.CouponCode.String,
+		PaymentStatus: PaymentStatusUnpaid,
 		CreatedAt:     now,
 		Items:         itemResponses,
+
+		ShippingMethod:  shippingMethod,
+		ShippingAddress: shippingAddress,
 	}, nil
 }
 
-func (s *service) List(ctx context.Context, p ListParams) ([]OrderResponse, error) {
-	limit := int32(p.PageSize)
-	offset := int32((p.Page - 1) * p.PageSize)
+func (s *service) List(ctx context.Context, p ListParams) ([]OrderResponse, int64, error) {
+	filter := toCountOrdersParams(p)
 	rows, err := s.repo.GetOrders(ctx, dbgen.GetOrdersParams{
-		Limit:  limit,
-		Offset: offset,
+		CustomerID:    filter.CustomerID,
+		Status:        filter.Status,
+		PaymentStatus: filter.PaymentStatus,
+		CreatedFrom:   filter.CreatedFrom,
+		CreatedTo:     filter.CreatedTo,
+		MinTotal:      filter.MinTotal,
+		MaxTotal:      filter.MaxTotal,
+		ProductID:     filter.ProductID,
+		OrderBy:       helper.StringPtrValue(p.Sort),
+		Limit:         int32(p.PageSize),
+		Offset:        int32((p.Page - 1) * p.PageSize),
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountOrders(ctx, filter)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	var resp []OrderResponse
+	resp := make([]OrderResponse, 0, len(rows))
 	for _, r := range rows {
-		var items []OrderItemResponse
-		if len(r.Items) > 0 {
-			if err := json.Unmarshal(r.Items, &items); err != nil {
-				log.Printf("error unmarshal items for order %s: %v", r.ID, err)
-			}
-		}
+		resp = append(resp, mapOrderRow(r))
+	}
+	return resp, total, nil
+}
 
-		totalPrice, _ := r.TotalPrice.Float64()
-
-		resp = append(resp, OrderResponse{
-			ID:            r.ID,
-			CustomerID:    r.CustomerID,
-			CustomerName:  r.CustomerName,
-			CustomerEmail: r.CustomerEmail,
-			TotalQuantity: r.TotalQuantity,
-			TotalPrice:    totalPrice,
-			CreatedAt:     r.CreatedAt,
-			Items:         items,
-		})
+// ListByCustomer: riwayat order satu customer dengan filter yang sama seperti List
+func (s *service) ListByCustomer(ctx context.Context, customerID string, p ListParams) ([]OrderResponse, int64, error) {
+	exists, err := s.repo.CustomerExists(ctx, customerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrCustomerNotFound
+	}
+
+	p.CustomerID = &customerID
+	return s.List(ctx, p)
+}
+
+// toCountOrdersParams: nilai kosong ("" / 0 / NULL) berarti filter tidak dipakai
+func toCountOrdersParams(p ListParams) dbgen.CountOrdersParams {
+	return dbgen.CountOrdersParams{
+		CustomerID:    helper.StringPtrValue(p.CustomerID),
+		Status:        helper.StringPtrValue(p.Status),
+		PaymentStatus: helper.StringPtrValue(p.PaymentStatus),
+		CreatedFrom:   helper.TimeToNull(p.CreatedFrom),
+		CreatedTo:     helper.TimeToNull(p.CreatedTo),
+		MinTotal:      helper.Float64PtrToDecimal(p.MinTotal),
+		MaxTotal:      helper.Float64PtrToDecimal(p.MaxTotal),
+		ProductID:     helper.StringPtrValue(p.ProductID),
 	}
-	return resp, nil
 }
 
 func (s *service) GetByID(ctx context.Context, id string) (OrderResponse, error) {
@@ -152,28 +307,137 @@ func (s *service) GetByID(ctx context.Context, id string) (OrderResponse, error)
 		return OrderResponse{}, err
 	}
 
-	var items []OrderItemResponse
+	// GetOrderByID memilih kolom yang sama dengan GetOrders
+	return mapOrderRow(dbgen.GetOrdersRow(r)), nil
+}
 
+// mapOrderRow dipakai listing & detail agar bentuk response selalu sama;
+// order tanpa item menghasilkan items [] (bukan null)
+func mapOrderRow(r dbgen.GetOrdersRow) OrderResponse {
+	var items []OrderItemResponse
 	if len(r.Items) > 0 {
-		fmt.Printf("raw items: %s\n", string(r.Items))
-
 		if err := json.Unmarshal(r.Items, &items); err != nil {
 			log.Printf("error unmarshal items for order %s: %v", r.ID, err)
 		}
 	}
+	if items == nil {
+		items = []OrderItemResponse{}
+	}
+
+	// shipping_address NULL (tetap nil) untuk order tanpa alamat tujuan
+	var shippingAddress *ShippingAddressResponse
+	if len(r.ShippingAddress) > 0 {
+		if err := json.Unmarshal(r.ShippingAddress, &shippingAddress); err != nil {
+			log.Printf("error unmarshal shipping address for order %s: %v", r.ID, err)
+		}
+	}
+
+	var shippingMethod *ShippingMethodResponse
+	if r.ShippingMethodName.Valid {
+		shippingMethod = &ShippingMethodResponse{ID: r.ShippingMethodID.String, Name: r.ShippingMethodName.String}
+	}
 
 	return OrderResponse{
 		ID:            r.ID,
 		CustomerID:    r.CustomerID,
+		Status:        r.Status,
 		CustomerName:  r.CustomerName,
 		CustomerEmail: r.CustomerEmail,
-		TotalQuantity: int32(r.TotalQuantity),
+		TotalQuantity: r.TotalQuantity,
+		Subtotal:      helper.DecimalToFloat64(r.Subtotal),
+		DiscountTotal: helper.DecimalToFloat64(r.DiscountTotal),
+		TaxTotal:      helper.DecimalToFloat64(r.TaxTotal),
+		ShippingTotal: helper.DecimalToFloat64(r.ShippingTotal),
+		GrandTotal:    helper.DecimalToFloat64(r.TotalPrice),
 		TotalPrice:    helper.DecimalToFloat64(r.TotalPrice),
+		RefundTotal:   helper.DecimalToFloat64(r.RefundTotal),
+		CouponCode:    r.CouponCode.String,
+		PaymentStatus: r.PaymentStatus,
+		PaidAt:        helper.NullTimeToPtr(r.PaidAt),
 		CreatedAt:     r.CreatedAt,
 		Items:         items,
-	}, nil
+
+		ShippingMethod:  shippingMethod,
+		ShippingAddress: shippingAddress,
+	}
 }
 
+// Delete menghapus order secara permanen. Hanya order yang sudah dibatalkan yang boleh dihapus,
+// karena pembatalan yang mengembalikan stok; menghapus order aktif akan menghilangkan stok itu.
 func (s *service) Delete(ctx context.Context, id string) error {
-	return s.repo.Delete(ctx, id)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	current, err := txRepo.GetOrderForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+	if current.Status != OrderStatusCancelled {
+		return ErrOrderNotDeletable
+	}
+
+	if err := txRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// decrementStock mengurangi stok varian jika item merujuk varian, jika tidak stok produk.
+// Update bersyarat (stock >= qty, varian aktif) sehingga 0 baris ter-update berarti stok tidak cukup
+// atau varian sudah dinonaktifkan. Stok yang habis karena pengurangan ini dicatat sebagai event StockDepleted.
+func decrementStock(ctx context.Context, repo Repository, item OrderItemRequest) error {
+	var affected int64
+	var err error
+
+	if item.VariantID != nil {
+		affected, err = repo.DecrementVariantStock(ctx, dbgen.DecrementProductVariantStockParams{
+			Quantity:  int32(item.Quantity),
+			ID:        *item.VariantID,
+			ProductID: item.ProductID,
+		})
+	} else {
+		affected, err = repo.DecrementProductStock(ctx, dbgen.DecrementProductStockParams{
+			Quantity: int32(item.Quantity),
+			ID:       item.ProductID,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		if item.VariantID != nil {
+			active, err := repo.IsVariantActive(ctx, *item.VariantID)
+			if err != nil {
+				return err
+			}
+			if !active {
+				return fmt.Errorf("%w: %s", ErrVariantInactive, *item.VariantID)
+			}
+		}
+		return fmt.Errorf("%w for product %s", ErrInsufficientStock, item.ProductID)
+	}
+
+	var remaining int32
+	if item.VariantID != nil {
+		remaining, err = repo.GetVariantStock(ctx, *item.VariantID)
+	} else {
+		remaining, err = repo.GetProductStock(ctx, item.ProductID)
+	}
+	if err != nil || remaining > 0 {
+		return err
+	}
+
+	return outbox.Record(ctx, repo, outbox.AggregateProduct, item.ProductID, outbox.EventStockDepleted, outbox.StockDepleted{
+		ProductID: item.ProductID,
+		VariantID: helper.StringPtrValue(item.VariantID),
+	})
 }
@@ -8,9 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"assignment-ptes-achmad-rifai/internal/address"
 	"assignment-ptes-achmad-rifai/internal/order"
 	mockOrder "assignment-ptes-achmad-rifai/internal/order/mocks"
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/promotion"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shipping"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -38,6 +42,43 @@ func setupServiceTest(t *testing.T) (order.Service, *mockOrder.MockRepository, s
 	return svc, repo, mock
 }
 
+// expectSnapshots: setiap item order membaca snapshot produk (nama, sku, kategori & harga efektif per produk)
+func expectSnapshots(repo *mockOrder.MockRepository, prices map[string]int64) {
+	repo.EXPECT().
+		GetItemSnapshot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p dbgen.GetOrderItemSnapshotParams) (dbgen.GetOrderItemSnapshotRow, error) {
+			return dbgen.GetOrderItemSnapshotRow{
+				ProductName:  "Kaos Polos",
+				Sku:          sql.NullString{String: "KP-001", Valid: true},
+				UnitPrice:    decimal.NewFromInt(prices[p.ProductID]),
+				VariantFound: p.VariantID.Valid,
+				CategoryID:   "cat-1",
+				CategoryName: "Pakaian",
+			}, nil
+		}).
+		AnyTimes()
+}
+
+// expectStockLeft: setelah stok dikurangi, sisa stok dibaca untuk event StockDepleted
+func expectStockLeft(repo *mockOrder.MockRepository, left int32) {
+	repo.EXPECT().GetProductStock(gomock.Any(), gomock.Any()).Return(left, nil).AnyTimes()
+	repo.EXPECT().GetVariantStock(gomock.Any(), gomock.Any()).Return(left, nil).AnyTimes()
+}
+
+// expectOutboxEvent mengembalikan pointer ke event yang ditulis ke outbox
+func expectOutboxEvent(repo *mockOrder.MockRepository, eventType string) *dbgen.CreateOutboxEventParams {
+	var got dbgen.CreateOutboxEventParams
+	repo.EXPECT().
+		CreateOutboxEvent(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).
+		DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+			if p.EventType == eventType {
+				got = p
+			}
+			return nil
+		})
+	return &got
+}
+
 func TestService_Create_WithTransaction(t *testing.T) {
 	ctx := context.Background()
 
@@ -62,16 +103,296 @@ func TestService_Create_WithTransaction(t *testing.T) {
 		defer dbTmp.Close()
 
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{productID: 50000})
+		expectStockLeft(repo, 10)
 		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
 		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			DecrementProductStock(gomock.Any(), dbgen.DecrementProductStockParams{Quantity: 2, ID: productID}).
+			Return(int64(1), nil)
+		placed := expectOutboxEvent(repo, outbox.EventOrderPlaced)
 
 		// Execute
 		res, err := svc.Create(ctx, req)
 
 		// Assert
 		assert.NoError(t, err)
+		assert.Equal(t, "Kaos Polos", res.Items[0].ProductName)
+		assert.Equal(t, "KP-001", res.Items[0].SKU)
+		assert.Equal(t, "Pakaian", res.Items[0].CategoryName)
 		assert.Equal(t, 2, int(res.TotalQuantity))
 		assert.Equal(t, float64(100000), res.TotalPrice)
+
+		// Event OrderPlaced ditulis di transaksi yang sama
+		assert.Equal(t, outbox.AggregateOrder, placed.AggregateType)
+		assert.Equal(t, res.ID, placed.AggregateID)
+		var payload outbox.OrderPlaced
+		assert.NoError(t, json.Unmarshal(placed.Payload, &payload))
+		assert.Equal(t, customerID, payload.CustomerID)
+		assert.Equal(t, float64(100000), payload.GrandTotal)
+		assert.Len(t, payload.Items, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_create_order_with_variant", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		productID := uuid.NewString()
+		variantID := uuid.NewString()
+		req := order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items: []order.OrderItemRequest{
+				{ProductID: productID, VariantID: &variantID, Quantity: 3, UnitPrice: 75000},
+			},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{productID: 75000})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			CreateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderItemParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderItemParams) error {
+				assert.True(t, p.VariantID.Valid)
+				assert.Equal(t, variantID, p.VariantID.String)
+				// Snapshot disimpan bersama item
+				assert.Equal(t, "Kaos Polos", p.ProductName)
+				assert.Equal(t, "KP-001", p.Sku.String)
+				assert.Equal(t, "Pakaian", p.CategoryName)
+				return nil
+			})
+		repo.EXPECT().
+			DecrementVariantStock(gomock.Any(), dbgen.DecrementProductVariantStockParams{Quantity: 3, ID: variantID, ProductID: productID}).
+			Return(int64(1), nil)
+		expectOutboxEvent(repo, outbox.EventOrderPlaced)
+
+		res, err := svc.Create(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, variantID, res.Items[0].VariantID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_records_stock_depleted", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		productID := uuid.NewString()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{productID: 1000})
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().GetProductStock(gomock.Any(), productID).Return(int32(0), nil)
+
+		var events []dbgen.CreateOutboxEventParams
+		repo.EXPECT().
+			CreateOutboxEvent(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+				events = append(events, p)
+				return nil
+			}).
+			Times(2)
+
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: productID, Quantity: 5, UnitPrice: 1000}},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, outbox.EventStockDepleted, events[0].EventType)
+		assert.Equal(t, productID, events[0].AggregateID)
+		assert.Equal(t, outbox.EventOrderPlaced, events[1].EventType)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_insufficient_stock_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		req := order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 5, UnitPrice: 100}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+		_, err := svc.Create(ctx, req)
+
+		assert.ErrorIs(t, err, order.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_inactive_variant_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		variantID := "v1"
+		req := order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", VariantID: &variantID, Quantity: 1, UnitPrice: 100}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementVariantStock(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().IsVariantActive(gomock.Any(), variantID).Return(false, nil)
+
+		_, err := svc.Create(ctx, req)
+
+		assert.ErrorIs(t, err, order.ErrVariantInactive)
+		assert.NotErrorIs(t, err, order.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_active_variant_out_of_stock_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		variantID := "v1"
+		req := order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", VariantID: &variantID, Quantity: 5, UnitPrice: 100}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementVariantStock(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().IsVariantActive(gomock.Any(), variantID).Return(true, nil)
+
+		_, err := svc.Create(ctx, req)
+
+		assert.ErrorIs(t, err, order.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_variant_required_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().
+			GetItemSnapshot(gomock.Any(), dbgen.GetOrderItemSnapshotParams{ProductID: "p1"}).
+			Return(dbgen.GetOrderItemSnapshotRow{ProductName: "Kaos Polos", CategoryID: "cat-1", HasActiveVariants: true}, nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 100}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrVariantRequired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_price_comes_from_snapshot", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		productID := uuid.NewString()
+		variantID := uuid.NewString()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		// Harga varian (60000) dipakai walaupun client tidak mengirim unit_price
+		expectSnapshots(repo, map[string]int64{productID: 60000})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			CreateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderItemParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderItemParams) error {
+				assert.Equal(t, "60000", p.UnitPrice.String())
+				assert.Equal(t, "120000", p.LineTotal.String())
+				return nil
+			})
+		repo.EXPECT().DecrementVariantStock(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		expectOutboxEvent(repo, outbox.EventOrderPlaced)
+
+		res, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: productID, VariantID: &variantID, Quantity: 2}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(60000), res.Items[0].UnitPrice)
+		assert.Equal(t, float64(120000), res.TotalPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_price_mismatch_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 50000})
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Times(0)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 1}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrPriceMismatch)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_unknown_variant_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		variantID := "v-other-product"
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().
+			GetItemSnapshot(gomock.Any(), gomock.Any()).
+			Return(dbgen.GetOrderItemSnapshotRow{ProductName: "Kaos Polos", UnitPrice: decimal.NewFromInt(100), CategoryID: "cat-1"}, nil)
+		repo.EXPECT().DecrementVariantStock(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: uuid.NewString(),
+			Items:      []order.OrderItemRequest{{ProductID: "p1", VariantID: &variantID, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrVariantNotFound)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -91,6 +412,9 @@ func TestService_Create_WithTransaction(t *testing.T) {
 		defer dbTmp.Close()
 
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
 		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
 
 		// Simulasi error pada item
@@ -116,99 +440,657 @@ func TestService_Create_WithTransaction(t *testing.T) {
 	})
 }
 
-func TestService_List(t *testing.T) {
+func TestService_Create_WithCoupon(t *testing.T) {
 	ctx := context.Background()
+	customerID := uuid.NewString()
+	code := "hemat10"
 
-	t.Run("success", func(t *testing.T) {
-		// Sesuaikan dengan setupServiceTest yang mengembalikan (svc, repo, mock)
-		svc, repo, _ := setupServiceTest(t)
-		p := order.ListParams{Page: 1, PageSize: 10}
+	newPromo := func() dbgen.Promotion {
+		return dbgen.Promotion{
+			ID:       "promo-1",
+			Code:     "HEMAT10",
+			Type:     promotion.TypePercentage,
+			Value:    decimal.NewFromInt(10),
+			IsActive: true,
+		}
+	}
 
-		rows := []dbgen.GetOrdersRow{
-			{
-				ID:            "o1",
-				CustomerID:    "c1",
-				TotalQuantity: 1,
-				// Pastikan menggunakan decimal.Decimal untuk field TotalPrice
-				TotalPrice: decimal.NewFromFloat(150000),
-				CreatedAt:  time.Now(),
+	t.Run("success_records_discount_per_line", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		req := order.CreateOrderRequest{
+			CustomerID: customerID,
+			CouponCode: &code,
+			Items: []order.OrderItemRequest{
+				{ProductID: "p1", Quantity: 2, UnitPrice: 50000},
+				{ProductID: "p2", Quantity: 1, UnitPrice: 100000},
 			},
 		}
 
-		repo.EXPECT().GetOrders(ctx, gomock.Any()).Return(rows, nil)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 50000, "p2": 100000})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().GetPromotionByCodeForUpdate(gomock.Any(), "HEMAT10").Return(newPromo(), nil)
+		repo.EXPECT().
+			CreateOrder(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderParams) error {
+				assert.Equal(t, "200000", p.Subtotal.String())
+				assert.Equal(t, "20000", p.DiscountTotal.String())
+				assert.Equal(t, "180000", p.TotalPrice.String())
+				assert.Equal(t, "promo-1", p.PromotionID.String)
+				assert.Equal(t, "HEMAT10", p.CouponCode.String)
+				return nil
+			})
+		repo.EXPECT().
+			CreateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderItemParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderItemParams) error {
+				assert.Equal(t, "10000", p.DiscountAmount.String())
+				assert.Equal(t, "promo-1", p.PromotionID.String)
+				return nil
+			}).
+			Times(2)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil).Times(2)
+		repo.EXPECT().IncrementPromotionUsage(gomock.Any(), "promo-1").Return(int64(1), nil)
+		repo.EXPECT().
+			CreatePromotionRedemption(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreatePromotionRedemptionParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreatePromotionRedemptionParams) error {
+				assert.Equal(t, customerID, p.CustomerID)
+				assert.Equal(t, "20000", p.DiscountAmount.String())
+				return nil
+			})
+		expectOutboxEvent(repo, outbox.EventOrderPlaced)
+
+		res, err := svc.Create(ctx, req)
 
-		res, err := svc.List(ctx, p)
 		assert.NoError(t, err)
-		assert.Len(t, res, 1)
-		assert.Equal(t, "o1", res[0].ID)
-		assert.Equal(t, float64(150000), res[0].TotalPrice)
+		assert.Equal(t, float64(200000), res.Subtotal)
+		assert.Equal(t, float64(20000), res.DiscountTotal)
+		assert.Equal(t, float64(180000), res.TotalPrice)
+		assert.Equal(t, "HEMAT10", res.CouponCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("repo error", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
-		p := order.ListParams{Page: 1, PageSize: 10}
+	t.Run("error_unknown_coupon_should_rollback", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
 
-		repo.EXPECT().GetOrders(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+		mock.ExpectBegin()
+		mock.ExpectRollback()
 
-		_, err := svc.List(ctx, p)
-		assert.Error(t, err)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().GetPromotionByCodeForUpdate(gomock.Any(), "HEMAT10").Return(dbgen.Promotion{}, sql.ErrNoRows)
+
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: customerID,
+			CouponCode: &code,
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 100}},
+		})
+
+		assert.ErrorIs(t, err, promotion.ErrInvalidCoupon)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
-}
 
-func TestService_GetByID(t *testing.T) {
-	ctx := context.Background()
-	id := uuid.NewString()
+	t.Run("error_customer_limit_reached", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
 
-	t.Run("success", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
+		promo := newPromo()
+		promo.UsageLimitPerCustomer = sql.NullInt32{Int32: 1, Valid: true}
 
-		// 1. Siapkan mock data items dalam bentuk JSON (seperti yang dihasilkan DB)
-		mockItemsJSON := `[
-            {
-                "id": "item-uuid-1",
-                "product_id": "prod-uuid-1",
-                "quantity": 2,
-                "unit_price": 100000
-            }
-        ]`
+		mock.ExpectBegin()
+		mock.ExpectRollback()
 
-		// 2. Mock return dari repo dengan Items sebagai json.RawMessage
-		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
-			ID:            id,
-			CustomerID:    uuid.NewString(),
-			CustomerName:  "John Doe",
-			CustomerEmail: "john@example.com",
-			TotalQuantity: 2,
-			TotalPrice:    decimal.NewFromFloat(200000),
-			CreatedAt:     time.Now(),
-			Items:         json.RawMessage(mockItemsJSON), // Data JSON simulasi
-		}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().GetPromotionByCodeForUpdate(gomock.Any(), "HEMAT10").Return(promo, nil)
+		repo.EXPECT().
+			CountCustomerRedemptions(gomock.Any(), dbgen.CountCustomerPromotionRedemptionsParams{PromotionID: "promo-1", CustomerID: customerID}).
+			Return(int64(1), nil)
 
-		res, err := svc.GetByID(ctx, id)
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: customerID,
+			CouponCode: &code,
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 100}},
+		})
 
-		// 3. Assertions
-		assert.NoError(t, err)
-		assert.Equal(t, id, res.ID)
-		assert.Len(t, res.Items, 1) // Memastikan unmarshal berhasil
-		assert.Equal(t, "prod-uuid-1", res.Items[0].ProductID)
-		assert.Equal(t, float64(200000), res.TotalPrice)
+		assert.ErrorIs(t, err, promotion.ErrCouponLimitReached)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("not found", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
-
-		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{}, sql.ErrNoRows)
+	t.Run("error_global_limit_reached_on_redeem", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
 
-		_, err := svc.GetByID(ctx, id)
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, sql.ErrNoRows)
-	})
+		mock.ExpectBegin()
+		mock.ExpectRollback()
 
-	t.Run("unmarshal error", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 100})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().GetPromotionByCodeForUpdate(gomock.Any(), "HEMAT10").Return(newPromo(), nil)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().IncrementPromotionUsage(gomock.Any(), "promo-1").Return(int64(0), nil)
 
-		// Broken JSON
-		invalidJSON := `[{"id": "item-1", "quantity": ]`
+		_, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: customerID,
+			CouponCode: &code,
+			Items:      []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 100}},
+		})
+
+		assert.ErrorIs(t, err, promotion.ErrCouponLimitReached)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestService_Create_WithTax(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, mock := setupServiceTest(t)
+
+	rules := []dbgen.TaxRule{
+		{ID: "ppn", Name: "PPN", Rate: decimal.NewFromInt(11), IsInclusive: true, IsActive: true},
+		{ID: "food", Name: "Food", CategoryID: sql.NullString{String: "cat-food", Valid: true}, Rate: decimal.NewFromInt(10), IsActive: true},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+	repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(rules, nil)
+	repo.EXPECT().
+		GetItemSnapshot(gomock.Any(), dbgen.GetOrderItemSnapshotParams{ProductID: "p1"}).
+		Return(dbgen.GetOrderItemSnapshotRow{ProductName: "Keripik", UnitPrice: decimal.NewFromInt(50000), CategoryID: "cat-food", CategoryName: "Makanan"}, nil)
+	repo.EXPECT().
+		GetItemSnapshot(gomock.Any(), dbgen.GetOrderItemSnapshotParams{ProductID: "p2"}).
+		Return(dbgen.GetOrderItemSnapshotRow{ProductName: "Kaos", UnitPrice: decimal.NewFromInt(111000), CategoryID: "cat-other", CategoryName: "Pakaian"}, nil)
+	repo.EXPECT().
+		CreateOrder(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderParams{})).
+		DoAndReturn(func(_ context.Context, p dbgen.CreateOrderParams) error {
+			// p1: 100000 + 10% eksklusif; p2: 111000 sudah termasuk PPN 11% (11000)
+			assert.Equal(t, "211000", p.Subtotal.String())
+			assert.Equal(t, "21000", p.TaxTotal.String())
+			assert.Equal(t, "221000", p.TotalPrice.String())
+			return nil
+		})
+	var items []dbgen.CreateOrderItemParams
+	repo.EXPECT().
+		CreateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderItemParams{})).
+		DoAndReturn(func(_ context.Context, p dbgen.CreateOrderItemParams) error {
+			items = append(items, p)
+			return nil
+		}).
+		Times(2)
+	repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil).Times(2)
+	expectStockLeft(repo, 10)
+	expectOutboxEvent(repo, outbox.EventOrderPlaced)
+
+	res, err := svc.Create(ctx, order.CreateOrderRequest{
+		CustomerID: uuid.NewString(),
+		Items: []order.OrderItemRequest{
+			{ProductID: "p1", Quantity: 2, UnitPrice: 50000},
+			{ProductID: "p2", Quantity: 1, UnitPrice: 111000},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, float64(21000), res.TaxTotal)
+	assert.Equal(t, float64(221000), res.GrandTotal)
+	assert.Equal(t, res.GrandTotal, res.TotalPrice)
+
+	assert.Len(t, items, 2)
+	assert.False(t, items[0].TaxInclusive)
+	assert.Equal(t, "10000", items[0].TaxAmount.String())
+	assert.Equal(t, "110000", items[0].LineTotal.String())
+	assert.True(t, items[1].TaxInclusive)
+	assert.Equal(t, "11000", items[1].TaxAmount.String())
+	assert.Equal(t, "111000", items[1].LineTotal.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestService_Create_WithShippingAddress(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.NewString()
+	items := []order.OrderItemRequest{{ProductID: "p1", Quantity: 1, UnitPrice: 50000}}
+	otherAddressID, addressID := "addr-other", "addr-1"
+
+	// expectPlacedOrder: order tanpa masalah sampai commit
+	expectPlacedOrder := func(repo *mockOrder.MockRepository, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 50000})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		expectOutboxEvent(repo, outbox.EventOrderPlaced)
+	}
+
+	t.Run("saved_address_is_snapshotted", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		expectPlacedOrder(repo, mock)
+
+		repo.EXPECT().
+			GetCustomerAddress(gomock.Any(), dbgen.GetCustomerAddressParams{ID: "addr-1", CustomerID: customerID}).
+			Return(dbgen.CustomerAddress{
+				ID: "addr-1", CustomerID: customerID, Label: "Rumah", RecipientName: "Budi", Phone: "+6281234567890",
+				Street: "Jl. Merdeka No. 1", City: "Bandung", Province: "Jawa Barat", PostalCode: "40111",
+			}, nil)
+		var saved dbgen.CreateOrderShippingAddressParams
+		repo.EXPECT().
+			CreateShippingAddress(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderShippingAddressParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderShippingAddressParams) error {
+				saved = p
+				return nil
+			})
+
+		res, err := svc.Create(ctx, order.CreateOrderRequest{CustomerID: customerID, Items: items, ShippingAddressID: &addressID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, res.ID, saved.OrderID)
+		assert.Equal(t, sql.NullString{String: "addr-1", Valid: true}, saved.AddressID)
+		assert.Equal(t, "Jl. Merdeka No. 1", saved.Street)
+		assert.Equal(t, "addr-1", res.ShippingAddress.AddressID)
+		assert.Equal(t, "Jawa Barat", res.ShippingAddress.Province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("inline_address_is_normalized", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		expectPlacedOrder(repo, mock)
+
+		var saved dbgen.CreateOrderShippingAddressParams
+		repo.EXPECT().
+			CreateShippingAddress(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderShippingAddressParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderShippingAddressParams) error {
+				saved = p
+				return nil
+			})
+
+		res, err := svc.Create(ctx, order.CreateOrderRequest{
+			CustomerID: customerID,
+			Items:      items,
+			ShippingAddress: &address.Address{
+				RecipientName: "Siti", Phone: "0812-3456-7890", Street: "Jl. Malioboro 5",
+				City: "Yogyakarta", Province: "yogyakarta", PostalCode: "55271",
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, saved.AddressID.Valid)
+		assert.Equal(t, "DI Yogyakarta", saved.Province)
+		assert.Equal(t, "+6281234567890", saved.Phone)
+		assert.Empty(t, res.ShippingAddress.AddressID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	cases := []struct {
+		name    string
+		req     order.CreateOrderRequest
+		wantErr error
+	}{
+		{
+			name:    "address of another customer",
+			req:     order.CreateOrderRequest{CustomerID: customerID, Items: items, ShippingAddressID: &otherAddressID},
+			wantErr: address.ErrAddressNotFound,
+		},
+		{
+			name: "both address id and inline address",
+			req: order.CreateOrderRequest{
+				CustomerID: customerID, Items: items, ShippingAddressID: &addressID,
+				ShippingAddress: &address.Address{RecipientName: "Siti", Phone: "081234567890", Street: "Jl. A", City: "Solo", Province: "Jawa Tengah", PostalCode: "57111"},
+			},
+			wantErr: address.ErrInvalidAddress,
+		},
+		{
+			name: "unknown province",
+			req: order.CreateOrderRequest{
+				CustomerID: customerID, Items: items,
+				ShippingAddress: &address.Address{RecipientName: "Siti", Phone: "081234567890", Street: "Jl. A", City: "Solo", Province: "Jawa Utara", PostalCode: "57111"},
+			},
+			wantErr: address.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, repo, mock := setupServiceTest(t)
+
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+			repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+			repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+			expectSnapshots(repo, map[string]int64{"p1": 50000})
+			repo.EXPECT().GetCustomerAddress(gomock.Any(), gomock.Any()).Return(dbgen.CustomerAddress{}, sql.ErrNoRows).AnyTimes()
+
+			_, err := svc.Create(ctx, tc.req)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestService_Create_WithShippingMethod(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.NewString()
+	items := []order.OrderItemRequest{{ProductID: "p1", Quantity: 2, UnitPrice: 50000}}
+	methodID := "ship-1"
+
+	weightRate := dbgen.ShippingMethod{
+		ID: methodID, Name: "JNE Reguler", RateType: shipping.RateTypeWeight,
+		BaseRate: decimal.NewFromInt(1000), RatePerKg: decimal.NewFromInt(9000), IsActive: true,
+	}
+
+	t.Run("cost_is_added_to_totals", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		expectSnapshots(repo, map[string]int64{"p1": 50000})
+		expectStockLeft(repo, 10)
+		repo.EXPECT().GetShippingMethod(gomock.Any(), methodID).Return(weightRate, nil)
+		// 2 x 800 g = 1,6 kg, ditagih 2 kg
+		repo.EXPECT().GetProductDimensions(gomock.Any(), "p1").Return(dbgen.GetProductDimensionsRow{WeightGrams: 800}, nil)
+		var created dbgen.CreateOrderParams
+		repo.EXPECT().
+			CreateOrder(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderParams) error {
+				created = p
+				return nil
+			})
+		repo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		expectOutboxEvent(repo, outbox.EventOrderPlaced)
+
+		res, err := svc.Create(ctx, order.CreateOrderRequest{CustomerID: customerID, Items: items, ShippingMethodID: &methodID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "19000", created.ShippingTotal.String())
+		assert.Equal(t, "119000", created.TotalPrice.String())
+		assert.Equal(t, sql.NullString{String: methodID, Valid: true}, created.ShippingMethodID)
+		assert.Equal(t, "JNE Reguler", created.ShippingMethodName.String)
+		assert.Equal(t, float64(19000), res.ShippingTotal)
+		assert.Equal(t, float64(119000), res.GrandTotal)
+		assert.Equal(t, &order.ShippingMethodResponse{ID: methodID, Name: "JNE Reguler"}, res.ShippingMethod)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	inactive := weightRate
+	inactive.IsActive = false
+	freeAbove := dbgen.ShippingMethod{
+		ID: methodID, Name: "Gratis Ongkir", RateType: shipping.RateTypeFreeAbove, IsActive: true,
+		FreeThreshold: decimal.NullDecimal{Decimal: decimal.NewFromInt(500000), Valid: true},
+	}
+
+	cases := []struct {
+		name    string
+		method  dbgen.ShippingMethod
+		err     error
+		wantErr error
+	}{
+		{"unknown method", dbgen.ShippingMethod{}, sql.ErrNoRows, shipping.ErrMethodNotFound},
+		{"inactive method", inactive, nil, shipping.ErrMethodNotFound},
+		{"free shipping below threshold", freeAbove, nil, shipping.ErrMethodUnavailable},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, repo, mock := setupServiceTest(t)
+
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+			repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+			repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+			expectSnapshots(repo, map[string]int64{"p1": 50000})
+			repo.EXPECT().GetShippingMethod(gomock.Any(), methodID).Return(tc.method, tc.err)
+			repo.EXPECT().GetProductDimensions(gomock.Any(), "p1").Return(dbgen.GetProductDimensionsRow{}, nil).AnyTimes()
+
+			_, err := svc.Create(ctx, order.CreateOrderRequest{CustomerID: customerID, Items: items, ShippingMethodID: &methodID})
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// Sesuaikan dengan setupServiceTest yang mengembalikan (svc, repo, mock)
+		svc, repo, _ := setupServiceTest(t)
+		p := order.ListParams{Page: 1, PageSize: 10}
+
+		rows := []dbgen.GetOrdersRow{
+			{
+				ID:            "o1",
+				CustomerID:    "c1",
+				TotalQuantity: 1,
+				// Pastikan menggunakan decimal.Decimal untuk field TotalPrice
+				TotalPrice: decimal.NewFromFloat(150000),
+				CreatedAt:  time.Now(),
+			},
+		}
+
+		repo.EXPECT().GetOrders(ctx, gomock.Any()).Return(rows, nil)
+		repo.EXPECT().CountOrders(ctx, gomock.Any()).Return(int64(1), nil)
+
+		res, total, err := svc.List(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "o1", res[0].ID)
+		assert.Equal(t, float64(150000), res[0].TotalPrice)
+	})
+
+	t.Run("maps filters to query params", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		customerID := "c1"
+		minTotal := 100000.0
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		sort := order.SortTotalAsc
+		p := order.ListParams{Page: 3, PageSize: 20, CustomerID: &customerID, MinTotal: &minTotal, CreatedFrom: &from, Sort: &sort}
+
+		repo.EXPECT().
+			GetOrders(ctx, gomock.AssignableToTypeOf(dbgen.GetOrdersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.GetOrdersParams) ([]dbgen.GetOrdersRow, error) {
+				assert.Equal(t, "c1", arg.CustomerID)
+				assert.Equal(t, "", arg.ProductID)
+				assert.True(t, decimal.NewFromInt(100000).Equal(arg.MinTotal))
+				assert.True(t, arg.MaxTotal.IsZero())
+				assert.Equal(t, sql.NullTime{Time: from, Valid: true}, arg.CreatedFrom)
+				assert.False(t, arg.CreatedTo.Valid)
+				assert.Equal(t, order.SortTotalAsc, arg.OrderBy)
+				assert.Equal(t, int32(20), arg.Limit)
+				assert.Equal(t, int32(40), arg.Offset)
+				return nil, nil
+			})
+		repo.EXPECT().
+			CountOrders(ctx, gomock.AssignableToTypeOf(dbgen.CountOrdersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.CountOrdersParams) (int64, error) {
+				assert.Equal(t, "c1", arg.CustomerID)
+				return 0, nil
+			})
+
+		res, total, err := svc.List(ctx, p)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		p := order.ListParams{Page: 1, PageSize: 10}
+
+		repo.EXPECT().GetOrders(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, _, err := svc.List(ctx, p)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ListByCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.NewString()
+
+	t.Run("filters by customer", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(ctx, customerID).Return(true, nil)
+		repo.EXPECT().
+			GetOrders(ctx, gomock.AssignableToTypeOf(dbgen.GetOrdersParams{})).
+			DoAndReturn(func(_ context.Context, arg dbgen.GetOrdersParams) ([]dbgen.GetOrdersRow, error) {
+				assert.Equal(t, customerID, arg.CustomerID)
+				return []dbgen.GetOrdersRow{{ID: "o1", CustomerID: customerID}}, nil
+			})
+		repo.EXPECT().CountOrders(ctx, gomock.Any()).Return(int64(1), nil)
+
+		res, total, err := svc.ListByCustomer(ctx, customerID, order.ListParams{Page: 1, PageSize: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("customer not found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().CustomerExists(ctx, customerID).Return(false, nil)
+
+		_, _, err := svc.ListByCustomer(ctx, customerID, order.ListParams{Page: 1, PageSize: 10})
+
+		assert.ErrorIs(t, err, order.ErrCustomerNotFound)
+	})
+}
+
+func TestService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		// 1. Siapkan mock data items dalam bentuk JSON (seperti yang dihasilkan DB)
+		mockItemsJSON := `[
+            {
+                "id": "item-uuid-1",
+                "product_id": "prod-uuid-1",
+                "quantity": 2,
+                "unit_price": 100000
+            }
+        ]`
+
+		// 2. Mock return dari repo dengan Items sebagai json.RawMessage
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
+			ID:            id,
+			CustomerID:    uuid.NewString(),
+			CustomerName:  "John Doe",
+			CustomerEmail: "john@example.com",
+			TotalQuantity: 2,
+			TotalPrice:    decimal.NewFromFloat(200000),
+			CreatedAt:     time.Now(),
+			Items:         json.RawMessage(mockItemsJSON), // Data JSON simulasi
+		}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+
+		// 3. Assertions
+		assert.NoError(t, err)
+		assert.Equal(t, id, res.ID)
+		assert.Len(t, res.Items, 1) // Memastikan unmarshal berhasil
+		assert.Equal(t, "prod-uuid-1", res.Items[0].ProductID)
+		assert.Equal(t, float64(200000), res.TotalPrice)
+	})
+
+	t.Run("shipping address snapshot", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
+			ID: id,
+			ShippingAddress: json.RawMessage(`{"address_id": null, "recipient_name": "Siti", "phone": "+6281234567890",
+				"street": "Jl. Malioboro 5", "city": "Yogyakarta", "province": "DI Yogyakarta", "postal_code": "55271"}`),
+		}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res.ShippingAddress)
+		assert.Empty(t, res.ShippingAddress.AddressID)
+		assert.Equal(t, "55271", res.ShippingAddress.PostalCode)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.GetByID(ctx, id)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("snapshot and current product", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
+			ID: id,
+			Items: json.RawMessage(`[{
+				"id": "item-1",
+				"product_id": "prod-1",
+				"product_name": "Kaos Lama",
+				"sku": "KP-001",
+				"category_name": "Pakaian",
+				"quantity": 1,
+				"unit_price": 50000,
+				"current_product": {"id": "prod-1", "name": "Kaos Baru", "price": 65000, "category_name": "Fashion", "is_active": true}
+			}]`),
+		}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+
+		assert.NoError(t, err)
+		item := res.Items[0]
+		assert.Equal(t, "Kaos Lama", item.ProductName)
+		assert.Equal(t, "Pakaian", item.CategoryName)
+		assert.Equal(t, float64(50000), item.UnitPrice)
+		assert.Equal(t, "Kaos Baru", item.CurrentProduct.Name)
+		assert.Equal(t, float64(65000), item.CurrentProduct.Price)
+	})
+
+	t.Run("order without items returns empty array", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
+			ID:    id,
+			Items: json.RawMessage(`[]`),
+		}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res.Items)
+		assert.Empty(t, res.Items)
+
+		body, _ := json.Marshal(res)
+		assert.Contains(t, string(body), `"items":[]`)
+	})
+
+	t.Run("unmarshal error", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		// Broken JSON
+		invalidJSON := `[{"id": "item-1", "quantity": ]`
 
 		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetOrderByIDRow{
 			ID:    id,
@@ -221,3 +1103,648 @@ func TestService_GetByID(t *testing.T) {
 		assert.Empty(t, res.Items)
 	})
 }
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.NewString()
+	productA := uuid.NewString()
+	productB := uuid.NewString()
+
+	pendingOrder := dbgen.Order{
+		ID:            orderID,
+		CustomerID:    uuid.NewString(),
+		Status:        order.OrderStatusPending,
+		TotalQuantity: 3,
+		Subtotal:      decimal.NewFromInt(250000),
+		TotalPrice:    decimal.NewFromInt(250000),
+		PaymentStatus: order.PaymentStatusUnpaid,
+		CreatedAt:     time.Now(),
+	}
+	items := []dbgen.OrderItem{
+		{ID: "item-a", OrderID: orderID, ProductID: sql.NullString{String: productA, Valid: true}, Quantity: 1, UnitPrice: decimal.NewFromInt(50000)},
+		{ID: "item-b", OrderID: orderID, ProductID: sql.NullString{String: productB, Valid: true}, Quantity: 2, UnitPrice: decimal.NewFromInt(100000)},
+	}
+
+	t.Run("success_adjusts_stock_and_records_revision", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		itemA, itemB := "item-a", "item-b"
+		req := order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{
+				{OrderItemID: &itemA, Quantity: 3},
+				{OrderItemID: &itemB, Quantity: 0},
+			},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+		repo.EXPECT().
+			DecrementProductStock(gomock.Any(), dbgen.DecrementProductStockParams{Quantity: 2, ID: productA}).
+			Return(int64(1), nil)
+		expectStockLeft(repo, 10)
+		repo.EXPECT().
+			IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: productB}).
+			Return(nil)
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().
+			UpdateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderItemParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderItemParams) error {
+				assert.Equal(t, "item-a", p.ID)
+				assert.Equal(t, int32(3), p.Quantity)
+				assert.True(t, decimal.NewFromInt(150000).Equal(p.LineTotal))
+				return nil
+			})
+		repo.EXPECT().DeleteOrderItem(gomock.Any(), dbgen.DeleteOrderItemParams{ID: "item-b", OrderID: orderID}).Return(nil)
+		repo.EXPECT().
+			UpdateOrderTotals(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderTotalsParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderTotalsParams) error {
+				assert.Equal(t, int32(3), p.TotalQuantity)
+				assert.True(t, decimal.NewFromInt(150000).Equal(p.TotalPrice))
+				return nil
+			})
+		// Total berubah, intent pending dengan nominal lama dibatalkan
+		repo.EXPECT().
+			CancelPendingPaymentIntents(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CancelPendingPaymentIntentsParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CancelPendingPaymentIntentsParams) (int64, error) {
+				assert.Equal(t, orderID, p.OrderID)
+				assert.True(t, p.Reason.Valid)
+				return 1, nil
+			})
+		repo.EXPECT().GetNextRevision(gomock.Any(), orderID).Return(int64(1), nil)
+		repo.EXPECT().
+			CreateRevision(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderRevisionParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderRevisionParams) error {
+				var changes []order.RevisionChange
+				assert.NoError(t, json.Unmarshal(p.Changes, &changes))
+				assert.Len(t, changes, 2)
+				assert.Equal(t, int32(1), p.Revision)
+				assert.True(t, decimal.NewFromInt(250000).Equal(p.PreviousTotal))
+				assert.True(t, decimal.NewFromInt(150000).Equal(p.NewTotal))
+				return nil
+			})
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:            orderID,
+			Status:        order.OrderStatusPending,
+			TotalQuantity: 3,
+			TotalPrice:    decimal.NewFromInt(150000),
+			Items:         json.RawMessage(`[]`),
+		}, nil)
+
+		res, err := svc.Update(ctx, orderID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(150000), res.TotalPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_drops_coupon_below_min_subtotal", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		couponOrder := pendingOrder
+		couponOrder.PromotionID = sql.NullString{String: "promo-1", Valid: true}
+		couponOrder.CouponCode = sql.NullString{String: "HEMAT10", Valid: true}
+
+		itemB := "item-b"
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(couponOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+		repo.EXPECT().
+			IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: productB}).
+			Return(nil)
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().GetPromotionByID(gomock.Any(), "promo-1").Return(dbgen.Promotion{
+			ID:          "promo-1",
+			Code:        "HEMAT10",
+			Type:        promotion.TypePercentage,
+			Value:       decimal.NewFromInt(10),
+			MinSubtotal: decimal.NewNullDecimal(decimal.NewFromInt(200000)),
+		}, nil)
+		repo.EXPECT().
+			UpdateOrderItem(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderItemParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderItemParams) error {
+				assert.True(t, p.DiscountAmount.IsZero())
+				assert.False(t, p.PromotionID.Valid)
+				return nil
+			})
+		repo.EXPECT().DeleteOrderItem(gomock.Any(), dbgen.DeleteOrderItemParams{ID: "item-b", OrderID: orderID}).Return(nil)
+		repo.EXPECT().
+			UpdateOrderTotals(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderTotalsParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderTotalsParams) error {
+				assert.True(t, p.DiscountTotal.IsZero())
+				assert.True(t, decimal.NewFromInt(50000).Equal(p.TotalPrice))
+				return nil
+			})
+		repo.EXPECT().CancelPendingPaymentIntents(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().DeleteRedemption(gomock.Any(), orderID).Return(nil)
+		repo.EXPECT().DecrementPromotionUsage(gomock.Any(), "promo-1").Return(nil)
+		repo.EXPECT().ClearCoupon(gomock.Any(), orderID).Return(nil)
+		repo.EXPECT().GetNextRevision(gomock.Any(), orderID).Return(int64(1), nil)
+		repo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:         orderID,
+			Status:     order.OrderStatusPending,
+			TotalPrice: decimal.NewFromInt(50000),
+			Items:      json.RawMessage(`[]`),
+		}, nil)
+
+		res, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &itemB, Quantity: 0}},
+		})
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, res.CouponRemoved) {
+			assert.Equal(t, "HEMAT10", res.CouponRemoved.Code)
+			assert.Contains(t, res.CouponRemoved.Reason, "minimum")
+		}
+		assert.Empty(t, res.CouponCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_recomputes_shipping", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		withShipping := pendingOrder
+		withShipping.ShippingMethodID = sql.NullString{String: "ship-1", Valid: true}
+		withShipping.ShippingTotal = decimal.NewFromInt(27000)
+		itemB := "item-b"
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(withShipping, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().ListActiveTaxRules(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().UpdateOrderItem(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		// Metode nonaktif tetap dipakai untuk order yang sudah memilihnya
+		repo.EXPECT().GetShippingMethod(gomock.Any(), "ship-1").Return(dbgen.ShippingMethod{
+			ID: "ship-1", RateType: shipping.RateTypeWeight, RatePerKg: decimal.NewFromInt(9000), IsActive: false,
+		}, nil)
+		repo.EXPECT().GetProductDimensions(gomock.Any(), productA).Return(dbgen.GetProductDimensionsRow{WeightGrams: 500}, nil)
+		repo.EXPECT().GetProductDimensions(gomock.Any(), productB).Return(dbgen.GetProductDimensionsRow{WeightGrams: 1000}, nil)
+		repo.EXPECT().
+			UpdateOrderTotals(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderTotalsParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderTotalsParams) error {
+				// 500 g + 1 x 1000 g = 1,5 kg, ditagih 2 kg
+				assert.Equal(t, "18000", p.ShippingTotal.String())
+				assert.Equal(t, "168000", p.TotalPrice.String())
+				return nil
+			})
+		repo.EXPECT().CancelPendingPaymentIntents(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().GetNextRevision(gomock.Any(), orderID).Return(int64(1), nil)
+		repo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{ID: orderID, Items: json.RawMessage(`[]`)}, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &itemB, Quantity: 1}},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_new_item_price_mismatch", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		req := order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{ProductID: "p-new", Quantity: 1, UnitPrice: 1000}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+		expectSnapshots(repo, map[string]int64{"p-new": 25000})
+		repo.EXPECT().DecrementProductStock(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.Update(ctx, orderID, req)
+
+		assert.ErrorIs(t, err, order.ErrPriceMismatch)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_already_paid", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		paid := pendingOrder
+		paid.PaymentStatus = order.PaymentStatusPaid
+		itemA := "item-a"
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(paid, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &itemA, Quantity: 2}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrOrderNotEditable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_unknown_order_item", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		unknown := "item-x"
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &unknown, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrOrderItemNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_item_of_deleted_product", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		itemA := "item-a"
+		withDeleted := []dbgen.OrderItem{
+			items[0],
+			{ID: "item-c", OrderID: orderID, ProductName: "Produk terhapus", Quantity: 1, UnitPrice: decimal.NewFromInt(30000)},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(withDeleted, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &itemA, Quantity: 2}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrInvalidOrderEdit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_increasing_item_of_deleted_variant", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		itemB := "item-b"
+		withDeletedVariant := []dbgen.OrderItem{
+			items[0],
+			items[1],
+		}
+		withDeletedVariant[1].VariantSku = sql.NullString{String: "KAOS-M", Valid: true}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(withDeletedVariant, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{{OrderItemID: &itemB, Quantity: 3}},
+		})
+
+		assert.ErrorIs(t, err, order.ErrVariantNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_removing_every_item", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		itemA, itemB := "item-a", "item-b"
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{
+			Items: []order.UpdateOrderItemRequest{
+				{OrderItemID: &itemA, Quantity: 0},
+				{OrderItemID: &itemB, Quantity: 0},
+			},
+		})
+
+		assert.ErrorIs(t, err, order.ErrInvalidOrderEdit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, orderID, order.UpdateOrderRequest{})
+
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+	})
+}
+
+func TestService_Cancel(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.NewString()
+	customerID := uuid.NewString()
+	variantID := uuid.NewString()
+
+	pendingOrder := dbgen.Order{
+		ID:            orderID,
+		CustomerID:    customerID,
+		Status:        order.OrderStatusPending,
+		PaymentStatus: order.PaymentStatusUnpaid,
+	}
+	items := []dbgen.OrderItem{
+		{ID: "item-a", OrderID: orderID, ProductID: sql.NullString{String: "p1", Valid: true}, Quantity: 2},
+		{ID: "item-b", OrderID: orderID, ProductID: sql.NullString{String: "p2", Valid: true}, VariantID: sql.NullString{String: variantID, Valid: true}, Quantity: 1},
+	}
+
+	t.Run("success_restores_stock_and_records_event", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: "p1"}).Return(nil)
+		repo.EXPECT().
+			IncrementVariantStock(gomock.Any(), dbgen.IncrementProductVariantStockParams{StockQuantity: 1, ID: variantID, ProductID: "p2"}).
+			Return(nil)
+		repo.EXPECT().UpdateStatus(gomock.Any(), dbgen.UpdateOrderStatusParams{Status: order.OrderStatusCancelled, ID: orderID}).Return(nil)
+		repo.EXPECT().
+			CancelPendingPaymentIntents(gomock.Any(), dbgen.CancelPendingPaymentIntentsParams{
+				Reason:  sql.NullString{String: "order cancelled", Valid: true},
+				OrderID: orderID,
+			}).
+			Return(int64(1), nil)
+		cancelled := expectOutboxEvent(repo, outbox.EventOrderCancelled)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:     orderID,
+			Status: order.OrderStatusCancelled,
+			Items:  json.RawMessage(`[]`),
+		}, nil)
+
+		res, err := svc.Cancel(ctx, orderID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order.OrderStatusCancelled, res.Status)
+		assert.Equal(t, orderID, cancelled.AggregateID)
+		assert.JSONEq(t, `{"order_id":"`+orderID+`","customer_id":"`+customerID+`"}`, string(cancelled.Payload))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_skips_restock_for_deleted_product", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		withDeleted := []dbgen.OrderItem{
+			items[0],
+			{ID: "item-c", OrderID: orderID, ProductName: "Produk terhapus", Quantity: 1},
+		}
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(withDeleted, nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: "p1"}).Return(nil)
+		repo.EXPECT().UpdateStatus(gomock.Any(), dbgen.UpdateOrderStatusParams{Status: order.OrderStatusCancelled, ID: orderID}).Return(nil)
+		repo.EXPECT().CancelPendingPaymentIntents(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		expectOutboxEvent(repo, outbox.EventOrderCancelled)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:     orderID,
+			Status: order.OrderStatusCancelled,
+			Items:  json.RawMessage(`[]`),
+		}, nil)
+
+		_, err := svc.Cancel(ctx, orderID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_skips_restock_for_deleted_variant", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		// Varian item-b sudah dihapus: variant_id NULL, snapshot variant_sku tetap ada
+		deletedVariant := items[1]
+		deletedVariant.VariantID = sql.NullString{}
+		deletedVariant.VariantSku = sql.NullString{String: "KAOS-M", Valid: true}
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(pendingOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return([]dbgen.OrderItem{items[0], deletedVariant}, nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: "p1"}).Return(nil)
+		repo.EXPECT().UpdateStatus(gomock.Any(), dbgen.UpdateOrderStatusParams{Status: order.OrderStatusCancelled, ID: orderID}).Return(nil)
+		repo.EXPECT().CancelPendingPaymentIntents(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		expectOutboxEvent(repo, outbox.EventOrderCancelled)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:     orderID,
+			Status: order.OrderStatusCancelled,
+			Items:  json.RawMessage(`[]`),
+		}, nil)
+
+		_, err := svc.Cancel(ctx, orderID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_already_cancelled", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		cancelledOrder := pendingOrder
+		cancelledOrder.Status = order.OrderStatusCancelled
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(cancelledOrder, nil)
+		repo.EXPECT().GetItemsByOrderID(gomock.Any(), orderID).Return(items, nil)
+
+		_, err := svc.Cancel(ctx, orderID)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotCancellable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
+
+		_, err := svc.Cancel(ctx, orderID)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.NewString()
+
+	t.Run("success_cancelled_order", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, Status: order.OrderStatusCancelled}, nil)
+		repo.EXPECT().Delete(gomock.Any(), orderID).Return(nil)
+
+		err := svc.Delete(ctx, orderID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_not_cancelled", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, Status: order.OrderStatusPending}, nil)
+
+		err := svc.Delete(ctx, orderID)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotDeletable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
+
+		err := svc.Delete(ctx, orderID)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestService_Ship(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.NewString()
+	customerID := uuid.NewString()
+
+	paidOrder := dbgen.Order{
+		ID:            orderID,
+		CustomerID:    customerID,
+		Status:        order.OrderStatusPending,
+		PaymentStatus: order.PaymentStatusPaid,
+	}
+	req := order.ShipOrderRequest{Carrier: "JNE", TrackingNumber: "JNE123"}
+
+	t.Run("success_records_shipment_and_event", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(paidOrder, nil)
+		repo.EXPECT().
+			CreateShipment(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOrderShipmentParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderShipmentParams) error {
+				assert.Equal(t, orderID, p.OrderID)
+				assert.Equal(t, "JNE", p.Carrier)
+				assert.Equal(t, "JNE123", p.TrackingNumber)
+				assert.False(t, p.ShippedAt.IsZero())
+				return nil
+			})
+		repo.EXPECT().UpdateStatus(gomock.Any(), dbgen.UpdateOrderStatusParams{Status: order.OrderStatusShipped, ID: orderID}).Return(nil)
+		shipped := expectOutboxEvent(repo, outbox.EventOrderShipped)
+		repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID:     orderID,
+			Status: order.OrderStatusShipped,
+			Items:  json.RawMessage(`[]`),
+		}, nil)
+
+		res, err := svc.Ship(ctx, orderID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order.OrderStatusShipped, res.Status)
+
+		var payload outbox.OrderShipped
+		assert.NoError(t, json.Unmarshal(shipped.Payload, &payload))
+		assert.Equal(t, customerID, payload.CustomerID)
+		assert.Equal(t, "JNE123", payload.TrackingNumber)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_unpaid", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		unpaidOrder := paidOrder
+		unpaidOrder.PaymentStatus = order.PaymentStatusUnpaid
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(unpaidOrder, nil)
+
+		_, err := svc.Ship(ctx, orderID, req)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotShippable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_already_shipped", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		shippedOrder := paidOrder
+		shippedOrder.Status = order.OrderStatusShipped
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), orderID).Return(shippedOrder, nil)
+
+		_, err := svc.Ship(ctx, orderID, req)
+
+		assert.ErrorIs(t, err, order.ErrOrderNotShippable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,66 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Ship menandai order yang sudah dibayar sebagai dikirim dan mencatat kurir & nomor resi.
+// Event OrderShipped ditulis ke outbox di transaksi yang sama.
+func (s *service) Ship(ctx context.Context, id string, req ShipOrderRequest) (OrderResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	current, err := txRepo.GetOrderForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, ErrOrderNotFound
+		}
+		return OrderResponse{}, err
+	}
+	if current.Status != OrderStatusPending || current.PaymentStatus != PaymentStatusPaid {
+		return OrderResponse{}, ErrOrderNotShippable
+	}
+
+	shippedAt := time.Now().UTC().Truncate(time.Second)
+	if err := txRepo.CreateShipment(ctx, dbgen.CreateOrderShipmentParams{
+		OrderID:        id,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		ShippedAt:      shippedAt,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := txRepo.UpdateStatus(ctx, dbgen.UpdateOrderStatusParams{
+		Status: OrderStatusShipped,
+		ID:     id,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := outbox.Record(ctx, txRepo, outbox.AggregateOrder, id, outbox.EventOrderShipped, outbox.OrderShipped{
+		OrderID:        id,
+		CustomerID:     current.CustomerID,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		ShippedAt:      shippedAt,
+	}); err != nil {
+		return OrderResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return OrderResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
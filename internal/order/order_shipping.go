@@ -0,0 +1,77 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// loadShippingMethod memuat metode pengiriman yang dipilih saat order dibuat.
+// nil berarti order tanpa metode pengiriman; metode nonaktif dianggap tidak ada.
+func loadShippingMethod(ctx context.Context, repo Repository, id *string) (*dbgen.ShippingMethod, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	method, err := repo.GetShippingMethod(ctx, *id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", shipping.ErrMethodNotFound, *id)
+		}
+		return nil, err
+	}
+	if !method.IsActive {
+		return nil, fmt.Errorf("%w: %s", shipping.ErrMethodNotFound, *id)
+	}
+
+	return &method, nil
+}
+
+// reloadShippingMethod memuat metode yang tersimpan di order untuk menghitung ulang ongkir
+// saat edit. Metode yang sudah dinonaktifkan tetap dipakai karena sudah dipilih customer.
+func reloadShippingMethod(ctx context.Context, repo Repository, o dbgen.Order) (*dbgen.ShippingMethod, error) {
+	if !o.ShippingMethodID.Valid {
+		return nil, nil
+	}
+
+	method, err := repo.GetShippingMethod(ctx, o.ShippingMethodID.String)
+	if err != nil {
+		return nil, err
+	}
+	return &method, nil
+}
+
+// quoteShipping menghitung ongkir item order dengan metode terpilih; free_above
+// dibandingkan dengan subtotal sebelum kupon, sama seperti /shipping/quote
+func quoteShipping(
+	ctx context.Context,
+	repo Repository,
+	method *dbgen.ShippingMethod,
+	items []OrderItemRequest,
+	subtotal decimal.Decimal,
+) (decimal.Decimal, error) {
+	if method == nil {
+		return decimal.Zero, nil
+	}
+
+	parcelItems := make([]shipping.Item, 0, len(items))
+	for _, item := range items {
+		parcelItems = append(parcelItems, shipping.Item{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+	weight, err := shipping.Weigh(ctx, repo, parcelItems)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	cost, ok := shipping.Cost(*method, shipping.Parcel{WeightGrams: weight, Value: subtotal})
+	if !ok {
+		return decimal.Zero, fmt.Errorf("%w: %s requires a subtotal of at least %s",
+			shipping.ErrMethodUnavailable, method.Name, method.FreeThreshold.Decimal.StringFixed(2))
+	}
+	return cost, nil
+}
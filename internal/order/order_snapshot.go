@@ -0,0 +1,72 @@
+package order
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// itemSnapshot adalah data produk yang disalin ke order_items saat item dibuat,
+// sehingga rename produk/kategori setelahnya tidak mengubah order lama.
+// unitPrice adalah harga efektif (varian atau produk) saat snapshot diambil.
+type itemSnapshot struct {
+	productName  string
+	sku          sql.NullString
+	variantSku   sql.NullString
+	categoryName string
+	unitPrice    decimal.Decimal
+}
+
+// loadSnapshot mengambil snapshot satu item dan sekaligus mengisi cache kategori
+// agar perhitungan kupon & pajak tidak query ulang. Harga item diisi dari harga efektif;
+// unit_price dari client hanya dipakai sebagai harga yang diharapkan dan harus sama.
+func loadSnapshot(ctx context.Context, repo Repository, categories *categoryLookup, item *OrderItemRequest) (itemSnapshot, error) {
+	row, err := repo.GetItemSnapshot(ctx, dbgen.GetOrderItemSnapshotParams{
+		VariantID: helper.StringToNull(item.VariantID),
+		ProductID: item.ProductID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return itemSnapshot{}, fmt.Errorf("%w: %s", ErrProductNotFound, item.ProductID)
+		}
+		return itemSnapshot{}, err
+	}
+	if item.VariantID != nil && !row.VariantFound {
+		return itemSnapshot{}, fmt.Errorf("%w: %s", ErrVariantNotFound, *item.VariantID)
+	}
+	// Produk bervarian dijual per varian; tanpa variant_id stok varian akan terlewati
+	if item.VariantID == nil && row.HasActiveVariants {
+		return itemSnapshot{}, fmt.Errorf("%w: %s", ErrVariantRequired, item.ProductID)
+	}
+	if item.UnitPrice > 0 && !helper.Float64ToDecimal(item.UnitPrice).Equal(row.UnitPrice) {
+		return itemSnapshot{}, fmt.Errorf("%w: %s costs %s", ErrPriceMismatch, item.ProductID, row.UnitPrice.StringFixed(2))
+	}
+	item.UnitPrice = helper.DecimalToFloat64(row.UnitPrice)
+
+	categories.cache[item.ProductID] = row.CategoryID
+	return itemSnapshot{
+		productName:  row.ProductName,
+		sku:          row.Sku,
+		variantSku:   row.VariantSku,
+		categoryName: row.CategoryName,
+		unitPrice:    row.UnitPrice,
+	}, nil
+}
+
+// loadSnapshots mengisi harga setiap item di tempat sehingga kupon, pajak & ongkir memakai harga efektif
+func loadSnapshots(ctx context.Context, repo Repository, categories *categoryLookup, items []OrderItemRequest) ([]itemSnapshot, error) {
+	snapshots := make([]itemSnapshot, 0, len(items))
+	for i := range items {
+		snap, err := loadSnapshot(ctx, repo, categories, &items[i])
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snap)
+	}
+	return snapshots, nil
+}
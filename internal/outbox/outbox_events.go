@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Jenis event domain yang ditulis ke outbox
+const (
+	EventOrderPlaced         = "OrderPlaced"
+	EventOrderShipped        = "OrderShipped"
+	EventOrderCancelled      = "OrderCancelled"
+	EventProductPriceChanged = "ProductPriceChanged"
+	EventStockDepleted       = "StockDepleted"
+	EventCustomerRegistered  = "CustomerRegistered"
+	EventCustomerAnonymised  = "CustomerAnonymised"
+)
+
+// EventTypes berisi semua jenis event yang bisa dilanggan konsumen eksternal
+var EventTypes = []string{
+	EventOrderPlaced,
+	EventOrderShipped,
+	EventOrderCancelled,
+	EventProductPriceChanged,
+	EventStockDepleted,
+	EventCustomerRegistered,
+	EventCustomerAnonymised,
+}
+
+// IsKnownEvent melaporkan apakah eventType termasuk EventTypes
+func IsKnownEvent(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Jenis aggregate pemilik event
+const (
+	AggregateOrder    = "order"
+	AggregateProduct  = "product"
+	AggregateCustomer = "customer"
+)
+
+// Writer dipenuhi repository domain yang bisa menulis ke tabel outbox.
+// Berikan repository yang terikat transaksi agar event ikut commit/rollback bersama perubahannya.
+type Writer interface {
+	CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error
+}
+
+// Record menulis satu event domain ke outbox
+func Record(ctx context.Context, w Writer, aggregateType, aggregateID, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return w.CreateOutboxEvent(ctx, dbgen.CreateOutboxEventParams{
+		ID:            newUUID.String(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+	})
+}
+
+// Message adalah event yang dikirim relay ke Sink
+type Message struct {
+	ID            string          `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+func toMessage(e dbgen.Outbox) Message {
+	return Message{
+		ID:            e.ID,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		EventType:     e.EventType,
+		Payload:       e.Payload,
+		OccurredAt:    e.CreatedAt,
+	}
+}
+
+// Payload event. Nominal uang memakai float64 seperti DTO response lainnya.
+
+type OrderPlacedItem struct {
+	ProductID string  `json:"product_id"`
+	VariantID string  `json:"variant_id,omitempty"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	LineTotal float64 `json:"line_total"`
+}
+
+type OrderPlaced struct {
+	OrderID       string            `json:"order_id"`
+	CustomerID    string            `json:"customer_id"`
+	TotalQuantity int               `json:"total_quantity"`
+	GrandTotal    float64           `json:"grand_total"`
+	CouponCode    string            `json:"coupon_code,omitempty"`
+	Items         []OrderPlacedItem `json:"items"`
+}
+
+type OrderCancelled struct {
+	OrderID    string `json:"order_id"`
+	CustomerID string `json:"customer_id"`
+}
+
+type OrderShipped struct {
+	OrderID        string    `json:"order_id"`
+	CustomerID     string    `json:"customer_id"`
+	Carrier        string    `json:"carrier"`
+	TrackingNumber string    `json:"tracking_number"`
+	ShippedAt      time.Time `json:"shipped_at"`
+}
+
+type ProductPriceChanged struct {
+	ProductID  string  `json:"product_id"`
+	OldPrice   float64 `json:"old_price"`
+	NewPrice   float64 `json:"new_price"`
+	Source     string  `json:"source"`
+	ScheduleID string  `json:"schedule_id,omitempty"`
+}
+
+type StockDepleted struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+}
+
+type CustomerRegistered struct {
+	CustomerID string `json:"customer_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+}
+
+// CustomerAnonymised meminta konsumen menghapus data pribadi customer yang pernah diterima
+// (mis. name & email dari CustomerRegistered)
+type CustomerAnonymised struct {
+	CustomerID string `json:"customer_id"`
+}
@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// DefaultRelayInterval adalah jeda antar polling tabel outbox
+const DefaultRelayInterval = 2 * time.Second
+
+// RelayBatchSize membatasi jumlah event yang dikunci per transaksi relay
+const RelayBatchSize = 100
+
+// Backoff retry: 5s, 10s, 20s, ... maksimal 1 jam. Event tidak pernah dibuang.
+const (
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// maxErrorLength sesuai panjang kolom outbox.last_error
+const maxErrorLength = 500
+
+// Relay memindahkan event dari tabel outbox ke Sink di background.
+// Event ditandai published setelah Publish sukses; jika commit gagal setelah itu,
+// event akan dipublikasikan ulang (at-least-once).
+type Relay struct {
+	db       *sql.DB
+	repo     Repository
+	sink     Sink
+	interval time.Duration
+}
+
+func NewRelay(db *sql.DB, repo Repository, sink Sink, interval time.Duration) *Relay {
+	if interval <= 0 {
+		interval = DefaultRelayInterval
+	}
+	return &Relay{db: db, repo: repo, sink: sink, interval: interval}
+}
+
+// Run memproses outbox sekali saat start lalu setiap interval, sampai ctx dibatalkan
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) tick(ctx context.Context) {
+	// Batch penuh berarti kemungkinan masih ada antrean, lanjutkan tanpa menunggu ticker
+	for ctx.Err() == nil {
+		published, failed, err := r.PublishPending(ctx, time.Now())
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("outbox relay: %v", err)
+			}
+			return
+		}
+		if failed > 0 {
+			log.Printf("outbox relay: %d event(s) failed, will retry", failed)
+		}
+		if published+failed < RelayBatchSize {
+			return
+		}
+	}
+}
+
+// PublishPending mengirim satu batch event yang jatuh tempo ke sink. Event yang gagal
+// dijadwalkan ulang dengan backoff eksponensial dan tidak menghentikan batch.
+func (r *Relay) PublishPending(ctx context.Context, now time.Time) (published int, failed int, err error) {
+	now = now.UTC()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	txRepo := r.repo.WithTx(tx)
+	events, err := txRepo.ListPending(ctx, dbgen.ListPendingOutboxEventsParams{
+		Now:   now,
+		Limit: RelayBatchSize,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, e := range events {
+		if pubErr := r.sink.Publish(ctx, toMessage(e)); pubErr != nil {
+			if err := txRepo.MarkFailed(ctx, dbgen.MarkOutboxEventFailedParams{
+				LastError:   sql.NullString{String: truncateError(pubErr), Valid: true},
+				AvailableAt: now.Add(RetryDelay(int(e.Attempts) + 1)),
+				ID:          e.ID,
+			}); err != nil {
+				return 0, 0, err
+			}
+			failed++
+			continue
+		}
+
+		if err := txRepo.MarkPublished(ctx, dbgen.MarkOutboxEventPublishedParams{
+			PublishedAt: sql.NullTime{Time: now, Valid: true},
+			ID:          e.ID,
+		}); err != nil {
+			return 0, 0, err
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+
+	return published, failed, nil
+}
+
+// RetryDelay mengembalikan jeda sebelum percobaan ke-attempt (dimulai dari 1)
+func RetryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func truncateError(err error) string {
+	msg := err.Error()
+	if len(msg) > maxErrorLength {
+		return msg[:maxErrorLength]
+	}
+	return msg
+}
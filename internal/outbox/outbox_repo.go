@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=outbox_repo.go -destination=mocks/outbox_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	ListPending(ctx context.Context, params dbgen.ListPendingOutboxEventsParams) ([]dbgen.Outbox, error)
+	MarkPublished(ctx context.Context, params dbgen.MarkOutboxEventPublishedParams) error
+	MarkFailed(ctx context.Context, params dbgen.MarkOutboxEventFailedParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) ListPending(ctx context.Context, params dbgen.ListPendingOutboxEventsParams) ([]dbgen.Outbox, error) {
+	return r.q.ListPendingOutboxEvents(ctx, params)
+}
+
+func (r *repository) MarkPublished(ctx context.Context, params dbgen.MarkOutboxEventPublishedParams) error {
+	return r.q.MarkOutboxEventPublished(ctx, params)
+}
+
+func (r *repository) MarkFailed(ctx context.Context, params dbgen.MarkOutboxEventFailedParams) error {
+	return r.q.MarkOutboxEventFailed(ctx, params)
+}
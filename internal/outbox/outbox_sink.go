@@ -0,0 +1,157 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultStream adalah nama Redis Stream tujuan event domain
+const DefaultStream = "events"
+
+// defaultStreamMaxLen membatasi panjang stream (perkiraan, lewat MAXLEN ~)
+const defaultStreamMaxLen = 100000
+
+// eraseScanCount adalah jumlah entry yang dibaca per XRANGE saat menghapus event customer
+const eraseScanCount = 500
+
+// Sink adalah tujuan publikasi event. Publish boleh dipanggil lebih dari sekali untuk
+// event yang sama (at-least-once), jadi konsumen harus dedup memakai Message.ID.
+type Sink interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// redisStreamSink menambahkan setiap event sebagai entry di Redis Stream
+type redisStreamSink struct {
+	rdb    *redis.Client
+	stream string
+}
+
+func NewRedisStreamSink(rdb *redis.Client, stream string) Sink {
+	if stream == "" {
+		stream = DefaultStream
+	}
+	return &redisStreamSink{rdb: rdb, stream: stream}
+}
+
+// Publish menambahkan entry ke stream. CustomerAnonymised lebih dulu menghapus entry lama milik
+// customer tersebut karena entry stream tidak bisa diubah dan payload-nya bisa berisi name & email.
+func (s *redisStreamSink) Publish(ctx context.Context, msg Message) error {
+	if msg.EventType == EventCustomerAnonymised {
+		if err := s.erase(ctx, AggregateCustomer, msg.AggregateID); err != nil {
+			return err
+		}
+	}
+
+	return s.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: defaultStreamMaxLen,
+		Approx: true,
+		Values: []interface{}{
+			"id", msg.ID,
+			"aggregate_type", msg.AggregateType,
+			"aggregate_id", msg.AggregateID,
+			"event_type", msg.EventType,
+			"payload", string(msg.Payload),
+			"occurred_at", msg.OccurredAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
+
+// erase menghapus (XDEL) semua entry milik satu aggregate. Aman diulang saat relay mengirim ulang event.
+func (s *redisStreamSink) erase(ctx context.Context, aggregateType, aggregateID string) error {
+	start := "-"
+	for {
+		entries, err := s.rdb.XRangeN(ctx, s.stream, start, "+", eraseScanCount).Result()
+		if err != nil {
+			return err
+		}
+
+		var ids []string
+		for _, e := range entries {
+			if e.Values["aggregate_type"] == aggregateType && e.Values["aggregate_id"] == aggregateID {
+				ids = append(ids, e.ID)
+			}
+		}
+		if len(ids) > 0 {
+			if err := s.rdb.XDel(ctx, s.stream, ids...).Err(); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < eraseScanCount {
+			return nil
+		}
+		// "(" membuat XRANGE mulai setelah entry terakhir yang sudah dibaca
+		start = "(" + entries[len(entries)-1].ID
+	}
+}
+
+// fanoutSink meneruskan event ke beberapa sink sekaligus
+type fanoutSink struct {
+	sinks []Sink
+}
+
+// NewFanoutSink mengirim setiap event ke semua sink. Jika salah satu gagal event
+// dicoba ulang ke semua sink, jadi setiap sink harus aman terhadap duplikat.
+func NewFanoutSink(sinks ...Sink) Sink {
+	return &fanoutSink{sinks: sinks}
+}
+
+func (s *fanoutSink) Publish(ctx context.Context, msg Message) error {
+	var errs []error
+	for _, sink := range s.sinks {
+		if err := sink.Publish(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MemorySink menyimpan event di memori; dipakai untuk test & development tanpa Redis
+type MemorySink struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Publish(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	// Sama seperti Redis Stream: event customer yang dianonimkan tidak disimpan lagi
+	if msg.EventType == EventCustomerAnonymised {
+		s.messages = slices.DeleteFunc(s.messages, func(m Message) bool {
+			return m.AggregateType == AggregateCustomer && m.AggregateID == msg.AggregateID
+		})
+	}
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// Messages mengembalikan salinan event yang sudah dipublikasikan
+func (s *MemorySink) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+// FailWith membuat Publish selanjutnya gagal dengan err; nil untuk memulihkan
+func (s *MemorySink) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
@@ -0,0 +1,242 @@
+package outbox_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	mockOutbox "assignment-ptes-achmad-rifai/internal/outbox/mocks"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type writerFunc func(ctx context.Context, params dbgen.CreateOutboxEventParams) error
+
+func (f writerFunc) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	return f(ctx, params)
+}
+
+func setupRelayTest(t *testing.T) (*outbox.Relay, *mockOutbox.MockRepository, *outbox.MemorySink, sqlmock.Sqlmock) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockOutbox.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	sink := outbox.NewMemorySink()
+	return outbox.NewRelay(db, repo, sink, time.Second), repo, sink, mock
+}
+
+func TestRecord(t *testing.T) {
+	var got dbgen.CreateOutboxEventParams
+	w := writerFunc(func(_ context.Context, params dbgen.CreateOutboxEventParams) error {
+		got = params
+		return nil
+	})
+
+	err := outbox.Record(context.Background(), w, outbox.AggregateCustomer, "c1", outbox.EventCustomerRegistered,
+		outbox.CustomerRegistered{CustomerID: "c1", Name: "Budi", Email: "budi@example.com"})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, outbox.AggregateCustomer, got.AggregateType)
+	assert.Equal(t, "c1", got.AggregateID)
+	assert.Equal(t, outbox.EventCustomerRegistered, got.EventType)
+	assert.JSONEq(t, `{"customer_id":"c1","name":"Budi","email":"budi@example.com"}`, string(got.Payload))
+}
+
+func TestRelay_PublishPending(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+	events := []dbgen.Outbox{
+		{ID: "e1", AggregateType: outbox.AggregateOrder, AggregateID: "o1", EventType: outbox.EventOrderPlaced, Payload: json.RawMessage(`{"order_id":"o1"}`), CreatedAt: now.Add(-time.Minute)},
+		{ID: "e2", AggregateType: outbox.AggregateProduct, AggregateID: "p1", EventType: outbox.EventStockDepleted, Payload: json.RawMessage(`{"product_id":"p1"}`), Attempts: 2},
+	}
+
+	t.Run("publishes_and_marks_events", func(t *testing.T) {
+		relay, repo, sink, mock := setupRelayTest(t)
+
+		mock.ExpectBegin()
+		repo.EXPECT().ListPending(ctx, dbgen.ListPendingOutboxEventsParams{Now: now, Limit: outbox.RelayBatchSize}).Return(events, nil)
+		repo.EXPECT().MarkPublished(ctx, dbgen.MarkOutboxEventPublishedParams{PublishedAt: sql.NullTime{Time: now, Valid: true}, ID: "e1"}).Return(nil)
+		repo.EXPECT().MarkPublished(ctx, dbgen.MarkOutboxEventPublishedParams{PublishedAt: sql.NullTime{Time: now, Valid: true}, ID: "e2"}).Return(nil)
+		mock.ExpectCommit()
+
+		published, failed, err := relay.PublishPending(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, published)
+		assert.Equal(t, 0, failed)
+		msgs := sink.Messages()
+		assert.Len(t, msgs, 2)
+		assert.Equal(t, "e1", msgs[0].ID)
+		assert.Equal(t, outbox.EventOrderPlaced, msgs[0].EventType)
+		assert.Equal(t, now.Add(-time.Minute), msgs[0].OccurredAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("sink_failure_schedules_retry", func(t *testing.T) {
+		relay, repo, sink, mock := setupRelayTest(t)
+		sink.FailWith(errors.New("connection refused"))
+
+		mock.ExpectBegin()
+		repo.EXPECT().ListPending(ctx, gomock.Any()).Return(events, nil)
+		repo.EXPECT().MarkFailed(ctx, dbgen.MarkOutboxEventFailedParams{
+			LastError:   sql.NullString{String: "connection refused", Valid: true},
+			AvailableAt: now.Add(5 * time.Second),
+			ID:          "e1",
+		}).Return(nil)
+		repo.EXPECT().MarkFailed(ctx, dbgen.MarkOutboxEventFailedParams{
+			LastError:   sql.NullString{String: "connection refused", Valid: true},
+			AvailableAt: now.Add(20 * time.Second),
+			ID:          "e2",
+		}).Return(nil)
+		mock.ExpectCommit()
+
+		published, failed, err := relay.PublishPending(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, published)
+		assert.Equal(t, 2, failed)
+		assert.Empty(t, sink.Messages())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("mark_error_rolls_back", func(t *testing.T) {
+		relay, repo, _, mock := setupRelayTest(t)
+
+		mock.ExpectBegin()
+		repo.EXPECT().ListPending(ctx, gomock.Any()).Return(events[:1], nil)
+		repo.EXPECT().MarkPublished(ctx, gomock.Any()).Return(errors.New("db down"))
+		mock.ExpectRollback()
+
+		_, _, err := relay.PublishPending(ctx, now)
+
+		assert.EqualError(t, err, "db down")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, outbox.RetryDelay(1))
+	assert.Equal(t, 10*time.Second, outbox.RetryDelay(2))
+	assert.Equal(t, 40*time.Second, outbox.RetryDelay(4))
+	assert.Equal(t, time.Hour, outbox.RetryDelay(20))
+}
+
+func TestRedisStreamSink_Publish(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	sink := outbox.NewRedisStreamSink(rdb, "")
+	occurred := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectXAdd(&redis.XAddArgs{
+		Stream: outbox.DefaultStream,
+		MaxLen: 100000,
+		Approx: true,
+		Values: []interface{}{
+			"id", "e1",
+			"aggregate_type", outbox.AggregateOrder,
+			"aggregate_id", "o1",
+			"event_type", outbox.EventOrderCancelled,
+			"payload", `{"order_id":"o1"}`,
+			"occurred_at", "2025-01-10T08:00:00Z",
+		},
+	}).SetVal("1-0")
+
+	err := sink.Publish(context.Background(), outbox.Message{
+		ID:            "e1",
+		AggregateType: outbox.AggregateOrder,
+		AggregateID:   "o1",
+		EventType:     outbox.EventOrderCancelled,
+		Payload:       json.RawMessage(`{"order_id":"o1"}`),
+		OccurredAt:    occurred,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRedisStreamSink_PublishCustomerAnonymised(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	sink := outbox.NewRedisStreamSink(rdb, "")
+	occurred := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectXRangeN(outbox.DefaultStream, "-", "+", 500).SetVal([]redis.XMessage{
+		{ID: "1-0", Values: map[string]interface{}{"aggregate_type": outbox.AggregateCustomer, "aggregate_id": "c1", "event_type": outbox.EventCustomerRegistered}},
+		{ID: "2-0", Values: map[string]interface{}{"aggregate_type": outbox.AggregateCustomer, "aggregate_id": "c2", "event_type": outbox.EventCustomerRegistered}},
+		{ID: "3-0", Values: map[string]interface{}{"aggregate_type": outbox.AggregateOrder, "aggregate_id": "c1", "event_type": outbox.EventOrderPlaced}},
+	})
+	mock.ExpectXDel(outbox.DefaultStream, "1-0").SetVal(1)
+	mock.ExpectXAdd(&redis.XAddArgs{
+		Stream: outbox.DefaultStream,
+		MaxLen: 100000,
+		Approx: true,
+		Values: []interface{}{
+			"id", "e2",
+			"aggregate_type", outbox.AggregateCustomer,
+			"aggregate_id", "c1",
+			"event_type", outbox.EventCustomerAnonymised,
+			"payload", `{"customer_id":"c1"}`,
+			"occurred_at", "2025-01-10T08:00:00Z",
+		},
+	}).SetVal("4-0")
+
+	err := sink.Publish(context.Background(), outbox.Message{
+		ID:            "e2",
+		AggregateType: outbox.AggregateCustomer,
+		AggregateID:   "c1",
+		EventType:     outbox.EventCustomerAnonymised,
+		Payload:       json.RawMessage(`{"customer_id":"c1"}`),
+		OccurredAt:    occurred,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMemorySink_PublishCustomerAnonymised(t *testing.T) {
+	sink := outbox.NewMemorySink()
+	ctx := context.Background()
+
+	assert.NoError(t, sink.Publish(ctx, outbox.Message{ID: "e1", AggregateType: outbox.AggregateCustomer, AggregateID: "c1", EventType: outbox.EventCustomerRegistered}))
+	assert.NoError(t, sink.Publish(ctx, outbox.Message{ID: "e2", AggregateType: outbox.AggregateCustomer, AggregateID: "c2", EventType: outbox.EventCustomerRegistered}))
+	assert.NoError(t, sink.Publish(ctx, outbox.Message{ID: "e3", AggregateType: outbox.AggregateCustomer, AggregateID: "c1", EventType: outbox.EventCustomerAnonymised}))
+
+	msgs := sink.Messages()
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, "e2", msgs[0].ID)
+	assert.Equal(t, "e3", msgs[1].ID)
+}
+
+func TestFanoutSink_Publish(t *testing.T) {
+	first, second := outbox.NewMemorySink(), outbox.NewMemorySink()
+	sink := outbox.NewFanoutSink(first, second)
+	msg := outbox.Message{ID: "e1", EventType: outbox.EventOrderPlaced}
+
+	second.FailWith(errors.New("webhook store down"))
+	err := sink.Publish(context.Background(), msg)
+
+	assert.Error(t, err)
+	assert.Len(t, first.Messages(), 1, "sink lain tetap menerima event")
+	assert.Empty(t, second.Messages())
+
+	second.FailWith(nil)
+	assert.NoError(t, sink.Publish(context.Background(), msg))
+	assert.Len(t, second.Messages(), 1)
+}
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_repo.go -destination=mocks/payment_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	payment "assignment-ptes-achmad-rifai/internal/payment"
+	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIntent mocks base method.
+func (m *MockRepository) CreateIntent(ctx context.Context, params dbgen.CreatePaymentIntentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIntent", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIntent indicates an expected call of CreateIntent.
+func (mr *MockRepositoryMockRecorder) CreateIntent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIntent", reflect.TypeOf((*MockRepository)(nil).CreateIntent), ctx, params)
+}
+
+// CreateWebhookEvent mocks base method.
+func (m *MockRepository) CreateWebhookEvent(ctx context.Context, params dbgen.CreatePaymentWebhookEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEvent", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookEvent indicates an expected call of CreateWebhookEvent.
+func (mr *MockRepositoryMockRecorder) CreateWebhookEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEvent", reflect.TypeOf((*MockRepository)(nil).CreateWebhookEvent), ctx, params)
+}
+
+// FlagIntentForRefund mocks base method.
+func (m *MockRepository) FlagIntentForRefund(ctx context.Context, params dbgen.FlagPaymentIntentForRefundParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagIntentForRefund", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlagIntentForRefund indicates an expected call of FlagIntentForRefund.
+func (mr *MockRepositoryMockRecorder) FlagIntentForRefund(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagIntentForRefund", reflect.TypeOf((*MockRepository)(nil).FlagIntentForRefund), ctx, params)
+}
+
+// GetIntentByID mocks base method.
+func (m *MockRepository) GetIntentByID(ctx context.Context, id string) (dbgen.PaymentIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntentByID", ctx, id)
+	ret0, _ := ret[0].(dbgen.PaymentIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntentByID indicates an expected call of GetIntentByID.
+func (mr *MockRepositoryMockRecorder) GetIntentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntentByID", reflect.TypeOf((*MockRepository)(nil).GetIntentByID), ctx, id)
+}
+
+// GetIntentByReferenceForUpdate mocks base method.
+func (m *MockRepository) GetIntentByReferenceForUpdate(ctx context.Context, params dbgen.GetPaymentIntentByReferenceForUpdateParams) (dbgen.PaymentIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntentByReferenceForUpdate", ctx, params)
+	ret0, _ := ret[0].(dbgen.PaymentIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntentByReferenceForUpdate indicates an expected call of GetIntentByReferenceForUpdate.
+func (mr *MockRepositoryMockRecorder) GetIntentByReferenceForUpdate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntentByReferenceForUpdate", reflect.TypeOf((*MockRepository)(nil).GetIntentByReferenceForUpdate), ctx, params)
+}
+
+// GetOrderPaymentInfo mocks base method.
+func (m *MockRepository) GetOrderPaymentInfo(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPaymentInfo", ctx, orderID)
+	ret0, _ := ret[0].(dbgen.GetOrderPaymentInfoRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPaymentInfo indicates an expected call of GetOrderPaymentInfo.
+func (mr *MockRepositoryMockRecorder) GetOrderPaymentInfo(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPaymentInfo", reflect.TypeOf((*MockRepository)(nil).GetOrderPaymentInfo), ctx, orderID)
+}
+
+// GetOrderPaymentInfoForUpdate mocks base method.
+func (m *MockRepository) GetOrderPaymentInfoForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPaymentInfoForUpdate", ctx, orderID)
+	ret0, _ := ret[0].(dbgen.GetOrderPaymentInfoForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPaymentInfoForUpdate indicates an expected call of GetOrderPaymentInfoForUpdate.
+func (mr *MockRepositoryMockRecorder) GetOrderPaymentInfoForUpdate(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPaymentInfoForUpdate", reflect.TypeOf((*MockRepository)(nil).GetOrderPaymentInfoForUpdate), ctx, orderID)
+}
+
+// GetPendingIntentByOrder mocks base method.
+func (m *MockRepository) GetPendingIntentByOrder(ctx context.Context, orderID string) (dbgen.PaymentIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingIntentByOrder", ctx, orderID)
+	ret0, _ := ret[0].(dbgen.PaymentIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingIntentByOrder indicates an expected call of GetPendingIntentByOrder.
+func (mr *MockRepositoryMockRecorder) GetPendingIntentByOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingIntentByOrder", reflect.TypeOf((*MockRepository)(nil).GetPendingIntentByOrder), ctx, orderID)
+}
+
+// ListIntentsByOrder mocks base method.
+func (m *MockRepository) ListIntentsByOrder(ctx context.Context, orderID string) ([]dbgen.PaymentIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIntentsByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]dbgen.PaymentIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIntentsByOrder indicates an expected call of ListIntentsByOrder.
+func (mr *MockRepositoryMockRecorder) ListIntentsByOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntentsByOrder", reflect.TypeOf((*MockRepository)(nil).ListIntentsByOrder), ctx, orderID)
+}
+
+// UpdateIntentStatus mocks base method.
+func (m *MockRepository) UpdateIntentStatus(ctx context.Context, params dbgen.UpdatePaymentIntentStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIntentStatus", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIntentStatus indicates an expected call of UpdateIntentStatus.
+func (mr *MockRepositoryMockRecorder) UpdateIntentStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIntentStatus", reflect.TypeOf((*MockRepository)(nil).UpdateIntentStatus), ctx, params)
+}
+
+// UpdateOrderPaymentStatus mocks base method.
+func (m *MockRepository) UpdateOrderPaymentStatus(ctx context.Context, params dbgen.UpdateOrderPaymentStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderPaymentStatus", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderPaymentStatus indicates an expected call of UpdateOrderPaymentStatus.
+func (mr *MockRepositoryMockRecorder) UpdateOrderPaymentStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderPaymentStatus", reflect.TypeOf((*MockRepository)(nil).UpdateOrderPaymentStatus), ctx, params)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) payment.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(payment.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
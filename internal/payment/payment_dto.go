@@ -0,0 +1,30 @@
+package payment
+
+import "time"
+
+// SimulateRequest dipakai untuk memicu webhook bertanda tangan dari fake gateway
+type SimulateRequest struct {
+	Status        string `json:"status" binding:"required,oneof=succeeded failed cancelled"`
+	FailureReason string `json:"failure_reason" binding:"omitempty,max=255"`
+}
+
+type IntentResponse struct {
+	ID               string  `json:"id"`
+	OrderID          string  `json:"order_id"`
+	Gateway          string  `json:"gateway"`
+	GatewayReference string  `json:"gateway_reference"`
+	Amount           float64 `json:"amount"`
+	Currency         string  `json:"currency"`
+	Status           string  `json:"status"`
+	PaymentURL       string  `json:"payment_url,omitempty"`
+	FailureReason    string  `json:"failure_reason,omitempty"`
+	// RefundReason terisi jika dana sudah diterima gateway tetapi tidak bisa melunasi order
+	RefundReason string    `json:"refund_reason,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type WebhookResponse struct {
+	EventID   string `json:"event_id"`
+	Duplicate bool   `json:"duplicate"` // true jika event sudah pernah diproses sebelumnya
+}
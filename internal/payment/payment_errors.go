@@ -0,0 +1,15 @@
+package payment
+
+import "errors"
+
+var (
+	ErrOrderNotFound         = errors.New("order not found")
+	ErrOrderAlreadyPaid      = errors.New("order is already paid")
+	ErrOrderCancelled        = errors.New("order is cancelled")
+	ErrPaymentNotFound       = errors.New("payment intent not found")
+	ErrUnknownGateway        = errors.New("unknown payment gateway")
+	ErrInvalidSignature      = errors.New("invalid webhook signature")
+	ErrInvalidEvent          = errors.New("invalid webhook event")
+	ErrSimulationUnsupported = errors.New("payment simulation is only available with the fake gateway")
+	ErrPaymentNotSimulatable = errors.New("only pending payments can be simulated")
+)
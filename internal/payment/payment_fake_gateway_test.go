@@ -0,0 +1,90 @@
+package payment_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/payment"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeGateway_CreateIntent(t *testing.T) {
+	gw := payment.NewFakeGateway("secret", "https://pay.test/")
+
+	gi, err := gw.CreateIntent(context.Background(), payment.IntentRequest{
+		IntentID: "pi-1",
+		OrderID:  "order-1",
+		Amount:   decimal.NewFromInt(50000),
+		Currency: payment.DefaultCurrency,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "fake_pi_pi-1", gi.Reference)
+	assert.Equal(t, "https://pay.test/fake_pi_pi-1", gi.PaymentURL)
+	assert.Equal(t, payment.StatusPending, gi.Status)
+}
+
+func TestFakeGateway_ParseWebhook(t *testing.T) {
+	gw := payment.NewFakeGateway("secret", "")
+
+	t.Run("simulated_event_round_trip", func(t *testing.T) {
+		payload, header, err := gw.SimulateEvent("fake_pi_1", payment.StatusFailed, "card declined")
+		assert.NoError(t, err)
+
+		evt, err := gw.ParseWebhook(payload, header)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, evt.ID)
+		assert.Equal(t, "payment_intent.failed", evt.Type)
+		assert.Equal(t, "fake_pi_1", evt.Reference)
+		assert.Equal(t, payment.StatusFailed, evt.Status)
+		assert.Equal(t, "card declined", evt.FailureReason)
+	})
+
+	t.Run("tampered_payload", func(t *testing.T) {
+		payload, header, _ := gw.SimulateEvent("fake_pi_1", payment.StatusSucceeded, "")
+		payload[len(payload)-2] = 'X'
+
+		_, err := gw.ParseWebhook(payload, header)
+
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+
+	t.Run("wrong_secret", func(t *testing.T) {
+		payload, header, _ := payment.NewFakeGateway("other", "").SimulateEvent("fake_pi_1", payment.StatusSucceeded, "")
+
+		_, err := gw.ParseWebhook(payload, header)
+
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+
+	t.Run("missing_header", func(t *testing.T) {
+		payload, _, _ := gw.SimulateEvent("fake_pi_1", payment.StatusSucceeded, "")
+
+		_, err := gw.ParseWebhook(payload, http.Header{})
+
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+
+	t.Run("stale_timestamp", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_1","type":"payment_intent.succeeded","data":{"reference":"fake_pi_1","status":"succeeded"}}`)
+		ts := fmt.Sprint(time.Now().Add(-time.Hour).Unix())
+
+		// Signature valid tetapi timestamp di luar toleransi (replay)
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(ts + "." + string(payload)))
+		header := http.Header{}
+		header.Set(payment.SignatureHeader, "t="+ts+",v1="+hex.EncodeToString(mac.Sum(nil)))
+
+		_, err := gw.ParseWebhook(payload, header)
+
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
+
+// Status payment intent
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+// DefaultCurrency dipakai untuk semua intent; harga di sistem ini dalam Rupiah
+const DefaultCurrency = "IDR"
+
+// PaymentGateway adalah abstraksi penyedia pembayaran (Midtrans, Xendit, fake, ...)
+type PaymentGateway interface {
+	Name() string
+	CreateIntent(ctx context.Context, req IntentRequest) (GatewayIntent, error)
+	// ParseWebhook memverifikasi signature callback lalu mengurai event-nya.
+	// Mengembalikan ErrInvalidSignature jika signature tidak valid.
+	ParseWebhook(payload []byte, header http.Header) (WebhookEvent, error)
+}
+
+// Simulator diimplementasikan gateway yang bisa membuat event webhook bertanda tangan
+// secara lokal (fake gateway), sehingga alur pembayaran bisa dites tanpa jaringan
+type Simulator interface {
+	SimulateEvent(reference, status, failureReason string) (payload []byte, header http.Header, err error)
+}
+
+type IntentRequest struct {
+	IntentID string // ID intent di sistem kita, dipakai gateway sebagai idempotency key
+	OrderID  string
+	Amount   decimal.Decimal
+	Currency string
+}
+
+type GatewayIntent struct {
+	Reference  string
+	PaymentURL string
+	Status     string
+}
+
+// WebhookEvent adalah event yang sudah diverifikasi & dinormalisasi dari gateway
+type WebhookEvent struct {
+	ID            string
+	Type          string
+	Reference     string
+	Status        string
+	FailureReason string
+}
@@ -0,0 +1,148 @@
+package payment
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// CreateIntent godoc
+// @Summary      Create payment intent
+// @Description  Create a payment intent for the order total. A pending intent with the same amount is returned as-is, so the call is safe to retry
+// @Tags         payments
+// @Produce      json
+// @Param        id       path      string  true  "Order ID"
+// @Success      201      {object}  IntentResponse
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Failure      409      {object}  map[string]string "Order already paid or cancelled"
+// @Router       /orders/{id}/payments [post]
+func (h *Handler) CreateIntent(c *gin.Context) {
+	res, err := h.service.CreateIntent(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create payment intent")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// ListByOrder godoc
+// @Summary      List order payments
+// @Description  Retrieve all payment intents of an order, newest first
+// @Tags         payments
+// @Produce      json
+// @Param        id       path      string  true  "Order ID"
+// @Success      200      {array}   IntentResponse
+// @Failure      404      {object}  map[string]string "Order not found"
+// @Router       /orders/{id}/payments [get]
+func (h *Handler) ListByOrder(c *gin.Context) {
+	res, err := h.service.ListByOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch payments")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get payment intent
+// @Description  Retrieve a single payment intent
+// @Tags         payments
+// @Produce      json
+// @Param        id       path      string  true  "Payment Intent ID"
+// @Success      200      {object}  IntentResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /payments/{id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get payment intent")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Simulate godoc
+// @Summary      Simulate payment result
+// @Description  Fake gateway only, registered when APP_ENV=development: send a signed webhook that settles a pending intent, so the full payment flow works offline
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string           true  "Payment Intent ID"
+// @Param        request  body      SimulateRequest  true  "Simulated result"
+// @Success      200      {object}  IntentResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Payment is no longer pending"
+// @Router       /payments/{id}/simulate [post]
+func (h *Handler) Simulate(c *gin.Context) {
+	var req SimulateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Simulate(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "SIMULATE_ERROR", "Failed to simulate payment")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Webhook godoc
+// @Summary      Payment gateway webhook
+// @Description  Receive a signed callback from the payment gateway. Events are processed once; redelivered events are acknowledged without side effects. A successful payment whose amount no longer matches the order total, or whose intent was already cancelled, does not mark the order paid and is flagged with refund_reason
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Param        gateway  path      string  true  "Gateway name, e.g. fake"
+// @Param        X-Payment-Signature  header  string  true  "t=<unix>,v1=<hex HMAC-SHA256>"
+// @Success      200      {object}  WebhookResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      401      {object}  map[string]string "Invalid signature"
+// @Failure      404      {object}  map[string]string
+// @Router       /payments/webhooks/{gateway} [post]
+func (h *Handler) Webhook(c *gin.Context) {
+	// Signature dihitung dari body mentah, jadi body tidak boleh di-bind ulang
+	payload, err := c.GetRawData()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.HandleWebhook(c.Request.Context(), c.Param("gateway"), payload, c.Request.Header)
+	if err != nil {
+		handleError(c, err, "WEBHOOK_ERROR", "Failed to process webhook")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrOrderNotFound), errors.Is(err, ErrPaymentNotFound), errors.Is(err, ErrUnknownGateway):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrOrderAlreadyPaid):
+		response.Error(c, http.StatusConflict, "ALREADY_PAID", err.Error(), nil)
+	case errors.Is(err, ErrOrderCancelled):
+		response.Error(c, http.StatusConflict, "ORDER_CANCELLED", err.Error(), nil)
+	case errors.Is(err, ErrPaymentNotSimulatable):
+		response.Error(c, http.StatusConflict, "PAYMENT_NOT_PENDING", err.Error(), nil)
+	case errors.Is(err, ErrInvalidSignature):
+		response.Error(c, http.StatusUnauthorized, "INVALID_SIGNATURE", err.Error(), nil)
+	case errors.Is(err, ErrInvalidEvent), errors.Is(err, ErrSimulationUnsupported):
+		response.Error(c, http.StatusBadRequest, "BAD_REQUEST", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
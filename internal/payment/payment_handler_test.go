@@ -0,0 +1,147 @@
+package payment_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/payment"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakePaymentService struct {
+	CreateIntentFn  func(ctx context.Context, orderID string) (payment.IntentResponse, error)
+	GetByIDFn       func(ctx context.Context, id string) (payment.IntentResponse, error)
+	ListByOrderFn   func(ctx context.Context, orderID string) ([]payment.IntentResponse, error)
+	HandleWebhookFn func(ctx context.Context, gateway string, payload []byte, header http.Header) (payment.WebhookResponse, error)
+	SimulateFn      func(ctx context.Context, id string, req payment.SimulateRequest) (payment.IntentResponse, error)
+}
+
+func (f *fakePaymentService) CreateIntent(ctx context.Context, orderID string) (payment.IntentResponse, error) {
+	return f.CreateIntentFn(ctx, orderID)
+}
+
+func (f *fakePaymentService) GetByID(ctx context.Context, id string) (payment.IntentResponse, error) {
+	return f.GetByIDFn(ctx, id)
+}
+
+func (f *fakePaymentService) ListByOrder(ctx context.Context, orderID string) ([]payment.IntentResponse, error) {
+	return f.ListByOrderFn(ctx, orderID)
+}
+
+func (f *fakePaymentService) HandleWebhook(ctx context.Context, gateway string, payload []byte, header http.Header) (payment.WebhookResponse, error) {
+	return f.HandleWebhookFn(ctx, gateway, payload, header)
+}
+
+func (f *fakePaymentService) Simulate(ctx context.Context, id string, req payment.SimulateRequest) (payment.IntentResponse, error) {
+	return f.SimulateFn(ctx, id, req)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_CreateIntent(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusCreated},
+		{"order not found", payment.ErrOrderNotFound, http.StatusNotFound},
+		{"already paid", payment.ErrOrderAlreadyPaid, http.StatusConflict},
+		{"order cancelled", payment.ErrOrderCancelled, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakePaymentService{
+				CreateIntentFn: func(ctx context.Context, orderID string) (payment.IntentResponse, error) {
+					return payment.IntentResponse{ID: "pi-1", OrderID: orderID}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			payment.RegisterRoutes(r.Group(""), payment.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/order-1/payments", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Webhook(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusOK},
+		{"invalid signature", payment.ErrInvalidSignature, http.StatusUnauthorized},
+		{"unknown gateway", payment.ErrUnknownGateway, http.StatusNotFound},
+		{"invalid event", payment.ErrInvalidEvent, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			body := `{"id":"evt_1"}`
+			svc := &fakePaymentService{
+				HandleWebhookFn: func(ctx context.Context, gateway string, payload []byte, header http.Header) (payment.WebhookResponse, error) {
+					// Body mentah & header harus diteruskan apa adanya untuk verifikasi signature
+					assert.Equal(t, "fake", gateway)
+					assert.Equal(t, body, string(payload))
+					assert.Equal(t, "t=1,v1=abc", header.Get(payment.SignatureHeader))
+					return payment.WebhookResponse{EventID: "evt_1"}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			payment.RegisterRoutes(r.Group(""), payment.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/payments/webhooks/fake", strings.NewReader(body))
+			req.Header.Set(payment.SignatureHeader, "t=1,v1=abc")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Simulate_InvalidStatus(t *testing.T) {
+	r := setupTestRouter()
+	payment.RegisterDevRoutes(r.Group(""), payment.NewHandler(&fakePaymentService{}))
+
+	req := httptest.NewRequest(http.MethodPost, "/payments/pi-1/simulate", strings.NewReader(`{"status":"refunded"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRegisterRoutes_SimulateOnlyInDevRoutes(t *testing.T) {
+	r := setupTestRouter()
+	payment.RegisterRoutes(r.Group(""), payment.NewHandler(&fakePaymentService{}))
+
+	req := httptest.NewRequest(http.MethodPost, "/payments/pi-1/simulate", strings.NewReader(`{"status":"succeeded"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
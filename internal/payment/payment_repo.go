@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=payment_repo.go -destination=mocks/payment_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	CreateIntent(ctx context.Context, params dbgen.CreatePaymentIntentParams) error
+	GetIntentByID(ctx context.Context, id string) (dbgen.PaymentIntent, error)
+	GetIntentByReferenceForUpdate(ctx context.Context, params dbgen.GetPaymentIntentByReferenceForUpdateParams) (dbgen.PaymentIntent, error)
+	GetPendingIntentByOrder(ctx context.Context, orderID string) (dbgen.PaymentIntent, error)
+	ListIntentsByOrder(ctx context.Context, orderID string) ([]dbgen.PaymentIntent, error)
+	UpdateIntentStatus(ctx context.Context, params dbgen.UpdatePaymentIntentStatusParams) error
+	FlagIntentForRefund(ctx context.Context, params dbgen.FlagPaymentIntentForRefundParams) error
+
+	// CreateWebhookEvent gagal dengan duplicate key jika event sudah pernah diproses
+	CreateWebhookEvent(ctx context.Context, params dbgen.CreatePaymentWebhookEventParams) error
+
+	// Order helpers
+	GetOrderPaymentInfo(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoRow, error)
+	GetOrderPaymentInfoForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoForUpdateRow, error)
+	UpdateOrderPaymentStatus(ctx context.Context, params dbgen.UpdateOrderPaymentStatusParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) CreateIntent(ctx context.Context, params dbgen.CreatePaymentIntentParams) error {
+	return r.q.CreatePaymentIntent(ctx, params)
+}
+
+func (r *repository) GetIntentByID(ctx context.Context, id string) (dbgen.PaymentIntent, error) {
+	return r.q.GetPaymentIntentByID(ctx, id)
+}
+
+func (r *repository) GetIntentByReferenceForUpdate(ctx context.Context, params dbgen.GetPaymentIntentByReferenceForUpdateParams) (dbgen.PaymentIntent, error) {
+	return r.q.GetPaymentIntentByReferenceForUpdate(ctx, params)
+}
+
+func (r *repository) GetPendingIntentByOrder(ctx context.Context, orderID string) (dbgen.PaymentIntent, error) {
+	return r.q.GetPendingPaymentIntentByOrder(ctx, orderID)
+}
+
+func (r *repository) ListIntentsByOrder(ctx context.Context, orderID string) ([]dbgen.PaymentIntent, error) {
+	return r.q.ListPaymentIntentsByOrder(ctx, orderID)
+}
+
+func (r *repository) UpdateIntentStatus(ctx context.Context, params dbgen.UpdatePaymentIntentStatusParams) error {
+	return r.q.UpdatePaymentIntentStatus(ctx, params)
+}
+
+func (r *repository) FlagIntentForRefund(ctx context.Context, params dbgen.FlagPaymentIntentForRefundParams) error {
+	return r.q.FlagPaymentIntentForRefund(ctx, params)
+}
+
+func (r *repository) CreateWebhookEvent(ctx context.Context, params dbgen.CreatePaymentWebhookEventParams) error {
+	return r.q.CreatePaymentWebhookEvent(ctx, params)
+}
+
+func (r *repository) GetOrderPaymentInfo(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoRow, error) {
+	return r.q.GetOrderPaymentInfo(ctx, orderID)
+}
+
+func (r *repository) GetOrderPaymentInfoForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderPaymentInfoForUpdateRow, error) {
+	return r.q.GetOrderPaymentInfoForUpdate(ctx, orderID)
+}
+
+func (r *repository) UpdateOrderPaymentStatus(ctx context.Context, params dbgen.UpdateOrderPaymentStatusParams) error {
+	return r.q.UpdateOrderPaymentStatus(ctx, params)
+}
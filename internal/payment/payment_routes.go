@@ -0,0 +1,22 @@
+package payment
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	orders := r.Group("/orders/:id/payments")
+	{
+		orders.POST("", handler.CreateIntent)
+		orders.GET("", handler.ListByOrder)
+	}
+
+	payments := r.Group("/payments")
+	{
+		payments.POST("/webhooks/:gateway", handler.Webhook)
+		payments.GET("/:id", handler.GetByID)
+	}
+}
+
+// RegisterDevRoutes mendaftarkan endpoint khusus development (APP_ENV=development)
+func RegisterDevRoutes(r *gin.RouterGroup, handler *Handler) {
+	r.POST("/payments/:id/simulate", handler.Simulate)
+}
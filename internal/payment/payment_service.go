@@ -0,0 +1,324 @@
+package payment
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=payment_service.go -destination=mocks/payment_service_mock.go -package=mock
+type Service interface {
+	CreateIntent(ctx context.Context, orderID string) (IntentResponse, error)
+	GetByID(ctx context.Context, id string) (IntentResponse, error)
+	ListByOrder(ctx context.Context, orderID string) ([]IntentResponse, error)
+	HandleWebhook(ctx context.Context, gateway string, payload []byte, header http.Header) (WebhookResponse, error)
+	Simulate(ctx context.Context, id string, req SimulateRequest) (IntentResponse, error)
+}
+
+// orderPaid sama dengan order.PaymentStatusPaid
+const orderPaid = "paid"
+
+// orderCancelled sama dengan order.OrderStatusCancelled
+const orderCancelled = "cancelled"
+
+type service struct {
+	db      *sql.DB // Diperlukan untuk memulai transaksi webhook
+	repo    Repository
+	gateway PaymentGateway
+}
+
+func NewService(db *sql.DB, repo Repository, gateway PaymentGateway) Service {
+	return &service{
+		db:      db,
+		repo:    repo,
+		gateway: gateway,
+	}
+}
+
+// CreateIntent membuat intent pembayaran sebesar total order. Intent pending yang
+// nominalnya masih sama dipakai ulang sehingga klien aman mengulang request.
+func (s *service) CreateIntent(ctx context.Context, orderID string) (IntentResponse, error) {
+	order, err := s.repo.GetOrderPaymentInfo(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return IntentResponse{}, ErrOrderNotFound
+		}
+		return IntentResponse{}, err
+	}
+	if order.PaymentStatus == orderPaid {
+		return IntentResponse{}, ErrOrderAlreadyPaid
+	}
+	if order.Status == orderCancelled {
+		return IntentResponse{}, ErrOrderCancelled
+	}
+
+	pending, err := s.repo.GetPendingIntentByOrder(ctx, orderID)
+	switch {
+	case err == nil:
+		if pending.Gateway == s.gateway.Name() && pending.Amount.Equal(order.TotalPrice) {
+			return mapToResponse(pending), nil
+		}
+		// Total order berubah sejak intent dibuat, intent lama dibatalkan
+		if err := s.repo.UpdateIntentStatus(ctx, dbgen.UpdatePaymentIntentStatusParams{
+			Status:        StatusCancelled,
+			FailureReason: sql.NullString{String: "superseded by a new payment intent", Valid: true},
+			ID:            pending.ID,
+		}); err != nil {
+			return IntentResponse{}, err
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return IntentResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return IntentResponse{}, err
+	}
+	id := newUUID.String()
+
+	gi, err := s.gateway.CreateIntent(ctx, IntentRequest{
+		IntentID: id,
+		OrderID:  orderID,
+		Amount:   order.TotalPrice,
+		Currency: DefaultCurrency,
+	})
+	if err != nil {
+		return IntentResponse{}, err
+	}
+
+	status := gi.Status
+	if status == "" {
+		status = StatusPending
+	}
+
+	if err := s.repo.CreateIntent(ctx, dbgen.CreatePaymentIntentParams{
+		ID:               id,
+		OrderID:          orderID,
+		Gateway:          s.gateway.Name(),
+		GatewayReference: gi.Reference,
+		Amount:           order.TotalPrice,
+		Currency:         DefaultCurrency,
+		Status:           status,
+		PaymentUrl:       sql.NullString{String: gi.PaymentURL, Valid: gi.PaymentURL != ""},
+	}); err != nil {
+		return IntentResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (IntentResponse, error) {
+	row, err := s.repo.GetIntentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return IntentResponse{}, ErrPaymentNotFound
+		}
+		return IntentResponse{}, err
+	}
+
+	return mapToResponse(row), nil
+}
+
+func (s *service) ListByOrder(ctx context.Context, orderID string) ([]IntentResponse, error) {
+	if _, err := s.repo.GetOrderPaymentInfo(ctx, orderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := s.repo.ListIntentsByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]IntentResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapToResponse(r))
+	}
+
+	return res, nil
+}
+
+// HandleWebhook memverifikasi signature lalu memproses event dalam satu transaksi.
+// Event dicatat berdasarkan (gateway, event_id) sehingga pengiriman ulang dari
+// gateway tidak diproses dua kali.
+func (s *service) HandleWebhook(ctx context.Context, gateway string, payload []byte, header http.Header) (WebhookResponse, error) {
+	if gateway != s.gateway.Name() {
+		return WebhookResponse{}, ErrUnknownGateway
+	}
+
+	evt, err := s.gateway.ParseWebhook(payload, header)
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+
+	if err := txRepo.CreateWebhookEvent(ctx, dbgen.CreatePaymentWebhookEventParams{
+		ID:        newUUID.String(),
+		Gateway:   gateway,
+		EventID:   evt.ID,
+		EventType: evt.Type,
+		Payload:   json.RawMessage(payload),
+	}); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return WebhookResponse{EventID: evt.ID, Duplicate: true}, nil
+		}
+		return WebhookResponse{}, err
+	}
+
+	intent, err := txRepo.GetIntentByReferenceForUpdate(ctx, dbgen.GetPaymentIntentByReferenceForUpdateParams{
+		Gateway:          gateway,
+		GatewayReference: evt.Reference,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WebhookResponse{}, ErrPaymentNotFound
+		}
+		return WebhookResponse{}, err
+	}
+
+	if err := applyEvent(ctx, txRepo, intent, evt); err != nil {
+		return WebhookResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return WebhookResponse{}, err
+	}
+
+	return WebhookResponse{EventID: evt.ID}, nil
+}
+
+// Simulate membuat event webhook bertanda tangan lewat gateway yang mendukung
+// simulasi (fake gateway) lalu memprosesnya melalui jalur webhook yang sama.
+func (s *service) Simulate(ctx context.Context, id string, req SimulateRequest) (IntentResponse, error) {
+	sim, ok := s.gateway.(Simulator)
+	if !ok {
+		return IntentResponse{}, ErrSimulationUnsupported
+	}
+
+	intent, err := s.repo.GetIntentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return IntentResponse{}, ErrPaymentNotFound
+		}
+		return IntentResponse{}, err
+	}
+	if intent.Status != StatusPending {
+		return IntentResponse{}, ErrPaymentNotSimulatable
+	}
+
+	payload, header, err := sim.SimulateEvent(intent.GatewayReference, req.Status, req.FailureReason)
+	if err != nil {
+		return IntentResponse{}, err
+	}
+
+	if _, err := s.HandleWebhook(ctx, intent.Gateway, payload, header); err != nil {
+		return IntentResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// applyEvent menerapkan transisi status. Hanya intent pending yang bisa berubah,
+// event untuk intent yang sudah final dicatat tetapi diabaikan. Pembayaran yang berhasil
+// namun tidak bisa melunasi order (order dibatalkan atau total berbeda) ditandai untuk refund,
+// order tetap unpaid.
+func applyEvent(ctx context.Context, repo Repository, intent dbgen.PaymentIntent, evt WebhookEvent) error {
+	switch evt.Status {
+	case StatusSucceeded, StatusFailed, StatusCancelled:
+	default:
+		return nil
+	}
+
+	if intent.Status != StatusPending {
+		// Gateway tetap bisa menerima dana untuk intent yang sudah kita batalkan
+		if intent.Status == StatusCancelled && evt.Status == StatusSucceeded {
+			return flagForRefund(ctx, repo, intent, "payment succeeded after the intent was cancelled")
+		}
+		return nil
+	}
+
+	var reason sql.NullString
+	if evt.Status != StatusSucceeded && evt.FailureReason != "" {
+		reason = sql.NullString{String: evt.FailureReason, Valid: true}
+	}
+
+	if err := repo.UpdateIntentStatus(ctx, dbgen.UpdatePaymentIntentStatusParams{
+		Status:        evt.Status,
+		FailureReason: reason,
+		ID:            intent.ID,
+	}); err != nil {
+		return err
+	}
+
+	if evt.Status != StatusSucceeded {
+		return nil
+	}
+
+	order, err := repo.GetOrderPaymentInfoForUpdate(ctx, intent.OrderID)
+	if err != nil {
+		return err
+	}
+	// Order yang sudah dibatalkan tidak boleh menjadi paid; dana dikembalikan
+	if order.Status == orderCancelled {
+		return flagForRefund(ctx, repo, intent, "order was cancelled before the payment succeeded")
+	}
+	// Total order bisa berubah (edit) setelah intent dibuat; nominal lama tidak melunasi order
+	if !intent.Amount.Equal(order.TotalPrice) {
+		return flagForRefund(ctx, repo, intent, fmt.Sprintf("paid amount %s does not match order total %s", intent.Amount, order.TotalPrice))
+	}
+
+	return repo.UpdateOrderPaymentStatus(ctx, dbgen.UpdateOrderPaymentStatusParams{
+		PaymentStatus: orderPaid,
+		PaidAt:        sql.NullTime{Time: time.Now(), Valid: true},
+		ID:            intent.OrderID,
+	})
+}
+
+// flagForRefund menandai intent yang dananya harus dikembalikan secara manual
+func flagForRefund(ctx context.Context, repo Repository, intent dbgen.PaymentIntent, reason string) error {
+	log.Printf("payment: intent %s for order %s needs a refund: %s", intent.ID, intent.OrderID, reason)
+	return repo.FlagIntentForRefund(ctx, dbgen.FlagPaymentIntentForRefundParams{
+		RefundReason: sql.NullString{String: reason, Valid: true},
+		ID:           intent.ID,
+	})
+}
+
+func mapToResponse(r dbgen.PaymentIntent) IntentResponse {
+	return IntentResponse{
+		ID:               r.ID,
+		OrderID:          r.OrderID,
+		Gateway:          r.Gateway,
+		GatewayReference: r.GatewayReference,
+		Amount:           helper.DecimalToFloat64(r.Amount),
+		Currency:         r.Currency,
+		Status:           r.Status,
+		PaymentURL:       r.PaymentUrl.String,
+		FailureReason:    r.FailureReason.String,
+		RefundReason:     r.RefundReason.String,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
@@ -0,0 +1,373 @@
+package payment_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/payment"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockPayment "assignment-ptes-achmad-rifai/internal/payment/mocks"
+)
+
+func setupServiceTest(t *testing.T, gw payment.PaymentGateway) (payment.Service, *mockPayment.MockRepository, sqlmock.Sqlmock) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockPayment.NewMockRepository(ctrl)
+
+	return payment.NewService(db, repo, gw), repo, mock
+}
+
+// plainGateway adalah gateway tanpa dukungan simulasi
+type plainGateway struct{}
+
+func (plainGateway) Name() string { return "plain" }
+
+func (plainGateway) CreateIntent(ctx context.Context, req payment.IntentRequest) (payment.GatewayIntent, error) {
+	return payment.GatewayIntent{Reference: "plain_" + req.IntentID}, nil
+}
+
+func (plainGateway) ParseWebhook(payload []byte, header http.Header) (payment.WebhookEvent, error) {
+	return payment.WebhookEvent{}, payment.ErrInvalidSignature
+}
+
+func TestService_CreateIntent(t *testing.T) {
+	ctx := context.Background()
+	total := decimal.NewFromInt(111000)
+
+	t.Run("success_new_intent", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoRow{ID: "order-1", TotalPrice: total, PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().GetPendingIntentByOrder(gomock.Any(), "order-1").Return(dbgen.PaymentIntent{}, sql.ErrNoRows)
+		repo.EXPECT().
+			CreateIntent(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreatePaymentIntentParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreatePaymentIntentParams) error {
+				assert.Equal(t, "fake_pi_"+p.ID, p.GatewayReference)
+				assert.Equal(t, payment.FakeGatewayName, p.Gateway)
+				assert.True(t, p.Amount.Equal(total))
+				assert.Equal(t, payment.StatusPending, p.Status)
+				assert.True(t, p.PaymentUrl.Valid)
+				return nil
+			})
+		repo.EXPECT().GetIntentByID(gomock.Any(), gomock.Any()).Return(dbgen.PaymentIntent{
+			ID: "pi-1", OrderID: "order-1", Amount: total, Status: payment.StatusPending,
+		}, nil)
+
+		res, err := svc.CreateIntent(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(111000), res.Amount)
+	})
+
+	t.Run("reuses_pending_intent", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoRow{ID: "order-1", TotalPrice: total, PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().GetPendingIntentByOrder(gomock.Any(), "order-1").Return(dbgen.PaymentIntent{
+			ID: "pi-1", OrderID: "order-1", Gateway: payment.FakeGatewayName, Amount: total, Status: payment.StatusPending,
+		}, nil)
+
+		res, err := svc.CreateIntent(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pi-1", res.ID)
+	})
+
+	t.Run("supersedes_intent_with_stale_amount", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoRow{ID: "order-1", TotalPrice: total, PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().GetPendingIntentByOrder(gomock.Any(), "order-1").Return(dbgen.PaymentIntent{
+			ID: "pi-old", Gateway: payment.FakeGatewayName, Amount: decimal.NewFromInt(99000), Status: payment.StatusPending,
+		}, nil)
+		repo.EXPECT().
+			UpdateIntentStatus(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdatePaymentIntentStatusParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdatePaymentIntentStatusParams) error {
+				assert.Equal(t, "pi-old", p.ID)
+				assert.Equal(t, payment.StatusCancelled, p.Status)
+				return nil
+			})
+		repo.EXPECT().CreateIntent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByID(gomock.Any(), gomock.Any()).Return(dbgen.PaymentIntent{ID: "pi-new"}, nil)
+
+		res, err := svc.CreateIntent(ctx, "order-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pi-new", res.ID)
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "missing").Return(dbgen.GetOrderPaymentInfoRow{}, sql.ErrNoRows)
+
+		_, err := svc.CreateIntent(ctx, "missing")
+
+		assert.ErrorIs(t, err, payment.ErrOrderNotFound)
+	})
+
+	t.Run("error_already_paid", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoRow{ID: "order-1", TotalPrice: total, PaymentStatus: "paid"}, nil)
+
+		_, err := svc.CreateIntent(ctx, "order-1")
+
+		assert.ErrorIs(t, err, payment.ErrOrderAlreadyPaid)
+	})
+
+	t.Run("error_order_cancelled", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetOrderPaymentInfo(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoRow{ID: "order-1", Status: "cancelled", TotalPrice: total, PaymentStatus: "unpaid"}, nil)
+
+		_, err := svc.CreateIntent(ctx, "order-1")
+
+		assert.ErrorIs(t, err, payment.ErrOrderCancelled)
+	})
+}
+
+func TestService_HandleWebhook(t *testing.T) {
+	ctx := context.Background()
+	gw := payment.NewFakeGateway("secret", "")
+	amount := decimal.NewFromInt(150000)
+	pending := dbgen.PaymentIntent{ID: "pi-1", OrderID: "order-1", Gateway: payment.FakeGatewayName, GatewayReference: "fake_pi_pi-1", Amount: amount, Status: payment.StatusPending}
+
+	t.Run("success_marks_order_paid", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			GetIntentByReferenceForUpdate(gomock.Any(), dbgen.GetPaymentIntentByReferenceForUpdateParams{Gateway: "fake", GatewayReference: "fake_pi_pi-1"}).
+			Return(pending, nil)
+		repo.EXPECT().
+			UpdateIntentStatus(gomock.Any(), dbgen.UpdatePaymentIntentStatusParams{Status: payment.StatusSucceeded, ID: "pi-1"}).
+			Return(nil)
+		repo.EXPECT().
+			GetOrderPaymentInfoForUpdate(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoForUpdateRow{ID: "order-1", Status: "pending", TotalPrice: amount, PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().
+			UpdateOrderPaymentStatus(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateOrderPaymentStatusParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateOrderPaymentStatusParams) error {
+				assert.Equal(t, "order-1", p.ID)
+				assert.Equal(t, "paid", p.PaymentStatus)
+				assert.True(t, p.PaidAt.Valid)
+				return nil
+			})
+
+		res, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+		assert.False(t, res.Duplicate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stale_amount_is_flagged_for_refund", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByReferenceForUpdate(gomock.Any(), gomock.Any()).Return(pending, nil)
+		repo.EXPECT().UpdateIntentStatus(gomock.Any(), gomock.Any()).Return(nil)
+		// Order diedit setelah intent dibuat sehingga totalnya naik
+		repo.EXPECT().
+			GetOrderPaymentInfoForUpdate(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoForUpdateRow{ID: "order-1", Status: "pending", TotalPrice: decimal.NewFromInt(200000), PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().
+			FlagIntentForRefund(gomock.Any(), gomock.AssignableToTypeOf(dbgen.FlagPaymentIntentForRefundParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.FlagPaymentIntentForRefundParams) error {
+				assert.Equal(t, "pi-1", p.ID)
+				assert.Contains(t, p.RefundReason.String, "does not match order total")
+				return nil
+			})
+		repo.EXPECT().UpdateOrderPaymentStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("cancelled_order_is_flagged_for_refund", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByReferenceForUpdate(gomock.Any(), gomock.Any()).Return(pending, nil)
+		repo.EXPECT().UpdateIntentStatus(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			GetOrderPaymentInfoForUpdate(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderPaymentInfoForUpdateRow{ID: "order-1", Status: "cancelled", TotalPrice: amount, PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().
+			FlagIntentForRefund(gomock.Any(), gomock.AssignableToTypeOf(dbgen.FlagPaymentIntentForRefundParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.FlagPaymentIntentForRefundParams) error {
+				assert.Equal(t, "pi-1", p.ID)
+				assert.Contains(t, p.RefundReason.String, "order was cancelled")
+				return nil
+			})
+		repo.EXPECT().UpdateOrderPaymentStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_on_cancelled_intent_is_flagged_for_refund", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+
+		superseded := pending
+		superseded.Status = payment.StatusCancelled
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByReferenceForUpdate(gomock.Any(), gomock.Any()).Return(superseded, nil)
+		repo.EXPECT().FlagIntentForRefund(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().UpdateIntentStatus(gomock.Any(), gomock.Any()).Times(0)
+		repo.EXPECT().UpdateOrderPaymentStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failed_keeps_order_unpaid", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusFailed, "insufficient funds")
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByReferenceForUpdate(gomock.Any(), gomock.Any()).Return(pending, nil)
+		repo.EXPECT().
+			UpdateIntentStatus(gomock.Any(), dbgen.UpdatePaymentIntentStatusParams{
+				Status:        payment.StatusFailed,
+				FailureReason: sql.NullString{String: "insufficient funds", Valid: true},
+				ID:            "pi-1",
+			}).
+			Return(nil)
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("duplicate_event_is_acknowledged", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062})
+
+		res, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+		assert.True(t, res.Duplicate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("settled_intent_is_not_changed", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t, gw)
+		payload, header, _ := gw.SimulateEvent("fake_pi_pi-1", payment.StatusFailed, "late event")
+
+		settled := pending
+		settled.Status = payment.StatusSucceeded
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().CreateWebhookEvent(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetIntentByReferenceForUpdate(gomock.Any(), gomock.Any()).Return(settled, nil)
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error_invalid_signature", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t, gw)
+		payload, _, _ := payment.NewFakeGateway("attacker", "").SimulateEvent("fake_pi_pi-1", payment.StatusSucceeded, "")
+		header := http.Header{}
+		header.Set(payment.SignatureHeader, "t=1,v1=deadbeef")
+
+		_, err := svc.HandleWebhook(ctx, "fake", payload, header)
+
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+
+	t.Run("error_unknown_gateway", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t, gw)
+
+		_, err := svc.HandleWebhook(ctx, "midtrans", []byte(`{}`), http.Header{})
+
+		assert.ErrorIs(t, err, payment.ErrUnknownGateway)
+	})
+}
+
+func TestService_Simulate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unsupported_gateway", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t, plainGateway{})
+
+		_, err := svc.Simulate(ctx, "pi-1", payment.SimulateRequest{Status: payment.StatusSucceeded})
+
+		assert.ErrorIs(t, err, payment.ErrSimulationUnsupported)
+	})
+
+	t.Run("not_pending", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t, payment.NewFakeGateway("secret", ""))
+
+		repo.EXPECT().GetIntentByID(gomock.Any(), "pi-1").Return(dbgen.PaymentIntent{ID: "pi-1", Status: payment.StatusFailed}, nil)
+
+		_, err := svc.Simulate(ctx, "pi-1", payment.SimulateRequest{Status: payment.StatusSucceeded})
+
+		assert.ErrorIs(t, err, payment.ErrPaymentNotSimulatable)
+	})
+}
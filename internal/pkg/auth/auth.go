@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// customerIDKey adalah key gin.Context untuk ID customer yang sudah login
+const customerIDKey = "auth.customer_id"
+
+// Authenticator memvalidasi token bearer dan mengembalikan ID customer pemiliknya
+type Authenticator func(ctx context.Context, token string) (string, error)
+
+// RequireCustomer menolak request tanpa token bearer yang valid dengan 401.
+// ID customer tersedia untuk handler berikutnya lewat CustomerID.
+func RequireCustomer(authenticate Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := BearerToken(c)
+		if token == "" {
+			response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Missing bearer token", nil)
+			c.Abort()
+			return
+		}
+
+		customerID, err := authenticate(c.Request.Context(), token)
+		if err != nil {
+			response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired token", nil)
+			c.Abort()
+			return
+		}
+
+		c.Set(customerIDKey, customerID)
+		c.Next()
+	}
+}
+
+// CustomerID mengembalikan ID customer yang diset RequireCustomer; kosong jika tidak ada
+func CustomerID(c *gin.Context) string {
+	return c.GetString(customerIDKey)
+}
+
+// BearerToken mengambil token dari header "Authorization: Bearer <token>"
+func BearerToken(c *gin.Context) string {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,57 @@
+package auth_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/auth"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireCustomer(t *testing.T) {
+	authenticate := func(ctx context.Context, token string) (string, error) {
+		if token == "valid-token" {
+			return "cust-1", nil
+		}
+		return "", errors.New("invalid token")
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/me", auth.RequireCustomer(authenticate), func(c *gin.Context) {
+		c.String(http.StatusOK, auth.CustomerID(c))
+	})
+
+	cases := []struct {
+		name   string
+		header string
+		code   int
+		body   string
+	}{
+		{"valid token", "Bearer valid-token", http.StatusOK, "cust-1"},
+		{"scheme is case insensitive", "bearer valid-token", http.StatusOK, "cust-1"},
+		{"missing header", "", http.StatusUnauthorized, ""},
+		{"wrong scheme", "Basic valid-token", http.StatusUnauthorized, ""},
+		{"invalid token", "Bearer nope", http.StatusUnauthorized, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+			if tc.body != "" {
+				assert.Equal(t, tc.body, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Client memasukkan job ke antrean
+type Client struct {
+	store Store
+}
+
+func NewClient(store Store) *Client {
+	return &Client{store: store}
+}
+
+// Enqueue meng-encode payload ke JSON lalu memasukkannya ke antrean, mengembalikan ID job
+func (c *Client) Enqueue(ctx context.Context, jobType string, payload any, opts EnqueueOptions) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+
+	job := NewJob{
+		ID:          newUUID.String(),
+		Type:        jobType,
+		Payload:     data,
+		MaxAttempts: opts.MaxAttempts,
+		UniqueKey:   opts.UniqueKey,
+		RunAt:       opts.RunAt,
+	}
+	if job.MaxAttempts <= 0 {
+		job.MaxAttempts = DefaultMaxAttempts
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = time.Now()
+	}
+	job.RunAt = job.RunAt.UTC()
+
+	if err := c.store.Enqueue(ctx, job); err != nil {
+		return "", err
+	}
+	return job.ID, nil
+}
@@ -0,0 +1,51 @@
+package jobs_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	// Jumat, 10 Januari 2025 08:17 UTC
+	from := time.Date(2025, 1, 10, 8, 17, 30, 0, time.UTC)
+
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 1, 10, 8, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 1, 10, 8, 30, 0, 0, time.UTC)},
+		{"0 9-17 * * *", time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2025, 1, 11, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 1", time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)}, // tanggal ATAU hari-minggu
+		{"@daily", time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{"@every 5m", time.Date(2025, 1, 10, 8, 20, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.spec, func(t *testing.T) {
+			schedule, err := jobs.ParseCron(tc.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@every 1ms"} {
+		_, err := jobs.ParseCron(spec)
+		assert.ErrorIs(t, err, jobs.ErrInvalidCron, spec)
+	}
+}
+
+func TestParseCron_Never(t *testing.T) {
+	schedule, err := jobs.ParseCron("0 0 30 2 *")
+	assert.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
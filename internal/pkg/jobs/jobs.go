@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrDuplicateJob = errors.New("job with the same unique key already enqueued")
+	ErrJobNotFound  = errors.New("job not found")
+	ErrInvalidCron  = errors.New("invalid cron expression")
+)
+
+// DefaultMaxAttempts dipakai jika EnqueueOptions.MaxAttempts tidak diisi
+const DefaultMaxAttempts = 5
+
+// Job adalah satu unit kerja yang diambil worker dari antrean
+type Job struct {
+	ID          string
+	Type        string
+	Payload     json.RawMessage
+	Attempt     int // Percobaan saat ini, dimulai dari 1
+	MaxAttempts int
+}
+
+// HandlerFunc memproses job. Error biasa membuat job dicoba ulang dengan backoff;
+// bungkus dengan Permanent agar job langsung masuk dead-letter.
+type HandlerFunc func(ctx context.Context, job Job) error
+
+// Handle mendaftarkan handler bertipe: payload di-decode ke T sebelum fn dipanggil.
+// Payload yang tidak bisa di-decode tidak akan pernah berhasil, jadi langsung dianggap permanen.
+func Handle[T any](w *Worker, jobType string, fn func(ctx context.Context, payload T) error) {
+	w.Register(jobType, func(ctx context.Context, job Job) error {
+		var payload T
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return Permanent(fmt.Errorf("decode %s payload: %w", jobType, err))
+		}
+		return fn(ctx, payload)
+	})
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent menandai error yang tidak perlu dicoba ulang
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent melaporkan apakah err (atau error yang dibungkusnya) dibuat lewat Permanent
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+type EnqueueOptions struct {
+	// RunAt menunda job sampai waktu tersebut; kosong berarti segera
+	RunAt time.Time
+	// MaxAttempts sebelum job masuk dead-letter; 0 berarti DefaultMaxAttempts
+	MaxAttempts int
+	// UniqueKey mencegah job yang sama diantrekan dua kali (ErrDuplicateJob)
+	UniqueKey string
+}
+
+// Enqueuer dipakai service yang perlu menjadwalkan pekerjaan di luar request
+type Enqueuer interface {
+	Enqueue(ctx context.Context, jobType string, payload any, opts EnqueueOptions) (string, error)
+}
+
+// Backoff retry: 10s, 20s, 40s, ... maksimal 1 jam
+const (
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// RetryDelay mengembalikan jeda sebelum percobaan berikutnya setelah attempt gagal (dimulai dari 1)
+func RetryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,204 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryJob struct {
+	NewJob
+	status      string
+	attempts    int
+	lockedUntil time.Time
+	lastError   string
+	createdAt   time.Time
+	updatedAt   time.Time
+	completedAt time.Time
+}
+
+// MemoryStore menyimpan antrean di memori; dipakai untuk test & development tanpa MySQL.
+// Isinya hilang saat proses berhenti.
+type MemoryStore struct {
+	mu   sync.Mutex
+	jobs map[string]*memoryJob
+	keys map[string]string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: map[string]*memoryJob{}, keys: map[string]string{}}
+}
+
+func (s *MemoryStore) Enqueue(_ context.Context, job NewJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if job.UniqueKey != "" {
+		if _, ok := s.keys[job.UniqueKey]; ok {
+			return ErrDuplicateJob
+		}
+		s.keys[job.UniqueKey] = job.ID
+	}
+
+	now := time.Now().UTC()
+	s.jobs[job.ID] = &memoryJob{NewJob: job, status: StatusPending, createdAt: now, updatedAt: now}
+	return nil
+}
+
+func (s *MemoryStore) Claim(_ context.Context, now time.Time, limit int, lease time.Duration) ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*memoryJob
+	for _, j := range s.jobs {
+		pending := j.status == StatusPending && !j.RunAt.After(now)
+		abandoned := j.status == StatusRunning && !j.lockedUntil.After(now)
+		if pending || abandoned {
+			due = append(due, j)
+		}
+	}
+	sort.Slice(due, func(a, b int) bool {
+		if !due[a].RunAt.Equal(due[b].RunAt) {
+			return due[a].RunAt.Before(due[b].RunAt)
+		}
+		return due[a].ID < due[b].ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]Job, 0, len(due))
+	for _, j := range due {
+		j.status = StatusRunning
+		j.attempts++
+		j.lockedUntil = now.Add(lease)
+		j.updatedAt = now
+		claimed = append(claimed, Job{
+			ID:          j.ID,
+			Type:        j.Type,
+			Payload:     j.Payload,
+			Attempt:     j.attempts,
+			MaxAttempts: j.MaxAttempts,
+		})
+	}
+	return claimed, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, id string, now time.Time) error {
+	return s.update(id, func(j *memoryJob) {
+		j.status = StatusCompleted
+		j.Payload = json.RawMessage(`{}`)
+		j.lastError = ""
+		j.completedAt = now
+	})
+}
+
+func (s *MemoryStore) Retry(_ context.Context, id string, runAt time.Time, reason string) error {
+	return s.update(id, func(j *memoryJob) {
+		j.status = StatusPending
+		j.lastError = reason
+		j.RunAt = runAt
+	})
+}
+
+func (s *MemoryStore) Bury(_ context.Context, id string, reason string) error {
+	return s.update(id, func(j *memoryJob) {
+		j.status = StatusDead
+		j.lastError = reason
+	})
+}
+
+func (s *MemoryStore) ListDead(_ context.Context, limit, offset int) ([]DeadJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var dead []DeadJob
+	for _, j := range s.jobs {
+		if j.status != StatusDead {
+			continue
+		}
+		dead = append(dead, DeadJob{
+			ID:        j.ID,
+			Type:      j.Type,
+			Payload:   j.Payload,
+			Attempts:  j.attempts,
+			LastError: j.lastError,
+			CreatedAt: j.createdAt,
+			FailedAt:  j.updatedAt,
+		})
+	}
+	sort.Slice(dead, func(a, b int) bool { return dead[a].ID > dead[b].ID })
+
+	if offset >= len(dead) {
+		return []DeadJob{}, nil
+	}
+	dead = dead[offset:]
+	if len(dead) > limit {
+		dead = dead[:limit]
+	}
+	return dead, nil
+}
+
+func (s *MemoryStore) Requeue(_ context.Context, id string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok || j.status != StatusDead {
+		return ErrJobNotFound
+	}
+	j.status = StatusPending
+	j.attempts = 0
+	j.lastError = ""
+	j.RunAt = now
+	j.updatedAt = now
+	return nil
+}
+
+func (s *MemoryStore) PurgeCompleted(_ context.Context, before time.Time, limit int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	for id, j := range s.jobs {
+		if purged >= int64(limit) {
+			break
+		}
+		if j.status == StatusCompleted && j.completedAt.Before(before) {
+			delete(s.jobs, id)
+			if j.UniqueKey != "" {
+				delete(s.keys, j.UniqueKey)
+			}
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Status mengembalikan status, jumlah percobaan & error terakhir job; dipakai di test
+func (s *MemoryStore) Status(id string) (status string, attempts int, lastError string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return "", 0, ""
+	}
+	return j.status, j.attempts, j.lastError
+}
+
+func (s *MemoryStore) update(id string, fn func(j *memoryJob)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return ErrJobNotFound
+	}
+	fn(j)
+	j.lockedUntil = time.Time{}
+	j.updatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,154 @@
+package jobs
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// maxErrorLength sesuai panjang kolom jobs.last_error
+const maxErrorLength = 500
+
+type mysqlStore struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+// NewMySQLStore menyimpan antrean di tabel jobs
+func NewMySQLStore(db *sql.DB, q *dbgen.Queries) Store {
+	return &mysqlStore{db: db, q: q}
+}
+
+func (s *mysqlStore) Enqueue(ctx context.Context, job NewJob) error {
+	inserted, err := s.q.CreateJob(ctx, dbgen.CreateJobParams{
+		ID:          job.ID,
+		Type:        job.Type,
+		Payload:     job.Payload,
+		MaxAttempts: int32(job.MaxAttempts),
+		UniqueKey:   sql.NullString{String: job.UniqueKey, Valid: job.UniqueKey != ""},
+		RunAt:       job.RunAt,
+	})
+	if err != nil {
+		return err
+	}
+	if inserted == 0 {
+		return ErrDuplicateJob
+	}
+	return nil
+}
+
+func (s *mysqlStore) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Job, error) {
+	now = now.UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := s.q.WithTx(tx)
+	rows, err := q.ListClaimableJobs(ctx, dbgen.ListClaimableJobsParams{
+		Now:   now,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]Job, 0, len(rows))
+	for _, r := range rows {
+		if err := q.MarkJobRunning(ctx, dbgen.MarkJobRunningParams{
+			LockedUntil: sql.NullTime{Time: now.Add(lease), Valid: true},
+			ID:          r.ID,
+		}); err != nil {
+			return nil, err
+		}
+		claimed = append(claimed, Job{
+			ID:          r.ID,
+			Type:        r.Type,
+			Payload:     r.Payload,
+			Attempt:     int(r.Attempts) + 1,
+			MaxAttempts: int(r.MaxAttempts),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (s *mysqlStore) Complete(ctx context.Context, id string, now time.Time) error {
+	return s.q.CompleteJob(ctx, dbgen.CompleteJobParams{
+		CompletedAt: sql.NullTime{Time: now.UTC(), Valid: true},
+		ID:          id,
+	})
+}
+
+func (s *mysqlStore) Retry(ctx context.Context, id string, runAt time.Time, reason string) error {
+	return s.q.RetryJob(ctx, dbgen.RetryJobParams{
+		LastError: sql.NullString{String: truncate(reason), Valid: true},
+		RunAt:     runAt.UTC(),
+		ID:        id,
+	})
+}
+
+func (s *mysqlStore) Bury(ctx context.Context, id string, reason string) error {
+	return s.q.BuryJob(ctx, dbgen.BuryJobParams{
+		LastError: sql.NullString{String: truncate(reason), Valid: true},
+		ID:        id,
+	})
+}
+
+func (s *mysqlStore) ListDead(ctx context.Context, limit, offset int) ([]DeadJob, error) {
+	rows, err := s.q.ListDeadJobs(ctx, dbgen.ListDeadJobsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]DeadJob, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, DeadJob{
+			ID:        r.ID,
+			Type:      r.Type,
+			Payload:   r.Payload,
+			Attempts:  int(r.Attempts),
+			LastError: r.LastError.String,
+			CreatedAt: r.CreatedAt,
+			FailedAt:  r.UpdatedAt,
+		})
+	}
+	return res, nil
+}
+
+func (s *mysqlStore) Requeue(ctx context.Context, id string, now time.Time) error {
+	affected, err := s.q.RequeueDeadJob(ctx, dbgen.RequeueDeadJobParams{
+		RunAt: now.UTC(),
+		ID:    id,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+func (s *mysqlStore) PurgeCompleted(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return s.q.DeleteCompletedJobsBefore(ctx, dbgen.DeleteCompletedJobsBeforeParams{
+		CompletedAt: sql.NullTime{Time: before.UTC(), Valid: true},
+		Limit:       int32(limit),
+	})
+}
+
+func truncate(msg string) string {
+	if len(msg) > maxErrorLength {
+		return msg[:maxErrorLength]
+	}
+	return msg
+}
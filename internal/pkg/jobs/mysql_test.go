@@ -0,0 +1,70 @@
+package jobs_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMySQLStore(t *testing.T) (jobs.Store, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return jobs.NewMySQLStore(db, dbgen.New(db)), mock
+}
+
+func TestMySQLStore_EnqueueDuplicate(t *testing.T) {
+	store, mock := setupMySQLStore(t)
+
+	mock.ExpectExec("INSERT IGNORE INTO").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := store.Enqueue(context.Background(), jobs.NewJob{ID: "j1", Type: "export", Payload: []byte("{}"), UniqueKey: "k"})
+
+	assert.ErrorIs(t, err, jobs.ErrDuplicateJob)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLStore_Claim(t *testing.T) {
+	store, mock := setupMySQLStore(t)
+	now := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "type", "payload", "status", "attempts", "max_attempts", "unique_key", "run_at", "locked_until", "last_error", "completed_at", "created_at", "updated_at"}
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+		WithArgs(now, now, 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("j1", "export", []byte(`{"month":"2025-01"}`), jobs.StatusPending, 1, 5, nil, now, nil, "timeout", nil, now, now))
+	mock.ExpectExec("UPDATE jobs").
+		WithArgs(now.Add(time.Minute), "j1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	claimed, err := store.Claim(context.Background(), now, 10, time.Minute)
+
+	assert.NoError(t, err)
+	if assert.Len(t, claimed, 1) {
+		assert.Equal(t, "export", claimed[0].Type)
+		assert.Equal(t, 2, claimed[0].Attempt)
+		assert.Equal(t, 5, claimed[0].MaxAttempts)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLStore_RequeueNotDead(t *testing.T) {
+	store, mock := setupMySQLStore(t)
+
+	mock.ExpectExec("UPDATE jobs").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.ErrorIs(t, store.Requeue(context.Background(), "j1", time.Now()), jobs.ErrJobNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
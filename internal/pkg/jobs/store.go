@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Status job di antrean, sama dengan kolom jobs.status
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusDead      = "dead"
+)
+
+// NewJob adalah job yang akan dimasukkan ke antrean
+type NewJob struct {
+	ID          string
+	Type        string
+	Payload     json.RawMessage
+	MaxAttempts int
+	UniqueKey   string
+	RunAt       time.Time
+}
+
+// DeadJob adalah job di dead-letter beserta error terakhirnya
+type DeadJob struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error"`
+	CreatedAt time.Time       `json:"created_at"`
+	FailedAt  time.Time       `json:"failed_at"`
+}
+
+// Store adalah penyimpanan antrean job. Implementasi: MySQL (durable) dan memori (test & development).
+type Store interface {
+	// Enqueue mengembalikan ErrDuplicateJob jika UniqueKey sudah dipakai
+	Enqueue(ctx context.Context, job NewJob) error
+	// Claim mengunci hingga limit job yang jatuh tempo selama lease; job yang lease-nya habis
+	// (worker mati di tengah jalan) ikut diambil ulang
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Job, error)
+	// Complete menandai job selesai dan mengosongkan payload-nya
+	Complete(ctx context.Context, id string, now time.Time) error
+	Retry(ctx context.Context, id string, runAt time.Time, reason string) error
+	// Bury memindahkan job ke dead-letter
+	Bury(ctx context.Context, id string, reason string) error
+
+	ListDead(ctx context.Context, limit, offset int) ([]DeadJob, error)
+	// Requeue mengembalikan job dari dead-letter ke antrean dengan jatah percobaan baru
+	Requeue(ctx context.Context, id string, now time.Time) error
+	// PurgeCompleted menghapus job selesai yang lebih lama dari before
+	PurgeCompleted(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,255 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Nilai default Config
+const (
+	DefaultConcurrency  = 4
+	DefaultPollInterval = time.Second
+	DefaultJobTimeout   = 5 * time.Minute
+)
+
+// storeTimeout membatasi pencatatan hasil job; tidak memakai ctx job agar hasil tetap
+// tersimpan walau job dibatalkan saat shutdown
+const storeTimeout = 10 * time.Second
+
+type Config struct {
+	// Concurrency adalah jumlah job yang boleh berjalan bersamaan
+	Concurrency int
+	// PollInterval adalah jeda antar pengecekan antrean & jadwal cron
+	PollInterval time.Duration
+	// JobTimeout membatasi durasi satu job. Lease job dua kali JobTimeout, jadi job
+	// dari worker yang mati akan diambil worker lain setelah lease habis.
+	JobTimeout time.Duration
+}
+
+type cronEntry struct {
+	name     string
+	schedule Schedule
+	jobType  string
+	payload  json.RawMessage
+	next     time.Time
+}
+
+// Worker mengambil job dari Store dan menjalankan handler yang terdaftar.
+// Run memblok sampai Shutdown dipanggil, mirip http.Server.
+type Worker struct {
+	store    Store
+	client   *Client
+	cfg      Config
+	handlers map[string]HandlerFunc
+	crons    []*cronEntry
+
+	active atomic.Int32
+	wg     sync.WaitGroup
+
+	// jobCtx dibatalkan jika drain melewati batas waktu Shutdown
+	jobCtx     context.Context
+	cancelJobs context.CancelFunc
+
+	quit     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	started  atomic.Bool
+}
+
+func NewWorker(store Store, cfg Config) *Worker {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = DefaultJobTimeout
+	}
+
+	jobCtx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		store:      store,
+		client:     NewClient(store),
+		cfg:        cfg,
+		handlers:   map[string]HandlerFunc{},
+		jobCtx:     jobCtx,
+		cancelJobs: cancel,
+		quit:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Register mendaftarkan handler untuk jobType; gunakan Handle untuk payload bertipe
+func (w *Worker) Register(jobType string, h HandlerFunc) {
+	w.handlers[jobType] = h
+}
+
+// Cron menjadwalkan jobType berulang sesuai spec (lihat ParseCron). Setiap jadwal diantrekan
+// dengan unique key per waktu eksekusi, jadi beberapa worker tidak menjalankannya dua kali.
+func (w *Worker) Cron(name, spec, jobType string, payload any) error {
+	schedule, err := ParseCron(spec)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	w.crons = append(w.crons, &cronEntry{
+		name:     name,
+		schedule: schedule,
+		jobType:  jobType,
+		payload:  data,
+		next:     schedule.Next(time.Now()),
+	})
+	return nil
+}
+
+// Run mengambil & menjalankan job sampai Shutdown dipanggil
+func (w *Worker) Run() {
+	w.started.Store(true)
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.enqueueCrons(time.Now())
+		w.poll()
+
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown berhenti mengambil job baru lalu menunggu job yang berjalan selesai.
+// Jika ctx habis lebih dulu, ctx job dibatalkan dan ctx.Err() dikembalikan; job tersebut
+// akan dicoba ulang setelah lease-nya habis.
+func (w *Worker) Shutdown(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.quit) })
+	if w.started.Load() {
+		<-w.done
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		w.cancelJobs()
+		return nil
+	case <-ctx.Done():
+		w.cancelJobs()
+		<-drained
+		return ctx.Err()
+	}
+}
+
+func (w *Worker) stopping() bool {
+	select {
+	case <-w.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (w *Worker) poll() {
+	// Terus mengambil selama slot tersedia & antrean penuh, tanpa menunggu ticker
+	for !w.stopping() {
+		free := w.cfg.Concurrency - int(w.active.Load())
+		if free <= 0 {
+			return
+		}
+
+		claimed, err := w.store.Claim(w.jobCtx, time.Now(), free, 2*w.cfg.JobTimeout)
+		if err != nil {
+			log.Printf("jobs: claim failed: %v", err)
+			return
+		}
+
+		for _, job := range claimed {
+			w.active.Add(1)
+			w.wg.Add(1)
+			go w.process(job)
+		}
+		if len(claimed) < free {
+			return
+		}
+	}
+}
+
+func (w *Worker) process(job Job) {
+	defer w.wg.Done()
+	defer w.active.Add(-1)
+
+	err := w.execute(job)
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		err = w.store.Complete(ctx, job.ID, now)
+	case IsPermanent(err) || job.Attempt >= job.MaxAttempts:
+		log.Printf("jobs: %s %s moved to dead-letter after %d attempt(s): %v", job.Type, job.ID, job.Attempt, err)
+		err = w.store.Bury(ctx, job.ID, err.Error())
+	default:
+		err = w.store.Retry(ctx, job.ID, now.Add(RetryDelay(job.Attempt)), err.Error())
+	}
+	if err != nil {
+		log.Printf("jobs: failed to record result of %s %s: %v", job.Type, job.ID, err)
+	}
+}
+
+// execute menjalankan handler dengan timeout; panic diubah menjadi error agar worker tetap hidup
+func (w *Worker) execute(job Job) (err error) {
+	h, ok := w.handlers[job.Type]
+	if !ok {
+		return Permanent(fmt.Errorf("no handler registered for job type %q", job.Type))
+	}
+
+	ctx, cancel := context.WithTimeout(w.jobCtx, w.cfg.JobTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return h(ctx, job)
+}
+
+func (w *Worker) enqueueCrons(now time.Time) {
+	for _, c := range w.crons {
+		if c.next.IsZero() || now.Before(c.next) {
+			continue
+		}
+
+		_, err := w.client.Enqueue(w.jobCtx, c.jobType, c.payload, EnqueueOptions{
+			RunAt:     c.next,
+			UniqueKey: fmt.Sprintf("cron:%s:%d", c.name, c.next.Unix()),
+		})
+		if err != nil && !errors.Is(err, ErrDuplicateJob) {
+			log.Printf("jobs: failed to enqueue cron %s: %v", c.name, err)
+			continue // dicoba lagi di tick berikutnya
+		}
+		c.next = c.schedule.Next(now)
+	}
+}
@@ -0,0 +1,232 @@
+package jobs_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/jobs"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+func newTestWorker(store jobs.Store) *jobs.Worker {
+	return jobs.NewWorker(store, jobs.Config{
+		Concurrency:  2,
+		PollInterval: 10 * time.Millisecond,
+		JobTimeout:   time.Second,
+	})
+}
+
+// runUntil menjalankan worker sampai cond terpenuhi (atau 2 detik), lalu drain
+func runUntil(t *testing.T, w *jobs.Worker, cond func() bool) {
+	t.Helper()
+	go w.Run()
+	assert.Eventually(t, cond, 2*time.Second, 5*time.Millisecond)
+	assert.NoError(t, w.Shutdown(context.Background()))
+}
+
+func TestWorker_TypedHandlerCompletesJob(t *testing.T) {
+	store := jobs.NewMemoryStore()
+	w := newTestWorker(store)
+
+	var got atomic.Value
+	jobs.Handle(w, "greet", func(ctx context.Context, p greeting) error {
+		got.Store(p.Name)
+		return nil
+	})
+
+	id, err := jobs.NewClient(store).Enqueue(context.Background(), "greet", greeting{Name: "Budi"}, jobs.EnqueueOptions{})
+	assert.NoError(t, err)
+
+	runUntil(t, w, func() bool {
+		status, _, _ := store.Status(id)
+		return status == jobs.StatusCompleted
+	})
+	assert.Equal(t, "Budi", got.Load())
+}
+
+func TestWorker_RetriesThenDeadLetters(t *testing.T) {
+	store := jobs.NewMemoryStore()
+	w := newTestWorker(store)
+
+	var calls atomic.Int32
+	w.Register("flaky", func(ctx context.Context, job jobs.Job) error {
+		calls.Add(1)
+		return errors.New("downstream unavailable")
+	})
+
+	ctx := context.Background()
+	id, err := jobs.NewClient(store).Enqueue(ctx, "flaky", nil, jobs.EnqueueOptions{MaxAttempts: 2})
+	assert.NoError(t, err)
+
+	go w.Run()
+	assert.Eventually(t, func() bool {
+		status, _, _ := store.Status(id)
+		return status == jobs.StatusPending && calls.Load() == 1
+	}, 2*time.Second, 5*time.Millisecond, "gagal pertama dijadwalkan ulang dengan backoff")
+	assert.NoError(t, w.Shutdown(ctx))
+
+	// Percepat retry: pindahkan run_at ke sekarang lewat Retry
+	assert.NoError(t, store.Retry(ctx, id, time.Now(), "fast-forward"))
+
+	w = newTestWorker(store)
+	w.Register("flaky", func(ctx context.Context, job jobs.Job) error {
+		calls.Add(1)
+		return errors.New("downstream unavailable")
+	})
+	runUntil(t, w, func() bool {
+		status, _, _ := store.Status(id)
+		return status == jobs.StatusDead
+	})
+
+	_, attempts, lastError := store.Status(id)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "downstream unavailable", lastError)
+
+	dead, err := store.ListDead(ctx, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, dead, 1)
+
+	assert.NoError(t, store.Requeue(ctx, id, time.Now()))
+	status, attempts, _ := store.Status(id)
+	assert.Equal(t, jobs.StatusPending, status)
+	assert.Zero(t, attempts)
+}
+
+func TestWorker_PermanentErrorsSkipRetry(t *testing.T) {
+	cases := []struct {
+		name    string
+		jobType string
+		payload any
+	}{
+		{"permanent error", "reject", greeting{Name: "x"}},
+		{"undecodable payload", "greet", []int{1, 2}},
+		{"unknown job type", "missing", nil},
+		{"panic", "explode", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := jobs.NewMemoryStore()
+			w := newTestWorker(store)
+			w.Register("reject", func(ctx context.Context, job jobs.Job) error {
+				return jobs.Permanent(errors.New("invalid input"))
+			})
+			jobs.Handle(w, "greet", func(ctx context.Context, p greeting) error { return nil })
+			w.Register("explode", func(ctx context.Context, job jobs.Job) error { panic("boom") })
+
+			maxAttempts := 5
+			if tc.name == "panic" {
+				maxAttempts = 1 // panic dianggap error biasa, jadi dicoba ulang sampai jatah habis
+			}
+			id, err := jobs.NewClient(store).Enqueue(context.Background(), tc.jobType, tc.payload, jobs.EnqueueOptions{MaxAttempts: maxAttempts})
+			assert.NoError(t, err)
+
+			runUntil(t, w, func() bool {
+				status, _, _ := store.Status(id)
+				return status == jobs.StatusDead
+			})
+			_, attempts, _ := store.Status(id)
+			assert.Equal(t, 1, attempts)
+		})
+	}
+}
+
+func TestWorker_ShutdownDrainsRunningJobs(t *testing.T) {
+	store := jobs.NewMemoryStore()
+	w := newTestWorker(store)
+
+	started := make(chan struct{})
+	var finished atomic.Bool
+	w.Register("slow", func(ctx context.Context, job jobs.Job) error {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		finished.Store(true)
+		return nil
+	})
+
+	id, _ := jobs.NewClient(store).Enqueue(context.Background(), "slow", nil, jobs.EnqueueOptions{})
+	go w.Run()
+	<-started
+
+	assert.NoError(t, w.Shutdown(context.Background()))
+	assert.True(t, finished.Load(), "Shutdown menunggu job yang sedang berjalan")
+	status, _, _ := store.Status(id)
+	assert.Equal(t, jobs.StatusCompleted, status)
+}
+
+func TestWorker_ShutdownTimeoutCancelsJobs(t *testing.T) {
+	store := jobs.NewMemoryStore()
+	w := newTestWorker(store)
+
+	started := make(chan struct{})
+	w.Register("stuck", func(ctx context.Context, job jobs.Job) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	id, _ := jobs.NewClient(store).Enqueue(context.Background(), "stuck", nil, jobs.EnqueueOptions{})
+	go w.Run()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, w.Shutdown(ctx), context.DeadlineExceeded)
+	status, _, lastError := store.Status(id)
+	assert.Equal(t, jobs.StatusPending, status, "job yang dibatalkan dijadwalkan ulang")
+	assert.Contains(t, lastError, "context canceled")
+}
+
+func TestWorker_CronEnqueuesOncePerTick(t *testing.T) {
+	store := jobs.NewMemoryStore()
+
+	var calls atomic.Int32
+	newWorker := func() *jobs.Worker {
+		w := newTestWorker(store)
+		w.Register("tick", func(ctx context.Context, job jobs.Job) error {
+			calls.Add(1)
+			return nil
+		})
+		assert.NoError(t, w.Cron("ticker", "@every 1s", "tick", nil))
+		return w
+	}
+
+	// Mulai tepat setelah pergantian detik agar hanya satu waktu eksekusi terlewati
+	now := time.Now()
+	time.Sleep(now.Truncate(time.Second).Add(time.Second + 100*time.Millisecond).Sub(now))
+
+	// Dua worker dengan jadwal yang sama hanya menghasilkan satu job per waktu eksekusi
+	first, second := newWorker(), newWorker()
+	go first.Run()
+	go second.Run()
+	time.Sleep(1300 * time.Millisecond)
+	assert.NoError(t, first.Shutdown(context.Background()))
+	assert.NoError(t, second.Shutdown(context.Background()))
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_EnqueueUniqueKey(t *testing.T) {
+	client := jobs.NewClient(jobs.NewMemoryStore())
+	ctx := context.Background()
+
+	_, err := client.Enqueue(ctx, "export", nil, jobs.EnqueueOptions{UniqueKey: "export:2025-01"})
+	assert.NoError(t, err)
+
+	_, err = client.Enqueue(ctx, "export", nil, jobs.EnqueueOptions{UniqueKey: "export:2025-01"})
+	assert.ErrorIs(t, err, jobs.ErrDuplicateJob)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, jobs.RetryDelay(1))
+	assert.Equal(t, 40*time.Second, jobs.RetryDelay(3))
+	assert.Equal(t, time.Hour, jobs.RetryDelay(20))
+}
@@ -0,0 +1,141 @@
+package storage_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/pkg/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := storage.NewLocalStorage(dir, "/media/")
+
+	t.Run("put, url and delete", func(t *testing.T) {
+		err := store.Put(ctx, "products/p1/img.jpg", strings.NewReader("jpeg-bytes"), "image/jpeg")
+		require.NoError(t, err)
+
+		got, err := os.ReadFile(filepath.Join(dir, "products", "p1", "img.jpg"))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg-bytes", string(got))
+		assert.Equal(t, "/media/products/p1/img.jpg", store.URL("products/p1/img.jpg"))
+
+		require.NoError(t, store.Delete(ctx, "products/p1/img.jpg"))
+		_, err = os.Stat(filepath.Join(dir, "products", "p1", "img.jpg"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("delete missing object is not an error", func(t *testing.T) {
+		assert.NoError(t, store.Delete(ctx, "products/none.jpg"))
+	})
+
+	t.Run("rejects keys escaping base dir", func(t *testing.T) {
+		for _, key := range []string{"../etc/passwd", "/abs/path", "a/../../b", ""} {
+			err := store.Put(ctx, key, strings.NewReader("x"), "")
+			assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+		}
+	})
+}
+
+// fakeS3 merekam request yang diterima, meniru endpoint path-style seperti MinIO
+type fakeS3 struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   []string
+	status   int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, string(body))
+	f.mu.Unlock()
+
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+		w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("put sends signed path-style request", func(t *testing.T) {
+		fake := &fakeS3{}
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+
+		store := storage.NewS3Storage(storage.S3Config{
+			Endpoint:  srv.URL,
+			Bucket:    "media",
+			AccessKey: "minio",
+			SecretKey: "minio123",
+		}, srv.Client())
+
+		err := store.Put(ctx, "products/p1/img.png", strings.NewReader("png-bytes"), "image/png")
+		require.NoError(t, err)
+		require.Len(t, fake.requests, 1)
+
+		req := fake.requests[0]
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/media/products/p1/img.png", req.URL.Path)
+		assert.Equal(t, "png-bytes", fake.bodies[0])
+		assert.Equal(t, "image/png", req.Header.Get("Content-Type"))
+		assert.NotEmpty(t, req.Header.Get("X-Amz-Date"))
+		sum := sha256.Sum256([]byte("png-bytes"))
+		assert.Equal(t, hex.EncodeToString(sum[:]), req.Header.Get("X-Amz-Content-Sha256"))
+
+		auth := req.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=minio/"), auth)
+		assert.Contains(t, auth, "/us-east-1/s3/aws4_request")
+		assert.Contains(t, auth, "SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date")
+		assert.Contains(t, auth, "Signature=")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		fake := &fakeS3{}
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+
+		store := storage.NewS3Storage(storage.S3Config{Endpoint: srv.URL, Bucket: "media"}, srv.Client())
+
+		require.NoError(t, store.Delete(ctx, "products/p1/img.png"))
+		assert.Equal(t, http.MethodDelete, fake.requests[0].Method)
+	})
+
+	t.Run("error status is returned", func(t *testing.T) {
+		fake := &fakeS3{status: http.StatusForbidden}
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+
+		store := storage.NewS3Storage(storage.S3Config{Endpoint: srv.URL, Bucket: "media"}, srv.Client())
+
+		err := store.Put(ctx, "a.png", strings.NewReader("x"), "image/png")
+		assert.ErrorContains(t, err, "status 403")
+	})
+
+	t.Run("public url", func(t *testing.T) {
+		store := storage.NewS3Storage(storage.S3Config{Endpoint: "http://localhost:9000/", Bucket: "media"}, nil)
+		assert.Equal(t, "http://localhost:9000/media/products/a.png", store.URL("products/a.png"))
+
+		store = storage.NewS3Storage(storage.S3Config{Endpoint: "http://localhost:9000", Bucket: "media", PublicURL: "https://cdn.example.com"}, nil)
+		assert.Equal(t, "https://cdn.example.com/products/a.png", store.URL("products/a.png"))
+	})
+}
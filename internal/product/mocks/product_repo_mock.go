@@ -10,10 +10,12 @@
 package mock
 
 import (
+	product "assignment-ptes-achmad-rifai/internal/product"
 	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	context "context"
 	reflect "reflect"
 
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +43,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CancelPriceSchedule mocks base method.
+func (m *MockRepository) CancelPriceSchedule(ctx context.Context, params dbgen.CancelPriceScheduleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPriceSchedule", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPriceSchedule indicates an expected call of CancelPriceSchedule.
+func (mr *MockRepositoryMockRecorder) CancelPriceSchedule(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPriceSchedule", reflect.TypeOf((*MockRepository)(nil).CancelPriceSchedule), ctx, params)
+}
+
 // Count mocks base method.
 func (m *MockRepository) Count(ctx context.Context, params dbgen.CountProductsParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +73,36 @@ func (mr *MockRepositoryMockRecorder) Count(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, params)
 }
 
+// CountOverlappingPriceSchedules mocks base method.
+func (m *MockRepository) CountOverlappingPriceSchedules(ctx context.Context, params dbgen.CountOverlappingPriceSchedulesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOverlappingPriceSchedules", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOverlappingPriceSchedules indicates an expected call of CountOverlappingPriceSchedules.
+func (mr *MockRepositoryMockRecorder) CountOverlappingPriceSchedules(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOverlappingPriceSchedules", reflect.TypeOf((*MockRepository)(nil).CountOverlappingPriceSchedules), ctx, params)
+}
+
+// CountPriceHistory mocks base method.
+func (m *MockRepository) CountPriceHistory(ctx context.Context, productID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPriceHistory", ctx, productID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPriceHistory indicates an expected call of CountPriceHistory.
+func (mr *MockRepositoryMockRecorder) CountPriceHistory(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPriceHistory", reflect.TypeOf((*MockRepository)(nil).CountPriceHistory), ctx, productID)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, params dbgen.CreateProductParams) error {
 	m.ctrl.T.Helper()
@@ -70,6 +117,48 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, params)
 }
 
+// CreateOutboxEvent mocks base method.
+func (m *MockRepository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEvent), ctx, params)
+}
+
+// CreatePriceHistory mocks base method.
+func (m *MockRepository) CreatePriceHistory(ctx context.Context, params dbgen.CreateProductPriceHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceHistory", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePriceHistory indicates an expected call of CreatePriceHistory.
+func (mr *MockRepositoryMockRecorder) CreatePriceHistory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceHistory", reflect.TypeOf((*MockRepository)(nil).CreatePriceHistory), ctx, params)
+}
+
+// CreatePriceSchedule mocks base method.
+func (m *MockRepository) CreatePriceSchedule(ctx context.Context, params dbgen.CreateProductPriceScheduleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSchedule", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePriceSchedule indicates an expected call of CreatePriceSchedule.
+func (mr *MockRepositoryMockRecorder) CreatePriceSchedule(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSchedule", reflect.TypeOf((*MockRepository)(nil).CreatePriceSchedule), ctx, params)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -84,6 +173,21 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// Exists mocks base method.
+func (m *MockRepository) Exists(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRepository)(nil).Exists), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id string) (dbgen.GetProductByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +203,66 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetCategoryByID mocks base method.
+func (m *MockRepository) GetCategoryByID(ctx context.Context, id string) (dbgen.GetCategoryByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, id)
+	ret0, _ := ret[0].(dbgen.GetCategoryByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockRepositoryMockRecorder) GetCategoryByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockRepository)(nil).GetCategoryByID), ctx, id)
+}
+
+// GetPriceForUpdate mocks base method.
+func (m *MockRepository) GetPriceForUpdate(ctx context.Context, id string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceForUpdate", ctx, id)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceForUpdate indicates an expected call of GetPriceForUpdate.
+func (mr *MockRepositoryMockRecorder) GetPriceForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceForUpdate", reflect.TypeOf((*MockRepository)(nil).GetPriceForUpdate), ctx, id)
+}
+
+// GetPriceSchedule mocks base method.
+func (m *MockRepository) GetPriceSchedule(ctx context.Context, params dbgen.GetProductPriceScheduleParams) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceSchedule", ctx, params)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceSchedule indicates an expected call of GetPriceSchedule.
+func (mr *MockRepositoryMockRecorder) GetPriceSchedule(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceSchedule", reflect.TypeOf((*MockRepository)(nil).GetPriceSchedule), ctx, params)
+}
+
+// GetPriceScheduleForUpdate mocks base method.
+func (m *MockRepository) GetPriceScheduleForUpdate(ctx context.Context, id string) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceScheduleForUpdate", ctx, id)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceScheduleForUpdate indicates an expected call of GetPriceScheduleForUpdate.
+func (mr *MockRepositoryMockRecorder) GetPriceScheduleForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceScheduleForUpdate", reflect.TypeOf((*MockRepository)(nil).GetPriceScheduleForUpdate), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockRepository) List(ctx context.Context, params dbgen.ListProductsParams) ([]dbgen.ListProductsRow, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +278,81 @@ func (mr *MockRepositoryMockRecorder) List(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, params)
 }
 
+// ListCategoryNames mocks base method.
+func (m *MockRepository) ListCategoryNames(ctx context.Context) ([]dbgen.ListCategoryNamesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryNames", ctx)
+	ret0, _ := ret[0].([]dbgen.ListCategoryNamesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryNames indicates an expected call of ListCategoryNames.
+func (mr *MockRepositoryMockRecorder) ListCategoryNames(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryNames", reflect.TypeOf((*MockRepository)(nil).ListCategoryNames), ctx)
+}
+
+// ListDuePriceSchedules mocks base method.
+func (m *MockRepository) ListDuePriceSchedules(ctx context.Context, params dbgen.ListDuePriceSchedulesParams) ([]dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuePriceSchedules", ctx, params)
+	ret0, _ := ret[0].([]dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDuePriceSchedules indicates an expected call of ListDuePriceSchedules.
+func (mr *MockRepositoryMockRecorder) ListDuePriceSchedules(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuePriceSchedules", reflect.TypeOf((*MockRepository)(nil).ListDuePriceSchedules), ctx, params)
+}
+
+// ListImportKeys mocks base method.
+func (m *MockRepository) ListImportKeys(ctx context.Context, ids, skus []string) ([]dbgen.ListProductKeysForImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportKeys", ctx, ids, skus)
+	ret0, _ := ret[0].([]dbgen.ListProductKeysForImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportKeys indicates an expected call of ListImportKeys.
+func (mr *MockRepositoryMockRecorder) ListImportKeys(ctx, ids, skus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportKeys", reflect.TypeOf((*MockRepository)(nil).ListImportKeys), ctx, ids, skus)
+}
+
+// ListPriceHistory mocks base method.
+func (m *MockRepository) ListPriceHistory(ctx context.Context, params dbgen.ListProductPriceHistoryParams) ([]dbgen.ProductPriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", ctx, params)
+	ret0, _ := ret[0].([]dbgen.ProductPriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory.
+func (mr *MockRepositoryMockRecorder) ListPriceHistory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockRepository)(nil).ListPriceHistory), ctx, params)
+}
+
+// ListPriceSchedules mocks base method.
+func (m *MockRepository) ListPriceSchedules(ctx context.Context, productID string) ([]dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceSchedules", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceSchedules indicates an expected call of ListPriceSchedules.
+func (mr *MockRepositoryMockRecorder) ListPriceSchedules(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceSchedules", reflect.TypeOf((*MockRepository)(nil).ListPriceSchedules), ctx, productID)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, params dbgen.UpdateProductParams) error {
 	m.ctrl.T.Helper()
@@ -127,3 +366,59 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, params)
 }
+
+// UpdateImported mocks base method.
+func (m *MockRepository) UpdateImported(ctx context.Context, params dbgen.UpdateImportedProductParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImported", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImported indicates an expected call of UpdateImported.
+func (mr *MockRepositoryMockRecorder) UpdateImported(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImported", reflect.TypeOf((*MockRepository)(nil).UpdateImported), ctx, params)
+}
+
+// UpdatePrice mocks base method.
+func (m *MockRepository) UpdatePrice(ctx context.Context, params dbgen.UpdateProductPriceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrice", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrice indicates an expected call of UpdatePrice.
+func (mr *MockRepositoryMockRecorder) UpdatePrice(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrice", reflect.TypeOf((*MockRepository)(nil).UpdatePrice), ctx, params)
+}
+
+// UpdatePriceScheduleState mocks base method.
+func (m *MockRepository) UpdatePriceScheduleState(ctx context.Context, params dbgen.UpdatePriceScheduleStateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceScheduleState", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePriceScheduleState indicates an expected call of UpdatePriceScheduleState.
+func (mr *MockRepositoryMockRecorder) UpdatePriceScheduleState(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceScheduleState", reflect.TypeOf((*MockRepository)(nil).UpdatePriceScheduleState), ctx, params)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) product.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(product.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
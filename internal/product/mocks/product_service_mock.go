@@ -12,7 +12,9 @@ package mock
 import (
 	product "assignment-ptes-achmad-rifai/internal/product"
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +43,35 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ApplyDuePriceSchedules mocks base method.
+func (m *MockService) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDuePriceSchedules", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDuePriceSchedules indicates an expected call of ApplyDuePriceSchedules.
+func (mr *MockServiceMockRecorder) ApplyDuePriceSchedules(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDuePriceSchedules", reflect.TypeOf((*MockService)(nil).ApplyDuePriceSchedules), ctx, now)
+}
+
+// CancelPriceSchedule mocks base method.
+func (m *MockService) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPriceSchedule", ctx, productID, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPriceSchedule indicates an expected call of CancelPriceSchedule.
+func (mr *MockServiceMockRecorder) CancelPriceSchedule(ctx, productID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPriceSchedule", reflect.TypeOf((*MockService)(nil).CancelPriceSchedule), ctx, productID, scheduleID)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req product.CreateProductRequest) (product.ProductResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +87,21 @@ func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req)
 }
 
+// CreatePriceSchedule mocks base method.
+func (m *MockService) CreatePriceSchedule(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSchedule", ctx, productID, req)
+	ret0, _ := ret[0].(product.PriceScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceSchedule indicates an expected call of CreatePriceSchedule.
+func (mr *MockServiceMockRecorder) CreatePriceSchedule(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSchedule", reflect.TypeOf((*MockService)(nil).CreatePriceSchedule), ctx, productID, req)
+}
+
 // Delete mocks base method.
 func (m *MockService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -70,6 +116,20 @@ func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
 }
 
+// Export mocks base method.
+func (m *MockService) Export(ctx context.Context, params product.ListParams, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, params, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockServiceMockRecorder) Export(ctx, params, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockService)(nil).Export), ctx, params, w)
+}
+
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id string) (product.ProductResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +145,21 @@ func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
 }
 
+// Import mocks base method.
+func (m *MockService) Import(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, format, r, dryRun)
+	ret0, _ := ret[0].(product.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockServiceMockRecorder) Import(ctx, format, r, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockService)(nil).Import), ctx, format, r, dryRun)
+}
+
 // List mocks base method.
 func (m *MockService) List(ctx context.Context, params product.ListParams) ([]product.ProductResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +176,53 @@ func (mr *MockServiceMockRecorder) List(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, params)
 }
 
+// ListByCategory mocks base method.
+func (m *MockService) ListByCategory(ctx context.Context, categoryID string, params product.ListParams) ([]product.ProductResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCategory", ctx, categoryID, params)
+	ret0, _ := ret[0].([]product.ProductResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByCategory indicates an expected call of ListByCategory.
+func (mr *MockServiceMockRecorder) ListByCategory(ctx, categoryID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCategory", reflect.TypeOf((*MockService)(nil).ListByCategory), ctx, categoryID, params)
+}
+
+// ListPriceSchedules mocks base method.
+func (m *MockService) ListPriceSchedules(ctx context.Context, productID string) ([]product.PriceScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceSchedules", ctx, productID)
+	ret0, _ := ret[0].([]product.PriceScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceSchedules indicates an expected call of ListPriceSchedules.
+func (mr *MockServiceMockRecorder) ListPriceSchedules(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceSchedules", reflect.TypeOf((*MockService)(nil).ListPriceSchedules), ctx, productID)
+}
+
+// PriceHistory mocks base method.
+func (m *MockService) PriceHistory(ctx context.Context, productID string, params product.PriceHistoryParams) ([]product.PriceHistoryResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceHistory", ctx, productID, params)
+	ret0, _ := ret[0].([]product.PriceHistoryResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PriceHistory indicates an expected call of PriceHistory.
+func (mr *MockServiceMockRecorder) PriceHistory(ctx, productID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceHistory", reflect.TypeOf((*MockService)(nil).PriceHistory), ctx, productID, params)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, id string, req product.UpdateProductRequest) (product.ProductResponse, error) {
 	m.ctrl.T.Helper()
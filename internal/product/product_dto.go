@@ -1,35 +1,73 @@
 package product
 
+import "time"
+
 type CreateProductRequest struct {
+	SKU           *string `json:"sku" binding:"omitempty,max=64"`
 	Name          string  `json:"name" binding:"required"`
 	Description   *string `json:"description"`
 	Price         float64 `json:"price" binding:"required,gt=0"`
 	CategoryID    string  `json:"category_id" binding:"required"`
 	StockQuantity int     `json:"stock_quantity" binding:"gte=0"`
 	IsActive      *bool   `json:"is_active"`
+
+	// Berat (gram) & dimensi (cm) untuk ongkos kirim; 0 berarti belum diisi
+	WeightGrams int `json:"weight_grams" binding:"gte=0"`
+	LengthCm    int `json:"length_cm" binding:"gte=0"`
+	WidthCm     int `json:"width_cm" binding:"gte=0"`
+	HeightCm    int `json:"height_cm" binding:"gte=0"`
 }
 
 type UpdateProductRequest struct {
+	SKU           *string `json:"sku" binding:"omitempty,max=64"`
 	Name          string  `json:"name" binding:"required"`
 	Description   *string `json:"description"`
 	Price         float64 `json:"price" binding:"required,gt=0"`
 	CategoryID    string  `json:"category_id" binding:"required"`
 	StockQuantity int     `json:"stock_quantity" binding:"gte=0"`
 	IsActive      *bool   `json:"is_active"`
+
+	// Berat (gram) & dimensi (cm) untuk ongkos kirim; 0 berarti belum diisi
+	WeightGrams int `json:"weight_grams" binding:"gte=0"`
+	LengthCm    int `json:"length_cm" binding:"gte=0"`
+	WidthCm     int `json:"width_cm" binding:"gte=0"`
+	HeightCm    int `json:"height_cm" binding:"gte=0"`
 }
 
 type ProductResponse struct {
 	ID            string  `json:"id"`
+	SKU           string  `json:"sku"`
 	Name          string  `json:"name"`
 	Description   string  `json:"description"`
 	Price         float64 `json:"price"`
 	StockQuantity int     `json:"stock_quantity"`
 	IsActive      bool    `json:"is_active"`
 	TotalSold     int     `json:"total_sold"`
+	WeightGrams   int     `json:"weight_grams"`
+	LengthCm      int     `json:"length_cm"`
+	WidthCm       int     `json:"width_cm"`
+	HeightCm      int     `json:"height_cm"`
+
+	// Agregasi varian; produk tanpa varian memakai price & stock_quantity miliknya
+	MinPrice     float64 `json:"min_price"`
+	MaxPrice     float64 `json:"max_price"`
+	TotalStock   int     `json:"total_stock"`
+	VariantCount int     `json:"variant_count"`
+
+	PrimaryImage *ProductImageResponse  `json:"primary_image"`
+	Images       []ProductImageResponse `json:"images"`
 
 	Category CategoryResponse `json:"category"`
 }
 
+type ProductImageResponse struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Position     int    `json:"position"`
+	IsPrimary    bool   `json:"is_primary"`
+}
+
 type CategoryResponse struct {
 	ID          string `json:"id"`
 	Name        string `json:"name,omitempty"`
@@ -47,3 +85,61 @@ type ListParams struct {
 	MaxStock *int32   `form:"max_stock"`
 	Sort     *string  `form:"sort"`
 }
+
+// ImportReport adalah hasil validasi/eksekusi bulk import per baris
+type ImportReport struct {
+	Format    string            `json:"format"`
+	DryRun    bool              `json:"dry_run"`
+	TotalRows int               `json:"total_rows"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
+
+type ImportRowResult struct {
+	// Row adalah nomor baris di file: CSV dihitung termasuk header, JSON mulai dari 1
+	Row    int                `json:"row"`
+	Action string             `json:"action"` // create, update, error
+	ID     string             `json:"id,omitempty"`
+	SKU    string             `json:"sku,omitempty"`
+	Errors []ImportFieldError `json:"errors,omitempty"`
+}
+
+type ImportFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type PriceHistoryParams struct {
+	Page     int `form:"page"`
+	PageSize int `form:"page_size"`
+}
+
+type PriceHistoryResponse struct {
+	ID         string    `json:"id"`
+	OldPrice   *float64  `json:"old_price"` // null untuk harga awal saat produk dibuat
+	NewPrice   float64   `json:"new_price"`
+	Source     string    `json:"source"` // create, manual, import, schedule
+	ScheduleID string    `json:"schedule_id,omitempty"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// CreatePriceScheduleRequest menjadwalkan harga baru; tanpa effective_to harga berlaku permanen
+type CreatePriceScheduleRequest struct {
+	Price         float64    `json:"price" binding:"required,gt=0"`
+	EffectiveFrom time.Time  `json:"effective_from" binding:"required"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+}
+
+type PriceScheduleResponse struct {
+	ID            string     `json:"id"`
+	ProductID     string     `json:"product_id"`
+	Price         float64    `json:"price"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+	Status        string     `json:"status"` // pending, active, completed, cancelled
+	PreviousPrice *float64   `json:"previous_price"`
+	AppliedAt     *time.Time `json:"applied_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
@@ -5,4 +5,14 @@ import "errors"
 var (
 	ErrInvalidProductName = errors.New("invalid product name")
 	ErrProductNotFound    = errors.New("product not found")
+	ErrCategoryNotFound   = errors.New("category not found")
+	ErrDuplicateSKU       = errors.New("sku already exists")
+
+	ErrUnsupportedImportFormat = errors.New("unsupported import format, use csv or json")
+	ErrInvalidImportFile       = errors.New("invalid import file")
+
+	ErrPriceScheduleNotFound   = errors.New("price schedule not found")
+	ErrInvalidPriceSchedule    = errors.New("effective_to must be after effective_from and in the future")
+	ErrPriceScheduleOverlap    = errors.New("price schedule overlaps an existing pending or active schedule")
+	ErrPriceScheduleNotPending = errors.New("only pending price schedules can be cancelled")
 )
@@ -0,0 +1,90 @@
+package product
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ExportPageSize adalah jumlah baris yang diambil per query saat streaming export
+const ExportPageSize = 500
+
+// exportHeader sama dengan kolom yang diterima Import, jadi file export bisa langsung di-import ulang
+var exportHeader = []string{
+	"id", "sku", "name", "description", "price", "category", "stock_quantity", "is_active",
+	"weight_grams", "length_cm", "width_cm", "height_cm",
+}
+
+// Export menulis seluruh produk yang cocok dengan filter sebagai CSV. Data diambil per halaman
+// dan di-flush tiap halaman supaya memory tetap kecil walau katalog berisi ribuan produk.
+func (s *service) Export(ctx context.Context, p ListParams, w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportHeader); err != nil {
+		return err
+	}
+
+	p.Page = 1
+	p.PageSize = ExportPageSize
+
+	for {
+		rows, err := s.repo.List(ctx, toListProductsParams(p))
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rows {
+			record := []string{
+				r.ID,
+				r.Sku.String,
+				r.Name,
+				r.Description.String,
+				r.Price.StringFixed(2),
+				r.CategoryName,
+				strconv.Itoa(int(r.StockQuantity)),
+				strconv.FormatBool(r.IsActive),
+				strconv.Itoa(int(r.WeightGrams)),
+				strconv.Itoa(int(r.LengthCm)),
+				strconv.Itoa(int(r.WidthCm)),
+				strconv.Itoa(int(r.HeightCm)),
+			}
+			for i := range record {
+				record[i] = escapeFormula(record[i])
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+
+		if len(rows) < p.PageSize {
+			return nil
+		}
+		p.Page++
+	}
+}
+
+// formulaPrefixes adalah karakter awal yang dieksekusi sebagai formula oleh Excel/Sheets
+const formulaPrefixes = "=+-@"
+
+// escapeFormula menambahkan ' di depan sel yang diawali karakter formula (CSV injection);
+// Import membuang ' tersebut sehingga file export tetap bisa di-import ulang
+func escapeFormula(v string) string {
+	if v != "" && strings.ContainsRune(formulaPrefixes, rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// unescapeFormula membalik escapeFormula untuk nilai hasil export
+func unescapeFormula(v string) string {
+	if len(v) > 1 && v[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(v[1])) {
+		return v[1:]
+	}
+	return v
+}
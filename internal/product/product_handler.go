@@ -3,8 +3,14 @@ package product
 import (
 	"assignment-ptes-achmad-rifai/internal/pkg/response"
 	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,6 +32,7 @@ func NewHandler(service Service) *Handler {
 // @Param        request body      CreateProductRequest  true  "Product Request"
 // @Success      201      {object}  ProductResponse
 // @Failure      400      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "SKU already exists"
 // @Router       /products [post]
 func (h *Handler) Create(c *gin.Context) {
 	var req CreateProductRequest
@@ -36,6 +43,10 @@ func (h *Handler) Create(c *gin.Context) {
 
 	res, err := h.service.Create(c.Request.Context(), req)
 	if err != nil {
+		if errors.Is(err, ErrDuplicateSKU) {
+			response.Error(c, 409, "DUPLICATE_SKU", err.Error(), nil)
+			return
+		}
 		response.Error(c, 500, "CREATE_ERROR", "Failed to create product", err.Error())
 		return
 	}
@@ -52,49 +63,44 @@ func (h *Handler) Create(c *gin.Context) {
 // @Success      200      {array}   ProductResponse
 // @Router       /products [get]
 func (h *Handler) GetAll(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-
-	// Tangkap filter dari query params
-	name := c.Query("name")
-	categoryID := c.Query("category_id")
-	minPriceStr := c.Query("min_price")
-	maxPriceStr := c.Query("max_price")
-	sortBy := c.DefaultQuery("sort", "name_asc") // Default sort
-
-	params := ListParams{
-		Page:     page,
-		PageSize: pageSize,
-		Sort:     &sortBy,
-	}
-
-	// Mapping string ke tipe data yang sesuai (pointer)
-	if name != "" {
-		params.Name = &name
-	}
-	if categoryID != "" {
+	params := parseListParams(c)
+	if categoryID := c.Query("category_id"); categoryID != "" {
 		params.Category = &categoryID
 	}
 
-	if minPrice, err := strconv.ParseFloat(minPriceStr, 64); err == nil {
-		params.MinPrice = &minPrice
-	}
-	if maxPrice, err := strconv.ParseFloat(maxPriceStr, 64); err == nil {
-		params.MaxPrice = &maxPrice
+	data, total, err := h.service.List(c.Request.Context(), params)
+	if err != nil {
+		response.Error(c, 500, "LIST_ERROR", "Failed to list products", err.Error())
+		return
 	}
 
-	data, total, err := h.service.List(c.Request.Context(), params)
+	response.Success(c, 200, data, paginationMeta(total, params))
+}
+
+// GetByCategory godoc
+// @Summary      List products in a category
+// @Description  Get the products of a single category using the same filters as the product list
+// @Tags         categories
+// @Produce      json
+// @Param        id       path     string      true   "Category ID"
+// @Param        query    query    ListParams  false  "Filter & Pagination Query"
+// @Success      200      {array}   ProductResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /categories/{id}/products [get]
+func (h *Handler) GetByCategory(c *gin.Context) {
+	params := parseListParams(c)
+
+	data, total, err := h.service.ListByCategory(c.Request.Context(), c.Param("id"), params)
 	if err != nil {
+		if errors.Is(err, ErrCategoryNotFound) {
+			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
+			return
+		}
 		response.Error(c, 500, "LIST_ERROR", "Failed to list products", err.Error())
 		return
 	}
 
-	response.Success(c, 200, data, &response.PaginationMeta{
-		Total:      total,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
-	})
+	response.Success(c, 200, data, paginationMeta(total, params))
 }
 
 // GetByID godoc
@@ -133,6 +139,7 @@ func (h *Handler) GetByID(c *gin.Context) {
 // @Success      200      {object}  ProductResponse
 // @Failure      400      {object}  map[string]string
 // @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "SKU already exists"
 // @Router       /products/{id} [put]
 func (h *Handler) Update(c *gin.Context) {
 	id := c.Param("id")
@@ -147,6 +154,10 @@ func (h *Handler) Update(c *gin.Context) {
 			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
 			return
 		}
+		if errors.Is(err, ErrDuplicateSKU) {
+			response.Error(c, 409, "DUPLICATE_SKU", err.Error(), nil)
+			return
+		}
 		response.Error(c, 500, "GET_ERROR", "Failed to get product", err.Error())
 		return
 	}
@@ -179,3 +190,257 @@ func (h *Handler) Delete(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, nil, nil)
 }
+
+// Import godoc
+// @Summary      Bulk import products
+// @Description  Upsert products from a CSV or JSON file (matched by id, then sku). Columns: id, sku, name, description, price, category (name or id), stock_quantity, is_active, weight_grams, length_cm, width_cm, height_cm. On update, empty or missing optional columns keep their current value. Invalid rows are reported and skipped; valid rows are written in transactional batches.
+// @Tags         products
+// @Accept       text/csv,application/json,multipart/form-data
+// @Produce      json
+// @Param        format   query     string  false  "csv or json (default: from file extension or Content-Type)"
+// @Param        dry_run  query     bool    false  "Validate only, do not write"
+// @Param        file     formData  file    false  "Import file (multipart upload)"
+// @Success      200      {object}  ImportReport
+// @Failure      400      {object}  map[string]string
+// @Router       /products/import [post]
+func (h *Handler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxImportBytes)
+
+	dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	format := c.Query("format")
+
+	var body io.Reader = c.Request.Body
+	if c.ContentType() == "multipart/form-data" {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			response.Error(c, 400, "VALIDATION_ERROR", "Field 'file' is required", err.Error())
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			response.Error(c, 400, "VALIDATION_ERROR", "Failed to read uploaded file", err.Error())
+			return
+		}
+		defer file.Close()
+
+		body = file
+		if format == "" {
+			format = strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")
+		}
+	} else if format == "" {
+		format = importFormatFromContentType(c.ContentType())
+	}
+
+	report, err := h.service.Import(c.Request.Context(), format, body, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUnsupportedImportFormat):
+			response.Error(c, 400, "UNSUPPORTED_FORMAT", err.Error(), nil)
+		case errors.Is(err, ErrInvalidImportFile):
+			response.Error(c, 400, "INVALID_FILE", err.Error(), nil)
+		default:
+			response.Error(c, 500, "IMPORT_ERROR", "Failed to import products", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, 200, report, nil)
+}
+
+// Export godoc
+// @Summary      Export products as CSV
+// @Description  Stream every product matching the list filters as CSV (same columns as import)
+// @Tags         products
+// @Produce      text/csv
+// @Param        query    query    ListParams  false  "Filter Query (pagination is ignored)"
+// @Success      200      {file}   file
+// @Router       /products/export [get]
+func (h *Handler) Export(c *gin.Context) {
+	params := parseListParams(c)
+	if categoryID := c.Query("category_id"); categoryID != "" {
+		params.Category = &categoryID
+	}
+
+	filename := fmt.Sprintf("products-%s.csv", time.Now().Format("20060102-150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+
+	if err := h.service.Export(c.Request.Context(), params, c.Writer); err != nil {
+		if !c.Writer.Written() {
+			response.Error(c, 500, "EXPORT_ERROR", "Failed to export products", err.Error())
+			return
+		}
+		// Sebagian CSV sudah terkirim, status tidak bisa diubah lagi
+		log.Printf("product export aborted: %v", err)
+	}
+}
+
+// PriceHistory godoc
+// @Summary      Product price history
+// @Description  List every recorded price change of a product, newest first
+// @Tags         products
+// @Produce      json
+// @Param        id         path     string  true   "Product ID"
+// @Param        page       query    int     false  "Page (default 1)"
+// @Param        page_size  query    int     false  "Page size (default 10)"
+// @Success      200      {array}   PriceHistoryResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /products/{id}/price-history [get]
+func (h *Handler) PriceHistory(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	params := PriceHistoryParams{Page: page, PageSize: pageSize}
+
+	data, total, err := h.service.PriceHistory(c.Request.Context(), c.Param("id"), params)
+	if err != nil {
+		if errors.Is(err, ErrProductNotFound) {
+			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
+			return
+		}
+		response.Error(c, 500, "LIST_ERROR", "Failed to list price history", err.Error())
+		return
+	}
+
+	response.Success(c, 200, data, paginationMeta(total, ListParams{Page: page, PageSize: pageSize}))
+}
+
+// CreatePriceSchedule godoc
+// @Summary      Schedule a price change
+// @Description  Schedule a future price for a product. Without effective_to the new price stays; with it the previous price is restored when the window ends.
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                      true  "Product ID"
+// @Param        request  body      CreatePriceScheduleRequest  true  "Schedule Request"
+// @Success      201      {object}  PriceScheduleResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Overlapping schedule"
+// @Router       /products/{id}/price-schedules [post]
+func (h *Handler) CreatePriceSchedule(c *gin.Context) {
+	var req CreatePriceScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, 400, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.CreatePriceSchedule(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidPriceSchedule):
+			response.Error(c, 400, "VALIDATION_ERROR", err.Error(), nil)
+		case errors.Is(err, ErrProductNotFound):
+			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrPriceScheduleOverlap):
+			response.Error(c, 409, "SCHEDULE_OVERLAP", err.Error(), nil)
+		default:
+			response.Error(c, 500, "CREATE_ERROR", "Failed to create price schedule", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// ListPriceSchedules godoc
+// @Summary      List price schedules
+// @Description  List all price schedules of a product, latest effective_from first
+// @Tags         products
+// @Produce      json
+// @Param        id       path      string  true  "Product ID"
+// @Success      200      {array}   PriceScheduleResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /products/{id}/price-schedules [get]
+func (h *Handler) ListPriceSchedules(c *gin.Context) {
+	data, err := h.service.ListPriceSchedules(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, ErrProductNotFound) {
+			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
+			return
+		}
+		response.Error(c, 500, "LIST_ERROR", "Failed to list price schedules", err.Error())
+		return
+	}
+
+	response.Success(c, 200, data, nil)
+}
+
+// CancelPriceSchedule godoc
+// @Summary      Cancel a price schedule
+// @Description  Cancel a pending price schedule. Active or finished schedules cannot be cancelled.
+// @Tags         products
+// @Produce      json
+// @Param        id           path      string  true  "Product ID"
+// @Param        schedule_id  path      string  true  "Schedule ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Schedule is not pending"
+// @Router       /products/{id}/price-schedules/{schedule_id} [delete]
+func (h *Handler) CancelPriceSchedule(c *gin.Context) {
+	err := h.service.CancelPriceSchedule(c.Request.Context(), c.Param("id"), c.Param("schedule_id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrPriceScheduleNotFound):
+			response.Error(c, 404, "NOT_FOUND", err.Error(), nil)
+		case errors.Is(err, ErrPriceScheduleNotPending):
+			response.Error(c, 409, "SCHEDULE_NOT_PENDING", err.Error(), nil)
+		default:
+			response.Error(c, 500, "DELETE_ERROR", "Failed to cancel price schedule", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
+func importFormatFromContentType(contentType string) string {
+	switch contentType {
+	case "text/csv", "application/csv":
+		return "csv"
+	case "application/json":
+		return "json"
+	}
+	return ""
+}
+
+// parseListParams membaca filter & pagination produk dari query string
+func parseListParams(c *gin.Context) ListParams {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	// Tangkap filter dari query params
+	name := c.Query("name")
+	minPriceStr := c.Query("min_price")
+	maxPriceStr := c.Query("max_price")
+	sortBy := c.DefaultQuery("sort", "name_asc") // Default sort
+
+	params := ListParams{
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     &sortBy,
+	}
+
+	// Mapping string ke tipe data yang sesuai (pointer)
+	if name != "" {
+		params.Name = &name
+	}
+
+	if minPrice, err := strconv.ParseFloat(minPriceStr, 64); err == nil {
+		params.MinPrice = &minPrice
+	}
+	if maxPrice, err := strconv.ParseFloat(maxPriceStr, 64); err == nil {
+		params.MaxPrice = &maxPrice
+	}
+
+	return params
+}
+
+func paginationMeta(total int64, p ListParams) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalPages: int((total + int64(p.PageSize) - 1) / int64(p.PageSize)),
+	}
+}
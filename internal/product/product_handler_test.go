@@ -6,10 +6,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
 	"assignment-ptes-achmad-rifai/internal/product"
 
 	"github.com/gin-gonic/gin"
@@ -24,6 +29,15 @@ type fakeProductService struct {
 	GetByIDFn func(ctx context.Context, id string) (product.ProductResponse, error)
 	UpdateFn  func(ctx context.Context, id string, req product.UpdateProductRequest) (product.ProductResponse, error)
 	DeleteFn  func(ctx context.Context, id string) error
+
+	ListByCategoryFn func(ctx context.Context, categoryID string, params product.ListParams) ([]product.ProductResponse, int64, error)
+	ImportFn         func(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error)
+	ExportFn         func(ctx context.Context, params product.ListParams, w io.Writer) error
+
+	PriceHistoryFn        func(ctx context.Context, productID string, params product.PriceHistoryParams) ([]product.PriceHistoryResponse, int64, error)
+	CreatePriceScheduleFn func(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error)
+	ListPriceSchedulesFn  func(ctx context.Context, productID string) ([]product.PriceScheduleResponse, error)
+	CancelPriceScheduleFn func(ctx context.Context, productID, scheduleID string) error
 }
 
 func (f *fakeProductService) Create(ctx context.Context, req product.CreateProductRequest) (product.ProductResponse, error) {
@@ -32,6 +46,9 @@ func (f *fakeProductService) Create(ctx context.Context, req product.CreateProdu
 func (f *fakeProductService) List(ctx context.Context, p product.ListParams) ([]product.ProductResponse, int64, error) {
 	return f.ListFn(ctx, p)
 }
+func (f *fakeProductService) ListByCategory(ctx context.Context, categoryID string, p product.ListParams) ([]product.ProductResponse, int64, error) {
+	return f.ListByCategoryFn(ctx, categoryID, p)
+}
 func (f *fakeProductService) GetByID(ctx context.Context, id string) (product.ProductResponse, error) {
 	return f.GetByIDFn(ctx, id)
 }
@@ -41,6 +58,28 @@ func (f *fakeProductService) Update(ctx context.Context, id string, req product.
 func (f *fakeProductService) Delete(ctx context.Context, id string) error {
 	return f.DeleteFn(ctx, id)
 }
+func (f *fakeProductService) Import(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error) {
+	return f.ImportFn(ctx, format, r, dryRun)
+}
+func (f *fakeProductService) Export(ctx context.Context, params product.ListParams, w io.Writer) error {
+	return f.ExportFn(ctx, params, w)
+}
+
+func (f *fakeProductService) PriceHistory(ctx context.Context, productID string, params product.PriceHistoryParams) ([]product.PriceHistoryResponse, int64, error) {
+	return f.PriceHistoryFn(ctx, productID, params)
+}
+func (f *fakeProductService) CreatePriceSchedule(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+	return f.CreatePriceScheduleFn(ctx, productID, req)
+}
+func (f *fakeProductService) ListPriceSchedules(ctx context.Context, productID string) ([]product.PriceScheduleResponse, error) {
+	return f.ListPriceSchedulesFn(ctx, productID)
+}
+func (f *fakeProductService) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) error {
+	return f.CancelPriceScheduleFn(ctx, productID, scheduleID)
+}
+func (f *fakeProductService) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
 
 // ==================== HELPERS ====================
 
@@ -159,6 +198,51 @@ func TestHandler_GetByID(t *testing.T) {
 	})
 }
 
+func TestHandler_GetByCategory(t *testing.T) {
+	t.Run("success - reuses list filters", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListByCategoryFn: func(ctx context.Context, categoryID string, p product.ListParams) ([]product.ProductResponse, int64, error) {
+				assert.Equal(t, "cat-1", categoryID)
+				assert.Equal(t, 2, p.Page)
+				assert.Equal(t, "kaos", *p.Name)
+				assert.Equal(t, float64(5000), *p.MinPrice)
+				return []product.ProductResponse{{ID: "p-1"}}, 11, nil
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		r.GET("/categories/:id/products", handler.GetByCategory)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/cat-1/products?page=2&name=kaos&min_price=5000", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var res response.ApiEnvelope
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, int64(11), res.Meta.Total)
+		assert.Equal(t, 2, res.Meta.TotalPages)
+	})
+
+	t.Run("error - category not found", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListByCategoryFn: func(ctx context.Context, categoryID string, p product.ListParams) ([]product.ProductResponse, int64, error) {
+				return nil, 0, product.ErrCategoryNotFound
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		r.GET("/categories/:id/products", handler.GetByCategory)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/none/products", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func TestHandler_Update(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeProductService{
@@ -214,3 +298,237 @@ func TestHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestHandler_Import(t *testing.T) {
+	t.Run("success - csv body with dry run", func(t *testing.T) {
+		svc := &fakeProductService{
+			ImportFn: func(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error) {
+				data, _ := io.ReadAll(r)
+				assert.Equal(t, "csv", format)
+				assert.True(t, dryRun)
+				assert.Contains(t, string(data), "Kaos Polos")
+				return product.ImportReport{Format: format, DryRun: dryRun, TotalRows: 1, Created: 1}, nil
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		product.RegisterRoutes(r.Group(""), handler)
+
+		body := "name,price,category\nKaos Polos,50000,Pakaian\n"
+		req := httptest.NewRequest(http.MethodPost, "/products/import?dry_run=true", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "text/csv")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("success - multipart upload uses file extension", func(t *testing.T) {
+		svc := &fakeProductService{
+			ImportFn: func(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error) {
+				assert.Equal(t, "json", format)
+				assert.False(t, dryRun)
+				return product.ImportReport{}, nil
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		r.POST("/products/import", handler.Import)
+
+		var buf bytes.Buffer
+		mw := multipart.NewWriter(&buf)
+		part, _ := mw.CreateFormFile("file", "products.json")
+		part.Write([]byte(`[]`))
+		mw.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/products/import", &buf)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		svc := &fakeProductService{
+			ImportFn: func(ctx context.Context, format string, r io.Reader, dryRun bool) (product.ImportReport, error) {
+				return product.ImportReport{}, product.ErrUnsupportedImportFormat
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		r.POST("/products/import", handler.Import)
+
+		req := httptest.NewRequest(http.MethodPost, "/products/import", bytes.NewReader([]byte("x")))
+		req.Header.Set("Content-Type", "text/plain")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandler_Export(t *testing.T) {
+	t.Run("success - streams csv", func(t *testing.T) {
+		svc := &fakeProductService{
+			ExportFn: func(ctx context.Context, params product.ListParams, w io.Writer) error {
+				assert.Equal(t, "cat-1", *params.Category)
+				_, err := w.Write([]byte("id,sku,name\n"))
+				return err
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		product.RegisterRoutes(r.Group(""), handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/export?category_id=cat-1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+		assert.Equal(t, "id,sku,name\n", w.Body.String())
+	})
+
+	t.Run("error before any output", func(t *testing.T) {
+		svc := &fakeProductService{
+			ExportFn: func(ctx context.Context, params product.ListParams, w io.Writer) error {
+				return errors.New("db error")
+			},
+		}
+		r := setupTestRouter()
+		handler := product.NewHandler(svc)
+		r.GET("/products/export", handler.Export)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/export", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_PriceHistory(t *testing.T) {
+	t.Run("success with pagination meta", func(t *testing.T) {
+		svc := &fakeProductService{
+			PriceHistoryFn: func(ctx context.Context, productID string, params product.PriceHistoryParams) ([]product.PriceHistoryResponse, int64, error) {
+				assert.Equal(t, "prod-1", productID)
+				assert.Equal(t, 2, params.Page)
+				return []product.PriceHistoryResponse{{ID: "h-1", NewPrice: 5000, Source: product.PriceSourceManual}}, 11, nil
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/prod-1/price-history?page=2&page_size=10", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp response.ApiEnvelope
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, int64(11), resp.Meta.Total)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		svc := &fakeProductService{
+			PriceHistoryFn: func(ctx context.Context, productID string, params product.PriceHistoryParams) ([]product.PriceHistoryResponse, int64, error) {
+				return nil, 0, product.ErrProductNotFound
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/none/price-history", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandler_PriceSchedules(t *testing.T) {
+	body := `{"price": 4500, "effective_from": "2030-01-01T00:00:00+07:00", "effective_to": "2030-01-08T00:00:00+07:00"}`
+
+	t.Run("create success", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceScheduleFn: func(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+				assert.Equal(t, "prod-1", productID)
+				assert.NotNil(t, req.EffectiveTo)
+				return product.PriceScheduleResponse{ID: "s-1", Status: product.PriceSchedulePending}, nil
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/price-schedules", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("create overlap", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceScheduleFn: func(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+				return product.PriceScheduleResponse{}, product.ErrPriceScheduleOverlap
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/price-schedules", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("create missing price", func(t *testing.T) {
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(&fakeProductService{}))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/price-schedules", strings.NewReader(`{"effective_from": "2030-01-01T00:00:00Z"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPriceSchedulesFn: func(ctx context.Context, productID string) ([]product.PriceScheduleResponse, error) {
+				return []product.PriceScheduleResponse{{ID: "s-1"}}, nil
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/prod-1/price-schedules", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("cancel not pending", func(t *testing.T) {
+		svc := &fakeProductService{
+			CancelPriceScheduleFn: func(ctx context.Context, productID, scheduleID string) error {
+				assert.Equal(t, "s-1", scheduleID)
+				return product.ErrPriceScheduleNotPending
+			},
+		}
+		r := setupTestRouter()
+		product.RegisterRoutes(r.Group(""), product.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodDelete, "/products/prod-1/price-schedules/s-1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,485 @@
+package product
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ImportBatchSize adalah jumlah baris valid yang ditulis dalam satu transaksi
+	ImportBatchSize = 200
+	// MaxImportRows membatasi jumlah baris per file
+	MaxImportRows = 20000
+	// MaxImportBytes membatasi ukuran body request import (32 MB)
+	MaxImportBytes = 32 << 20
+)
+
+const (
+	importActionCreate = "create"
+	importActionUpdate = "update"
+	importActionError  = "error"
+)
+
+// dimensionColumns adalah kolom berat (gram) & dimensi (cm) yang opsional di file import
+var dimensionColumns = []string{"weight_grams", "length_cm", "width_cm", "height_cm"}
+
+// importRecord adalah satu baris mentah dari file, semua nilai masih berupa string
+type importRecord struct {
+	row    int
+	fields map[string]string
+}
+
+// importItem adalah baris valid yang siap ditulis; tepat satu dari create/update terisi
+type importItem struct {
+	result *ImportRowResult
+	create *dbgen.CreateProductParams
+	update *dbgen.UpdateImportedProductParams
+}
+
+// importState menyimpan lookup yang dipakai lintas baris selama satu import
+type importState struct {
+	categories map[string]string // lower(name) dan id -> category id
+	seenIDs    map[string]int
+	seenSKUs   map[string]int
+
+	// Produk yang sudah ada di database, dimuat per chunk oleh loadImportKeys
+	existingIDs map[string]bool
+	skuOwners   map[string]string // sku -> product id
+}
+
+// Import memvalidasi setiap baris lalu melakukan upsert (berdasarkan id atau sku).
+// Baris yang tidak valid dilaporkan dan dilewati; baris valid ditulis per batch dalam
+// transaksi terpisah. Dengan dryRun=true tidak ada yang ditulis ke database.
+func (s *service) Import(ctx context.Context, format string, r io.Reader, dryRun bool) (ImportReport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+
+	records, err := parseImport(format, r)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	categories, err := s.repo.ListCategoryNames(ctx)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	state := importState{
+		categories:  make(map[string]string, len(categories)*2),
+		seenIDs:     map[string]int{},
+		seenSKUs:    map[string]int{},
+		existingIDs: map[string]bool{},
+		skuOwners:   map[string]string{},
+	}
+	for _, c := range categories {
+		state.categories[strings.ToLower(c.Name)] = c.ID
+		state.categories[c.ID] = c.ID
+	}
+
+	report := ImportReport{
+		Format:    format,
+		DryRun:    dryRun,
+		TotalRows: len(records),
+		Rows:      make([]ImportRowResult, len(records)),
+	}
+
+	items := make([]importItem, 0, len(records))
+	for i, rec := range records {
+		// Produk yang sudah ada dimuat sekali per chunk, bukan query per baris
+		if i%ImportBatchSize == 0 {
+			chunk := records[i:min(i+ImportBatchSize, len(records))]
+			if err := s.loadImportKeys(ctx, chunk, &state); err != nil {
+				return ImportReport{}, err
+			}
+		}
+
+		res := &report.Rows[i]
+		res.Row = rec.row
+
+		item, fieldErrs, err := validateImportRow(rec, &state)
+		if err != nil {
+			return ImportReport{}, err
+		}
+		if len(fieldErrs) > 0 {
+			res.Action = importActionError
+			res.Errors = fieldErrs
+			continue
+		}
+
+		*res = *item.result
+		item.result = res
+		items = append(items, item)
+	}
+
+	if !dryRun {
+		for start := 0; start < len(items); start += ImportBatchSize {
+			batch := items[start:min(start+ImportBatchSize, len(items))]
+			if err := s.writeImportBatch(ctx, batch); err != nil {
+				for _, item := range batch {
+					item.result.Action = importActionError
+					item.result.Errors = append(item.result.Errors, ImportFieldError{
+						Message: "batch rolled back: " + err.Error(),
+					})
+				}
+			}
+		}
+	}
+
+	for _, res := range report.Rows {
+		switch res.Action {
+		case importActionCreate:
+			report.Created++
+		case importActionUpdate:
+			report.Updated++
+		default:
+			report.Failed++
+		}
+	}
+
+	if !dryRun && report.Created+report.Updated > 0 {
+		s.invalidateDashboardCache(ctx)
+	}
+
+	return report, nil
+}
+
+// loadImportKeys mengambil id & sku yang sudah ada untuk satu chunk dalam satu query
+func (s *service) loadImportKeys(ctx context.Context, chunk []importRecord, state *importState) error {
+	var ids, skus []string
+	for _, rec := range chunk {
+		if id := rec.fields["id"]; id != "" {
+			ids = append(ids, id)
+		}
+		if sku := rec.fields["sku"]; sku != "" {
+			skus = append(skus, sku)
+		}
+	}
+	if len(ids) == 0 && len(skus) == 0 {
+		return nil
+	}
+	slices.Sort(ids)
+	slices.Sort(skus)
+
+	rows, err := s.repo.ListImportKeys(ctx, slices.Compact(ids), slices.Compact(skus))
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		state.existingIDs[row.ID] = true
+		if row.Sku.Valid {
+			state.skuOwners[row.Sku.String] = row.ID
+		}
+	}
+	return nil
+}
+
+func validateImportRow(rec importRecord, state *importState) (importItem, []ImportFieldError, error) {
+	var errs []ImportFieldError
+	fail := func(field, message string) {
+		errs = append(errs, ImportFieldError{Field: field, Message: message})
+	}
+
+	id := rec.fields["id"]
+	sku := rec.fields["sku"]
+	name := rec.fields["name"]
+	description := rec.fields["description"]
+
+	if name == "" {
+		fail("name", "is required")
+	} else if len(name) > 150 {
+		fail("name", "must be at most 150 characters")
+	}
+	if len(sku) > 64 {
+		fail("sku", "must be at most 64 characters")
+	}
+
+	price, err := decimal.NewFromString(rec.fields["price"])
+	if err != nil {
+		fail("price", "must be a number")
+	} else if !price.IsPositive() {
+		fail("price", "must be greater than 0")
+	}
+
+	categoryID, ok := state.categories[strings.ToLower(rec.fields["category"])]
+	if rec.fields["category"] == "" {
+		fail("category", "is required")
+	} else if !ok {
+		fail("category", fmt.Sprintf("category %q not found", rec.fields["category"]))
+	}
+
+	// Kolom opsional yang kosong tetap NULL: create memakai default, update mempertahankan nilai lama
+	stock := parseImportInt(rec.fields, "stock_quantity", fail)
+
+	// Berat & dimensi opsional, urutannya sama dengan dimensionColumns
+	var dims [4]sql.NullInt32
+	for i, field := range dimensionColumns {
+		dims[i] = parseImportInt(rec.fields, field, fail)
+	}
+
+	var isActive sql.NullBool
+	if v := rec.fields["is_active"]; v != "" {
+		if isActive.Bool, err = strconv.ParseBool(v); err != nil {
+			fail("is_active", "must be true or false")
+		}
+		isActive.Valid = true
+	}
+
+	if id != "" {
+		if prev, dup := state.seenIDs[id]; dup {
+			fail("id", fmt.Sprintf("duplicate of row %d", prev))
+		}
+	}
+	if sku != "" {
+		if prev, dup := state.seenSKUs[sku]; dup {
+			fail("sku", fmt.Sprintf("duplicate of row %d", prev))
+		}
+	}
+	if len(errs) > 0 {
+		return importItem{}, errs, nil
+	}
+
+	// Cari produk yang sudah ada: id diutamakan, lalu sku
+	skuOwner := ""
+	if sku != "" {
+		skuOwner = state.skuOwners[sku]
+	}
+
+	action := importActionCreate
+	switch {
+	case id != "":
+		if !state.existingIDs[id] {
+			fail("id", "product not found")
+		} else if skuOwner != "" && skuOwner != id {
+			fail("sku", "already used by another product")
+		}
+		action = importActionUpdate
+	case skuOwner != "":
+		id = skuOwner
+		action = importActionUpdate
+	default:
+		newUUID, err := uuid.NewV7()
+		if err != nil {
+			return importItem{}, nil, err
+		}
+		id = newUUID.String()
+	}
+	if len(errs) > 0 {
+		return importItem{}, errs, nil
+	}
+
+	state.seenIDs[id] = rec.row
+	if sku != "" {
+		state.seenSKUs[sku] = rec.row
+	}
+
+	skuNull := sql.NullString{String: sku, Valid: sku != ""}
+	descNull := sql.NullString{String: description, Valid: description != ""}
+
+	item := importItem{
+		result: &ImportRowResult{Row: rec.row, Action: action, ID: id, SKU: sku},
+	}
+	if action == importActionCreate {
+		item.create = &dbgen.CreateProductParams{
+			ID:            id,
+			Sku:           skuNull,
+			Name:          name,
+			Description:   descNull,
+			Price:         price,
+			CategoryID:    categoryID,
+			StockQuantity: stock.Int32,
+			WeightGrams:   dims[0].Int32,
+			LengthCm:      dims[1].Int32,
+			WidthCm:       dims[2].Int32,
+			HeightCm:      dims[3].Int32,
+			IsActive:      !isActive.Valid || isActive.Bool,
+		}
+	} else {
+		item.update = &dbgen.UpdateImportedProductParams{
+			ID:            id,
+			Sku:           skuNull,
+			Name:          name,
+			Description:   descNull,
+			Price:         price,
+			CategoryID:    categoryID,
+			StockQuantity: stock,
+			WeightGrams:   dims[0],
+			LengthCm:      dims[1],
+			WidthCm:       dims[2],
+			HeightCm:      dims[3],
+			IsActive:      isActive,
+		}
+	}
+
+	return item, nil, nil
+}
+
+// parseImportInt membaca kolom bilangan bulat non-negatif; kolom kosong menghasilkan NULL
+func parseImportInt(fields map[string]string, field string, fail func(field, message string)) sql.NullInt32 {
+	v := fields[field]
+	if v == "" {
+		return sql.NullInt32{}
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		fail(field, "must be a non-negative integer")
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(n), Valid: true}
+}
+
+func (s *service) writeImportBatch(ctx context.Context, batch []importItem) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	for _, item := range batch {
+		if item.create != nil {
+			err = txRepo.Create(ctx, *item.create)
+			if err == nil {
+				err = recordPriceChange(ctx, txRepo, item.create.ID, decimal.NullDecimal{}, item.create.Price, PriceSourceImport, "")
+			}
+		} else {
+			err = writeImportUpdate(ctx, txRepo, *item.update)
+		}
+		if err != nil {
+			if helper.IsDuplicateKeyError(err) {
+				err = ErrDuplicateSKU
+			}
+			return fmt.Errorf("row %d: %w", item.result.Row, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// writeImportUpdate mengunci harga lama sebelum update agar perubahan harga tercatat di history
+func writeImportUpdate(ctx context.Context, repo Repository, params dbgen.UpdateImportedProductParams) error {
+	oldPrice, err := repo.GetPriceForUpdate(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+	if err := repo.UpdateImported(ctx, params); err != nil {
+		return err
+	}
+	return recordPriceChange(ctx, repo, params.ID, decimal.NewNullDecimal(oldPrice), params.Price, PriceSourceImport, "")
+}
+
+func parseImport(format string, r io.Reader) ([]importRecord, error) {
+	var (
+		records []importRecord
+		err     error
+	)
+
+	switch format {
+	case "csv":
+		records, err = parseImportCSV(r)
+	case "json":
+		records, err = parseImportJSON(r)
+	default:
+		return nil, ErrUnsupportedImportFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+	if len(records) > MaxImportRows {
+		return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidImportFile, MaxImportRows)
+	}
+
+	return records, nil
+}
+
+func parseImportCSV(r io.Reader) ([]importRecord, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, err
+	}
+
+	columns := make([]string, len(header))
+	for i, h := range header {
+		// Excel sering menambahkan BOM di awal file
+		columns[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+	}
+	for _, required := range []string{"name", "price", "category"} {
+		if !slices.Contains(columns, required) {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	var records []importRecord
+	for {
+		values, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		fields := make(map[string]string, len(columns))
+		for i, col := range columns {
+			fields[col] = unescapeFormula(strings.TrimSpace(values[i]))
+		}
+		records = append(records, importRecord{row: line, fields: fields})
+
+		if len(records) > MaxImportRows {
+			break
+		}
+	}
+
+	return records, nil
+}
+
+func parseImportJSON(r io.Reader) ([]importRecord, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var raw []map[string]any
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	records := make([]importRecord, 0, len(raw))
+	for i, obj := range raw {
+		fields := make(map[string]string, len(obj))
+		for k, v := range obj {
+			var value string
+			switch val := v.(type) {
+			case nil:
+			case string:
+				value = val
+			case json.Number:
+				value = val.String()
+			case bool:
+				value = strconv.FormatBool(val)
+			default:
+				return nil, fmt.Errorf("row %d: field %q must be a string, number or boolean", i+1, k)
+			}
+			fields[strings.ToLower(k)] = strings.TrimSpace(value)
+		}
+		records = append(records, importRecord{row: i + 1, fields: fields})
+	}
+
+	return records, nil
+}
@@ -0,0 +1,373 @@
+package product
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Sumber perubahan harga yang dicatat di product_price_history
+const (
+	PriceSourceCreate   = "create"
+	PriceSourceManual   = "manual"
+	PriceSourceImport   = "import"
+	PriceSourceSchedule = "schedule"
+)
+
+// Status jadwal harga: pending -> active (punya effective_to) -> completed,
+// pending -> completed (tanpa effective_to), atau pending -> cancelled
+const (
+	PriceSchedulePending   = "pending"
+	PriceScheduleActive    = "active"
+	PriceScheduleCompleted = "completed"
+	PriceScheduleCancelled = "cancelled"
+)
+
+// PriceScheduleBatchSize membatasi jumlah jadwal yang diproses per tick worker
+const PriceScheduleBatchSize = 100
+
+func (s *service) PriceHistory(
+	ctx context.Context,
+	productID string,
+	p PriceHistoryParams,
+) ([]PriceHistoryResponse, int64, error) {
+	if err := s.ensureProductExists(ctx, productID); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.repo.ListPriceHistory(ctx, dbgen.ListProductPriceHistoryParams{
+		ProductID: productID,
+		Limit:     int32(p.PageSize),
+		Offset:    int32((p.Page - 1) * p.PageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountPriceHistory(ctx, productID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]PriceHistoryResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, PriceHistoryResponse{
+			ID:         r.ID,
+			OldPrice:   helper.NullDecimalToFloat64Ptr(r.OldPrice),
+			NewPrice:   helper.DecimalToFloat64(r.NewPrice),
+			Source:     r.Source,
+			ScheduleID: r.ScheduleID.String,
+			ChangedAt:  r.ChangedAt,
+		})
+	}
+
+	return res, total, nil
+}
+
+func (s *service) CreatePriceSchedule(
+	ctx context.Context,
+	productID string,
+	req CreatePriceScheduleRequest,
+) (PriceScheduleResponse, error) {
+	// Jadwal disimpan dalam UTC, sama dengan waktu yang dipakai worker
+	from := req.EffectiveFrom.UTC()
+	var to sql.NullTime
+	if req.EffectiveTo != nil {
+		to = sql.NullTime{Time: req.EffectiveTo.UTC(), Valid: true}
+		if !to.Time.After(from) || !to.Time.After(time.Now()) {
+			return PriceScheduleResponse{}, ErrInvalidPriceSchedule
+		}
+	}
+
+	if err := s.ensureProductExists(ctx, productID); err != nil {
+		return PriceScheduleResponse{}, err
+	}
+
+	overlaps, err := s.repo.CountOverlappingPriceSchedules(ctx, dbgen.CountOverlappingPriceSchedulesParams{
+		ProductID: productID,
+		NewFrom:   from,
+		NewTo:     to,
+	})
+	if err != nil {
+		return PriceScheduleResponse{}, err
+	}
+	if overlaps > 0 {
+		return PriceScheduleResponse{}, ErrPriceScheduleOverlap
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return PriceScheduleResponse{}, err
+	}
+
+	params := dbgen.CreateProductPriceScheduleParams{
+		ID:            newUUID.String(),
+		ProductID:     productID,
+		Price:         helper.Float64ToDecimal(req.Price),
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+		Status:        PriceSchedulePending,
+	}
+	if err := s.repo.CreatePriceSchedule(ctx, params); err != nil {
+		return PriceScheduleResponse{}, err
+	}
+
+	return mapPriceSchedule(dbgen.ProductPriceSchedule{
+		ID:            params.ID,
+		ProductID:     productID,
+		Price:         params.Price,
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+		Status:        PriceSchedulePending,
+		CreatedAt:     time.Now().UTC(),
+	}), nil
+}
+
+func (s *service) ListPriceSchedules(ctx context.Context, productID string) ([]PriceScheduleResponse, error) {
+	if err := s.ensureProductExists(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListPriceSchedules(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]PriceScheduleResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapPriceSchedule(r))
+	}
+
+	return res, nil
+}
+
+func (s *service) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) error {
+	affected, err := s.repo.CancelPriceSchedule(ctx, dbgen.CancelPriceScheduleParams{
+		ID:        scheduleID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	// Tidak ada baris ter-update: bedakan jadwal yang tidak ada dengan yang sudah berjalan
+	if _, err := s.repo.GetPriceSchedule(ctx, dbgen.GetProductPriceScheduleParams{
+		ID:        scheduleID,
+		ProductID: productID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrPriceScheduleNotFound
+		}
+		return err
+	}
+
+	return ErrPriceScheduleNotPending
+}
+
+// ApplyDuePriceSchedules memulai jadwal pending yang sudah jatuh tempo dan mengakhiri
+// jadwal active yang sudah lewat effective_to. Setiap jadwal diproses di transaksinya
+// sendiri; kegagalan satu jadwal dicatat dan tidak menghentikan yang lain.
+func (s *service) ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int, error) {
+	now = now.UTC()
+	due, err := s.repo.ListDuePriceSchedules(ctx, dbgen.ListDuePriceSchedulesParams{
+		Now:   now,
+		Limit: PriceScheduleBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, sch := range due {
+		changed, err := s.applyPriceSchedule(ctx, sch.ID, now)
+		if err != nil {
+			log.Printf("failed to apply price schedule %s: %v", sch.ID, err)
+			continue
+		}
+		if changed {
+			applied++
+		}
+	}
+
+	if applied > 0 {
+		s.invalidateDashboardCache(ctx)
+	}
+
+	return applied, nil
+}
+
+// applyPriceSchedule mengunci ulang jadwal sehingga aman dijalankan oleh beberapa worker
+func (s *service) applyPriceSchedule(ctx context.Context, scheduleID string, now time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+	sch, err := txRepo.GetPriceScheduleForUpdate(ctx, scheduleID)
+	if err != nil {
+		return false, err
+	}
+
+	state := dbgen.UpdatePriceScheduleStateParams{
+		ID:            sch.ID,
+		PreviousPrice: sch.PreviousPrice,
+		AppliedAt:     sch.AppliedAt,
+	}
+	changed := false
+
+	switch {
+	case sch.Status == PriceSchedulePending && !sch.EffectiveFrom.After(now):
+		current, err := txRepo.GetPriceForUpdate(ctx, sch.ProductID)
+		if err != nil {
+			return false, err
+		}
+
+		state.PreviousPrice = decimal.NewNullDecimal(current)
+		state.AppliedAt = sql.NullTime{Time: now, Valid: true}
+		state.Status = PriceScheduleCompleted
+
+		// Jendela yang sudah lewat seluruhnya (mis. worker sempat mati) tidak diterapkan
+		if sch.EffectiveTo.Valid && !sch.EffectiveTo.Time.After(now) {
+			break
+		}
+		if sch.EffectiveTo.Valid {
+			state.Status = PriceScheduleActive
+		}
+		if err := setScheduledPrice(ctx, txRepo, sch, current, sch.Price); err != nil {
+			return false, err
+		}
+		changed = true
+
+	case sch.Status == PriceScheduleActive && sch.EffectiveTo.Valid && !sch.EffectiveTo.Time.After(now):
+		current, err := txRepo.GetPriceForUpdate(ctx, sch.ProductID)
+		if err != nil {
+			return false, err
+		}
+
+		state.Status = PriceScheduleCompleted
+
+		// Kembalikan harga lama hanya jika harga belum diubah manual selama jadwal aktif
+		if sch.PreviousPrice.Valid && current.Equal(sch.Price) {
+			if err := setScheduledPrice(ctx, txRepo, sch, current, sch.PreviousPrice.Decimal); err != nil {
+				return false, err
+			}
+			changed = true
+		}
+
+	default:
+		// Sudah diproses worker lain
+		return false, nil
+	}
+
+	if err := txRepo.UpdatePriceScheduleState(ctx, state); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+func setScheduledPrice(
+	ctx context.Context,
+	repo Repository,
+	sch dbgen.ProductPriceSchedule,
+	oldPrice, newPrice decimal.Decimal,
+) error {
+	if err := repo.UpdatePrice(ctx, dbgen.UpdateProductPriceParams{
+		Price: newPrice,
+		ID:    sch.ProductID,
+	}); err != nil {
+		return err
+	}
+
+	return recordPriceChange(ctx, repo, sch.ProductID, decimal.NewNullDecimal(oldPrice), newPrice, PriceSourceSchedule, sch.ID)
+}
+
+// recordPriceChange menulis baris product_price_history bila harga berubah, dan event
+// ProductPriceChanged ke outbox bila harga sebelumnya ada (bukan produk baru).
+// Dipanggil di dalam transaksi yang sama dengan perubahan harganya.
+func recordPriceChange(
+	ctx context.Context,
+	repo Repository,
+	productID string,
+	oldPrice decimal.NullDecimal,
+	newPrice decimal.Decimal,
+	source string,
+	scheduleID string,
+) error {
+	if oldPrice.Valid && oldPrice.Decimal.Equal(newPrice) {
+		return nil
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	if err := repo.CreatePriceHistory(ctx, dbgen.CreateProductPriceHistoryParams{
+		ID:         newUUID.String(),
+		ProductID:  productID,
+		OldPrice:   oldPrice,
+		NewPrice:   newPrice,
+		Source:     source,
+		ScheduleID: sql.NullString{String: scheduleID, Valid: scheduleID != ""},
+	}); err != nil {
+		return err
+	}
+
+	if !oldPrice.Valid {
+		return nil
+	}
+	return outbox.Record(ctx, repo, outbox.AggregateProduct, productID, outbox.EventProductPriceChanged, outbox.ProductPriceChanged{
+		ProductID:  productID,
+		OldPrice:   helper.DecimalToFloat64(oldPrice.Decimal),
+		NewPrice:   helper.DecimalToFloat64(newPrice),
+		Source:     source,
+		ScheduleID: scheduleID,
+	})
+}
+
+func (s *service) ensureProductExists(ctx context.Context, id string) error {
+	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProductNotFound
+	}
+	return nil
+}
+
+func mapPriceSchedule(r dbgen.ProductPriceSchedule) PriceScheduleResponse {
+	res := PriceScheduleResponse{
+		ID:            r.ID,
+		ProductID:     r.ProductID,
+		Price:         helper.DecimalToFloat64(r.Price),
+		EffectiveFrom: r.EffectiveFrom,
+		Status:        r.Status,
+		PreviousPrice: helper.NullDecimalToFloat64Ptr(r.PreviousPrice),
+		CreatedAt:     r.CreatedAt,
+	}
+	if r.EffectiveTo.Valid {
+		res.EffectiveTo = &r.EffectiveTo.Time
+	}
+	if r.AppliedAt.Valid {
+		res.AppliedAt = &r.AppliedAt.Time
+	}
+	return res
+}
@@ -3,16 +3,47 @@ package product
 import (
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
 	"context"
+	"database/sql"
+
+	"github.com/shopspring/decimal"
 )
 
 //go:generate mockgen -source=product_repo.go -destination=mocks/product_repo_mock.go -package=mock
 type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
 	Create(ctx context.Context, params dbgen.CreateProductParams) error
 	GetByID(ctx context.Context, id string) (dbgen.GetProductByIDRow, error)
 	List(ctx context.Context, params dbgen.ListProductsParams) ([]dbgen.ListProductsRow, error)
 	Count(ctx context.Context, params dbgen.CountProductsParams) (int64, error)
 	Update(ctx context.Context, params dbgen.UpdateProductParams) error
 	Delete(ctx context.Context, id string) error
+	GetCategoryByID(ctx context.Context, id string) (dbgen.GetCategoryByIDRow, error)
+
+	// Bulk import helpers
+	Exists(ctx context.Context, id string) (bool, error)
+	ListImportKeys(ctx context.Context, ids, skus []string) ([]dbgen.ListProductKeysForImportRow, error)
+	ListCategoryNames(ctx context.Context) ([]dbgen.ListCategoryNamesRow, error)
+	UpdateImported(ctx context.Context, params dbgen.UpdateImportedProductParams) error
+
+	// Price history & scheduled prices
+	GetPriceForUpdate(ctx context.Context, id string) (decimal.Decimal, error)
+	UpdatePrice(ctx context.Context, params dbgen.UpdateProductPriceParams) error
+	CreatePriceHistory(ctx context.Context, params dbgen.CreateProductPriceHistoryParams) error
+	ListPriceHistory(ctx context.Context, params dbgen.ListProductPriceHistoryParams) ([]dbgen.ProductPriceHistory, error)
+	CountPriceHistory(ctx context.Context, productID string) (int64, error)
+	CreatePriceSchedule(ctx context.Context, params dbgen.CreateProductPriceScheduleParams) error
+	GetPriceSchedule(ctx context.Context, params dbgen.GetProductPriceScheduleParams) (dbgen.ProductPriceSchedule, error)
+	GetPriceScheduleForUpdate(ctx context.Context, id string) (dbgen.ProductPriceSchedule, error)
+	ListPriceSchedules(ctx context.Context, productID string) ([]dbgen.ProductPriceSchedule, error)
+	CountOverlappingPriceSchedules(ctx context.Context, params dbgen.CountOverlappingPriceSchedulesParams) (int64, error)
+	CancelPriceSchedule(ctx context.Context, params dbgen.CancelPriceScheduleParams) (int64, error)
+	ListDuePriceSchedules(ctx context.Context, params dbgen.ListDuePriceSchedulesParams) ([]dbgen.ProductPriceSchedule, error)
+	UpdatePriceScheduleState(ctx context.Context, params dbgen.UpdatePriceScheduleStateParams) error
+
+	// Outbox, ditulis di dalam transaksi yang sama dengan perubahan harga
+	CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error
 }
 
 type repository struct {
@@ -26,6 +57,16 @@ func NewRepository(q *dbgen.Queries) Repository {
 	}
 }
 
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
 func (r *repository) Create(ctx context.Context, params dbgen.CreateProductParams) error {
 	return r.q.CreateProduct(ctx, params)
 }
@@ -55,3 +96,98 @@ func (r *repository) Update(ctx context.Context, params dbgen.UpdateProductParam
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.q.DeleteProduct(ctx, id)
 }
+
+func (r *repository) GetCategoryByID(ctx context.Context, id string) (dbgen.GetCategoryByIDRow, error) {
+	return r.q.GetCategoryByID(ctx, id)
+}
+
+func (r *repository) Exists(ctx context.Context, id string) (bool, error) {
+	return r.q.ProductExists(ctx, id)
+}
+
+func (r *repository) ListImportKeys(ctx context.Context, ids, skus []string) ([]dbgen.ListProductKeysForImportRow, error) {
+	params := dbgen.ListProductKeysForImportParams{
+		Ids:  ids,
+		Skus: make([]sql.NullString, len(skus)),
+	}
+	for i, sku := range skus {
+		params.Skus[i] = sql.NullString{String: sku, Valid: true}
+	}
+	return r.q.ListProductKeysForImport(ctx, params)
+}
+
+func (r *repository) ListCategoryNames(ctx context.Context) ([]dbgen.ListCategoryNamesRow, error) {
+	return r.q.ListCategoryNames(ctx)
+}
+
+func (r *repository) UpdateImported(ctx context.Context, params dbgen.UpdateImportedProductParams) error {
+	return r.q.UpdateImportedProduct(ctx, params)
+}
+
+func (r *repository) GetPriceForUpdate(ctx context.Context, id string) (decimal.Decimal, error) {
+	return r.q.GetProductPriceForUpdate(ctx, id)
+}
+
+func (r *repository) UpdatePrice(ctx context.Context, params dbgen.UpdateProductPriceParams) error {
+	return r.q.UpdateProductPrice(ctx, params)
+}
+
+func (r *repository) CreatePriceHistory(ctx context.Context, params dbgen.CreateProductPriceHistoryParams) error {
+	return r.q.CreateProductPriceHistory(ctx, params)
+}
+
+func (r *repository) ListPriceHistory(
+	ctx context.Context,
+	params dbgen.ListProductPriceHistoryParams,
+) ([]dbgen.ProductPriceHistory, error) {
+	return r.q.ListProductPriceHistory(ctx, params)
+}
+
+func (r *repository) CountPriceHistory(ctx context.Context, productID string) (int64, error) {
+	return r.q.CountProductPriceHistory(ctx, productID)
+}
+
+func (r *repository) CreatePriceSchedule(ctx context.Context, params dbgen.CreateProductPriceScheduleParams) error {
+	return r.q.CreateProductPriceSchedule(ctx, params)
+}
+
+func (r *repository) GetPriceSchedule(
+	ctx context.Context,
+	params dbgen.GetProductPriceScheduleParams,
+) (dbgen.ProductPriceSchedule, error) {
+	return r.q.GetProductPriceSchedule(ctx, params)
+}
+
+func (r *repository) GetPriceScheduleForUpdate(ctx context.Context, id string) (dbgen.ProductPriceSchedule, error) {
+	return r.q.GetProductPriceScheduleForUpdate(ctx, id)
+}
+
+func (r *repository) ListPriceSchedules(ctx context.Context, productID string) ([]dbgen.ProductPriceSchedule, error) {
+	return r.q.ListProductPriceSchedules(ctx, productID)
+}
+
+func (r *repository) CountOverlappingPriceSchedules(
+	ctx context.Context,
+	params dbgen.CountOverlappingPriceSchedulesParams,
+) (int64, error) {
+	return r.q.CountOverlappingPriceSchedules(ctx, params)
+}
+
+func (r *repository) CancelPriceSchedule(ctx context.Context, params dbgen.CancelPriceScheduleParams) (int64, error) {
+	return r.q.CancelPriceSchedule(ctx, params)
+}
+
+func (r *repository) ListDuePriceSchedules(
+	ctx context.Context,
+	params dbgen.ListDuePriceSchedulesParams,
+) ([]dbgen.ProductPriceSchedule, error) {
+	return r.q.ListDuePriceSchedules(ctx, params)
+}
+
+func (r *repository) UpdatePriceScheduleState(ctx context.Context, params dbgen.UpdatePriceScheduleStateParams) error {
+	return r.q.UpdatePriceScheduleState(ctx, params)
+}
+
+func (r *repository) CreateOutboxEvent(ctx context.Context, params dbgen.CreateOutboxEventParams) error {
+	return r.q.CreateOutboxEvent(ctx, params)
+}
@@ -5,10 +5,21 @@ import "github.com/gin-gonic/gin"
 func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 	products := r.Group("/products")
 	{
-		products.POST("", handler.Create)       // Create new product
-		products.GET("", handler.GetAll)        // Get products with filters & pagination
-		products.GET("/:id", handler.GetByID)   // Get detail product
-		products.PUT("/:id", handler.Update)    // Update product info
-		products.DELETE("/:id", handler.Delete) // Delete product
+		products.POST("", handler.Create)        // Membuat produk baru
+		products.GET("", handler.GetAll)         // Daftar produk dengan filter & paginasi
+		products.POST("/import", handler.Import) // Upsert massal dari CSV/JSON
+		products.GET("/export", handler.Export)  // Streaming hasil filter sebagai CSV
+		products.GET("/:id", handler.GetByID)    // Detail produk
+		products.PUT("/:id", handler.Update)     // Mengubah data produk
+		products.DELETE("/:id", handler.Delete)  // Menghapus produk
+
+		// Riwayat harga & perubahan harga terjadwal
+		products.GET("/:id/price-history", handler.PriceHistory)
+		products.POST("/:id/price-schedules", handler.CreatePriceSchedule)
+		products.GET("/:id/price-schedules", handler.ListPriceSchedules)
+		products.DELETE("/:id/price-schedules/:schedule_id", handler.CancelPriceSchedule)
 	}
+
+	// Sub-resource kategori: produk dalam satu kategori, memakai filter listing produk
+	r.GET("/categories/:id/products", handler.GetByCategory)
 }
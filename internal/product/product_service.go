@@ -6,28 +6,46 @@ import (
 	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"io"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 //go:generate mockgen -source=product_service.go -destination=mocks/product_service_mock.go -package=mock
 type Service interface {
 	Create(ctx context.Context, req CreateProductRequest) (ProductResponse, error)
 	List(ctx context.Context, params ListParams) ([]ProductResponse, int64, error)
+	ListByCategory(ctx context.Context, categoryID string, params ListParams) ([]ProductResponse, int64, error)
 	GetByID(ctx context.Context, id string) (ProductResponse, error)
 	Update(ctx context.Context, id string, req UpdateProductRequest) (ProductResponse, error)
 	Delete(ctx context.Context, id string) error
+
+	// Bulk import/export (lihat product_import.go & product_export.go)
+	Import(ctx context.Context, format string, r io.Reader, dryRun bool) (ImportReport, error)
+	Export(ctx context.Context, params ListParams, w io.Writer) error
+
+	// Riwayat & penjadwalan harga (lihat product_price.go)
+	PriceHistory(ctx context.Context, productID string, params PriceHistoryParams) ([]PriceHistoryResponse, int64, error)
+	CreatePriceSchedule(ctx context.Context, productID string, req CreatePriceScheduleRequest) (PriceScheduleResponse, error)
+	ListPriceSchedules(ctx context.Context, productID string) ([]PriceScheduleResponse, error)
+	CancelPriceSchedule(ctx context.Context, productID, scheduleID string) error
+	ApplyDuePriceSchedules(ctx context.Context, now time.Time) (int, error)
 }
 
 type service struct {
+	db   *sql.DB // Diperlukan untuk memulai transaksi (perubahan harga & bulk import)
 	repo Repository
 	rdb  *redis.Client
 }
 
-func NewService(repo Repository, rdb *redis.Client) Service {
-	return &service{repo: repo, rdb: rdb}
+func NewService(db *sql.DB, repo Repository, rdb *redis.Client) Service {
+	return &service{db: db, repo: repo, rdb: rdb}
 }
 func (s *service) Create(
 	ctx context.Context,
@@ -41,30 +59,55 @@ func (s *service) Create(
 	productID := newUUID.String()
 	params := dbgen.CreateProductParams{
 		ID:            productID,
+		Sku:           helper.StringToNull(req.SKU),
 		Name:          req.Name,
 		Description:   helper.StringToNull(req.Description),
 		Price:         helper.Float64ToDecimal(req.Price),
 		CategoryID:    req.CategoryID,
 		StockQuantity: int32(req.StockQuantity),
+		WeightGrams:   int32(req.WeightGrams),
+		LengthCm:      int32(req.LengthCm),
+		WidthCm:       int32(req.WidthCm),
+		HeightCm:      int32(req.HeightCm),
 		IsActive:      helper.BoolPtrValue(req.IsActive, true),
 	}
 
-	if err := s.repo.Create(ctx, params); err != nil {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
 		return ProductResponse{}, err
 	}
+	defer tx.Rollback()
 
-	dashboardCacheKey := dashboard.ProductReportKey
-	if err := s.rdb.Del(ctx, dashboardCacheKey).Err(); err != nil {
-		log.Printf("failed to invalidate dashboard product cache: %v", err)
+	txRepo := s.repo.WithTx(tx)
+	if err := txRepo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return ProductResponse{}, ErrDuplicateSKU
+		}
+		return ProductResponse{}, err
+	}
+
+	if err := recordPriceChange(ctx, txRepo, productID, decimal.NullDecimal{}, params.Price, PriceSourceCreate, ""); err != nil {
+		return ProductResponse{}, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return ProductResponse{}, err
+	}
+
+	s.invalidateDashboardCache(ctx)
+
 	return ProductResponse{
 		ID:            productID,
+		SKU:           helper.StringPtrValue(req.SKU),
 		Name:          req.Name,
 		Description:   helper.StringPtrValue(req.Description),
 		Price:         req.Price,
 		StockQuantity: req.StockQuantity,
 		IsActive:      helper.BoolPtrValue(req.IsActive, true),
+		MinPrice:      req.Price,
+		MaxPrice:      req.Price,
+		TotalStock:    req.StockQuantity,
+		Images:        []ProductImageResponse{},
 		Category: CategoryResponse{
 			ID: req.CategoryID,
 		},
@@ -75,21 +118,7 @@ func (s *service) List(
 	p ListParams,
 ) ([]ProductResponse, int64, error) {
 
-	limit := int32(p.PageSize)
-	offset := int32((p.Page - 1) * p.PageSize)
-
-	rows, err := s.repo.List(ctx, dbgen.ListProductsParams{
-		SearchName: helper.StringPtrValue(p.Name),          // "" = no filter
-		CategoryID: helper.StringPtrValue(p.Category),      // "" = no filter
-		MinPrice:   helper.Float64PtrToDecimal(p.MinPrice), // 0 = no filter
-		MaxPrice:   helper.Float64PtrToDecimal(p.MaxPrice),
-		MinStock:   helper.Int32PtrValue(p.MinStock), // 0 = no filter
-		MaxStock:   helper.Int32PtrValue(p.MaxStock),
-		OrderBy:    helper.StringPtrValue(p.Sort),
-		Limit:      limit,
-		Offset:     offset,
-	})
-
+	rows, err := s.repo.List(ctx, toListProductsParams(p))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -113,6 +142,21 @@ func (s *service) List(
 
 	return res, total, nil
 }
+func (s *service) ListByCategory(
+	ctx context.Context,
+	categoryID string,
+	p ListParams,
+) ([]ProductResponse, int64, error) {
+	if _, err := s.repo.GetCategoryByID(ctx, categoryID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, 0, ErrCategoryNotFound
+		}
+		return nil, 0, err
+	}
+
+	p.Category = &categoryID
+	return s.List(ctx, p)
+}
 func (s *service) GetByID(ctx context.Context, id string) (ProductResponse, error) {
 	row, err := s.repo.GetByID(ctx, id)
 	if err != nil {
@@ -132,39 +176,103 @@ func (s *service) Update(
 
 	params := dbgen.UpdateProductParams{
 		ID:            id,
+		Sku:           helper.StringToNull(req.SKU),
 		Name:          req.Name,
 		Description:   helper.StringToNull(req.Description),
 		Price:         helper.Float64ToDecimal(req.Price),
 		CategoryID:    req.CategoryID,
 		StockQuantity: int32(req.StockQuantity),
+		WeightGrams:   int32(req.WeightGrams),
+		LengthCm:      int32(req.LengthCm),
+		WidthCm:       int32(req.WidthCm),
+		HeightCm:      int32(req.HeightCm),
 		IsActive:      helper.BoolPtrValue(req.IsActive, true),
 	}
 
-	if err := s.repo.Update(ctx, params); err != nil {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
 		return ProductResponse{}, err
 	}
+	defer tx.Rollback()
 
-	dashboardCacheKey := dashboard.ProductReportKey
-	if err := s.rdb.Del(ctx, dashboardCacheKey).Err(); err != nil {
-		log.Printf("failed to invalidate dashboard product cache: %v", err)
+	// Kunci baris produk agar harga lama yang dicatat konsisten dengan update
+	txRepo := s.repo.WithTx(tx)
+	oldPrice, err := txRepo.GetPriceForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ProductResponse{}, ErrProductNotFound
+		}
+		return ProductResponse{}, err
+	}
+
+	if err := txRepo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return ProductResponse{}, ErrDuplicateSKU
+		}
+		return ProductResponse{}, err
+	}
+
+	if err := recordPriceChange(ctx, txRepo, id, decimal.NewNullDecimal(oldPrice), params.Price, PriceSourceManual, ""); err != nil {
+		return ProductResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProductResponse{}, err
 	}
 
+	s.invalidateDashboardCache(ctx)
+
 	return s.GetByID(ctx, id)
 }
 func (s *service) Delete(ctx context.Context, id string) error {
+	// Item order yang merujuk produk ini tetap ada; product_id-nya dikosongkan (ON DELETE SET NULL)
 	return s.repo.Delete(ctx, id)
 }
 
+func (s *service) invalidateDashboardCache(ctx context.Context) {
+	dashboardCacheKey := dashboard.ProductReportKey
+	if err := s.rdb.Del(ctx, dashboardCacheKey).Err(); err != nil {
+		log.Printf("failed to invalidate dashboard product cache: %v", err)
+	}
+}
+
+func toListProductsParams(p ListParams) dbgen.ListProductsParams {
+	return dbgen.ListProductsParams{
+		SearchName: helper.StringPtrValue(p.Name),          // "" = no filter
+		CategoryID: helper.StringPtrValue(p.Category),      // "" = no filter
+		MinPrice:   helper.Float64PtrToDecimal(p.MinPrice), // 0 = no filter
+		MaxPrice:   helper.Float64PtrToDecimal(p.MaxPrice),
+		MinStock:   helper.Int32PtrValue(p.MinStock), // 0 = no filter
+		MaxStock:   helper.Int32PtrValue(p.MaxStock),
+		OrderBy:    helper.StringPtrValue(p.Sort),
+		Limit:      int32(p.PageSize),
+		Offset:     int32((p.Page - 1) * p.PageSize),
+	}
+}
+
 // Mapper khusus untuk hasil List
 func mapListToResponse(r dbgen.ListProductsRow) ProductResponse {
+	images, primary := decodeImages(r.Images)
+
 	return ProductResponse{
 		ID:            r.ID,
+		SKU:           r.Sku.String,
 		Name:          r.Name,
 		Description:   r.Description.String,
 		Price:         helper.DecimalToFloat64(r.Price),
 		StockQuantity: int(r.StockQuantity),
+		WeightGrams:   int(r.WeightGrams),
+		LengthCm:      int(r.LengthCm),
+		WidthCm:       int(r.WidthCm),
+		HeightCm:      int(r.HeightCm),
 		TotalSold:     int(r.TotalSold),
 		IsActive:      r.IsActive,
+		MinPrice:      helper.DecimalToFloat64(r.MinPrice),
+		MaxPrice:      helper.DecimalToFloat64(r.MaxPrice),
+		TotalStock:    int(r.TotalStock),
+		VariantCount:  int(r.VariantCount),
+		PrimaryImage:  primary,
+		Images:        images,
 		Category: CategoryResponse{
 			ID:          r.CategoryID,
 			Name:        r.CategoryName,
@@ -175,13 +283,26 @@ func mapListToResponse(r dbgen.ListProductsRow) ProductResponse {
 
 // Mapper khusus untuk hasil GetByID
 func mapDetailToResponse(r dbgen.GetProductByIDRow) ProductResponse {
+	images, primary := decodeImages(r.Images)
+
 	return ProductResponse{
 		ID:            r.ID,
+		SKU:           r.Sku.String,
 		Name:          r.Name,
 		Description:   r.Description.String,
 		Price:         helper.DecimalToFloat64(r.Price),
 		StockQuantity: int(r.StockQuantity),
+		WeightGrams:   int(r.WeightGrams),
+		LengthCm:      int(r.LengthCm),
+		WidthCm:       int(r.WidthCm),
+		HeightCm:      int(r.HeightCm),
 		IsActive:      r.IsActive,
+		MinPrice:      helper.DecimalToFloat64(r.MinPrice),
+		MaxPrice:      helper.DecimalToFloat64(r.MaxPrice),
+		TotalStock:    int(r.TotalStock),
+		VariantCount:  int(r.VariantCount),
+		PrimaryImage:  primary,
+		Images:        images,
 		Category: CategoryResponse{
 			ID:          r.CategoryID,
 			Name:        r.CategoryName,
@@ -189,3 +310,32 @@ func mapDetailToResponse(r dbgen.GetProductByIDRow) ProductResponse {
 		},
 	}
 }
+
+// decodeImages membaca kolom JSON images, diurutkan berdasarkan position.
+// Primary image fallback ke gambar pertama kalau belum ada yang ditandai.
+func decodeImages(raw json.RawMessage) ([]ProductImageResponse, *ProductImageResponse) {
+	images := []ProductImageResponse{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &images); err != nil {
+			log.Printf("failed to decode product images: %v", err)
+			return []ProductImageResponse{}, nil
+		}
+	}
+	if len(images) == 0 {
+		return images, nil
+	}
+
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].Position < images[j].Position
+	})
+
+	primary := images[0]
+	for _, img := range images {
+		if img.IsPrimary {
+			primary = img
+			break
+		}
+	}
+
+	return images, &primary
+}
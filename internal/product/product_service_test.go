@@ -4,24 +4,45 @@ package product_test
 
 import (
 	"assignment-ptes-achmad-rifai/internal/dashboard"
+	"assignment-ptes-achmad-rifai/internal/outbox"
 	"assignment-ptes-achmad-rifai/internal/product"
 	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	mockProduct "assignment-ptes-achmad-rifai/internal/product/mocks"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-redis/redismock/v9"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
 func setupServiceTest(t *testing.T) (product.Service, *mockProduct.MockRepository, redismock.ClientMock) {
+	svc, repo, redisMock, _ := setupServiceTestWithDB(t)
+	return svc, repo, redisMock
+}
+
+func setupServiceTestWithDB(t *testing.T) (product.Service, *mockProduct.MockRepository, redismock.ClientMock, sqlmock.Sqlmock) {
 	ctrl := gomock.NewController(t)
 	t.Cleanup(ctrl.Finish)
 
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
 	// Mock Redis
 	dbRedis, redisMock := redismock.NewClientMock()
 
@@ -29,9 +50,9 @@ func setupServiceTest(t *testing.T) (product.Service, *mockProduct.MockRepositor
 	repo := mockProduct.NewMockRepository(ctrl)
 
 	// Create Service
-	svc := product.NewService(repo, dbRedis)
+	svc := product.NewService(db, repo, dbRedis)
 
-	return svc, repo, redisMock
+	return svc, repo, redisMock, mock
 }
 
 func TestService_Create(t *testing.T) {
@@ -41,27 +62,45 @@ func TestService_Create(t *testing.T) {
 		Price: 3500,
 	}
 
-	t.Run("success", func(t *testing.T) {
-		svc, repo, redisMock := setupServiceTest(t)
+	t.Run("success - records initial price", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		repo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			Return(nil)
+		repo.EXPECT().
+			CreatePriceHistory(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateProductPriceHistoryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductPriceHistoryParams) error {
+				assert.False(t, p.OldPrice.Valid)
+				assert.Equal(t, "3500", p.NewPrice.String())
+				assert.Equal(t, product.PriceSourceCreate, p.Source)
+				return nil
+			})
 
 		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
 
 		res, err := svc.Create(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, req.Name, res.Name)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("error database", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		repo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			Return(errors.New("db error"))
 
 		_, err := svc.Create(ctx, req)
 		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -90,6 +129,36 @@ func TestService_List(t *testing.T) {
 	})
 }
 
+func TestService_ListByCategory(t *testing.T) {
+	ctx := context.Background()
+	p := product.ListParams{Page: 1, PageSize: 10}
+
+	t.Run("success - filters by category", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().GetCategoryByID(ctx, "cat-1").Return(dbgen.GetCategoryByIDRow{ID: "cat-1"}, nil)
+		repo.EXPECT().
+			List(gomock.Any(), gomock.AssignableToTypeOf(dbgen.ListProductsParams{})).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsParams) ([]dbgen.ListProductsRow, error) {
+				assert.Equal(t, "cat-1", params.CategoryID)
+				return []dbgen.ListProductsRow{{ID: "1", CategoryID: "cat-1"}}, nil
+			})
+		repo.EXPECT().Count(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+		res, total, err := svc.ListByCategory(ctx, "cat-1", p)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().GetCategoryByID(ctx, "none").Return(dbgen.GetCategoryByIDRow{}, sql.ErrNoRows)
+
+		_, _, err := svc.ListByCategory(ctx, "none", p)
+		assert.ErrorIs(t, err, product.ErrCategoryNotFound)
+	})
+}
+
 func TestService_GetByID(t *testing.T) {
 	ctx := context.Background()
 	id := "uuid-1"
@@ -103,6 +172,33 @@ func TestService_GetByID(t *testing.T) {
 		assert.Equal(t, id, res.ID)
 	})
 
+	t.Run("success - images sorted with primary", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{
+			ID: id,
+			Images: json.RawMessage(`[
+				{"id":"img-2","url":"/media/2.jpg","thumbnail_url":"/media/2_thumb.jpg","position":1,"is_primary":true},
+				{"id":"img-1","url":"/media/1.jpg","thumbnail_url":"/media/1_thumb.jpg","position":0,"is_primary":false}
+			]`),
+		}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+		assert.NoError(t, err)
+		assert.Len(t, res.Images, 2)
+		assert.Equal(t, "img-1", res.Images[0].ID)
+		assert.Equal(t, "img-2", res.PrimaryImage.ID)
+	})
+
+	t.Run("success - no images", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Images: json.RawMessage(`[]`)}, nil)
+
+		res, err := svc.GetByID(ctx, id)
+		assert.NoError(t, err)
+		assert.Empty(t, res.Images)
+		assert.Nil(t, res.PrimaryImage)
+	})
+
 	t.Run("not found", func(t *testing.T) {
 		svc, repo, _ := setupServiceTest(t)
 		repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
@@ -115,22 +211,80 @@ func TestService_GetByID(t *testing.T) {
 func TestService_Update(t *testing.T) {
 	ctx := context.Background()
 	id := "uuid-1"
-	req := product.UpdateProductRequest{Name: "New Name"}
+	req := product.UpdateProductRequest{Name: "New Name", Price: 5000}
 
-	t.Run("success", func(t *testing.T) {
-		svc, repo, redisMock := setupServiceTest(t)
+	t.Run("success - price change is recorded", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(gomock.Any(), id).Return(decimal.NewFromInt(4000), nil)
 		repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			CreatePriceHistory(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateProductPriceHistoryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductPriceHistoryParams) error {
+				assert.Equal(t, "4000", p.OldPrice.Decimal.String())
+				assert.Equal(t, "5000", p.NewPrice.String())
+				assert.Equal(t, product.PriceSourceManual, p.Source)
+				return nil
+			})
+		repo.EXPECT().
+			CreateOutboxEvent(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateOutboxEventParams) error {
+				assert.Equal(t, outbox.EventProductPriceChanged, p.EventType)
+				assert.Equal(t, id, p.AggregateID)
+				assert.JSONEq(t, `{"product_id":"uuid-1","old_price":4000,"new_price":5000,"source":"manual"}`, string(p.Payload))
+				return nil
+			})
 		repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Name: "New Name"}, nil)
 		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
 		res, err := svc.Update(ctx, id, req)
 		assert.NoError(t, err)
 		assert.Equal(t, "New Name", res.Name)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - unchanged price writes no history", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(gomock.Any(), id).Return(decimal.NewFromInt(5000), nil)
+		repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id}, nil)
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
+		_, err := svc.Update(ctx, id, req)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(gomock.Any(), id).Return(decimal.Decimal{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, id, req)
+		assert.ErrorIs(t, err, product.ErrProductNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("not found after update", func(t *testing.T) {
-		svc, repo, _ := setupServiceTest(t)
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(gomock.Any(), id).Return(decimal.NewFromInt(5000), nil)
 		repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
 
 		_, err := svc.Update(ctx, id, req)
 		assert.ErrorIs(t, err, product.ErrProductNotFound)
@@ -157,3 +311,533 @@ func TestService_Delete(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestService_Import(t *testing.T) {
+	ctx := context.Background()
+	categories := []dbgen.ListCategoryNamesRow{
+		{ID: "cat-1", Name: "Pakaian"},
+		{ID: "cat-2", Name: "Elektronik"},
+	}
+
+	t.Run("dry run - reports actions and row errors without writing", func(t *testing.T) {
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+
+		csvBody := strings.Join([]string{
+			"sku,name,price,category,stock_quantity,is_active",
+			"KAOS-01,Kaos Polos,50000,pakaian,10,true",
+			"HP-01,Handphone,2500000,Elektronik,5,",
+			"BAD-01,Rusak,-1,Mainan,x,maybe",
+			"KAOS-01,Kaos Duplikat,50000,Pakaian,1,true",
+		}, "\n")
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().
+			ListImportKeys(ctx, []string(nil), []string{"BAD-01", "HP-01", "KAOS-01"}).
+			Return([]dbgen.ListProductKeysForImportRow{{ID: "prod-hp", Sku: sql.NullString{String: "HP-01", Valid: true}}}, nil)
+
+		report, err := svc.Import(ctx, "CSV", strings.NewReader(csvBody), true)
+
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 4, report.TotalRows)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Updated)
+		assert.Equal(t, 2, report.Failed)
+
+		assert.Equal(t, 2, report.Rows[0].Row)
+		assert.Equal(t, "create", report.Rows[0].Action)
+		assert.Equal(t, "update", report.Rows[1].Action)
+		assert.Equal(t, "prod-hp", report.Rows[1].ID)
+
+		fields := map[string]bool{}
+		for _, e := range report.Rows[2].Errors {
+			fields[e.Field] = true
+		}
+		assert.Equal(t, map[string]bool{"price": true, "category": true, "stock_quantity": true, "is_active": true}, fields)
+		assert.Equal(t, "sku", report.Rows[3].Errors[0].Field)
+		assert.Contains(t, report.Rows[3].Errors[0].Message, "row 2")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("json - upsert by id in one transaction", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+
+		jsonBody := `[
+			{"id": "prod-1", "name": "Kaos", "price": 60000, "category": "cat-1", "stock_quantity": 3, "is_active": false, "weight_grams": 200},
+			{"name": "Kemeja", "price": "120000.50", "category": "Pakaian"}
+		]`
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().ListImportKeys(ctx, []string{"prod-1"}, []string(nil)).Return([]dbgen.ListProductKeysForImportRow{{ID: "prod-1"}}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(ctx, "prod-1").Return(decimal.NewFromInt(50000), nil)
+		repo.EXPECT().
+			UpdateImported(ctx, gomock.AssignableToTypeOf(dbgen.UpdateImportedProductParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateImportedProductParams) error {
+				assert.Equal(t, "prod-1", p.ID)
+				assert.Equal(t, sql.NullBool{Bool: false, Valid: true}, p.IsActive)
+				assert.False(t, p.Sku.Valid)
+				assert.Equal(t, sql.NullInt32{Int32: 3, Valid: true}, p.StockQuantity)
+				assert.Equal(t, sql.NullInt32{Int32: 200, Valid: true}, p.WeightGrams)
+				return nil
+			})
+		repo.EXPECT().
+			Create(ctx, gomock.AssignableToTypeOf(dbgen.CreateProductParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductParams) error {
+				assert.NotEmpty(t, p.ID)
+				assert.Equal(t, "cat-1", p.CategoryID)
+				assert.Equal(t, "120000.5", p.Price.String())
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().
+			CreatePriceHistory(ctx, gomock.AssignableToTypeOf(dbgen.CreateProductPriceHistoryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductPriceHistoryParams) error {
+				assert.Equal(t, product.PriceSourceImport, p.Source)
+				return nil
+			}).
+			Times(2)
+		// Hanya produk yang diupdate (harga lama ada) yang menghasilkan event
+		repo.EXPECT().CreateOutboxEvent(ctx, gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).Return(nil)
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
+		report, err := svc.Import(ctx, "json", strings.NewReader(jsonBody), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Updated)
+		assert.Equal(t, 0, report.Failed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("partial re-import keeps omitted columns", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().
+			ListImportKeys(ctx, []string(nil), []string{"KAOS-01"}).
+			Return([]dbgen.ListProductKeysForImportRow{{ID: "prod-1", Sku: sql.NullString{String: "KAOS-01", Valid: true}}}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceForUpdate(ctx, "prod-1").Return(decimal.NewFromInt(55000), nil)
+		repo.EXPECT().
+			UpdateImported(ctx, gomock.AssignableToTypeOf(dbgen.UpdateImportedProductParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateImportedProductParams) error {
+				assert.Equal(t, "Kaos Baru", p.Name)
+				assert.Equal(t, "60000", p.Price.String())
+				// Kolom kosong/absen dikirim NULL agar stok, dimensi, status & deskripsi tidak tertimpa
+				assert.False(t, p.StockQuantity.Valid)
+				assert.False(t, p.WeightGrams.Valid)
+				assert.False(t, p.HeightCm.Valid)
+				assert.False(t, p.IsActive.Valid)
+				assert.False(t, p.Description.Valid)
+				assert.True(t, p.Sku.Valid)
+				return nil
+			})
+		repo.EXPECT().CreatePriceHistory(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOutboxEvent(ctx, gomock.Any()).Return(nil)
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
+		body := "sku,name,price,category,stock_quantity,is_active\nKAOS-01,Kaos Baru,60000,Pakaian,,\n"
+		report, err := svc.Import(ctx, "csv", strings.NewReader(body), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failed batch is rolled back and reported", func(t *testing.T) {
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().CreatePriceHistory(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("deadlock"))
+
+		body := "name,price,category\nA,1000,Pakaian\nB,2000,Pakaian\n"
+		report, err := svc.Import(ctx, "csv", strings.NewReader(body), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, report.Created)
+		assert.Equal(t, 2, report.Failed)
+		assert.Contains(t, report.Rows[0].Errors[0].Message, "row 3: deadlock")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown id is a row error", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTestWithDB(t)
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().ListImportKeys(ctx, []string{"missing"}, []string(nil)).Return(nil, nil)
+
+		report, err := svc.Import(ctx, "json", strings.NewReader(`[{"id":"missing","name":"X","price":1,"category":"Pakaian"}]`), true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id", report.Rows[0].Errors[0].Field)
+	})
+
+	t.Run("quote prefix from export is stripped", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTestWithDB(t)
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().ListImportKeys(ctx, []string(nil), []string{"-SKU"}).Return(nil, nil)
+
+		body := "sku,name,price,category\n'-SKU,'=Kaos,1000,Pakaian\n"
+		report, err := svc.Import(ctx, "csv", strings.NewReader(body), true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "-SKU", report.Rows[0].SKU)
+		assert.Equal(t, 1, report.Created)
+	})
+
+	t.Run("existing products are looked up once per chunk", func(t *testing.T) {
+		svc, repo, _, _ := setupServiceTestWithDB(t)
+
+		lines := []string{"sku,name,price,category"}
+		for i := 0; i <= product.ImportBatchSize; i++ {
+			lines = append(lines, fmt.Sprintf("SKU-%d,Produk %d,1000,Pakaian", i, i))
+		}
+
+		repo.EXPECT().ListCategoryNames(ctx).Return(categories, nil)
+		repo.EXPECT().
+			ListImportKeys(ctx, []string(nil), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ []string, skus []string) ([]dbgen.ListProductKeysForImportRow, error) {
+				assert.LessOrEqual(t, len(skus), product.ImportBatchSize)
+				return nil, nil
+			}).
+			Times(2)
+
+		report, err := svc.Import(ctx, "csv", strings.NewReader(strings.Join(lines, "\n")), true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, product.ImportBatchSize+1, report.Created)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		svc, _, _, _ := setupServiceTestWithDB(t)
+
+		_, err := svc.Import(ctx, "xml", strings.NewReader("<x/>"), true)
+		assert.ErrorIs(t, err, product.ErrUnsupportedImportFormat)
+
+		_, err = svc.Import(ctx, "csv", strings.NewReader("name,price\nA,1\n"), true)
+		assert.ErrorIs(t, err, product.ErrInvalidImportFile)
+
+		_, err = svc.Import(ctx, "json", strings.NewReader(`{"name":"A"}`), true)
+		assert.ErrorIs(t, err, product.ErrInvalidImportFile)
+	})
+}
+
+func TestService_Export(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - writes header and rows", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		category := "cat-1"
+
+		repo.EXPECT().
+			List(ctx, gomock.AssignableToTypeOf(dbgen.ListProductsParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.ListProductsParams) ([]dbgen.ListProductsRow, error) {
+				assert.Equal(t, "cat-1", p.CategoryID)
+				assert.Equal(t, int32(product.ExportPageSize), p.Limit)
+				assert.Equal(t, int32(0), p.Offset)
+				return []dbgen.ListProductsRow{
+					{
+						ID:            "p-1",
+						Sku:           sql.NullString{String: "KAOS-01", Valid: true},
+						Name:          "Kaos, Polos",
+						Price:         decimal.NewFromInt(50000),
+						CategoryName:  "Pakaian",
+						StockQuantity: 10,
+						WeightGrams:   250,
+						LengthCm:      30,
+						WidthCm:       20,
+						HeightCm:      2,
+						IsActive:      true,
+					},
+				}, nil
+			})
+
+		var buf bytes.Buffer
+		err := svc.Export(ctx, product.ListParams{Page: 3, PageSize: 10, Category: &category}, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t,
+			"id,sku,name,description,price,category,stock_quantity,is_active,weight_grams,length_cm,width_cm,height_cm\n"+
+				"p-1,KAOS-01,\"Kaos, Polos\",,50000.00,Pakaian,10,true,250,30,20,2\n",
+			buf.String(),
+		)
+	})
+
+	t.Run("formula cells are prefixed with a quote", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().List(ctx, gomock.Any()).Return([]dbgen.ListProductsRow{
+			{
+				ID:           "p-1",
+				Sku:          sql.NullString{String: "-SKU", Valid: true},
+				Name:         "=HYPERLINK(\"http://evil\")",
+				Description:  sql.NullString{String: "@SUM(A1)", Valid: true},
+				Price:        decimal.NewFromInt(1000),
+				CategoryName: "+Pakaian",
+			},
+		}, nil)
+
+		var buf bytes.Buffer
+		err := svc.Export(ctx, product.ListParams{}, &buf)
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "p-1,'-SKU,\"'=HYPERLINK(\"\"http://evil\"\")\",'@SUM(A1),1000.00,'+Pakaian,")
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().List(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		err := svc.Export(ctx, product.ListParams{}, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
+
+func TestService_PriceHistory(t *testing.T) {
+	ctx := context.Background()
+	p := product.PriceHistoryParams{Page: 2, PageSize: 5}
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().Exists(ctx, "prod-1").Return(true, nil)
+		repo.EXPECT().
+			ListPriceHistory(ctx, dbgen.ListProductPriceHistoryParams{ProductID: "prod-1", Limit: 5, Offset: 5}).
+			Return([]dbgen.ProductPriceHistory{
+				{ID: "h-2", NewPrice: decimal.NewFromInt(4500), OldPrice: decimal.NewNullDecimal(decimal.NewFromInt(5000)), Source: product.PriceSourceSchedule, ScheduleID: sql.NullString{String: "s-1", Valid: true}},
+				{ID: "h-1", NewPrice: decimal.NewFromInt(5000), Source: product.PriceSourceCreate},
+			}, nil)
+		repo.EXPECT().CountPriceHistory(ctx, "prod-1").Return(int64(7), nil)
+
+		res, total, err := svc.PriceHistory(ctx, "prod-1", p)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), total)
+		assert.Len(t, res, 2)
+		assert.Equal(t, float64(5000), *res[0].OldPrice)
+		assert.Equal(t, "s-1", res[0].ScheduleID)
+		assert.Nil(t, res[1].OldPrice)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().Exists(ctx, "none").Return(false, nil)
+
+		_, _, err := svc.PriceHistory(ctx, "none", p)
+		assert.ErrorIs(t, err, product.ErrProductNotFound)
+	})
+}
+
+func TestService_CreatePriceSchedule(t *testing.T) {
+	ctx := context.Background()
+	from := time.Now().Add(24 * time.Hour)
+	to := from.Add(48 * time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().Exists(ctx, "prod-1").Return(true, nil)
+		repo.EXPECT().CountOverlappingPriceSchedules(ctx, gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().
+			CreatePriceSchedule(ctx, gomock.AssignableToTypeOf(dbgen.CreateProductPriceScheduleParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductPriceScheduleParams) error {
+				assert.Equal(t, product.PriceSchedulePending, p.Status)
+				assert.Equal(t, time.UTC, p.EffectiveFrom.Location())
+				assert.True(t, p.EffectiveTo.Valid)
+				return nil
+			})
+
+		res, err := svc.CreatePriceSchedule(ctx, "prod-1", product.CreatePriceScheduleRequest{
+			Price: 4500, EffectiveFrom: from, EffectiveTo: &to,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, product.PriceSchedulePending, res.Status)
+		assert.Equal(t, float64(4500), res.Price)
+	})
+
+	t.Run("invalid window", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+		_, err := svc.CreatePriceSchedule(ctx, "prod-1", product.CreatePriceScheduleRequest{
+			Price: 4500, EffectiveFrom: to, EffectiveTo: &from,
+		})
+		assert.ErrorIs(t, err, product.ErrInvalidPriceSchedule)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().Exists(ctx, "prod-1").Return(true, nil)
+		repo.EXPECT().CountOverlappingPriceSchedules(ctx, gomock.Any()).Return(int64(1), nil)
+
+		_, err := svc.CreatePriceSchedule(ctx, "prod-1", product.CreatePriceScheduleRequest{
+			Price: 4500, EffectiveFrom: from,
+		})
+		assert.ErrorIs(t, err, product.ErrPriceScheduleOverlap)
+	})
+}
+
+func TestService_CancelPriceSchedule(t *testing.T) {
+	ctx := context.Background()
+	params := dbgen.CancelPriceScheduleParams{ID: "s-1", ProductID: "prod-1"}
+	getParams := dbgen.GetProductPriceScheduleParams{ID: "s-1", ProductID: "prod-1"}
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().CancelPriceSchedule(ctx, params).Return(int64(1), nil)
+
+		assert.NoError(t, svc.CancelPriceSchedule(ctx, "prod-1", "s-1"))
+	})
+
+	t.Run("not pending", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().CancelPriceSchedule(ctx, params).Return(int64(0), nil)
+		repo.EXPECT().GetPriceSchedule(ctx, getParams).Return(dbgen.ProductPriceSchedule{Status: product.PriceScheduleActive}, nil)
+
+		assert.ErrorIs(t, svc.CancelPriceSchedule(ctx, "prod-1", "s-1"), product.ErrPriceScheduleNotPending)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		repo.EXPECT().CancelPriceSchedule(ctx, params).Return(int64(0), nil)
+		repo.EXPECT().GetPriceSchedule(ctx, getParams).Return(dbgen.ProductPriceSchedule{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, svc.CancelPriceSchedule(ctx, "prod-1", "s-1"), product.ErrPriceScheduleNotFound)
+	})
+}
+
+func TestService_ApplyDuePriceSchedules(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("starts pending schedule with an end date", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		sch := dbgen.ProductPriceSchedule{
+			ID:            "s-1",
+			ProductID:     "prod-1",
+			Price:         decimal.NewFromInt(4500),
+			EffectiveFrom: now.Add(-time.Minute),
+			EffectiveTo:   sql.NullTime{Time: now.Add(time.Hour), Valid: true},
+			Status:        product.PriceSchedulePending,
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ListDuePriceSchedules(ctx, dbgen.ListDuePriceSchedulesParams{Now: now, Limit: product.PriceScheduleBatchSize}).
+			Return([]dbgen.ProductPriceSchedule{sch}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceScheduleForUpdate(ctx, "s-1").Return(sch, nil)
+		repo.EXPECT().GetPriceForUpdate(ctx, "prod-1").Return(decimal.NewFromInt(5000), nil)
+		repo.EXPECT().UpdatePrice(ctx, dbgen.UpdateProductPriceParams{Price: sch.Price, ID: "prod-1"}).Return(nil)
+		repo.EXPECT().
+			CreatePriceHistory(ctx, gomock.AssignableToTypeOf(dbgen.CreateProductPriceHistoryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductPriceHistoryParams) error {
+				assert.Equal(t, product.PriceSourceSchedule, p.Source)
+				assert.Equal(t, "s-1", p.ScheduleID.String)
+				return nil
+			})
+		repo.EXPECT().CreateOutboxEvent(ctx, gomock.AssignableToTypeOf(dbgen.CreateOutboxEventParams{})).Return(nil)
+		repo.EXPECT().
+			UpdatePriceScheduleState(ctx, gomock.AssignableToTypeOf(dbgen.UpdatePriceScheduleStateParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdatePriceScheduleStateParams) error {
+				assert.Equal(t, product.PriceScheduleActive, p.Status)
+				assert.Equal(t, "5000", p.PreviousPrice.Decimal.String())
+				return nil
+			})
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
+		applied, err := svc.ApplyDuePriceSchedules(ctx, now)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ends active schedule and restores previous price", func(t *testing.T) {
+		svc, repo, redisMock, mock := setupServiceTestWithDB(t)
+		sch := dbgen.ProductPriceSchedule{
+			ID:            "s-1",
+			ProductID:     "prod-1",
+			Price:         decimal.NewFromInt(4500),
+			EffectiveTo:   sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			Status:        product.PriceScheduleActive,
+			PreviousPrice: decimal.NewNullDecimal(decimal.NewFromInt(5000)),
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ListDuePriceSchedules(ctx, gomock.Any()).Return([]dbgen.ProductPriceSchedule{sch}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceScheduleForUpdate(ctx, "s-1").Return(sch, nil)
+		repo.EXPECT().GetPriceForUpdate(ctx, "prod-1").Return(decimal.NewFromInt(4500), nil)
+		repo.EXPECT().UpdatePrice(ctx, dbgen.UpdateProductPriceParams{Price: decimal.NewFromInt(5000), ID: "prod-1"}).Return(nil)
+		repo.EXPECT().CreatePriceHistory(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().CreateOutboxEvent(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().
+			UpdatePriceScheduleState(ctx, gomock.AssignableToTypeOf(dbgen.UpdatePriceScheduleStateParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdatePriceScheduleStateParams) error {
+				assert.Equal(t, product.PriceScheduleCompleted, p.Status)
+				return nil
+			})
+		redisMock.ExpectDel(dashboard.ProductReportKey).SetVal(1)
+
+		applied, err := svc.ApplyDuePriceSchedules(ctx, now)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps manual price changed during the window", func(t *testing.T) {
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+		sch := dbgen.ProductPriceSchedule{
+			ID:            "s-1",
+			ProductID:     "prod-1",
+			Price:         decimal.NewFromInt(4500),
+			EffectiveTo:   sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			Status:        product.PriceScheduleActive,
+			PreviousPrice: decimal.NewNullDecimal(decimal.NewFromInt(5000)),
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().ListDuePriceSchedules(ctx, gomock.Any()).Return([]dbgen.ProductPriceSchedule{sch}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceScheduleForUpdate(ctx, "s-1").Return(sch, nil)
+		repo.EXPECT().GetPriceForUpdate(ctx, "prod-1").Return(decimal.NewFromInt(4000), nil)
+		repo.EXPECT().UpdatePriceScheduleState(ctx, gomock.Any()).Return(nil)
+
+		applied, err := svc.ApplyDuePriceSchedules(ctx, now)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips schedule already handled by another worker", func(t *testing.T) {
+		svc, repo, _, mock := setupServiceTestWithDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().ListDuePriceSchedules(ctx, gomock.Any()).Return([]dbgen.ProductPriceSchedule{{ID: "s-1"}}, nil)
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetPriceScheduleForUpdate(ctx, "s-1").
+			Return(dbgen.ProductPriceSchedule{ID: "s-1", Status: product.PriceScheduleCompleted}, nil)
+
+		applied, err := svc.ApplyDuePriceSchedules(ctx, now)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,48 @@
+package promotion
+
+import "time"
+
+// PromotionRequest dipakai untuk create maupun update promosi.
+// category_id opsional membatasi diskon ke item dalam kategori tersebut.
+type PromotionRequest struct {
+	Code                  string     `json:"code" binding:"required,max=64"`
+	Name                  string     `json:"name" binding:"required,max=150"`
+	Type                  string     `json:"type" binding:"required,oneof=percentage fixed buy_x_get_y"`
+	Value                 float64    `json:"value" binding:"gte=0"` // Persen (percentage) atau nominal (fixed)
+	BuyQuantity           *int       `json:"buy_quantity" binding:"omitempty,gt=0"`
+	GetQuantity           *int       `json:"get_quantity" binding:"omitempty,gt=0"`
+	CategoryID            *string    `json:"category_id"`
+	MinSubtotal           *float64   `json:"min_subtotal" binding:"omitempty,gte=0"`
+	MaxDiscount           *float64   `json:"max_discount" binding:"omitempty,gt=0"`
+	UsageLimit            *int       `json:"usage_limit" binding:"omitempty,gt=0"`
+	UsageLimitPerCustomer *int       `json:"usage_limit_per_customer" binding:"omitempty,gt=0"`
+	StartsAt              *time.Time `json:"starts_at"`
+	EndsAt                *time.Time `json:"ends_at"`
+	IsActive              *bool      `json:"is_active"`
+}
+
+type ListParams struct {
+	Page     int `form:"page"`
+	PageSize int `form:"page_size"`
+}
+
+type PromotionResponse struct {
+	ID                    string     `json:"id"`
+	Code                  string     `json:"code"`
+	Name                  string     `json:"name"`
+	Type                  string     `json:"type"`
+	Value                 float64    `json:"value"`
+	BuyQuantity           *int       `json:"buy_quantity"`
+	GetQuantity           *int       `json:"get_quantity"`
+	CategoryID            *string    `json:"category_id"`
+	MinSubtotal           *float64   `json:"min_subtotal"`
+	MaxDiscount           *float64   `json:"max_discount"`
+	UsageLimit            *int       `json:"usage_limit"`
+	UsageLimitPerCustomer *int       `json:"usage_limit_per_customer"`
+	UsedCount             int        `json:"used_count"`
+	StartsAt              *time.Time `json:"starts_at"`
+	EndsAt                *time.Time `json:"ends_at"`
+	IsActive              bool       `json:"is_active"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+}
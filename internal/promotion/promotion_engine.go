@@ -0,0 +1,141 @@
+package promotion
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Tipe promosi yang didukung
+const (
+	TypePercentage = "percentage"
+	TypeFixed      = "fixed"
+	TypeBuyXGetY   = "buy_x_get_y"
+)
+
+// Line adalah satu baris order yang akan dihitung diskonnya
+type Line struct {
+	ProductID  string
+	CategoryID string
+	Quantity   int
+	UnitPrice  decimal.Decimal
+}
+
+// Result berisi subtotal, total diskon, dan diskon per baris (urutan sama dengan input)
+type Result struct {
+	Subtotal      decimal.Decimal
+	Discount      decimal.Decimal
+	LineDiscounts []decimal.Decimal
+}
+
+// NormalizeCode menyeragamkan kode kupon (case-insensitive, tanpa spasi di tepi)
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Calculate memvalidasi promosi terhadap waktu & isi order lalu menghitung diskonnya.
+// Kuota pemakaian tidak dicek di sini karena butuh lock di database (lihat order service).
+func Calculate(p dbgen.Promotion, lines []Line, now time.Time) (Result, error) {
+	if !p.IsActive {
+		return Result{}, fmt.Errorf("%w: coupon is not active", ErrInvalidCoupon)
+	}
+	if p.StartsAt.Valid && now.Before(p.StartsAt.Time) {
+		return Result{}, fmt.Errorf("%w: coupon is not valid yet", ErrInvalidCoupon)
+	}
+	if p.EndsAt.Valid && !now.Before(p.EndsAt.Time) {
+		return Result{}, fmt.Errorf("%w: coupon has expired", ErrInvalidCoupon)
+	}
+
+	subtotal := decimal.Zero
+	for _, l := range lines {
+		subtotal = subtotal.Add(lineTotal(l))
+	}
+	if p.MinSubtotal.Valid && subtotal.LessThan(p.MinSubtotal.Decimal) {
+		return Result{}, fmt.Errorf("%w: order subtotal is below the minimum of %s", ErrInvalidCoupon, p.MinSubtotal.Decimal.StringFixed(2))
+	}
+
+	// weights menentukan porsi diskon tiap baris; 0 untuk baris yang tidak eligible
+	weights := make([]decimal.Decimal, len(lines))
+	eligible := decimal.Zero
+	for i, l := range lines {
+		if p.CategoryID.Valid && l.CategoryID != p.CategoryID.String {
+			weights[i] = decimal.Zero
+			continue
+		}
+		weights[i] = lineTotal(l)
+		eligible = eligible.Add(weights[i])
+	}
+
+	var total decimal.Decimal
+	switch p.Type {
+	case TypePercentage:
+		total = eligible.Mul(p.Value).Div(decimal.NewFromInt(100)).Round(2)
+	case TypeFixed:
+		total = decimal.Min(p.Value, eligible)
+	case TypeBuyXGetY:
+		buy, get := int(p.BuyQuantity.Int32), int(p.GetQuantity.Int32)
+		total = decimal.Zero
+		for i, l := range lines {
+			if weights[i].IsZero() || buy <= 0 || get <= 0 {
+				weights[i] = decimal.Zero
+				continue
+			}
+			// Setiap kelipatan (buy+get) unit, get unit gratis
+			free := (l.Quantity / (buy + get)) * get
+			weights[i] = l.UnitPrice.Mul(decimal.NewFromInt(int64(free)))
+			total = total.Add(weights[i])
+		}
+	default:
+		return Result{}, fmt.Errorf("%w: unsupported promotion type %q", ErrInvalidCoupon, p.Type)
+	}
+
+	if !total.IsPositive() {
+		return Result{}, fmt.Errorf("%w: coupon does not apply to any item in this order", ErrInvalidCoupon)
+	}
+	if p.MaxDiscount.Valid && total.GreaterThan(p.MaxDiscount.Decimal) {
+		total = p.MaxDiscount.Decimal
+	}
+
+	return Result{
+		Subtotal:      subtotal,
+		Discount:      total,
+		LineDiscounts: allocate(total, weights),
+	}, nil
+}
+
+func lineTotal(l Line) decimal.Decimal {
+	return l.UnitPrice.Mul(decimal.NewFromInt(int64(l.Quantity)))
+}
+
+// allocate membagi total secara proporsional terhadap weights (dibulatkan 2 desimal).
+// Selisih pembulatan dibebankan ke baris eligible terakhir sehingga jumlahnya tepat sama.
+func allocate(total decimal.Decimal, weights []decimal.Decimal) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(weights))
+	sum := decimal.Zero
+	last := -1
+	for i, w := range weights {
+		res[i] = decimal.Zero
+		if w.IsPositive() {
+			sum = sum.Add(w)
+			last = i
+		}
+	}
+	if last < 0 {
+		return res
+	}
+
+	allocated := decimal.Zero
+	for i, w := range weights {
+		if !w.IsPositive() || i == last {
+			continue
+		}
+		res[i] = total.Mul(w).Div(sum).Round(2)
+		allocated = allocated.Add(res[i])
+	}
+	res[last] = total.Sub(allocated)
+
+	return res
+}
@@ -0,0 +1,162 @@
+package promotion_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func line(productID, categoryID string, qty int, price int64) promotion.Line {
+	return promotion.Line{
+		ProductID:  productID,
+		CategoryID: categoryID,
+		Quantity:   qty,
+		UnitPrice:  decimal.NewFromInt(price),
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("percentage_with_cap", func(t *testing.T) {
+		p := dbgen.Promotion{
+			Type:        promotion.TypePercentage,
+			Value:       decimal.NewFromInt(50),
+			MaxDiscount: decimal.NewNullDecimal(decimal.NewFromInt(30000)),
+			IsActive:    true,
+		}
+
+		res, err := promotion.Calculate(p, []promotion.Line{
+			line("p1", "c1", 1, 40000),
+			line("p2", "c1", 1, 60000),
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "100000", res.Subtotal.String())
+		assert.Equal(t, "30000", res.Discount.String())
+		assert.Equal(t, "12000", res.LineDiscounts[0].String())
+		assert.Equal(t, "18000", res.LineDiscounts[1].String())
+	})
+
+	t.Run("fixed_capped_to_eligible_amount", func(t *testing.T) {
+		p := dbgen.Promotion{
+			Type:     promotion.TypeFixed,
+			Value:    decimal.NewFromInt(50000),
+			IsActive: true,
+		}
+
+		res, err := promotion.Calculate(p, []promotion.Line{line("p1", "c1", 2, 15000)}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "30000", res.Discount.String())
+	})
+
+	t.Run("buy_x_get_y", func(t *testing.T) {
+		p := dbgen.Promotion{
+			Type:        promotion.TypeBuyXGetY,
+			BuyQuantity: sql.NullInt32{Int32: 2, Valid: true},
+			GetQuantity: sql.NullInt32{Int32: 1, Valid: true},
+			IsActive:    true,
+		}
+
+		// 7 unit -> 2 kelipatan (2+1) -> 2 unit gratis; 2 unit belum cukup
+		res, err := promotion.Calculate(p, []promotion.Line{
+			line("p1", "c1", 7, 10000),
+			line("p2", "c1", 2, 5000),
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "20000", res.Discount.String())
+		assert.Equal(t, "20000", res.LineDiscounts[0].String())
+		assert.True(t, res.LineDiscounts[1].IsZero())
+	})
+
+	t.Run("category_scope", func(t *testing.T) {
+		p := dbgen.Promotion{
+			Type:       promotion.TypePercentage,
+			Value:      decimal.NewFromInt(10),
+			CategoryID: sql.NullString{String: "c2", Valid: true},
+			IsActive:   true,
+		}
+
+		res, err := promotion.Calculate(p, []promotion.Line{
+			line("p1", "c1", 1, 100000),
+			line("p2", "c2", 1, 50000),
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "5000", res.Discount.String())
+		assert.True(t, res.LineDiscounts[0].IsZero())
+		assert.Equal(t, "5000", res.LineDiscounts[1].String())
+	})
+
+	t.Run("allocation_sums_exactly", func(t *testing.T) {
+		p := dbgen.Promotion{
+			Type:     promotion.TypeFixed,
+			Value:    decimal.NewFromInt(100),
+			IsActive: true,
+		}
+
+		res, err := promotion.Calculate(p, []promotion.Line{
+			line("p1", "", 1, 100),
+			line("p2", "", 1, 100),
+			line("p3", "", 1, 100),
+		}, now)
+
+		assert.NoError(t, err)
+		sum := decimal.Zero
+		for _, d := range res.LineDiscounts {
+			sum = sum.Add(d)
+		}
+		assert.True(t, sum.Equal(res.Discount))
+		assert.Equal(t, "33.33", res.LineDiscounts[0].String())
+		assert.Equal(t, "33.34", res.LineDiscounts[2].String())
+	})
+
+	t.Run("invalid_coupon", func(t *testing.T) {
+		base := dbgen.Promotion{
+			Type:     promotion.TypePercentage,
+			Value:    decimal.NewFromInt(10),
+			IsActive: true,
+		}
+
+		inactive := base
+		inactive.IsActive = false
+
+		notStarted := base
+		notStarted.StartsAt = sql.NullTime{Time: now.Add(time.Hour), Valid: true}
+
+		expired := base
+		expired.EndsAt = sql.NullTime{Time: now, Valid: true}
+
+		belowMin := base
+		belowMin.MinSubtotal = decimal.NewNullDecimal(decimal.NewFromInt(200000))
+
+		otherCategory := base
+		otherCategory.CategoryID = sql.NullString{String: "c9", Valid: true}
+
+		cases := map[string]dbgen.Promotion{
+			"inactive":      inactive,
+			"not_started":   notStarted,
+			"expired":       expired,
+			"below_minimum": belowMin,
+			"no_eligible":   otherCategory,
+		}
+
+		for name, p := range cases {
+			t.Run(name, func(t *testing.T) {
+				_, err := promotion.Calculate(p, []promotion.Line{line("p1", "c1", 1, 100000)}, now)
+				assert.ErrorIs(t, err, promotion.ErrInvalidCoupon)
+			})
+		}
+	})
+}
+
+func TestNormalizeCode(t *testing.T) {
+	assert.Equal(t, "HEMAT10", promotion.NormalizeCode("  hemat10 "))
+}
@@ -0,0 +1,14 @@
+package promotion
+
+import "errors"
+
+var (
+	ErrPromotionNotFound = errors.New("promotion not found")
+	ErrCategoryNotFound  = errors.New("category not found")
+	ErrDuplicateCode     = errors.New("promotion code already exists")
+	ErrInvalidPromotion  = errors.New("invalid promotion")
+
+	// Error saat kupon dipakai pada order; detail dibungkus dengan fmt.Errorf("%w: ...")
+	ErrInvalidCoupon      = errors.New("invalid coupon")
+	ErrCouponLimitReached = errors.New("coupon usage limit reached")
+)
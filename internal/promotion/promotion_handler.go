@@ -0,0 +1,148 @@
+package promotion
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Create a promotion
+// @Description  Create a coupon (percentage, fixed, buy_x_get_y), optionally scoped to a category, with usage limits and a validity window
+// @Tags         promotions
+// @Accept       json
+// @Produce      json
+// @Param        request  body      PromotionRequest  true  "Promotion Request"
+// @Success      201      {object}  PromotionResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Category not found"
+// @Failure      409      {object}  map[string]string "Code already exists"
+// @Router       /promotions [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create promotion")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List promotions
+// @Description  Retrieve a paginated list of promotions, newest first
+// @Tags         promotions
+// @Produce      json
+// @Param        page       query    int  false  "Page number"
+// @Param        page_size  query    int  false  "Items per page"
+// @Success      200      {array}   PromotionResponse
+// @Router       /promotions [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	res, total, err := h.service.List(c.Request.Context(), ListParams{Page: page, PageSize: pageSize})
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch promotions", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, &response.PaginationMeta{
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	})
+}
+
+// GetByID godoc
+// @Summary      Get promotion
+// @Description  Retrieve a single promotion including its usage count
+// @Tags         promotions
+// @Produce      json
+// @Param        id       path      string  true  "Promotion ID"
+// @Success      200      {object}  PromotionResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /promotions/{id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get promotion")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Update godoc
+// @Summary      Update promotion
+// @Description  Replace a promotion's rules; orders already placed keep their recorded discount
+// @Tags         promotions
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string            true  "Promotion ID"
+// @Param        request  body      PromotionRequest  true  "Promotion Request"
+// @Success      200      {object}  PromotionResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Code already exists"
+// @Router       /promotions/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update promotion")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Deactivate promotion
+// @Description  Deactivate a promotion so its code can no longer be used; redemption history is kept
+// @Tags         promotions
+// @Produce      json
+// @Param        id       path      string  true  "Promotion ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string
+// @Router       /promotions/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to deactivate promotion")
+		return
+	}
+	response.Success(c, http.StatusOK, "Promotion deactivated successfully", nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrPromotionNotFound), errors.Is(err, ErrCategoryNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrInvalidPromotion):
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, ErrDuplicateCode):
+		response.Error(c, http.StatusConflict, "DUPLICATE_CODE", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
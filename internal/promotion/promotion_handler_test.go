@@ -0,0 +1,109 @@
+package promotion_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/promotion"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakePromotionService struct {
+	CreateFn  func(ctx context.Context, req promotion.PromotionRequest) (promotion.PromotionResponse, error)
+	ListFn    func(ctx context.Context, p promotion.ListParams) ([]promotion.PromotionResponse, int64, error)
+	GetByIDFn func(ctx context.Context, id string) (promotion.PromotionResponse, error)
+	UpdateFn  func(ctx context.Context, id string, req promotion.PromotionRequest) (promotion.PromotionResponse, error)
+	DeleteFn  func(ctx context.Context, id string) error
+}
+
+func (f *fakePromotionService) Create(ctx context.Context, req promotion.PromotionRequest) (promotion.PromotionResponse, error) {
+	return f.CreateFn(ctx, req)
+}
+
+func (f *fakePromotionService) List(ctx context.Context, p promotion.ListParams) ([]promotion.PromotionResponse, int64, error) {
+	return f.ListFn(ctx, p)
+}
+
+func (f *fakePromotionService) GetByID(ctx context.Context, id string) (promotion.PromotionResponse, error) {
+	return f.GetByIDFn(ctx, id)
+}
+
+func (f *fakePromotionService) Update(ctx context.Context, id string, req promotion.PromotionRequest) (promotion.PromotionResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+
+func (f *fakePromotionService) Delete(ctx context.Context, id string) error {
+	return f.DeleteFn(ctx, id)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"code":"HEMAT10","name":"Hemat","type":"percentage","value":10}`, nil, http.StatusCreated},
+		{"invalid type", `{"code":"HEMAT10","name":"Hemat","type":"bogus","value":10}`, nil, http.StatusBadRequest},
+		{"invalid rules", `{"code":"HEMAT10","name":"Hemat","type":"percentage","value":150}`, promotion.ErrInvalidPromotion, http.StatusBadRequest},
+		{"duplicate code", `{"code":"HEMAT10","name":"Hemat","type":"percentage","value":10}`, promotion.ErrDuplicateCode, http.StatusConflict},
+		{"category not found", `{"code":"HEMAT10","name":"Hemat","type":"percentage","value":10,"category_id":"x"}`, promotion.ErrCategoryNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakePromotionService{
+				CreateFn: func(ctx context.Context, req promotion.PromotionRequest) (promotion.PromotionResponse, error) {
+					if tc.err != nil {
+						return promotion.PromotionResponse{}, tc.err
+					}
+					return promotion.PromotionResponse{ID: "promo-1", Code: req.Code}, nil
+				},
+			}
+
+			r := setupTestRouter()
+			r.POST("/promotions", promotion.NewHandler(svc).Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/promotions", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_GetByID_NotFound(t *testing.T) {
+	svc := &fakePromotionService{
+		GetByIDFn: func(ctx context.Context, id string) (promotion.PromotionResponse, error) {
+			return promotion.PromotionResponse{}, promotion.ErrPromotionNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	r.GET("/promotions/:id", promotion.NewHandler(svc).GetByID)
+
+	req := httptest.NewRequest(http.MethodGet, "/promotions/missing", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
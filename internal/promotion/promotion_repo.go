@@ -0,0 +1,55 @@
+package promotion
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=promotion_repo.go -destination=mocks/promotion_repo_mock.go -package=mock
+type Repository interface {
+	Create(ctx context.Context, params dbgen.CreatePromotionParams) error
+	GetByID(ctx context.Context, id string) (dbgen.Promotion, error)
+	List(ctx context.Context, params dbgen.ListPromotionsParams) ([]dbgen.Promotion, error)
+	Count(ctx context.Context) (int64, error)
+	Update(ctx context.Context, params dbgen.UpdatePromotionParams) error
+	Deactivate(ctx context.Context, id string) (int64, error)
+	CategoryExists(ctx context.Context, id string) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreatePromotionParams) error {
+	return r.q.CreatePromotion(ctx, params)
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (dbgen.Promotion, error) {
+	return r.q.GetPromotionByID(ctx, id)
+}
+
+func (r *repository) List(ctx context.Context, params dbgen.ListPromotionsParams) ([]dbgen.Promotion, error) {
+	return r.q.ListPromotions(ctx, params)
+}
+
+func (r *repository) Count(ctx context.Context) (int64, error) {
+	return r.q.CountPromotions(ctx)
+}
+
+func (r *repository) Update(ctx context.Context, params dbgen.UpdatePromotionParams) error {
+	return r.q.UpdatePromotion(ctx, params)
+}
+
+func (r *repository) Deactivate(ctx context.Context, id string) (int64, error) {
+	return r.q.DeactivatePromotion(ctx, id)
+}
+
+// CategoryExists mengembalikan sql.ErrNoRows jika kategori tidak ditemukan
+func (r *repository) CategoryExists(ctx context.Context, id string) error {
+	_, err := r.q.GetCategoryByID(ctx, id)
+	return err
+}
@@ -0,0 +1,14 @@
+package promotion
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	promotions := r.Group("/promotions")
+	{
+		promotions.POST("", handler.Create)
+		promotions.GET("", handler.GetAll)
+		promotions.GET("/:id", handler.GetByID)
+		promotions.PUT("/:id", handler.Update)
+		promotions.DELETE("/:id", handler.Delete) // Menonaktifkan, riwayat pemakaian tetap ada
+	}
+}
@@ -0,0 +1,216 @@
+package promotion
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=promotion_service.go -destination=mocks/promotion_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, req PromotionRequest) (PromotionResponse, error)
+	List(ctx context.Context, params ListParams) ([]PromotionResponse, int64, error)
+	GetByID(ctx context.Context, id string) (PromotionResponse, error)
+	Update(ctx context.Context, id string, req PromotionRequest) (PromotionResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Create(ctx context.Context, req PromotionRequest) (PromotionResponse, error) {
+	if err := s.validate(ctx, req); err != nil {
+		return PromotionResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return PromotionResponse{}, err
+	}
+	id := newUUID.String()
+
+	params := dbgen.CreatePromotionParams{
+		ID:                    id,
+		Code:                  NormalizeCode(req.Code),
+		Name:                  req.Name,
+		Type:                  req.Type,
+		Value:                 helper.Float64ToDecimal(req.Value),
+		BuyQuantity:           helper.IntToNullInt32(req.BuyQuantity),
+		GetQuantity:           helper.IntToNullInt32(req.GetQuantity),
+		CategoryID:            helper.StringToNull(req.CategoryID),
+		MinSubtotal:           helper.Float64ToNullDecimal(req.MinSubtotal),
+		MaxDiscount:           helper.Float64ToNullDecimal(req.MaxDiscount),
+		UsageLimit:            helper.IntToNullInt32(req.UsageLimit),
+		UsageLimitPerCustomer: helper.IntToNullInt32(req.UsageLimitPerCustomer),
+		StartsAt:              utcNullTime(req.StartsAt),
+		EndsAt:                utcNullTime(req.EndsAt),
+		IsActive:              helper.BoolPtrValue(req.IsActive, true),
+	}
+
+	if err := s.repo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return PromotionResponse{}, ErrDuplicateCode
+		}
+		return PromotionResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *service) List(ctx context.Context, p ListParams) ([]PromotionResponse, int64, error) {
+	rows, err := s.repo.List(ctx, dbgen.ListPromotionsParams{
+		Limit:  int32(p.PageSize),
+		Offset: int32((p.Page - 1) * p.PageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]PromotionResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapToResponse(r))
+	}
+
+	return res, total, nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (PromotionResponse, error) {
+	row, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PromotionResponse{}, ErrPromotionNotFound
+		}
+		return PromotionResponse{}, err
+	}
+
+	return mapToResponse(row), nil
+}
+
+func (s *service) Update(ctx context.Context, id string, req PromotionRequest) (PromotionResponse, error) {
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return PromotionResponse{}, err
+	}
+	if err := s.validate(ctx, req); err != nil {
+		return PromotionResponse{}, err
+	}
+
+	params := dbgen.UpdatePromotionParams{
+		Code:                  NormalizeCode(req.Code),
+		Name:                  req.Name,
+		Type:                  req.Type,
+		Value:                 helper.Float64ToDecimal(req.Value),
+		BuyQuantity:           helper.IntToNullInt32(req.BuyQuantity),
+		GetQuantity:           helper.IntToNullInt32(req.GetQuantity),
+		CategoryID:            helper.StringToNull(req.CategoryID),
+		MinSubtotal:           helper.Float64ToNullDecimal(req.MinSubtotal),
+		MaxDiscount:           helper.Float64ToNullDecimal(req.MaxDiscount),
+		UsageLimit:            helper.IntToNullInt32(req.UsageLimit),
+		UsageLimitPerCustomer: helper.IntToNullInt32(req.UsageLimitPerCustomer),
+		StartsAt:              utcNullTime(req.StartsAt),
+		EndsAt:                utcNullTime(req.EndsAt),
+		IsActive:              helper.BoolPtrValue(req.IsActive, true),
+		ID:                    id,
+	}
+
+	if err := s.repo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return PromotionResponse{}, ErrDuplicateCode
+		}
+		return PromotionResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// Delete hanya menonaktifkan promosi agar riwayat pemakaian di order tetap utuh
+func (s *service) Delete(ctx context.Context, id string) error {
+	affected, err := s.repo.Deactivate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// MySQL melaporkan 0 baris jika promosi sudah nonaktif, jadi cek keberadaannya
+		_, err := s.GetByID(ctx, id)
+		return err
+	}
+	return nil
+}
+
+func (s *service) validate(ctx context.Context, req PromotionRequest) error {
+	switch req.Type {
+	case TypePercentage:
+		if req.Value <= 0 || req.Value > 100 {
+			return fmt.Errorf("%w: percentage value must be between 0 and 100", ErrInvalidPromotion)
+		}
+	case TypeFixed:
+		if req.Value <= 0 {
+			return fmt.Errorf("%w: fixed value must be greater than 0", ErrInvalidPromotion)
+		}
+	case TypeBuyXGetY:
+		if req.BuyQuantity == nil || req.GetQuantity == nil {
+			return fmt.Errorf("%w: buy_quantity and get_quantity are required for buy_x_get_y", ErrInvalidPromotion)
+		}
+	}
+
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidPromotion)
+	}
+
+	if req.CategoryID != nil {
+		if err := s.repo.CategoryExists(ctx, *req.CategoryID); err != nil {
+			if err == sql.ErrNoRows {
+				return ErrCategoryNotFound
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapToResponse(r dbgen.Promotion) PromotionResponse {
+	return PromotionResponse{
+		ID:                    r.ID,
+		Code:                  r.Code,
+		Name:                  r.Name,
+		Type:                  r.Type,
+		Value:                 helper.DecimalToFloat64(r.Value),
+		BuyQuantity:           helper.NullInt32ToIntPtr(r.BuyQuantity),
+		GetQuantity:           helper.NullInt32ToIntPtr(r.GetQuantity),
+		CategoryID:            helper.NullStringToPtr(r.CategoryID),
+		MinSubtotal:           helper.NullDecimalToFloat64Ptr(r.MinSubtotal),
+		MaxDiscount:           helper.NullDecimalToFloat64Ptr(r.MaxDiscount),
+		UsageLimit:            helper.NullInt32ToIntPtr(r.UsageLimit),
+		UsageLimitPerCustomer: helper.NullInt32ToIntPtr(r.UsageLimitPerCustomer),
+		UsedCount:             int(r.UsedCount),
+		StartsAt:              helper.NullTimeToPtr(r.StartsAt),
+		EndsAt:                helper.NullTimeToPtr(r.EndsAt),
+		IsActive:              r.IsActive,
+		CreatedAt:             r.CreatedAt,
+		UpdatedAt:             r.UpdatedAt,
+	}
+}
+
+// Window promosi disimpan dalam UTC, sama dengan waktu yang dipakai saat checkout
+func utcNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	utc := t.UTC()
+	return helper.TimeToNull(&utc)
+}
@@ -0,0 +1,144 @@
+package promotion_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/promotion"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockPromotion "assignment-ptes-achmad-rifai/internal/promotion/mocks"
+)
+
+func setupServiceTest(t *testing.T) (promotion.Service, *mockPromotion.MockRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockPromotion.NewMockRepository(ctrl)
+
+	return promotion.NewService(repo), repo
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_normalizes_code", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		categoryID := "cat-1"
+		req := promotion.PromotionRequest{
+			Code:       " hemat10 ",
+			Name:       "Hemat 10%",
+			Type:       promotion.TypePercentage,
+			Value:      10,
+			CategoryID: &categoryID,
+		}
+
+		repo.EXPECT().CategoryExists(gomock.Any(), categoryID).Return(nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreatePromotionParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreatePromotionParams) error {
+				assert.NotEmpty(t, p.ID)
+				assert.Equal(t, "HEMAT10", p.Code)
+				assert.Equal(t, "cat-1", p.CategoryID.String)
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.Promotion{
+			ID:       "promo-1",
+			Code:     "HEMAT10",
+			Type:     promotion.TypePercentage,
+			Value:    decimal.NewFromInt(10),
+			IsActive: true,
+		}, nil)
+
+		res, err := svc.Create(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "HEMAT10", res.Code)
+		assert.Equal(t, float64(10), res.Value)
+	})
+
+	t.Run("error_invalid_rules", func(t *testing.T) {
+		start := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+		end := start.Add(-time.Hour)
+
+		cases := map[string]promotion.PromotionRequest{
+			"percentage_over_100": {Code: "A", Name: "A", Type: promotion.TypePercentage, Value: 150},
+			"fixed_zero":          {Code: "B", Name: "B", Type: promotion.TypeFixed, Value: 0},
+			"bxgy_missing_qty":    {Code: "C", Name: "C", Type: promotion.TypeBuyXGetY},
+			"window_reversed":     {Code: "D", Name: "D", Type: promotion.TypeFixed, Value: 1, StartsAt: &start, EndsAt: &end},
+		}
+
+		for name, req := range cases {
+			t.Run(name, func(t *testing.T) {
+				svc, _ := setupServiceTest(t)
+
+				_, err := svc.Create(ctx, req)
+
+				assert.ErrorIs(t, err, promotion.ErrInvalidPromotion)
+			})
+		}
+	})
+
+	t.Run("error_category_not_found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		categoryID := "missing"
+		repo.EXPECT().CategoryExists(gomock.Any(), categoryID).Return(sql.ErrNoRows)
+
+		_, err := svc.Create(ctx, promotion.PromotionRequest{
+			Code: "X", Name: "X", Type: promotion.TypeFixed, Value: 1000, CategoryID: &categoryID,
+		})
+
+		assert.ErrorIs(t, err, promotion.ErrCategoryNotFound)
+	})
+
+	t.Run("error_duplicate_code", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062})
+
+		_, err := svc.Create(ctx, promotion.PromotionRequest{
+			Code: "X", Name: "X", Type: promotion.TypeFixed, Value: 1000,
+		})
+
+		assert.ErrorIs(t, err, promotion.ErrDuplicateCode)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().Deactivate(gomock.Any(), "promo-1").Return(int64(1), nil)
+
+		assert.NoError(t, svc.Delete(ctx, "promo-1"))
+	})
+
+	t.Run("already_inactive", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().Deactivate(gomock.Any(), "promo-1").Return(int64(0), nil)
+		repo.EXPECT().GetByID(gomock.Any(), "promo-1").Return(dbgen.Promotion{ID: "promo-1"}, nil)
+
+		assert.NoError(t, svc.Delete(ctx, "promo-1"))
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().Deactivate(gomock.Any(), "missing").Return(int64(0), nil)
+		repo.EXPECT().GetByID(gomock.Any(), "missing").Return(dbgen.Promotion{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, svc.Delete(ctx, "missing"), promotion.ErrPromotionNotFound)
+	})
+}
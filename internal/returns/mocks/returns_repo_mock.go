@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: returns_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=returns_repo.go -destination=mocks/returns_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	returns "assignment-ptes-achmad-rifai/internal/returns"
+	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddOrderRefundTotal mocks base method.
+func (m *MockRepository) AddOrderRefundTotal(ctx context.Context, params dbgen.AddOrderRefundTotalParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrderRefundTotal", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrderRefundTotal indicates an expected call of AddOrderRefundTotal.
+func (mr *MockRepositoryMockRecorder) AddOrderRefundTotal(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderRefundTotal", reflect.TypeOf((*MockRepository)(nil).AddOrderRefundTotal), ctx, params)
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, params dbgen.CreateReturnParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, params)
+}
+
+// CreateItem mocks base method.
+func (m *MockRepository) CreateItem(ctx context.Context, params dbgen.CreateReturnItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockRepositoryMockRecorder) CreateItem(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockRepository)(nil).CreateItem), ctx, params)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id string) (dbgen.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(dbgen.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// GetOrderForUpdate mocks base method.
+func (m *MockRepository) GetOrderForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderForReturnRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderForUpdate", ctx, orderID)
+	ret0, _ := ret[0].(dbgen.GetOrderForReturnRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderForUpdate indicates an expected call of GetOrderForUpdate.
+func (mr *MockRepositoryMockRecorder) GetOrderForUpdate(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderForUpdate", reflect.TypeOf((*MockRepository)(nil).GetOrderForUpdate), ctx, orderID)
+}
+
+// GetOrderItems mocks base method.
+func (m *MockRepository) GetOrderItems(ctx context.Context, orderID string) ([]dbgen.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItems", ctx, orderID)
+	ret0, _ := ret[0].([]dbgen.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItems indicates an expected call of GetOrderItems.
+func (mr *MockRepositoryMockRecorder) GetOrderItems(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItems", reflect.TypeOf((*MockRepository)(nil).GetOrderItems), ctx, orderID)
+}
+
+// IncrementProductStock mocks base method.
+func (m *MockRepository) IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementProductStock", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementProductStock indicates an expected call of IncrementProductStock.
+func (mr *MockRepositoryMockRecorder) IncrementProductStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementProductStock", reflect.TypeOf((*MockRepository)(nil).IncrementProductStock), ctx, params)
+}
+
+// IncrementReturnedQuantity mocks base method.
+func (m *MockRepository) IncrementReturnedQuantity(ctx context.Context, params dbgen.IncrementOrderItemReturnedQuantityParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementReturnedQuantity", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementReturnedQuantity indicates an expected call of IncrementReturnedQuantity.
+func (mr *MockRepositoryMockRecorder) IncrementReturnedQuantity(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementReturnedQuantity", reflect.TypeOf((*MockRepository)(nil).IncrementReturnedQuantity), ctx, params)
+}
+
+// IncrementVariantStock mocks base method.
+func (m *MockRepository) IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementVariantStock", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementVariantStock indicates an expected call of IncrementVariantStock.
+func (mr *MockRepositoryMockRecorder) IncrementVariantStock(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementVariantStock", reflect.TypeOf((*MockRepository)(nil).IncrementVariantStock), ctx, params)
+}
+
+// ListByOrder mocks base method.
+func (m *MockRepository) ListByOrder(ctx context.Context, orderID string) ([]dbgen.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]dbgen.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockRepositoryMockRecorder) ListByOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockRepository)(nil).ListByOrder), ctx, orderID)
+}
+
+// ListItemsByOrder mocks base method.
+func (m *MockRepository) ListItemsByOrder(ctx context.Context, orderID string) ([]dbgen.ReturnItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemsByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]dbgen.ReturnItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemsByOrder indicates an expected call of ListItemsByOrder.
+func (mr *MockRepositoryMockRecorder) ListItemsByOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsByOrder", reflect.TypeOf((*MockRepository)(nil).ListItemsByOrder), ctx, orderID)
+}
+
+// MarkRefunded mocks base method.
+func (m *MockRepository) MarkRefunded(ctx context.Context, params dbgen.MarkReturnRefundedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRefunded", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRefunded indicates an expected call of MarkRefunded.
+func (mr *MockRepositoryMockRecorder) MarkRefunded(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRefunded", reflect.TypeOf((*MockRepository)(nil).MarkRefunded), ctx, params)
+}
+
+// OrderExists mocks base method.
+func (m *MockRepository) OrderExists(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderExists", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderExists indicates an expected call of OrderExists.
+func (mr *MockRepositoryMockRecorder) OrderExists(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderExists", reflect.TypeOf((*MockRepository)(nil).OrderExists), ctx, orderID)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) returns.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(returns.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
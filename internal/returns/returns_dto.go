@@ -0,0 +1,38 @@
+package returns
+
+import "time"
+
+// Status retur
+const (
+	StatusRefundPending = "refund_pending"
+	StatusRefunded      = "refunded"
+	StatusCompleted     = "completed" // Order belum dibayar, tidak ada dana yang perlu dikembalikan
+)
+
+type ReturnItemRequest struct {
+	OrderItemID string `json:"order_item_id" binding:"required"`
+	Quantity    int    `json:"quantity" binding:"required,gt=0"`
+}
+
+type CreateReturnRequest struct {
+	Items  []ReturnItemRequest `json:"items" binding:"required,gt=0,dive"`
+	Reason *string             `json:"reason" binding:"omitempty,max=255"`
+}
+
+type ReturnItemResponse struct {
+	ID           string  `json:"id"`
+	OrderItemID  string  `json:"order_item_id"`
+	Quantity     int     `json:"quantity"`
+	RefundAmount float64 `json:"refund_amount"`
+}
+
+type ReturnResponse struct {
+	ID           string               `json:"id"`
+	OrderID      string               `json:"order_id"`
+	Status       string               `json:"status"`
+	Reason       *string              `json:"reason,omitempty"`
+	RefundAmount float64              `json:"refund_amount"`
+	RefundedAt   *time.Time           `json:"refunded_at,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	Items        []ReturnItemResponse `json:"items"`
+}
@@ -0,0 +1,12 @@
+package returns
+
+import "errors"
+
+var (
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderCancelled      = errors.New("cancelled orders cannot be returned")
+	ErrOrderItemNotFound   = errors.New("order item not found in this order")
+	ErrReturnNotFound      = errors.New("return not found")
+	ErrQuantityExceeded    = errors.New("return quantity exceeds the quantity still returnable")
+	ErrReturnNotRefundable = errors.New("only returns awaiting refund can be marked as refunded")
+)
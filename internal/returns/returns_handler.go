@@ -0,0 +1,98 @@
+package returns
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Return order items
+// @Description  Return selected items and quantities of an order. Returned units are restocked and the refund is the paid share of each line (after discounts, including tax)
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string               true  "Order ID"
+// @Param        request  body      CreateReturnRequest  true  "Return Request"
+// @Success      201      {object}  ReturnResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Order or order item not found"
+// @Failure      409      {object}  map[string]string "Quantity exceeds what is still returnable or order is cancelled"
+// @Router       /orders/{id}/returns [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req CreateReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create return")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// ListByOrder godoc
+// @Summary      List order returns
+// @Description  Retrieve all returns of an order, newest first
+// @Tags         returns
+// @Produce      json
+// @Param        id       path      string  true  "Order ID"
+// @Success      200      {array}   ReturnResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /orders/{id}/returns [get]
+func (h *Handler) ListByOrder(c *gin.Context) {
+	res, err := h.service.ListByOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch returns")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// MarkRefunded godoc
+// @Summary      Mark return refunded
+// @Description  Confirm that the refund of a return has been paid back to the customer
+// @Tags         returns
+// @Produce      json
+// @Param        id         path      string  true  "Order ID"
+// @Param        return_id  path      string  true  "Return ID"
+// @Success      200        {object}  ReturnResponse
+// @Failure      404        {object}  map[string]string
+// @Failure      409        {object}  map[string]string "Return is not awaiting refund"
+// @Router       /orders/{id}/returns/{return_id}/refund [post]
+func (h *Handler) MarkRefunded(c *gin.Context) {
+	res, err := h.service.MarkRefunded(c.Request.Context(), c.Param("id"), c.Param("return_id"))
+	if err != nil {
+		handleError(c, err, "REFUND_ERROR", "Failed to mark return as refunded")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrOrderNotFound), errors.Is(err, ErrOrderItemNotFound), errors.Is(err, ErrReturnNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrQuantityExceeded):
+		response.Error(c, http.StatusConflict, "QUANTITY_EXCEEDED", err.Error(), nil)
+	case errors.Is(err, ErrOrderCancelled):
+		response.Error(c, http.StatusConflict, "ORDER_CANCELLED", err.Error(), nil)
+	case errors.Is(err, ErrReturnNotRefundable):
+		response.Error(c, http.StatusConflict, "NOT_REFUNDABLE", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,98 @@
+package returns_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/returns"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeReturnsService struct {
+	CreateFn       func(ctx context.Context, orderID string, req returns.CreateReturnRequest) (returns.ReturnResponse, error)
+	ListByOrderFn  func(ctx context.Context, orderID string) ([]returns.ReturnResponse, error)
+	MarkRefundedFn func(ctx context.Context, orderID, returnID string) (returns.ReturnResponse, error)
+}
+
+func (f *fakeReturnsService) Create(ctx context.Context, orderID string, req returns.CreateReturnRequest) (returns.ReturnResponse, error) {
+	return f.CreateFn(ctx, orderID, req)
+}
+
+func (f *fakeReturnsService) ListByOrder(ctx context.Context, orderID string) ([]returns.ReturnResponse, error) {
+	return f.ListByOrderFn(ctx, orderID)
+}
+
+func (f *fakeReturnsService) MarkRefunded(ctx context.Context, orderID, returnID string) (returns.ReturnResponse, error) {
+	return f.MarkRefundedFn(ctx, orderID, returnID)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"items":[{"order_item_id":"oi-1","quantity":1}]}`, nil, http.StatusCreated},
+		{"empty items", `{"items":[]}`, nil, http.StatusBadRequest},
+		{"zero quantity", `{"items":[{"order_item_id":"oi-1","quantity":0}]}`, nil, http.StatusBadRequest},
+		{"order not found", `{"items":[{"order_item_id":"oi-1","quantity":1}]}`, returns.ErrOrderNotFound, http.StatusNotFound},
+		{"quantity exceeded", `{"items":[{"order_item_id":"oi-1","quantity":9}]}`, returns.ErrQuantityExceeded, http.StatusConflict},
+		{"order cancelled", `{"items":[{"order_item_id":"oi-1","quantity":1}]}`, returns.ErrOrderCancelled, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeReturnsService{
+				CreateFn: func(ctx context.Context, orderID string, req returns.CreateReturnRequest) (returns.ReturnResponse, error) {
+					assert.Equal(t, "order-1", orderID)
+					return returns.ReturnResponse{ID: "ret-1", OrderID: orderID}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			returns.RegisterRoutes(r.Group(""), returns.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/order-1/returns", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_MarkRefunded_NotRefundable(t *testing.T) {
+	svc := &fakeReturnsService{
+		MarkRefundedFn: func(ctx context.Context, orderID, returnID string) (returns.ReturnResponse, error) {
+			assert.Equal(t, "ret-1", returnID)
+			return returns.ReturnResponse{}, returns.ErrReturnNotRefundable
+		},
+	}
+
+	r := setupTestRouter()
+	returns.RegisterRoutes(r.Group(""), returns.NewHandler(svc))
+
+	req := httptest.NewRequest(http.MethodPost, "/orders/order-1/returns/ret-1/refund", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
@@ -0,0 +1,19 @@
+package returns
+
+import "github.com/shopspring/decimal"
+
+// RefundAmount menghitung refund untuk qty unit dari sebuah baris order.
+// Dasarnya line_total (sudah dikurangi diskon dan termasuk pajak), dibagi rata per unit.
+// Dihitung sebagai selisih porsi kumulatif sehingga retur bertahap atas seluruh
+// quantity selalu berjumlah tepat line_total tanpa selisih pembulatan.
+func RefundAmount(lineTotal decimal.Decimal, quantity, alreadyReturned, qty int32) decimal.Decimal {
+	if quantity <= 0 {
+		return decimal.Zero
+	}
+
+	share := func(n int32) decimal.Decimal {
+		return lineTotal.Mul(decimal.NewFromInt32(n)).Div(decimal.NewFromInt32(quantity)).Round(2)
+	}
+
+	return share(alreadyReturned + qty).Sub(share(alreadyReturned))
+}
@@ -0,0 +1,34 @@
+package returns_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/returns"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundAmount(t *testing.T) {
+	t.Run("proportional_to_paid_line_total", func(t *testing.T) {
+		// 4 unit x 25000, diskon 10000 -> line_total 90000
+		refund := returns.RefundAmount(decimal.NewFromInt(90000), 4, 0, 1)
+
+		assert.Equal(t, "22500", refund.String())
+	})
+
+	t.Run("staged_returns_sum_to_line_total", func(t *testing.T) {
+		lineTotal := decimal.RequireFromString("100")
+
+		first := returns.RefundAmount(lineTotal, 3, 0, 1)
+		second := returns.RefundAmount(lineTotal, 3, 1, 1)
+		third := returns.RefundAmount(lineTotal, 3, 2, 1)
+
+		assert.Equal(t, "33.33", first.String())
+		assert.Equal(t, "33.34", second.String())
+		assert.True(t, first.Add(second).Add(third).Equal(lineTotal))
+	})
+
+	t.Run("zero_quantity_line", func(t *testing.T) {
+		assert.True(t, returns.RefundAmount(decimal.NewFromInt(1000), 0, 0, 1).IsZero())
+	})
+}
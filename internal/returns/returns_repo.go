@@ -0,0 +1,103 @@
+package returns
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=returns_repo.go -destination=mocks/returns_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	// GetOrderForUpdate mengunci baris order agar retur pada order yang sama diproses berurutan
+	GetOrderForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderForReturnRow, error)
+	OrderExists(ctx context.Context, orderID string) error
+	GetOrderItems(ctx context.Context, orderID string) ([]dbgen.OrderItem, error)
+	AddOrderRefundTotal(ctx context.Context, params dbgen.AddOrderRefundTotalParams) error
+
+	// IncrementReturnedQuantity mengembalikan 0 jika sisa quantity tidak cukup
+	IncrementReturnedQuantity(ctx context.Context, params dbgen.IncrementOrderItemReturnedQuantityParams) (int64, error)
+	IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error
+	IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error
+
+	Create(ctx context.Context, params dbgen.CreateReturnParams) error
+	CreateItem(ctx context.Context, params dbgen.CreateReturnItemParams) error
+	GetByID(ctx context.Context, id string) (dbgen.Return, error)
+	ListByOrder(ctx context.Context, orderID string) ([]dbgen.Return, error)
+	ListItemsByOrder(ctx context.Context, orderID string) ([]dbgen.ReturnItem, error)
+	MarkRefunded(ctx context.Context, params dbgen.MarkReturnRefundedParams) (int64, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) GetOrderForUpdate(ctx context.Context, orderID string) (dbgen.GetOrderForReturnRow, error) {
+	return r.q.GetOrderForReturn(ctx, orderID)
+}
+
+// OrderExists mengembalikan sql.ErrNoRows jika order tidak ditemukan
+func (r *repository) OrderExists(ctx context.Context, orderID string) error {
+	_, err := r.q.GetOrderPaymentInfo(ctx, orderID)
+	return err
+}
+
+func (r *repository) GetOrderItems(ctx context.Context, orderID string) ([]dbgen.OrderItem, error) {
+	return r.q.GetOrderItemsByOrderID(ctx, orderID)
+}
+
+func (r *repository) AddOrderRefundTotal(ctx context.Context, params dbgen.AddOrderRefundTotalParams) error {
+	return r.q.AddOrderRefundTotal(ctx, params)
+}
+
+func (r *repository) IncrementReturnedQuantity(ctx context.Context, params dbgen.IncrementOrderItemReturnedQuantityParams) (int64, error) {
+	return r.q.IncrementOrderItemReturnedQuantity(ctx, params)
+}
+
+func (r *repository) IncrementProductStock(ctx context.Context, params dbgen.IncrementProductStockParams) error {
+	return r.q.IncrementProductStock(ctx, params)
+}
+
+func (r *repository) IncrementVariantStock(ctx context.Context, params dbgen.IncrementProductVariantStockParams) error {
+	return r.q.IncrementProductVariantStock(ctx, params)
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateReturnParams) error {
+	return r.q.CreateReturn(ctx, params)
+}
+
+func (r *repository) CreateItem(ctx context.Context, params dbgen.CreateReturnItemParams) error {
+	return r.q.CreateReturnItem(ctx, params)
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (dbgen.Return, error) {
+	return r.q.GetReturnByID(ctx, id)
+}
+
+func (r *repository) ListByOrder(ctx context.Context, orderID string) ([]dbgen.Return, error) {
+	return r.q.ListReturnsByOrder(ctx, orderID)
+}
+
+func (r *repository) ListItemsByOrder(ctx context.Context, orderID string) ([]dbgen.ReturnItem, error) {
+	return r.q.ListReturnItemsByOrder(ctx, orderID)
+}
+
+func (r *repository) MarkRefunded(ctx context.Context, params dbgen.MarkReturnRefundedParams) (int64, error) {
+	return r.q.MarkReturnRefunded(ctx, params)
+}
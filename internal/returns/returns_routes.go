@@ -0,0 +1,12 @@
+package returns
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	returns := r.Group("/orders/:id/returns")
+	{
+		returns.POST("", handler.Create)
+		returns.GET("", handler.ListByOrder)
+		returns.POST("/:return_id/refund", handler.MarkRefunded)
+	}
+}
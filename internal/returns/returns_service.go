@@ -0,0 +1,282 @@
+package returns
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockgen -source=returns_service.go -destination=mocks/returns_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, orderID string, req CreateReturnRequest) (ReturnResponse, error)
+	ListByOrder(ctx context.Context, orderID string) ([]ReturnResponse, error)
+	MarkRefunded(ctx context.Context, orderID, returnID string) (ReturnResponse, error)
+}
+
+const (
+	orderPaid      = "paid"      // sama dengan order.PaymentStatusPaid
+	orderCancelled = "cancelled" // sama dengan order.OrderStatusCancelled
+)
+
+type service struct {
+	db   *sql.DB // Diperlukan untuk memulai transaksi
+	repo Repository
+}
+
+func NewService(db *sql.DB, repo Repository) Service {
+	return &service{
+		db:   db,
+		repo: repo,
+	}
+}
+
+// Create meretur sebagian/seluruh item order dalam satu transaksi: quantity retur
+// dicatat per baris, stok dikembalikan, dan refund ditambahkan ke refund_total order.
+func (s *service) Create(ctx context.Context, orderID string, req CreateReturnRequest) (ReturnResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	order, err := txRepo.GetOrderForUpdate(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReturnResponse{}, ErrOrderNotFound
+		}
+		return ReturnResponse{}, err
+	}
+	// Stok order yang dibatalkan sudah dikembalikan; retur akan menghitungnya dua kali
+	if order.Status == orderCancelled {
+		return ReturnResponse{}, ErrOrderCancelled
+	}
+
+	orderItems, err := txRepo.GetOrderItems(ctx, orderID)
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	byID := make(map[string]dbgen.OrderItem, len(orderItems))
+	for _, oi := range orderItems {
+		byID[oi.ID] = oi
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	returnID := newUUID.String()
+
+	total := decimal.Zero
+	itemParams := make([]dbgen.CreateReturnItemParams, 0, len(req.Items))
+	for _, item := range mergeItems(req.Items) {
+		oi, ok := byID[item.OrderItemID]
+		if !ok {
+			return ReturnResponse{}, fmt.Errorf("%w: %s", ErrOrderItemNotFound, item.OrderItemID)
+		}
+
+		qty := int32(item.Quantity)
+		affected, err := txRepo.IncrementReturnedQuantity(ctx, dbgen.IncrementOrderItemReturnedQuantityParams{
+			Quantity: qty,
+			ID:       oi.ID,
+			OrderID:  orderID,
+		})
+		if err != nil {
+			return ReturnResponse{}, err
+		}
+		if affected == 0 {
+			return ReturnResponse{}, fmt.Errorf("%w: item %s has %d returnable", ErrQuantityExceeded, oi.ID, oi.Quantity-oi.ReturnedQuantity)
+		}
+
+		if err := restock(ctx, txRepo, oi, qty); err != nil {
+			return ReturnResponse{}, err
+		}
+
+		newUUID, err := uuid.NewV7()
+		if err != nil {
+			return ReturnResponse{}, err
+		}
+
+		refund := RefundAmount(oi.LineTotal, oi.Quantity, oi.ReturnedQuantity, qty)
+		total = total.Add(refund)
+		itemParams = append(itemParams, dbgen.CreateReturnItemParams{
+			ID:           newUUID.String(),
+			ReturnID:     returnID,
+			OrderItemID:  oi.ID,
+			Quantity:     qty,
+			RefundAmount: refund,
+		})
+	}
+
+	// Dana hanya perlu dikembalikan jika order sudah dibayar
+	status := StatusCompleted
+	if order.PaymentStatus == orderPaid && total.IsPositive() {
+		status = StatusRefundPending
+	}
+
+	if err := txRepo.Create(ctx, dbgen.CreateReturnParams{
+		ID:           returnID,
+		OrderID:      orderID,
+		Status:       status,
+		Reason:       helper.StringToNull(req.Reason),
+		RefundAmount: total,
+	}); err != nil {
+		return ReturnResponse{}, err
+	}
+
+	itemResponses := make([]ReturnItemResponse, 0, len(itemParams))
+	for _, p := range itemParams {
+		if err := txRepo.CreateItem(ctx, p); err != nil {
+			return ReturnResponse{}, err
+		}
+		itemResponses = append(itemResponses, mapItemToResponse(dbgen.ReturnItem(p)))
+	}
+
+	if err := txRepo.AddOrderRefundTotal(ctx, dbgen.AddOrderRefundTotalParams{
+		RefundTotal: total,
+		ID:          orderID,
+	}); err != nil {
+		return ReturnResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ReturnResponse{}, err
+	}
+
+	return ReturnResponse{
+		ID:           returnID,
+		OrderID:      orderID,
+		Status:       status,
+		Reason:       req.Reason,
+		RefundAmount: helper.DecimalToFloat64(total),
+		CreatedAt:    time.Now(),
+		Items:        itemResponses,
+	}, nil
+}
+
+func (s *service) ListByOrder(ctx context.Context, orderID string) ([]ReturnResponse, error) {
+	if err := s.repo.OrderExists(ctx, orderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := s.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.ListItemsByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	itemsByReturn := make(map[string][]ReturnItemResponse)
+	for _, it := range items {
+		itemsByReturn[it.ReturnID] = append(itemsByReturn[it.ReturnID], mapItemToResponse(it))
+	}
+
+	res := make([]ReturnResponse, 0, len(rows))
+	for _, r := range rows {
+		resp := mapToResponse(r)
+		if its, ok := itemsByReturn[r.ID]; ok {
+			resp.Items = its
+		}
+		res = append(res, resp)
+	}
+
+	return res, nil
+}
+
+// MarkRefunded menandai dana retur sudah dikembalikan ke customer
+func (s *service) MarkRefunded(ctx context.Context, orderID, returnID string) (ReturnResponse, error) {
+	affected, err := s.repo.MarkRefunded(ctx, dbgen.MarkReturnRefundedParams{
+		RefundedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:         returnID,
+		OrderID:    orderID,
+	})
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+
+	row, err := s.repo.GetByID(ctx, returnID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReturnResponse{}, ErrReturnNotFound
+		}
+		return ReturnResponse{}, err
+	}
+	if row.OrderID != orderID {
+		return ReturnResponse{}, ErrReturnNotFound
+	}
+	if affected == 0 {
+		return ReturnResponse{}, ErrReturnNotRefundable
+	}
+
+	return mapToResponse(row), nil
+}
+
+// mergeItems menggabungkan baris yang sama agar validasi sisa quantity akurat
+func mergeItems(items []ReturnItemRequest) []ReturnItemRequest {
+	merged := make([]ReturnItemRequest, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, it := range items {
+		if i, ok := index[it.OrderItemID]; ok {
+			merged[i].Quantity += it.Quantity
+			continue
+		}
+		index[it.OrderItemID] = len(merged)
+		merged = append(merged, it)
+	}
+	return merged
+}
+
+// restock mengembalikan stok ke varian jika item merujuk varian, jika tidak ke produk.
+// Item yang produk atau variannya sudah dihapus (variant_sku terisi, variant_id NULL) dilewati.
+func restock(ctx context.Context, repo Repository, oi dbgen.OrderItem, qty int32) error {
+	if !oi.ProductID.Valid || (oi.VariantSku.Valid && !oi.VariantID.Valid) {
+		return nil
+	}
+	if oi.VariantID.Valid {
+		return repo.IncrementVariantStock(ctx, dbgen.IncrementProductVariantStockParams{
+			StockQuantity: qty,
+			ID:            oi.VariantID.String,
+			ProductID:     oi.ProductID.String,
+		})
+	}
+
+	return repo.IncrementProductStock(ctx, dbgen.IncrementProductStockParams{
+		StockQuantity: qty,
+		ID:            oi.ProductID.String,
+	})
+}
+
+func mapToResponse(r dbgen.Return) ReturnResponse {
+	return ReturnResponse{
+		ID:           r.ID,
+		OrderID:      r.OrderID,
+		Status:       r.Status,
+		Reason:       helper.NullStringToPtr(r.Reason),
+		RefundAmount: helper.DecimalToFloat64(r.RefundAmount),
+		RefundedAt:   helper.NullTimeToPtr(r.RefundedAt),
+		CreatedAt:    r.CreatedAt,
+		Items:        []ReturnItemResponse{},
+	}
+}
+
+func mapItemToResponse(r dbgen.ReturnItem) ReturnItemResponse {
+	return ReturnItemResponse{
+		ID:           r.ID,
+		OrderItemID:  r.OrderItemID,
+		Quantity:     int(r.Quantity),
+		RefundAmount: helper.DecimalToFloat64(r.RefundAmount),
+	}
+}
@@ -0,0 +1,253 @@
+package returns_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/returns"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockReturns "assignment-ptes-achmad-rifai/internal/returns/mocks"
+)
+
+func setupServiceTest(t *testing.T) (returns.Service, *mockReturns.MockRepository, sqlmock.Sqlmock) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockReturns.NewMockRepository(ctrl)
+
+	return returns.NewService(db, repo), repo, mock
+}
+
+func orderItems() []dbgen.OrderItem {
+	return []dbgen.OrderItem{
+		// 4 x 25000 dengan diskon kupon 10000
+		{ID: "oi-1", OrderID: "order-1", ProductID: sql.NullString{String: "p1", Valid: true}, Quantity: 4, LineTotal: decimal.NewFromInt(90000)},
+		{ID: "oi-2", OrderID: "order-1", ProductID: sql.NullString{String: "p2", Valid: true}, VariantID: sql.NullString{String: "v1", Valid: true}, Quantity: 2, ReturnedQuantity: 1, LineTotal: decimal.NewFromInt(50000)},
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_restocks_and_refunds_paid_order", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "order-1").Return(dbgen.GetOrderForReturnRow{ID: "order-1", PaymentStatus: "paid"}, nil)
+		repo.EXPECT().GetOrderItems(gomock.Any(), "order-1").Return(orderItems(), nil)
+
+		repo.EXPECT().
+			IncrementReturnedQuantity(gomock.Any(), dbgen.IncrementOrderItemReturnedQuantityParams{Quantity: 2, ID: "oi-1", OrderID: "order-1"}).
+			Return(int64(1), nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), dbgen.IncrementProductStockParams{StockQuantity: 2, ID: "p1"}).Return(nil)
+		repo.EXPECT().
+			IncrementReturnedQuantity(gomock.Any(), dbgen.IncrementOrderItemReturnedQuantityParams{Quantity: 1, ID: "oi-2", OrderID: "order-1"}).
+			Return(int64(1), nil)
+		repo.EXPECT().
+			IncrementVariantStock(gomock.Any(), dbgen.IncrementProductVariantStockParams{StockQuantity: 1, ID: "v1", ProductID: "p2"}).
+			Return(nil)
+
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateReturnParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateReturnParams) error {
+				assert.Equal(t, returns.StatusRefundPending, p.Status)
+				assert.Equal(t, "70000", p.RefundAmount.String())
+				return nil
+			})
+		repo.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		repo.EXPECT().
+			AddOrderRefundTotal(gomock.Any(), gomock.AssignableToTypeOf(dbgen.AddOrderRefundTotalParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.AddOrderRefundTotalParams) error {
+				assert.Equal(t, "order-1", p.ID)
+				assert.Equal(t, "70000", p.RefundTotal.String())
+				return nil
+			})
+
+		// oi-1 diminta dalam dua baris, digabung menjadi 2 unit
+		res, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-1", Quantity: 1},
+			{OrderItemID: "oi-2", Quantity: 1},
+			{OrderItemID: "oi-1", Quantity: 1},
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(70000), res.RefundAmount)
+		assert.Len(t, res.Items, 2)
+		assert.Equal(t, float64(45000), res.Items[0].RefundAmount)
+		assert.Equal(t, float64(25000), res.Items[1].RefundAmount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unpaid_order_completes_without_refund_due", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "order-1").Return(dbgen.GetOrderForReturnRow{ID: "order-1", PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().GetOrderItems(gomock.Any(), "order-1").Return(orderItems(), nil)
+		repo.EXPECT().IncrementReturnedQuantity(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().IncrementProductStock(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateReturnParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateReturnParams) error {
+				assert.Equal(t, returns.StatusCompleted, p.Status)
+				return nil
+			})
+		repo.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().AddOrderRefundTotal(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-1", Quantity: 4},
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, returns.StatusCompleted, res.Status)
+		assert.Equal(t, float64(90000), res.RefundAmount)
+	})
+
+	t.Run("deleted_product_is_not_restocked", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		items := orderItems()
+		items[0].ProductID = sql.NullString{}
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "order-1").Return(dbgen.GetOrderForReturnRow{ID: "order-1", PaymentStatus: "unpaid"}, nil)
+		repo.EXPECT().GetOrderItems(gomock.Any(), "order-1").Return(items, nil)
+		repo.EXPECT().IncrementReturnedQuantity(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().AddOrderRefundTotal(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-1", Quantity: 1},
+		}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error_quantity_exceeded", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "order-1").Return(dbgen.GetOrderForReturnRow{ID: "order-1"}, nil)
+		repo.EXPECT().GetOrderItems(gomock.Any(), "order-1").Return(orderItems(), nil)
+		repo.EXPECT().IncrementReturnedQuantity(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+		_, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-2", Quantity: 2},
+		}})
+
+		assert.ErrorIs(t, err, returns.ErrQuantityExceeded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_item_not_in_order", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "order-1").Return(dbgen.GetOrderForReturnRow{ID: "order-1"}, nil)
+		repo.EXPECT().GetOrderItems(gomock.Any(), "order-1").Return(orderItems(), nil)
+
+		_, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "other", Quantity: 1},
+		}})
+
+		assert.ErrorIs(t, err, returns.ErrOrderItemNotFound)
+	})
+
+	t.Run("error_order_cancelled", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().
+			GetOrderForUpdate(gomock.Any(), "order-1").
+			Return(dbgen.GetOrderForReturnRow{ID: "order-1", Status: "cancelled"}, nil)
+		repo.EXPECT().IncrementReturnedQuantity(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := svc.Create(ctx, "order-1", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-1", Quantity: 1},
+		}})
+
+		assert.ErrorIs(t, err, returns.ErrOrderCancelled)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_order_not_found", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetOrderForUpdate(gomock.Any(), "missing").Return(dbgen.GetOrderForReturnRow{}, sql.ErrNoRows)
+
+		_, err := svc.Create(ctx, "missing", returns.CreateReturnRequest{Items: []returns.ReturnItemRequest{
+			{OrderItemID: "oi-1", Quantity: 1},
+		}})
+
+		assert.ErrorIs(t, err, returns.ErrOrderNotFound)
+	})
+}
+
+func TestService_MarkRefunded(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().MarkRefunded(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().GetByID(gomock.Any(), "ret-1").Return(dbgen.Return{ID: "ret-1", OrderID: "order-1", Status: returns.StatusRefunded}, nil)
+
+		res, err := svc.MarkRefunded(ctx, "order-1", "ret-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, returns.StatusRefunded, res.Status)
+	})
+
+	t.Run("not_awaiting_refund", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().MarkRefunded(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().GetByID(gomock.Any(), "ret-1").Return(dbgen.Return{ID: "ret-1", OrderID: "order-1", Status: returns.StatusCompleted}, nil)
+
+		_, err := svc.MarkRefunded(ctx, "order-1", "ret-1")
+
+		assert.ErrorIs(t, err, returns.ErrReturnNotRefundable)
+	})
+
+	t.Run("belongs_to_other_order", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().MarkRefunded(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().GetByID(gomock.Any(), "ret-1").Return(dbgen.Return{ID: "ret-1", OrderID: "order-2"}, nil)
+
+		_, err := svc.MarkRefunded(ctx, "order-1", "ret-1")
+
+		assert.ErrorIs(t, err, returns.ErrReturnNotFound)
+	})
+}
@@ -8,15 +8,19 @@ package dbgen
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createCategory = `-- name: CreateCategory :exec
 INSERT INTO categories (
     id,
     name,
-    description
+    description,
+    is_active,
+    created_at,
+    updated_at
 ) VALUES (
-    ?, ?, ?
+    ?, ?, ?, ?, ?, ?
 )
 `
 
@@ -24,10 +28,20 @@ type CreateCategoryParams struct {
 	ID          string         `json:"id"`
 	Name        string         `json:"name"`
 	Description sql.NullString `json:"description"`
+	IsActive    bool           `json:"is_active"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) error {
-	_, err := q.exec(ctx, q.createCategoryStmt, createCategory, arg.ID, arg.Name, arg.Description)
+	_, err := q.exec(ctx, q.createCategoryStmt, createCategory,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.IsActive,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
 	return err
 }
 
@@ -43,11 +57,18 @@ func (q *Queries) DeleteCategory(ctx context.Context, id string) error {
 
 const getCategories = `-- name: GetCategories :many
 SELECT
-    id,
-    name,
-    description
-FROM categories
-ORDER BY name ASC
+    c.id,
+    c.name,
+    c.description,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    COUNT(p.id) AS product_count,
+    CAST(IFNULL(SUM(p.is_active), 0) AS SIGNED) AS active_product_count
+FROM categories c
+LEFT JOIN products p ON p.category_id = c.id
+GROUP BY c.id
+ORDER BY c.name ASC
 LIMIT
     ?
 OFFSET
@@ -60,9 +81,14 @@ type GetCategoriesParams struct {
 }
 
 type GetCategoriesRow struct {
-	ID          string         `json:"id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
+	ID                 string         `json:"id"`
+	Name               string         `json:"name"`
+	Description        sql.NullString `json:"description"`
+	IsActive           bool           `json:"is_active"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	ProductCount       int64          `json:"product_count"`
+	ActiveProductCount int64          `json:"active_product_count"`
 }
 
 func (q *Queries) GetCategories(ctx context.Context, arg GetCategoriesParams) ([]GetCategoriesRow, error) {
@@ -74,7 +100,16 @@ func (q *Queries) GetCategories(ctx context.Context, arg GetCategoriesParams) ([
 	var items []GetCategoriesRow
 	for rows.Next() {
 		var i GetCategoriesRow
-		if err := rows.Scan(&i.ID, &i.Name, &i.Description); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProductCount,
+			&i.ActiveProductCount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -90,42 +125,106 @@ func (q *Queries) GetCategories(ctx context.Context, arg GetCategoriesParams) ([
 
 const getCategoryByID = `-- name: GetCategoryByID :one
 SELECT
-    id,
-    name,
-    description
-FROM categories
-WHERE id = ?
+    c.id,
+    c.name,
+    c.description,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    COUNT(p.id) AS product_count,
+    CAST(IFNULL(SUM(p.is_active), 0) AS SIGNED) AS active_product_count
+FROM categories c
+LEFT JOIN products p ON p.category_id = c.id
+WHERE c.id = ?
+GROUP BY c.id
 LIMIT 1
 `
 
 type GetCategoryByIDRow struct {
-	ID          string         `json:"id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
+	ID                 string         `json:"id"`
+	Name               string         `json:"name"`
+	Description        sql.NullString `json:"description"`
+	IsActive           bool           `json:"is_active"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	ProductCount       int64          `json:"product_count"`
+	ActiveProductCount int64          `json:"active_product_count"`
 }
 
 func (q *Queries) GetCategoryByID(ctx context.Context, id string) (GetCategoryByIDRow, error) {
 	row := q.queryRow(ctx, q.getCategoryByIDStmt, getCategoryByID, id)
 	var i GetCategoryByIDRow
-	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProductCount,
+		&i.ActiveProductCount,
+	)
 	return i, err
 }
 
+const listCategoryNames = `-- name: ListCategoryNames :many
+SELECT
+    id,
+    name
+FROM
+    categories
+`
+
+type ListCategoryNamesRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListCategoryNames(ctx context.Context) ([]ListCategoryNamesRow, error) {
+	rows, err := q.query(ctx, q.listCategoryNamesStmt, listCategoryNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryNamesRow
+	for rows.Next() {
+		var i ListCategoryNamesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateCategory = `-- name: UpdateCategory :exec
 UPDATE categories
 SET
     name = ?,
-    description = ?
+    description = ?,
+    is_active = ?
 WHERE id = ?
 `
 
 type UpdateCategoryParams struct {
 	Name        string         `json:"name"`
 	Description sql.NullString `json:"description"`
+	IsActive    bool           `json:"is_active"`
 	ID          string         `json:"id"`
 }
 
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) error {
-	_, err := q.exec(ctx, q.updateCategoryStmt, updateCategory, arg.Name, arg.Description, arg.ID)
+	_, err := q.exec(ctx, q.updateCategoryStmt, updateCategory,
+		arg.Name,
+		arg.Description,
+		arg.IsActive,
+		arg.ID,
+	)
 	return err
 }
@@ -7,11 +7,114 @@ package dbgen
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
+const anonymiseCustomer = `-- name: AnonymiseCustomer :execrows
+UPDATE customers
+SET
+    name = ?,
+    email = ?,
+    password_hash = NULL,
+    email_verified_at = NULL,
+    anonymised_at = ?
+WHERE
+    id = ?
+    AND anonymised_at IS NULL
+`
+
+type AnonymiseCustomerParams struct {
+	Name         string       `json:"name"`
+	Email        string       `json:"email"`
+	AnonymisedAt sql.NullTime `json:"anonymised_at"`
+	ID           string       `json:"id"`
+}
+
+// Menghapus data pribadi customer; 0 baris berarti customer sudah dianonimkan sebelumnya
+func (q *Queries) AnonymiseCustomer(ctx context.Context, arg AnonymiseCustomerParams) (int64, error) {
+	result, err := q.exec(ctx, q.anonymiseCustomerStmt, anonymiseCustomer,
+		arg.Name,
+		arg.Email,
+		arg.AnonymisedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countCustomers = `-- name: CountCustomers :one
+SELECT
+    COUNT(*) AS total
+FROM
+    customers c
+    LEFT JOIN (
+        SELECT
+            customer_id,
+            COUNT(*) AS order_count,
+            SUM(total_price - refund_total) AS total_spent
+        FROM
+            orders
+        WHERE
+            status <> 'cancelled'
+        GROUP BY
+            customer_id
+    ) s ON s.customer_id = c.id
+WHERE
+    (
+        ? = ''
+        OR c.name LIKE CONCAT (?, '%')
+        OR c.email LIKE CONCAT (?, '%')
+    )
+    AND (
+        ? IS NULL
+        OR c.created_at >= ?
+    )
+    AND (
+        ? IS NULL
+        OR c.created_at < ?
+    )
+    AND (
+        ? IS NULL
+        OR IFNULL (s.total_spent, 0) >= ?
+    )
+    AND (
+        ? IS NULL
+        OR IFNULL (s.total_spent, 0) <= ?
+    )
+`
+
+type CountCustomersParams struct {
+	Search      string              `json:"search"`
+	CreatedFrom sql.NullTime        `json:"created_from"`
+	CreatedTo   sql.NullTime        `json:"created_to"`
+	MinSpent    decimal.NullDecimal `json:"min_spent"`
+	MaxSpent    decimal.NullDecimal `json:"max_spent"`
+}
+
+func (q *Queries) CountCustomers(ctx context.Context, arg CountCustomersParams) (int64, error) {
+	row := q.queryRow(ctx, q.countCustomersStmt, countCustomers,
+		arg.Search,
+		arg.Search,
+		arg.Search,
+		arg.CreatedFrom,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CreatedTo,
+		arg.MinSpent,
+		arg.MinSpent,
+		arg.MaxSpent,
+		arg.MaxSpent,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createCustomer = `-- name: CreateCustomer :exec
 INSERT INTO
     customers (id, name, email, created_at)
@@ -36,6 +139,51 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 	return err
 }
 
+const createCustomerAccount = `-- name: CreateCustomerAccount :exec
+INSERT INTO
+    customers (id, name, email, password_hash, created_at)
+VALUES
+    (?, ?, ?, ?, ?)
+`
+
+type CreateCustomerAccountParams struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Email        string         `json:"email"`
+	PasswordHash sql.NullString `json:"password_hash"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+func (q *Queries) CreateCustomerAccount(ctx context.Context, arg CreateCustomerAccountParams) error {
+	_, err := q.exec(ctx, q.createCustomerAccountStmt, createCustomerAccount,
+		arg.ID,
+		arg.Name,
+		arg.Email,
+		arg.PasswordHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const customerExists = `-- name: CustomerExists :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            customers
+        WHERE
+            id = ?
+    ) AS customer_exists
+`
+
+func (q *Queries) CustomerExists(ctx context.Context, id string) (bool, error) {
+	row := q.queryRow(ctx, q.customerExistsStmt, customerExists, id)
+	var customer_exists bool
+	err := row.Scan(&customer_exists)
+	return customer_exists, err
+}
+
 const deleteCustomer = `-- name: DeleteCustomer :exec
 DELETE FROM customers
 WHERE
@@ -47,11 +195,49 @@ func (q *Queries) DeleteCustomer(ctx context.Context, id string) error {
 	return err
 }
 
+const getCustomerAccountByID = `-- name: GetCustomerAccountByID :one
+SELECT
+    id,
+    name,
+    email,
+    email_verified_at,
+    created_at
+FROM
+    customers
+WHERE
+    id = ?
+LIMIT
+    1
+`
+
+type GetCustomerAccountByIDRow struct {
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	Email           string       `json:"email"`
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+func (q *Queries) GetCustomerAccountByID(ctx context.Context, id string) (GetCustomerAccountByIDRow, error) {
+	row := q.queryRow(ctx, q.getCustomerAccountByIDStmt, getCustomerAccountByID, id)
+	var i GetCustomerAccountByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getCustomerByID = `-- name: GetCustomerByID :one
 SELECT
     id,
     name,
     email,
+    email_verified_at,
+    anonymised_at,
     created_at
 FROM
     customers
@@ -62,10 +248,12 @@ LIMIT
 `
 
 type GetCustomerByIDRow struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	Email           string       `json:"email"`
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	AnonymisedAt    sql.NullTime `json:"anonymised_at"`
+	CreatedAt       time.Time    `json:"created_at"`
 }
 
 func (q *Queries) GetCustomerByID(ctx context.Context, id string) (GetCustomerByIDRow, error) {
@@ -75,21 +263,246 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id string) (GetCustomerBy
 		&i.ID,
 		&i.Name,
 		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.AnonymisedAt,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const getCustomers = `-- name: GetCustomers :many
+const getCustomerCredentialsByEmail = `-- name: GetCustomerCredentialsByEmail :one
 SELECT
     id,
     name,
     email,
+    password_hash,
+    email_verified_at,
     created_at
 FROM
     customers
+WHERE
+    email = ?
+LIMIT
+    1
+`
+
+type GetCustomerCredentialsByEmailRow struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Email           string         `json:"email"`
+	PasswordHash    sql.NullString `json:"password_hash"`
+	EmailVerifiedAt sql.NullTime   `json:"email_verified_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+func (q *Queries) GetCustomerCredentialsByEmail(ctx context.Context, email string) (GetCustomerCredentialsByEmailRow, error) {
+	row := q.queryRow(ctx, q.getCustomerCredentialsByEmailStmt, getCustomerCredentialsByEmail, email)
+	var i GetCustomerCredentialsByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCustomerFavouriteCategories = `-- name: GetCustomerFavouriteCategories :many
+SELECT
+    oi.category_name,
+    CAST(SUM(oi.quantity - oi.returned_quantity) AS SIGNED) AS total_quantity,
+    CAST(SUM(oi.line_total - IFNULL(ri.refunded, 0)) AS DECIMAL(15, 2)) AS total_spent,
+    COUNT(DISTINCT o.id) AS order_count
+FROM
+    orders o
+    JOIN order_items oi ON oi.order_id = o.id
+    LEFT JOIN (
+        SELECT
+            order_item_id,
+            SUM(refund_amount) AS refunded
+        FROM
+            return_items
+        GROUP BY
+            order_item_id
+    ) ri ON ri.order_item_id = oi.id
+WHERE
+    o.customer_id = ?
+    AND o.status <> 'cancelled'
+GROUP BY
+    oi.category_name
+HAVING
+    total_quantity > 0
+ORDER BY
+    total_quantity DESC,
+    total_spent DESC,
+    oi.category_name ASC
+LIMIT
+    ?
+`
+
+type GetCustomerFavouriteCategoriesParams struct {
+	CustomerID string `json:"customer_id"`
+	Limit      int32  `json:"limit"`
+}
+
+type GetCustomerFavouriteCategoriesRow struct {
+	CategoryName  string          `json:"category_name"`
+	TotalQuantity int64           `json:"total_quantity"`
+	TotalSpent    decimal.Decimal `json:"total_spent"`
+	OrderCount    int64           `json:"order_count"`
+}
+
+// Kategori favorit dari snapshot category_name di order_items, diurutkan dari jumlah item bersih (setelah retur);
+// total_spent sudah dikurangi refund retur per baris
+func (q *Queries) GetCustomerFavouriteCategories(ctx context.Context, arg GetCustomerFavouriteCategoriesParams) ([]GetCustomerFavouriteCategoriesRow, error) {
+	rows, err := q.query(ctx, q.getCustomerFavouriteCategoriesStmt, getCustomerFavouriteCategories, arg.CustomerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCustomerFavouriteCategoriesRow
+	for rows.Next() {
+		var i GetCustomerFavouriteCategoriesRow
+		if err := rows.Scan(
+			&i.CategoryName,
+			&i.TotalQuantity,
+			&i.TotalSpent,
+			&i.OrderCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerIDByEmail = `-- name: GetCustomerIDByEmail :one
+SELECT
+    id
+FROM
+    customers
+WHERE
+    email = ?
+LIMIT
+    1
+`
+
+func (q *Queries) GetCustomerIDByEmail(ctx context.Context, email string) (string, error) {
+	row := q.queryRow(ctx, q.getCustomerIDByEmailStmt, getCustomerIDByEmail, email)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getCustomerOrderStats = `-- name: GetCustomerOrderStats :one
+SELECT
+    COUNT(*) AS order_count,
+    CAST(IFNULL (SUM(total_price - refund_total), 0) AS DECIMAL(15, 2)) AS lifetime_spend,
+    MIN(created_at) AS first_order_at,
+    MAX(created_at) AS last_order_at
+FROM
+    orders
+WHERE
+    customer_id = ?
+    AND status <> 'cancelled'
+`
+
+type GetCustomerOrderStatsRow struct {
+	OrderCount    int64           `json:"order_count"`
+	LifetimeSpend decimal.Decimal `json:"lifetime_spend"`
+	FirstOrderAt  sql.NullTime    `json:"first_order_at"`
+	LastOrderAt   sql.NullTime    `json:"last_order_at"`
+}
+
+// Order yang dibatalkan tidak dihitung sebagai pembelian
+func (q *Queries) GetCustomerOrderStats(ctx context.Context, customerID string) (GetCustomerOrderStatsRow, error) {
+	row := q.queryRow(ctx, q.getCustomerOrderStatsStmt, getCustomerOrderStats, customerID)
+	var i GetCustomerOrderStatsRow
+	err := row.Scan(
+		&i.OrderCount,
+		&i.LifetimeSpend,
+		&i.FirstOrderAt,
+		&i.LastOrderAt,
+	)
+	return i, err
+}
+
+const getCustomers = `-- name: GetCustomers :many
+SELECT
+    c.id,
+    c.name,
+    c.email,
+    c.created_at,
+    CAST(IFNULL (s.order_count, 0) AS SIGNED) AS order_count,
+    CAST(IFNULL (s.total_spent, 0) AS DECIMAL(15, 2)) AS total_spent
+FROM
+    customers c
+    LEFT JOIN (
+        SELECT
+            customer_id,
+            COUNT(*) AS order_count,
+            SUM(total_price - refund_total) AS total_spent
+        FROM
+            orders
+        WHERE
+            status <> 'cancelled'
+        GROUP BY
+            customer_id
+    ) s ON s.customer_id = c.id
+WHERE
+    (
+        ? = ''
+        OR c.name LIKE CONCAT (?, '%')
+        OR c.email LIKE CONCAT (?, '%')
+    )
+    AND (
+        ? IS NULL
+        OR c.created_at >= ?
+    )
+    AND (
+        ? IS NULL
+        OR c.created_at < ?
+    )
+    AND (
+        ? IS NULL
+        OR IFNULL (s.total_spent, 0) >= ?
+    )
+    AND (
+        ? IS NULL
+        OR IFNULL (s.total_spent, 0) <= ?
+    )
 ORDER BY
-    created_at DESC
+    CASE
+        WHEN ? = 'name_asc' THEN c.name
+    END ASC,
+    CASE
+        WHEN ? = 'name_desc' THEN c.name
+    END DESC,
+    CASE
+        WHEN ? = 'created_asc' THEN c.created_at
+    END ASC,
+    CASE
+        WHEN ? = 'total_spent_asc' THEN IFNULL (s.total_spent, 0)
+    END ASC,
+    CASE
+        WHEN ? = 'total_spent_desc' THEN IFNULL (s.total_spent, 0)
+    END DESC,
+    CASE
+        WHEN ? = 'order_count_asc' THEN IFNULL (s.order_count, 0)
+    END ASC,
+    CASE
+        WHEN ? = 'order_count_desc' THEN IFNULL (s.order_count, 0)
+    END DESC,
+    c.created_at DESC,
+    c.id DESC
 LIMIT
     ?
 OFFSET
@@ -97,19 +510,49 @@ OFFSET
 `
 
 type GetCustomersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Search      string              `json:"search"`
+	CreatedFrom sql.NullTime        `json:"created_from"`
+	CreatedTo   sql.NullTime        `json:"created_to"`
+	MinSpent    decimal.NullDecimal `json:"min_spent"`
+	MaxSpent    decimal.NullDecimal `json:"max_spent"`
+	OrderBy     interface{}         `json:"order_by"`
+	Limit       int32               `json:"limit"`
+	Offset      int32               `json:"offset"`
 }
 
 type GetCustomersRow struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Email      string          `json:"email"`
+	CreatedAt  time.Time       `json:"created_at"`
+	OrderCount int64           `json:"order_count"`
+	TotalSpent decimal.Decimal `json:"total_spent"`
 }
 
+// total_spent dihitung sama seperti ringkasan customer: total_price dikurangi refund retur, tanpa order yang dibatalkan
 func (q *Queries) GetCustomers(ctx context.Context, arg GetCustomersParams) ([]GetCustomersRow, error) {
-	rows, err := q.query(ctx, q.getCustomersStmt, getCustomers, arg.Limit, arg.Offset)
+	rows, err := q.query(ctx, q.getCustomersStmt, getCustomers,
+		arg.Search,
+		arg.Search,
+		arg.Search,
+		arg.CreatedFrom,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CreatedTo,
+		arg.MinSpent,
+		arg.MinSpent,
+		arg.MaxSpent,
+		arg.MaxSpent,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.OrderBy,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +565,8 @@ func (q *Queries) GetCustomers(ctx context.Context, arg GetCustomersParams) ([]G
 			&i.Name,
 			&i.Email,
 			&i.CreatedAt,
+			&i.OrderCount,
+			&i.TotalSpent,
 		); err != nil {
 			return nil, err
 		}
@@ -141,11 +586,13 @@ SELECT
     c.id,
     c.name,
     c.email,
-    CAST(SUM(o.total_price) AS DECIMAL(10, 2)) as total_spent,
+    CAST(SUM(o.total_price - o.refund_total) AS DECIMAL(10, 2)) as total_spent,
     COUNT(o.id) as total_orders
 FROM
     customers c
     JOIN orders o ON c.id = o.customer_id
+WHERE
+    o.status <> 'cancelled'
 GROUP BY
     c.id
 ORDER BY
@@ -191,6 +638,43 @@ func (q *Queries) GetTopCustomers(ctx context.Context, limit int32) ([]GetTopCus
 	return items, nil
 }
 
+const lockCustomer = `-- name: LockCustomer :one
+SELECT
+    id
+FROM
+    customers
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE
+`
+
+// Mengunci baris customer agar perubahan alamat default untuk satu customer berjalan berurutan
+func (q *Queries) LockCustomer(ctx context.Context, id string) (string, error) {
+	row := q.queryRow(ctx, q.lockCustomerStmt, lockCustomer, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const markCustomerEmailVerified = `-- name: MarkCustomerEmailVerified :exec
+UPDATE customers
+SET
+    email_verified_at = ?
+WHERE
+    id = ?
+    AND email_verified_at IS NULL
+`
+
+type MarkCustomerEmailVerifiedParams struct {
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	ID              string       `json:"id"`
+}
+
+func (q *Queries) MarkCustomerEmailVerified(ctx context.Context, arg MarkCustomerEmailVerifiedParams) error {
+	_, err := q.exec(ctx, q.markCustomerEmailVerifiedStmt, markCustomerEmailVerified, arg.EmailVerifiedAt, arg.ID)
+	return err
+}
+
 const updateCustomer = `-- name: UpdateCustomer :exec
 UPDATE customers
 SET
@@ -210,3 +694,48 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 	_, err := q.exec(ctx, q.updateCustomerStmt, updateCustomer, arg.Name, arg.Email, arg.ID)
 	return err
 }
+
+const updateCustomerPassword = `-- name: UpdateCustomerPassword :exec
+UPDATE customers
+SET
+    password_hash = ?
+WHERE
+    id = ?
+`
+
+type UpdateCustomerPasswordParams struct {
+	PasswordHash sql.NullString `json:"password_hash"`
+	ID           string         `json:"id"`
+}
+
+func (q *Queries) UpdateCustomerPassword(ctx context.Context, arg UpdateCustomerPasswordParams) error {
+	_, err := q.exec(ctx, q.updateCustomerPasswordStmt, updateCustomerPassword, arg.PasswordHash, arg.ID)
+	return err
+}
+
+const updateCustomerProfile = `-- name: UpdateCustomerProfile :exec
+UPDATE customers
+SET
+    email_verified_at = IF(email = ?, email_verified_at, NULL),
+    name = ?,
+    email = ?
+WHERE
+    id = ?
+`
+
+type UpdateCustomerProfileParams struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	ID    string `json:"id"`
+}
+
+// Mengganti email membatalkan status verifikasi; email_verified_at dievaluasi sebelum email diubah
+func (q *Queries) UpdateCustomerProfile(ctx context.Context, arg UpdateCustomerProfileParams) error {
+	_, err := q.exec(ctx, q.updateCustomerProfileStmt, updateCustomerProfile,
+		arg.Email,
+		arg.Name,
+		arg.Email,
+		arg.ID,
+	)
+	return err
+}
@@ -80,3 +80,35 @@ func (q *Queries) GetRecentProducts(ctx context.Context, limit int32) ([]GetRece
 	}
 	return items, nil
 }
+
+const getRevenueReport = `-- name: GetRevenueReport :one
+SELECT
+    COUNT(*) AS total_orders,
+    CAST(IFNULL(SUM(total_price), 0) AS DECIMAL(15, 2)) AS gross_revenue,
+    CAST(IFNULL(SUM(refund_total), 0) AS DECIMAL(15, 2)) AS total_refunds,
+    CAST(IFNULL(SUM(total_price - refund_total), 0) AS DECIMAL(15, 2)) AS net_revenue
+FROM
+    orders
+WHERE
+    status <> 'cancelled'
+`
+
+type GetRevenueReportRow struct {
+	TotalOrders  int64           `json:"total_orders"`
+	GrossRevenue decimal.Decimal `json:"gross_revenue"`
+	TotalRefunds decimal.Decimal `json:"total_refunds"`
+	NetRevenue   decimal.Decimal `json:"net_revenue"`
+}
+
+// Order yang dibatalkan tidak pernah menjadi pendapatan
+func (q *Queries) GetRevenueReport(ctx context.Context) (GetRevenueReportRow, error) {
+	row := q.queryRow(ctx, q.getRevenueReportStmt, getRevenueReport)
+	var i GetRevenueReportRow
+	err := row.Scan(
+		&i.TotalOrders,
+		&i.GrossRevenue,
+		&i.TotalRefunds,
+		&i.NetRevenue,
+	)
+	return i, err
+}
@@ -24,91 +24,705 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addOrderRefundTotalStmt, err = db.PrepareContext(ctx, addOrderRefundTotal); err != nil {
+		return nil, fmt.Errorf("error preparing query AddOrderRefundTotal: %w", err)
+	}
+	if q.anonymiseCustomerStmt, err = db.PrepareContext(ctx, anonymiseCustomer); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymiseCustomer: %w", err)
+	}
+	if q.anonymiseOrderShippingAddressesStmt, err = db.PrepareContext(ctx, anonymiseOrderShippingAddresses); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymiseOrderShippingAddresses: %w", err)
+	}
+	if q.anonymiseOutboxPayloadsStmt, err = db.PrepareContext(ctx, anonymiseOutboxPayloads); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymiseOutboxPayloads: %w", err)
+	}
+	if q.anonymiseWebhookDeliveryPayloadsStmt, err = db.PrepareContext(ctx, anonymiseWebhookDeliveryPayloads); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymiseWebhookDeliveryPayloads: %w", err)
+	}
+	if q.buryJobStmt, err = db.PrepareContext(ctx, buryJob); err != nil {
+		return nil, fmt.Errorf("error preparing query BuryJob: %w", err)
+	}
+	if q.cancelPendingPaymentIntentsStmt, err = db.PrepareContext(ctx, cancelPendingPaymentIntents); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelPendingPaymentIntents: %w", err)
+	}
+	if q.cancelPriceScheduleStmt, err = db.PrepareContext(ctx, cancelPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelPriceSchedule: %w", err)
+	}
+	if q.claimWebhookDeliveryStmt, err = db.PrepareContext(ctx, claimWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimWebhookDelivery: %w", err)
+	}
+	if q.clearDefaultCustomerAddressStmt, err = db.PrepareContext(ctx, clearDefaultCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearDefaultCustomerAddress: %w", err)
+	}
+	if q.clearOrderCouponStmt, err = db.PrepareContext(ctx, clearOrderCoupon); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearOrderCoupon: %w", err)
+	}
+	if q.clearPrimaryProductImageStmt, err = db.PrepareContext(ctx, clearPrimaryProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearPrimaryProductImage: %w", err)
+	}
+	if q.completeJobStmt, err = db.PrepareContext(ctx, completeJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteJob: %w", err)
+	}
+	if q.countCustomerAddressesStmt, err = db.PrepareContext(ctx, countCustomerAddresses); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCustomerAddresses: %w", err)
+	}
+	if q.countCustomerPromotionRedemptionsStmt, err = db.PrepareContext(ctx, countCustomerPromotionRedemptions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCustomerPromotionRedemptions: %w", err)
+	}
+	if q.countCustomersStmt, err = db.PrepareContext(ctx, countCustomers); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCustomers: %w", err)
+	}
+	if q.countOrdersStmt, err = db.PrepareContext(ctx, countOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query CountOrders: %w", err)
+	}
+	if q.countOtherDefaultTaxRulesStmt, err = db.PrepareContext(ctx, countOtherDefaultTaxRules); err != nil {
+		return nil, fmt.Errorf("error preparing query CountOtherDefaultTaxRules: %w", err)
+	}
+	if q.countOverlappingPriceSchedulesStmt, err = db.PrepareContext(ctx, countOverlappingPriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query CountOverlappingPriceSchedules: %w", err)
+	}
+	if q.countProductPriceHistoryStmt, err = db.PrepareContext(ctx, countProductPriceHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CountProductPriceHistory: %w", err)
+	}
 	if q.countProductsStmt, err = db.PrepareContext(ctx, countProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query CountProducts: %w", err)
 	}
+	if q.countPromotionsStmt, err = db.PrepareContext(ctx, countPromotions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountPromotions: %w", err)
+	}
+	if q.countWebhookDeliveriesStmt, err = db.PrepareContext(ctx, countWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query CountWebhookDeliveries: %w", err)
+	}
 	if q.createCategoryStmt, err = db.PrepareContext(ctx, createCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCategory: %w", err)
 	}
 	if q.createCustomerStmt, err = db.PrepareContext(ctx, createCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCustomer: %w", err)
 	}
+	if q.createCustomerAccountStmt, err = db.PrepareContext(ctx, createCustomerAccount); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerAccount: %w", err)
+	}
+	if q.createCustomerAddressStmt, err = db.PrepareContext(ctx, createCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerAddress: %w", err)
+	}
+	if q.createCustomerTokenStmt, err = db.PrepareContext(ctx, createCustomerToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerToken: %w", err)
+	}
+	if q.createJobStmt, err = db.PrepareContext(ctx, createJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateJob: %w", err)
+	}
 	if q.createOrderStmt, err = db.PrepareContext(ctx, createOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrder: %w", err)
 	}
 	if q.createOrderItemStmt, err = db.PrepareContext(ctx, createOrderItem); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrderItem: %w", err)
 	}
+	if q.createOrderRevisionStmt, err = db.PrepareContext(ctx, createOrderRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOrderRevision: %w", err)
+	}
+	if q.createOrderShipmentStmt, err = db.PrepareContext(ctx, createOrderShipment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOrderShipment: %w", err)
+	}
+	if q.createOrderShippingAddressStmt, err = db.PrepareContext(ctx, createOrderShippingAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOrderShippingAddress: %w", err)
+	}
+	if q.createOutboxEventStmt, err = db.PrepareContext(ctx, createOutboxEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOutboxEvent: %w", err)
+	}
+	if q.createPaymentIntentStmt, err = db.PrepareContext(ctx, createPaymentIntent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePaymentIntent: %w", err)
+	}
+	if q.createPaymentWebhookEventStmt, err = db.PrepareContext(ctx, createPaymentWebhookEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePaymentWebhookEvent: %w", err)
+	}
 	if q.createProductStmt, err = db.PrepareContext(ctx, createProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProduct: %w", err)
 	}
+	if q.createProductImageStmt, err = db.PrepareContext(ctx, createProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductImage: %w", err)
+	}
+	if q.createProductPriceHistoryStmt, err = db.PrepareContext(ctx, createProductPriceHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductPriceHistory: %w", err)
+	}
+	if q.createProductPriceScheduleStmt, err = db.PrepareContext(ctx, createProductPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductPriceSchedule: %w", err)
+	}
+	if q.createProductVariantStmt, err = db.PrepareContext(ctx, createProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductVariant: %w", err)
+	}
+	if q.createPromotionStmt, err = db.PrepareContext(ctx, createPromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePromotion: %w", err)
+	}
+	if q.createPromotionRedemptionStmt, err = db.PrepareContext(ctx, createPromotionRedemption); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePromotionRedemption: %w", err)
+	}
+	if q.createReturnStmt, err = db.PrepareContext(ctx, createReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReturn: %w", err)
+	}
+	if q.createReturnItemStmt, err = db.PrepareContext(ctx, createReturnItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReturnItem: %w", err)
+	}
+	if q.createShippingMethodStmt, err = db.PrepareContext(ctx, createShippingMethod); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateShippingMethod: %w", err)
+	}
+	if q.createTaxRuleStmt, err = db.PrepareContext(ctx, createTaxRule); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTaxRule: %w", err)
+	}
+	if q.createWebhookDeliveryStmt, err = db.PrepareContext(ctx, createWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookDelivery: %w", err)
+	}
+	if q.createWebhookDeliveryAttemptStmt, err = db.PrepareContext(ctx, createWebhookDeliveryAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookDeliveryAttempt: %w", err)
+	}
+	if q.createWebhookEndpointStmt, err = db.PrepareContext(ctx, createWebhookEndpoint); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookEndpoint: %w", err)
+	}
+	if q.customerExistsStmt, err = db.PrepareContext(ctx, customerExists); err != nil {
+		return nil, fmt.Errorf("error preparing query CustomerExists: %w", err)
+	}
+	if q.deactivatePromotionStmt, err = db.PrepareContext(ctx, deactivatePromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query DeactivatePromotion: %w", err)
+	}
+	if q.decrementProductStockStmt, err = db.PrepareContext(ctx, decrementProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementProductStock: %w", err)
+	}
+	if q.decrementProductVariantStockStmt, err = db.PrepareContext(ctx, decrementProductVariantStock); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementProductVariantStock: %w", err)
+	}
+	if q.decrementPromotionUsageStmt, err = db.PrepareContext(ctx, decrementPromotionUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementPromotionUsage: %w", err)
+	}
+	if q.deleteAllCustomerAddressesStmt, err = db.PrepareContext(ctx, deleteAllCustomerAddresses); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAllCustomerAddresses: %w", err)
+	}
+	if q.deleteAllCustomerTokensStmt, err = db.PrepareContext(ctx, deleteAllCustomerTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAllCustomerTokens: %w", err)
+	}
 	if q.deleteCategoryStmt, err = db.PrepareContext(ctx, deleteCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCategory: %w", err)
 	}
+	if q.deleteCompletedJobsBeforeStmt, err = db.PrepareContext(ctx, deleteCompletedJobsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCompletedJobsBefore: %w", err)
+	}
 	if q.deleteCustomerStmt, err = db.PrepareContext(ctx, deleteCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCustomer: %w", err)
 	}
+	if q.deleteCustomerAddressStmt, err = db.PrepareContext(ctx, deleteCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCustomerAddress: %w", err)
+	}
+	if q.deleteCustomerEmailJobsStmt, err = db.PrepareContext(ctx, deleteCustomerEmailJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCustomerEmailJobs: %w", err)
+	}
+	if q.deleteCustomerTokenStmt, err = db.PrepareContext(ctx, deleteCustomerToken); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCustomerToken: %w", err)
+	}
+	if q.deleteCustomerTokensStmt, err = db.PrepareContext(ctx, deleteCustomerTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCustomerTokens: %w", err)
+	}
 	if q.deleteOrderStmt, err = db.PrepareContext(ctx, deleteOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteOrder: %w", err)
 	}
+	if q.deleteOrderItemStmt, err = db.PrepareContext(ctx, deleteOrderItem); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOrderItem: %w", err)
+	}
 	if q.deleteProductStmt, err = db.PrepareContext(ctx, deleteProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteProduct: %w", err)
 	}
+	if q.deleteProductImageStmt, err = db.PrepareContext(ctx, deleteProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductImage: %w", err)
+	}
+	if q.deleteProductVariantStmt, err = db.PrepareContext(ctx, deleteProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductVariant: %w", err)
+	}
+	if q.deletePromotionRedemptionByOrderStmt, err = db.PrepareContext(ctx, deletePromotionRedemptionByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePromotionRedemptionByOrder: %w", err)
+	}
+	if q.deleteShippingMethodStmt, err = db.PrepareContext(ctx, deleteShippingMethod); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteShippingMethod: %w", err)
+	}
+	if q.deleteTaxRuleStmt, err = db.PrepareContext(ctx, deleteTaxRule); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTaxRule: %w", err)
+	}
+	if q.deleteWebhookEndpointStmt, err = db.PrepareContext(ctx, deleteWebhookEndpoint); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWebhookEndpoint: %w", err)
+	}
+	if q.disableFailingWebhookEndpointStmt, err = db.PrepareContext(ctx, disableFailingWebhookEndpoint); err != nil {
+		return nil, fmt.Errorf("error preparing query DisableFailingWebhookEndpoint: %w", err)
+	}
+	if q.flagPaymentIntentForRefundStmt, err = db.PrepareContext(ctx, flagPaymentIntentForRefund); err != nil {
+		return nil, fmt.Errorf("error preparing query FlagPaymentIntentForRefund: %w", err)
+	}
 	if q.getCategoriesStmt, err = db.PrepareContext(ctx, getCategories); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategories: %w", err)
 	}
 	if q.getCategoryByIDStmt, err = db.PrepareContext(ctx, getCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryByID: %w", err)
 	}
+	if q.getCustomerAccountByIDStmt, err = db.PrepareContext(ctx, getCustomerAccountByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerAccountByID: %w", err)
+	}
+	if q.getCustomerAddressStmt, err = db.PrepareContext(ctx, getCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerAddress: %w", err)
+	}
 	if q.getCustomerByIDStmt, err = db.PrepareContext(ctx, getCustomerByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCustomerByID: %w", err)
 	}
+	if q.getCustomerCredentialsByEmailStmt, err = db.PrepareContext(ctx, getCustomerCredentialsByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerCredentialsByEmail: %w", err)
+	}
+	if q.getCustomerFavouriteCategoriesStmt, err = db.PrepareContext(ctx, getCustomerFavouriteCategories); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerFavouriteCategories: %w", err)
+	}
+	if q.getCustomerIDByEmailStmt, err = db.PrepareContext(ctx, getCustomerIDByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerIDByEmail: %w", err)
+	}
+	if q.getCustomerOrderStatsStmt, err = db.PrepareContext(ctx, getCustomerOrderStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerOrderStats: %w", err)
+	}
 	if q.getCustomersStmt, err = db.PrepareContext(ctx, getCustomers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCustomers: %w", err)
 	}
+	if q.getNextOrderRevisionStmt, err = db.PrepareContext(ctx, getNextOrderRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNextOrderRevision: %w", err)
+	}
+	if q.getNextProductImagePositionStmt, err = db.PrepareContext(ctx, getNextProductImagePosition); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNextProductImagePosition: %w", err)
+	}
+	if q.getNotificationPreferencesStmt, err = db.PrepareContext(ctx, getNotificationPreferences); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNotificationPreferences: %w", err)
+	}
 	if q.getOrderByIDStmt, err = db.PrepareContext(ctx, getOrderByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderByID: %w", err)
 	}
+	if q.getOrderForReturnStmt, err = db.PrepareContext(ctx, getOrderForReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderForReturn: %w", err)
+	}
+	if q.getOrderForUpdateStmt, err = db.PrepareContext(ctx, getOrderForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderForUpdate: %w", err)
+	}
+	if q.getOrderItemSnapshotStmt, err = db.PrepareContext(ctx, getOrderItemSnapshot); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderItemSnapshot: %w", err)
+	}
 	if q.getOrderItemsByOrderIDStmt, err = db.PrepareContext(ctx, getOrderItemsByOrderID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderItemsByOrderID: %w", err)
 	}
+	if q.getOrderPaymentInfoStmt, err = db.PrepareContext(ctx, getOrderPaymentInfo); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderPaymentInfo: %w", err)
+	}
+	if q.getOrderPaymentInfoForUpdateStmt, err = db.PrepareContext(ctx, getOrderPaymentInfoForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderPaymentInfoForUpdate: %w", err)
+	}
+	if q.getOrderShipmentStmt, err = db.PrepareContext(ctx, getOrderShipment); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderShipment: %w", err)
+	}
 	if q.getOrdersStmt, err = db.PrepareContext(ctx, getOrders); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrders: %w", err)
 	}
+	if q.getPaymentIntentByIDStmt, err = db.PrepareContext(ctx, getPaymentIntentByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentIntentByID: %w", err)
+	}
+	if q.getPaymentIntentByReferenceForUpdateStmt, err = db.PrepareContext(ctx, getPaymentIntentByReferenceForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentIntentByReferenceForUpdate: %w", err)
+	}
+	if q.getPendingPaymentIntentByOrderStmt, err = db.PrepareContext(ctx, getPendingPaymentIntentByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPendingPaymentIntentByOrder: %w", err)
+	}
 	if q.getProductByIDStmt, err = db.PrepareContext(ctx, getProductByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductByID: %w", err)
 	}
+	if q.getProductCategoryIDStmt, err = db.PrepareContext(ctx, getProductCategoryID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductCategoryID: %w", err)
+	}
 	if q.getProductDashboardReportStmt, err = db.PrepareContext(ctx, getProductDashboardReport); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductDashboardReport: %w", err)
 	}
+	if q.getProductDimensionsStmt, err = db.PrepareContext(ctx, getProductDimensions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductDimensions: %w", err)
+	}
+	if q.getProductImageByIDStmt, err = db.PrepareContext(ctx, getProductImageByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductImageByID: %w", err)
+	}
+	if q.getProductPriceForUpdateStmt, err = db.PrepareContext(ctx, getProductPriceForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductPriceForUpdate: %w", err)
+	}
+	if q.getProductPriceScheduleStmt, err = db.PrepareContext(ctx, getProductPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductPriceSchedule: %w", err)
+	}
+	if q.getProductPriceScheduleForUpdateStmt, err = db.PrepareContext(ctx, getProductPriceScheduleForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductPriceScheduleForUpdate: %w", err)
+	}
+	if q.getProductStockStmt, err = db.PrepareContext(ctx, getProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductStock: %w", err)
+	}
+	if q.getProductVariantByIDStmt, err = db.PrepareContext(ctx, getProductVariantByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductVariantByID: %w", err)
+	}
+	if q.getProductVariantIsActiveStmt, err = db.PrepareContext(ctx, getProductVariantIsActive); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductVariantIsActive: %w", err)
+	}
+	if q.getProductVariantStockStmt, err = db.PrepareContext(ctx, getProductVariantStock); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductVariantStock: %w", err)
+	}
+	if q.getPromotionByCodeForUpdateStmt, err = db.PrepareContext(ctx, getPromotionByCodeForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPromotionByCodeForUpdate: %w", err)
+	}
+	if q.getPromotionByIDStmt, err = db.PrepareContext(ctx, getPromotionByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPromotionByID: %w", err)
+	}
 	if q.getRecentProductsStmt, err = db.PrepareContext(ctx, getRecentProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRecentProducts: %w", err)
 	}
+	if q.getReturnByIDStmt, err = db.PrepareContext(ctx, getReturnByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReturnByID: %w", err)
+	}
+	if q.getRevenueReportStmt, err = db.PrepareContext(ctx, getRevenueReport); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRevenueReport: %w", err)
+	}
+	if q.getShippingMethodByIDStmt, err = db.PrepareContext(ctx, getShippingMethodByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShippingMethodByID: %w", err)
+	}
+	if q.getTaxRuleByIDStmt, err = db.PrepareContext(ctx, getTaxRuleByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTaxRuleByID: %w", err)
+	}
 	if q.getTopCustomersStmt, err = db.PrepareContext(ctx, getTopCustomers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTopCustomers: %w", err)
 	}
+	if q.getValidCustomerTokenStmt, err = db.PrepareContext(ctx, getValidCustomerToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetValidCustomerToken: %w", err)
+	}
+	if q.getWebhookDeliveryStmt, err = db.PrepareContext(ctx, getWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhookDelivery: %w", err)
+	}
+	if q.getWebhookEndpointByIDStmt, err = db.PrepareContext(ctx, getWebhookEndpointByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhookEndpointByID: %w", err)
+	}
+	if q.incrementOrderItemReturnedQuantityStmt, err = db.PrepareContext(ctx, incrementOrderItemReturnedQuantity); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementOrderItemReturnedQuantity: %w", err)
+	}
+	if q.incrementProductStockStmt, err = db.PrepareContext(ctx, incrementProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementProductStock: %w", err)
+	}
+	if q.incrementProductVariantStockStmt, err = db.PrepareContext(ctx, incrementProductVariantStock); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementProductVariantStock: %w", err)
+	}
+	if q.incrementPromotionUsageStmt, err = db.PrepareContext(ctx, incrementPromotionUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementPromotionUsage: %w", err)
+	}
+	if q.incrementWebhookEndpointFailuresStmt, err = db.PrepareContext(ctx, incrementWebhookEndpointFailures); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementWebhookEndpointFailures: %w", err)
+	}
+	if q.listActiveShippingMethodsStmt, err = db.PrepareContext(ctx, listActiveShippingMethods); err != nil {
+		return nil, fmt.Errorf("error preparing query ListActiveShippingMethods: %w", err)
+	}
+	if q.listActiveTaxRulesStmt, err = db.PrepareContext(ctx, listActiveTaxRules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListActiveTaxRules: %w", err)
+	}
+	if q.listCategoryNamesStmt, err = db.PrepareContext(ctx, listCategoryNames); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryNames: %w", err)
+	}
+	if q.listClaimableJobsStmt, err = db.PrepareContext(ctx, listClaimableJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListClaimableJobs: %w", err)
+	}
+	if q.listCustomerAddressesStmt, err = db.PrepareContext(ctx, listCustomerAddresses); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCustomerAddresses: %w", err)
+	}
+	if q.listDeadJobsStmt, err = db.PrepareContext(ctx, listDeadJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDeadJobs: %w", err)
+	}
+	if q.listDuePriceSchedulesStmt, err = db.PrepareContext(ctx, listDuePriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDuePriceSchedules: %w", err)
+	}
+	if q.listDueWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listDueWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDueWebhookDeliveries: %w", err)
+	}
+	if q.listOrderRevisionsStmt, err = db.PrepareContext(ctx, listOrderRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrderRevisions: %w", err)
+	}
+	if q.listPaymentIntentsByOrderStmt, err = db.PrepareContext(ctx, listPaymentIntentsByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPaymentIntentsByOrder: %w", err)
+	}
+	if q.listPendingOutboxEventsStmt, err = db.PrepareContext(ctx, listPendingOutboxEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPendingOutboxEvents: %w", err)
+	}
+	if q.listProductImagesByProductIDStmt, err = db.PrepareContext(ctx, listProductImagesByProductID); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductImagesByProductID: %w", err)
+	}
+	if q.listProductKeysForImportStmt, err = db.PrepareContext(ctx, listProductKeysForImport); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductKeysForImport: %w", err)
+	}
+	if q.listProductPriceHistoryStmt, err = db.PrepareContext(ctx, listProductPriceHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductPriceHistory: %w", err)
+	}
+	if q.listProductPriceSchedulesStmt, err = db.PrepareContext(ctx, listProductPriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductPriceSchedules: %w", err)
+	}
+	if q.listProductVariantsByProductIDStmt, err = db.PrepareContext(ctx, listProductVariantsByProductID); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductVariantsByProductID: %w", err)
+	}
 	if q.listProductsStmt, err = db.PrepareContext(ctx, listProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProducts: %w", err)
 	}
+	if q.listPromotionsStmt, err = db.PrepareContext(ctx, listPromotions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPromotions: %w", err)
+	}
+	if q.listReturnItemsByOrderStmt, err = db.PrepareContext(ctx, listReturnItemsByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReturnItemsByOrder: %w", err)
+	}
+	if q.listReturnsByOrderStmt, err = db.PrepareContext(ctx, listReturnsByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReturnsByOrder: %w", err)
+	}
+	if q.listShippingMethodsStmt, err = db.PrepareContext(ctx, listShippingMethods); err != nil {
+		return nil, fmt.Errorf("error preparing query ListShippingMethods: %w", err)
+	}
+	if q.listTaxRulesStmt, err = db.PrepareContext(ctx, listTaxRules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTaxRules: %w", err)
+	}
+	if q.listWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveries: %w", err)
+	}
+	if q.listWebhookDeliveryAttemptsStmt, err = db.PrepareContext(ctx, listWebhookDeliveryAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveryAttempts: %w", err)
+	}
+	if q.listWebhookEndpointsStmt, err = db.PrepareContext(ctx, listWebhookEndpoints); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookEndpoints: %w", err)
+	}
+	if q.listWebhookEndpointsForEventStmt, err = db.PrepareContext(ctx, listWebhookEndpointsForEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookEndpointsForEvent: %w", err)
+	}
+	if q.lockCustomerStmt, err = db.PrepareContext(ctx, lockCustomer); err != nil {
+		return nil, fmt.Errorf("error preparing query LockCustomer: %w", err)
+	}
+	if q.markCustomerEmailVerifiedStmt, err = db.PrepareContext(ctx, markCustomerEmailVerified); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkCustomerEmailVerified: %w", err)
+	}
+	if q.markJobRunningStmt, err = db.PrepareContext(ctx, markJobRunning); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkJobRunning: %w", err)
+	}
+	if q.markOutboxEventFailedStmt, err = db.PrepareContext(ctx, markOutboxEventFailed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkOutboxEventFailed: %w", err)
+	}
+	if q.markOutboxEventPublishedStmt, err = db.PrepareContext(ctx, markOutboxEventPublished); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkOutboxEventPublished: %w", err)
+	}
+	if q.markReturnRefundedStmt, err = db.PrepareContext(ctx, markReturnRefunded); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkReturnRefunded: %w", err)
+	}
+	if q.productExistsStmt, err = db.PrepareContext(ctx, productExists); err != nil {
+		return nil, fmt.Errorf("error preparing query ProductExists: %w", err)
+	}
+	if q.productHasActiveVariantsStmt, err = db.PrepareContext(ctx, productHasActiveVariants); err != nil {
+		return nil, fmt.Errorf("error preparing query ProductHasActiveVariants: %w", err)
+	}
+	if q.promoteDefaultCustomerAddressStmt, err = db.PrepareContext(ctx, promoteDefaultCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query PromoteDefaultCustomerAddress: %w", err)
+	}
+	if q.redeliverWebhookDeliveryStmt, err = db.PrepareContext(ctx, redeliverWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query RedeliverWebhookDelivery: %w", err)
+	}
+	if q.requeueDeadJobStmt, err = db.PrepareContext(ctx, requeueDeadJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueDeadJob: %w", err)
+	}
+	if q.resetWebhookEndpointFailuresStmt, err = db.PrepareContext(ctx, resetWebhookEndpointFailures); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetWebhookEndpointFailures: %w", err)
+	}
+	if q.retryJobStmt, err = db.PrepareContext(ctx, retryJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RetryJob: %w", err)
+	}
+	if q.setPrimaryProductImageStmt, err = db.PrepareContext(ctx, setPrimaryProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query SetPrimaryProductImage: %w", err)
+	}
 	if q.updateCategoryStmt, err = db.PrepareContext(ctx, updateCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCategory: %w", err)
 	}
 	if q.updateCustomerStmt, err = db.PrepareContext(ctx, updateCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCustomer: %w", err)
 	}
+	if q.updateCustomerAddressStmt, err = db.PrepareContext(ctx, updateCustomerAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCustomerAddress: %w", err)
+	}
+	if q.updateCustomerPasswordStmt, err = db.PrepareContext(ctx, updateCustomerPassword); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCustomerPassword: %w", err)
+	}
+	if q.updateCustomerProfileStmt, err = db.PrepareContext(ctx, updateCustomerProfile); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCustomerProfile: %w", err)
+	}
+	if q.updateImportedProductStmt, err = db.PrepareContext(ctx, updateImportedProduct); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateImportedProduct: %w", err)
+	}
+	if q.updateOrderItemStmt, err = db.PrepareContext(ctx, updateOrderItem); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateOrderItem: %w", err)
+	}
+	if q.updateOrderPaymentStatusStmt, err = db.PrepareContext(ctx, updateOrderPaymentStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateOrderPaymentStatus: %w", err)
+	}
+	if q.updateOrderStatusStmt, err = db.PrepareContext(ctx, updateOrderStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateOrderStatus: %w", err)
+	}
+	if q.updateOrderTotalsStmt, err = db.PrepareContext(ctx, updateOrderTotals); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateOrderTotals: %w", err)
+	}
+	if q.updatePaymentIntentStatusStmt, err = db.PrepareContext(ctx, updatePaymentIntentStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePaymentIntentStatus: %w", err)
+	}
+	if q.updatePriceScheduleStateStmt, err = db.PrepareContext(ctx, updatePriceScheduleState); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePriceScheduleState: %w", err)
+	}
 	if q.updateProductStmt, err = db.PrepareContext(ctx, updateProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProduct: %w", err)
 	}
+	if q.updateProductImagePositionStmt, err = db.PrepareContext(ctx, updateProductImagePosition); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductImagePosition: %w", err)
+	}
+	if q.updateProductPriceStmt, err = db.PrepareContext(ctx, updateProductPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductPrice: %w", err)
+	}
+	if q.updateProductVariantStmt, err = db.PrepareContext(ctx, updateProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductVariant: %w", err)
+	}
+	if q.updatePromotionStmt, err = db.PrepareContext(ctx, updatePromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePromotion: %w", err)
+	}
+	if q.updatePromotionRedemptionDiscountStmt, err = db.PrepareContext(ctx, updatePromotionRedemptionDiscount); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePromotionRedemptionDiscount: %w", err)
+	}
+	if q.updateShippingMethodStmt, err = db.PrepareContext(ctx, updateShippingMethod); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateShippingMethod: %w", err)
+	}
+	if q.updateTaxRuleStmt, err = db.PrepareContext(ctx, updateTaxRule); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTaxRule: %w", err)
+	}
+	if q.updateWebhookDeliveryResultStmt, err = db.PrepareContext(ctx, updateWebhookDeliveryResult); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhookDeliveryResult: %w", err)
+	}
+	if q.updateWebhookEndpointStmt, err = db.PrepareContext(ctx, updateWebhookEndpoint); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhookEndpoint: %w", err)
+	}
+	if q.upsertNotificationPreferencesStmt, err = db.PrepareContext(ctx, upsertNotificationPreferences); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertNotificationPreferences: %w", err)
+	}
+	if q.useCustomerTokenStmt, err = db.PrepareContext(ctx, useCustomerToken); err != nil {
+		return nil, fmt.Errorf("error preparing query UseCustomerToken: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addOrderRefundTotalStmt != nil {
+		if cerr := q.addOrderRefundTotalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addOrderRefundTotalStmt: %w", cerr)
+		}
+	}
+	if q.anonymiseCustomerStmt != nil {
+		if cerr := q.anonymiseCustomerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymiseCustomerStmt: %w", cerr)
+		}
+	}
+	if q.anonymiseOrderShippingAddressesStmt != nil {
+		if cerr := q.anonymiseOrderShippingAddressesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymiseOrderShippingAddressesStmt: %w", cerr)
+		}
+	}
+	if q.anonymiseOutboxPayloadsStmt != nil {
+		if cerr := q.anonymiseOutboxPayloadsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymiseOutboxPayloadsStmt: %w", cerr)
+		}
+	}
+	if q.anonymiseWebhookDeliveryPayloadsStmt != nil {
+		if cerr := q.anonymiseWebhookDeliveryPayloadsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymiseWebhookDeliveryPayloadsStmt: %w", cerr)
+		}
+	}
+	if q.buryJobStmt != nil {
+		if cerr := q.buryJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing buryJobStmt: %w", cerr)
+		}
+	}
+	if q.cancelPendingPaymentIntentsStmt != nil {
+		if cerr := q.cancelPendingPaymentIntentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelPendingPaymentIntentsStmt: %w", cerr)
+		}
+	}
+	if q.cancelPriceScheduleStmt != nil {
+		if cerr := q.cancelPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelPriceScheduleStmt: %w", cerr)
+		}
+	}
+	if q.claimWebhookDeliveryStmt != nil {
+		if cerr := q.claimWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.clearDefaultCustomerAddressStmt != nil {
+		if cerr := q.clearDefaultCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearDefaultCustomerAddressStmt: %w", cerr)
+		}
+	}
+	if q.clearOrderCouponStmt != nil {
+		if cerr := q.clearOrderCouponStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearOrderCouponStmt: %w", cerr)
+		}
+	}
+	if q.clearPrimaryProductImageStmt != nil {
+		if cerr := q.clearPrimaryProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearPrimaryProductImageStmt: %w", cerr)
+		}
+	}
+	if q.completeJobStmt != nil {
+		if cerr := q.completeJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeJobStmt: %w", cerr)
+		}
+	}
+	if q.countCustomerAddressesStmt != nil {
+		if cerr := q.countCustomerAddressesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCustomerAddressesStmt: %w", cerr)
+		}
+	}
+	if q.countCustomerPromotionRedemptionsStmt != nil {
+		if cerr := q.countCustomerPromotionRedemptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCustomerPromotionRedemptionsStmt: %w", cerr)
+		}
+	}
+	if q.countCustomersStmt != nil {
+		if cerr := q.countCustomersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCustomersStmt: %w", cerr)
+		}
+	}
+	if q.countOrdersStmt != nil {
+		if cerr := q.countOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countOrdersStmt: %w", cerr)
+		}
+	}
+	if q.countOtherDefaultTaxRulesStmt != nil {
+		if cerr := q.countOtherDefaultTaxRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countOtherDefaultTaxRulesStmt: %w", cerr)
+		}
+	}
+	if q.countOverlappingPriceSchedulesStmt != nil {
+		if cerr := q.countOverlappingPriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countOverlappingPriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.countProductPriceHistoryStmt != nil {
+		if cerr := q.countProductPriceHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countProductPriceHistoryStmt: %w", cerr)
+		}
+	}
 	if q.countProductsStmt != nil {
 		if cerr := q.countProductsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countProductsStmt: %w", cerr)
 		}
 	}
+	if q.countPromotionsStmt != nil {
+		if cerr := q.countPromotionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countPromotionsStmt: %w", cerr)
+		}
+	}
+	if q.countWebhookDeliveriesStmt != nil {
+		if cerr := q.countWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
 	if q.createCategoryStmt != nil {
 		if cerr := q.createCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCategoryStmt: %w", cerr)
@@ -119,6 +733,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createCustomerStmt: %w", cerr)
 		}
 	}
+	if q.createCustomerAccountStmt != nil {
+		if cerr := q.createCustomerAccountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerAccountStmt: %w", cerr)
+		}
+	}
+	if q.createCustomerAddressStmt != nil {
+		if cerr := q.createCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerAddressStmt: %w", cerr)
+		}
+	}
+	if q.createCustomerTokenStmt != nil {
+		if cerr := q.createCustomerTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerTokenStmt: %w", cerr)
+		}
+	}
+	if q.createJobStmt != nil {
+		if cerr := q.createJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createJobStmt: %w", cerr)
+		}
+	}
 	if q.createOrderStmt != nil {
 		if cerr := q.createOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createOrderStmt: %w", cerr)
@@ -129,31 +763,231 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createOrderItemStmt: %w", cerr)
 		}
 	}
+	if q.createOrderRevisionStmt != nil {
+		if cerr := q.createOrderRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOrderRevisionStmt: %w", cerr)
+		}
+	}
+	if q.createOrderShipmentStmt != nil {
+		if cerr := q.createOrderShipmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOrderShipmentStmt: %w", cerr)
+		}
+	}
+	if q.createOrderShippingAddressStmt != nil {
+		if cerr := q.createOrderShippingAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOrderShippingAddressStmt: %w", cerr)
+		}
+	}
+	if q.createOutboxEventStmt != nil {
+		if cerr := q.createOutboxEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOutboxEventStmt: %w", cerr)
+		}
+	}
+	if q.createPaymentIntentStmt != nil {
+		if cerr := q.createPaymentIntentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPaymentIntentStmt: %w", cerr)
+		}
+	}
+	if q.createPaymentWebhookEventStmt != nil {
+		if cerr := q.createPaymentWebhookEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPaymentWebhookEventStmt: %w", cerr)
+		}
+	}
 	if q.createProductStmt != nil {
 		if cerr := q.createProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createProductStmt: %w", cerr)
 		}
 	}
+	if q.createProductImageStmt != nil {
+		if cerr := q.createProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductImageStmt: %w", cerr)
+		}
+	}
+	if q.createProductPriceHistoryStmt != nil {
+		if cerr := q.createProductPriceHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductPriceHistoryStmt: %w", cerr)
+		}
+	}
+	if q.createProductPriceScheduleStmt != nil {
+		if cerr := q.createProductPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductPriceScheduleStmt: %w", cerr)
+		}
+	}
+	if q.createProductVariantStmt != nil {
+		if cerr := q.createProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductVariantStmt: %w", cerr)
+		}
+	}
+	if q.createPromotionStmt != nil {
+		if cerr := q.createPromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPromotionStmt: %w", cerr)
+		}
+	}
+	if q.createPromotionRedemptionStmt != nil {
+		if cerr := q.createPromotionRedemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPromotionRedemptionStmt: %w", cerr)
+		}
+	}
+	if q.createReturnStmt != nil {
+		if cerr := q.createReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReturnStmt: %w", cerr)
+		}
+	}
+	if q.createReturnItemStmt != nil {
+		if cerr := q.createReturnItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReturnItemStmt: %w", cerr)
+		}
+	}
+	if q.createShippingMethodStmt != nil {
+		if cerr := q.createShippingMethodStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createShippingMethodStmt: %w", cerr)
+		}
+	}
+	if q.createTaxRuleStmt != nil {
+		if cerr := q.createTaxRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTaxRuleStmt: %w", cerr)
+		}
+	}
+	if q.createWebhookDeliveryStmt != nil {
+		if cerr := q.createWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.createWebhookDeliveryAttemptStmt != nil {
+		if cerr := q.createWebhookDeliveryAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookDeliveryAttemptStmt: %w", cerr)
+		}
+	}
+	if q.createWebhookEndpointStmt != nil {
+		if cerr := q.createWebhookEndpointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookEndpointStmt: %w", cerr)
+		}
+	}
+	if q.customerExistsStmt != nil {
+		if cerr := q.customerExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing customerExistsStmt: %w", cerr)
+		}
+	}
+	if q.deactivatePromotionStmt != nil {
+		if cerr := q.deactivatePromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deactivatePromotionStmt: %w", cerr)
+		}
+	}
+	if q.decrementProductStockStmt != nil {
+		if cerr := q.decrementProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementProductStockStmt: %w", cerr)
+		}
+	}
+	if q.decrementProductVariantStockStmt != nil {
+		if cerr := q.decrementProductVariantStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementProductVariantStockStmt: %w", cerr)
+		}
+	}
+	if q.decrementPromotionUsageStmt != nil {
+		if cerr := q.decrementPromotionUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementPromotionUsageStmt: %w", cerr)
+		}
+	}
+	if q.deleteAllCustomerAddressesStmt != nil {
+		if cerr := q.deleteAllCustomerAddressesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAllCustomerAddressesStmt: %w", cerr)
+		}
+	}
+	if q.deleteAllCustomerTokensStmt != nil {
+		if cerr := q.deleteAllCustomerTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAllCustomerTokensStmt: %w", cerr)
+		}
+	}
 	if q.deleteCategoryStmt != nil {
 		if cerr := q.deleteCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCategoryStmt: %w", cerr)
 		}
 	}
+	if q.deleteCompletedJobsBeforeStmt != nil {
+		if cerr := q.deleteCompletedJobsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCompletedJobsBeforeStmt: %w", cerr)
+		}
+	}
 	if q.deleteCustomerStmt != nil {
 		if cerr := q.deleteCustomerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCustomerStmt: %w", cerr)
 		}
 	}
+	if q.deleteCustomerAddressStmt != nil {
+		if cerr := q.deleteCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCustomerAddressStmt: %w", cerr)
+		}
+	}
+	if q.deleteCustomerEmailJobsStmt != nil {
+		if cerr := q.deleteCustomerEmailJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCustomerEmailJobsStmt: %w", cerr)
+		}
+	}
+	if q.deleteCustomerTokenStmt != nil {
+		if cerr := q.deleteCustomerTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCustomerTokenStmt: %w", cerr)
+		}
+	}
+	if q.deleteCustomerTokensStmt != nil {
+		if cerr := q.deleteCustomerTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCustomerTokensStmt: %w", cerr)
+		}
+	}
 	if q.deleteOrderStmt != nil {
 		if cerr := q.deleteOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteOrderStmt: %w", cerr)
 		}
 	}
+	if q.deleteOrderItemStmt != nil {
+		if cerr := q.deleteOrderItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOrderItemStmt: %w", cerr)
+		}
+	}
 	if q.deleteProductStmt != nil {
 		if cerr := q.deleteProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteProductStmt: %w", cerr)
 		}
 	}
+	if q.deleteProductImageStmt != nil {
+		if cerr := q.deleteProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductImageStmt: %w", cerr)
+		}
+	}
+	if q.deleteProductVariantStmt != nil {
+		if cerr := q.deleteProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductVariantStmt: %w", cerr)
+		}
+	}
+	if q.deletePromotionRedemptionByOrderStmt != nil {
+		if cerr := q.deletePromotionRedemptionByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePromotionRedemptionByOrderStmt: %w", cerr)
+		}
+	}
+	if q.deleteShippingMethodStmt != nil {
+		if cerr := q.deleteShippingMethodStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteShippingMethodStmt: %w", cerr)
+		}
+	}
+	if q.deleteTaxRuleStmt != nil {
+		if cerr := q.deleteTaxRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTaxRuleStmt: %w", cerr)
+		}
+	}
+	if q.deleteWebhookEndpointStmt != nil {
+		if cerr := q.deleteWebhookEndpointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWebhookEndpointStmt: %w", cerr)
+		}
+	}
+	if q.disableFailingWebhookEndpointStmt != nil {
+		if cerr := q.disableFailingWebhookEndpointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing disableFailingWebhookEndpointStmt: %w", cerr)
+		}
+	}
+	if q.flagPaymentIntentForRefundStmt != nil {
+		if cerr := q.flagPaymentIntentForRefundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing flagPaymentIntentForRefundStmt: %w", cerr)
+		}
+	}
 	if q.getCategoriesStmt != nil {
 		if cerr := q.getCategoriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCategoriesStmt: %w", cerr)
@@ -164,56 +998,461 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCategoryByIDStmt: %w", cerr)
 		}
 	}
+	if q.getCustomerAccountByIDStmt != nil {
+		if cerr := q.getCustomerAccountByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerAccountByIDStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerAddressStmt != nil {
+		if cerr := q.getCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerAddressStmt: %w", cerr)
+		}
+	}
 	if q.getCustomerByIDStmt != nil {
 		if cerr := q.getCustomerByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCustomerByIDStmt: %w", cerr)
 		}
 	}
+	if q.getCustomerCredentialsByEmailStmt != nil {
+		if cerr := q.getCustomerCredentialsByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerCredentialsByEmailStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerFavouriteCategoriesStmt != nil {
+		if cerr := q.getCustomerFavouriteCategoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerFavouriteCategoriesStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerIDByEmailStmt != nil {
+		if cerr := q.getCustomerIDByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerIDByEmailStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerOrderStatsStmt != nil {
+		if cerr := q.getCustomerOrderStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerOrderStatsStmt: %w", cerr)
+		}
+	}
 	if q.getCustomersStmt != nil {
 		if cerr := q.getCustomersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCustomersStmt: %w", cerr)
 		}
 	}
+	if q.getNextOrderRevisionStmt != nil {
+		if cerr := q.getNextOrderRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNextOrderRevisionStmt: %w", cerr)
+		}
+	}
+	if q.getNextProductImagePositionStmt != nil {
+		if cerr := q.getNextProductImagePositionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNextProductImagePositionStmt: %w", cerr)
+		}
+	}
+	if q.getNotificationPreferencesStmt != nil {
+		if cerr := q.getNotificationPreferencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNotificationPreferencesStmt: %w", cerr)
+		}
+	}
 	if q.getOrderByIDStmt != nil {
 		if cerr := q.getOrderByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderByIDStmt: %w", cerr)
 		}
 	}
+	if q.getOrderForReturnStmt != nil {
+		if cerr := q.getOrderForReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderForReturnStmt: %w", cerr)
+		}
+	}
+	if q.getOrderForUpdateStmt != nil {
+		if cerr := q.getOrderForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getOrderItemSnapshotStmt != nil {
+		if cerr := q.getOrderItemSnapshotStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderItemSnapshotStmt: %w", cerr)
+		}
+	}
 	if q.getOrderItemsByOrderIDStmt != nil {
 		if cerr := q.getOrderItemsByOrderIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderItemsByOrderIDStmt: %w", cerr)
 		}
 	}
+	if q.getOrderPaymentInfoStmt != nil {
+		if cerr := q.getOrderPaymentInfoStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderPaymentInfoStmt: %w", cerr)
+		}
+	}
+	if q.getOrderPaymentInfoForUpdateStmt != nil {
+		if cerr := q.getOrderPaymentInfoForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderPaymentInfoForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getOrderShipmentStmt != nil {
+		if cerr := q.getOrderShipmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderShipmentStmt: %w", cerr)
+		}
+	}
 	if q.getOrdersStmt != nil {
 		if cerr := q.getOrdersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrdersStmt: %w", cerr)
 		}
 	}
+	if q.getPaymentIntentByIDStmt != nil {
+		if cerr := q.getPaymentIntentByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentIntentByIDStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentIntentByReferenceForUpdateStmt != nil {
+		if cerr := q.getPaymentIntentByReferenceForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentIntentByReferenceForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getPendingPaymentIntentByOrderStmt != nil {
+		if cerr := q.getPendingPaymentIntentByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPendingPaymentIntentByOrderStmt: %w", cerr)
+		}
+	}
 	if q.getProductByIDStmt != nil {
 		if cerr := q.getProductByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProductByIDStmt: %w", cerr)
 		}
 	}
+	if q.getProductCategoryIDStmt != nil {
+		if cerr := q.getProductCategoryIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductCategoryIDStmt: %w", cerr)
+		}
+	}
 	if q.getProductDashboardReportStmt != nil {
 		if cerr := q.getProductDashboardReportStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProductDashboardReportStmt: %w", cerr)
 		}
 	}
+	if q.getProductDimensionsStmt != nil {
+		if cerr := q.getProductDimensionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductDimensionsStmt: %w", cerr)
+		}
+	}
+	if q.getProductImageByIDStmt != nil {
+		if cerr := q.getProductImageByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductImageByIDStmt: %w", cerr)
+		}
+	}
+	if q.getProductPriceForUpdateStmt != nil {
+		if cerr := q.getProductPriceForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductPriceForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getProductPriceScheduleStmt != nil {
+		if cerr := q.getProductPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductPriceScheduleStmt: %w", cerr)
+		}
+	}
+	if q.getProductPriceScheduleForUpdateStmt != nil {
+		if cerr := q.getProductPriceScheduleForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductPriceScheduleForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getProductStockStmt != nil {
+		if cerr := q.getProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductStockStmt: %w", cerr)
+		}
+	}
+	if q.getProductVariantByIDStmt != nil {
+		if cerr := q.getProductVariantByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductVariantByIDStmt: %w", cerr)
+		}
+	}
+	if q.getProductVariantIsActiveStmt != nil {
+		if cerr := q.getProductVariantIsActiveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductVariantIsActiveStmt: %w", cerr)
+		}
+	}
+	if q.getProductVariantStockStmt != nil {
+		if cerr := q.getProductVariantStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductVariantStockStmt: %w", cerr)
+		}
+	}
+	if q.getPromotionByCodeForUpdateStmt != nil {
+		if cerr := q.getPromotionByCodeForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPromotionByCodeForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getPromotionByIDStmt != nil {
+		if cerr := q.getPromotionByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPromotionByIDStmt: %w", cerr)
+		}
+	}
 	if q.getRecentProductsStmt != nil {
 		if cerr := q.getRecentProductsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRecentProductsStmt: %w", cerr)
 		}
 	}
+	if q.getReturnByIDStmt != nil {
+		if cerr := q.getReturnByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReturnByIDStmt: %w", cerr)
+		}
+	}
+	if q.getRevenueReportStmt != nil {
+		if cerr := q.getRevenueReportStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRevenueReportStmt: %w", cerr)
+		}
+	}
+	if q.getShippingMethodByIDStmt != nil {
+		if cerr := q.getShippingMethodByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShippingMethodByIDStmt: %w", cerr)
+		}
+	}
+	if q.getTaxRuleByIDStmt != nil {
+		if cerr := q.getTaxRuleByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTaxRuleByIDStmt: %w", cerr)
+		}
+	}
 	if q.getTopCustomersStmt != nil {
 		if cerr := q.getTopCustomersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTopCustomersStmt: %w", cerr)
 		}
 	}
+	if q.getValidCustomerTokenStmt != nil {
+		if cerr := q.getValidCustomerTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getValidCustomerTokenStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookDeliveryStmt != nil {
+		if cerr := q.getWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookEndpointByIDStmt != nil {
+		if cerr := q.getWebhookEndpointByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookEndpointByIDStmt: %w", cerr)
+		}
+	}
+	if q.incrementOrderItemReturnedQuantityStmt != nil {
+		if cerr := q.incrementOrderItemReturnedQuantityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementOrderItemReturnedQuantityStmt: %w", cerr)
+		}
+	}
+	if q.incrementProductStockStmt != nil {
+		if cerr := q.incrementProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementProductStockStmt: %w", cerr)
+		}
+	}
+	if q.incrementProductVariantStockStmt != nil {
+		if cerr := q.incrementProductVariantStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementProductVariantStockStmt: %w", cerr)
+		}
+	}
+	if q.incrementPromotionUsageStmt != nil {
+		if cerr := q.incrementPromotionUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementPromotionUsageStmt: %w", cerr)
+		}
+	}
+	if q.incrementWebhookEndpointFailuresStmt != nil {
+		if cerr := q.incrementWebhookEndpointFailuresStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementWebhookEndpointFailuresStmt: %w", cerr)
+		}
+	}
+	if q.listActiveShippingMethodsStmt != nil {
+		if cerr := q.listActiveShippingMethodsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listActiveShippingMethodsStmt: %w", cerr)
+		}
+	}
+	if q.listActiveTaxRulesStmt != nil {
+		if cerr := q.listActiveTaxRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listActiveTaxRulesStmt: %w", cerr)
+		}
+	}
+	if q.listCategoryNamesStmt != nil {
+		if cerr := q.listCategoryNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryNamesStmt: %w", cerr)
+		}
+	}
+	if q.listClaimableJobsStmt != nil {
+		if cerr := q.listClaimableJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listClaimableJobsStmt: %w", cerr)
+		}
+	}
+	if q.listCustomerAddressesStmt != nil {
+		if cerr := q.listCustomerAddressesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCustomerAddressesStmt: %w", cerr)
+		}
+	}
+	if q.listDeadJobsStmt != nil {
+		if cerr := q.listDeadJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDeadJobsStmt: %w", cerr)
+		}
+	}
+	if q.listDuePriceSchedulesStmt != nil {
+		if cerr := q.listDuePriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDuePriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.listDueWebhookDeliveriesStmt != nil {
+		if cerr := q.listDueWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDueWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listOrderRevisionsStmt != nil {
+		if cerr := q.listOrderRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrderRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.listPaymentIntentsByOrderStmt != nil {
+		if cerr := q.listPaymentIntentsByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPaymentIntentsByOrderStmt: %w", cerr)
+		}
+	}
+	if q.listPendingOutboxEventsStmt != nil {
+		if cerr := q.listPendingOutboxEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPendingOutboxEventsStmt: %w", cerr)
+		}
+	}
+	if q.listProductImagesByProductIDStmt != nil {
+		if cerr := q.listProductImagesByProductIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductImagesByProductIDStmt: %w", cerr)
+		}
+	}
+	if q.listProductKeysForImportStmt != nil {
+		if cerr := q.listProductKeysForImportStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductKeysForImportStmt: %w", cerr)
+		}
+	}
+	if q.listProductPriceHistoryStmt != nil {
+		if cerr := q.listProductPriceHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductPriceHistoryStmt: %w", cerr)
+		}
+	}
+	if q.listProductPriceSchedulesStmt != nil {
+		if cerr := q.listProductPriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductPriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.listProductVariantsByProductIDStmt != nil {
+		if cerr := q.listProductVariantsByProductIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductVariantsByProductIDStmt: %w", cerr)
+		}
+	}
 	if q.listProductsStmt != nil {
 		if cerr := q.listProductsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProductsStmt: %w", cerr)
 		}
 	}
+	if q.listPromotionsStmt != nil {
+		if cerr := q.listPromotionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPromotionsStmt: %w", cerr)
+		}
+	}
+	if q.listReturnItemsByOrderStmt != nil {
+		if cerr := q.listReturnItemsByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReturnItemsByOrderStmt: %w", cerr)
+		}
+	}
+	if q.listReturnsByOrderStmt != nil {
+		if cerr := q.listReturnsByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReturnsByOrderStmt: %w", cerr)
+		}
+	}
+	if q.listShippingMethodsStmt != nil {
+		if cerr := q.listShippingMethodsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listShippingMethodsStmt: %w", cerr)
+		}
+	}
+	if q.listTaxRulesStmt != nil {
+		if cerr := q.listTaxRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTaxRulesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveriesStmt != nil {
+		if cerr := q.listWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveryAttemptsStmt != nil {
+		if cerr := q.listWebhookDeliveryAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveryAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookEndpointsStmt != nil {
+		if cerr := q.listWebhookEndpointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookEndpointsStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookEndpointsForEventStmt != nil {
+		if cerr := q.listWebhookEndpointsForEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookEndpointsForEventStmt: %w", cerr)
+		}
+	}
+	if q.lockCustomerStmt != nil {
+		if cerr := q.lockCustomerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockCustomerStmt: %w", cerr)
+		}
+	}
+	if q.markCustomerEmailVerifiedStmt != nil {
+		if cerr := q.markCustomerEmailVerifiedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markCustomerEmailVerifiedStmt: %w", cerr)
+		}
+	}
+	if q.markJobRunningStmt != nil {
+		if cerr := q.markJobRunningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markJobRunningStmt: %w", cerr)
+		}
+	}
+	if q.markOutboxEventFailedStmt != nil {
+		if cerr := q.markOutboxEventFailedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markOutboxEventFailedStmt: %w", cerr)
+		}
+	}
+	if q.markOutboxEventPublishedStmt != nil {
+		if cerr := q.markOutboxEventPublishedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markOutboxEventPublishedStmt: %w", cerr)
+		}
+	}
+	if q.markReturnRefundedStmt != nil {
+		if cerr := q.markReturnRefundedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markReturnRefundedStmt: %w", cerr)
+		}
+	}
+	if q.productExistsStmt != nil {
+		if cerr := q.productExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing productExistsStmt: %w", cerr)
+		}
+	}
+	if q.productHasActiveVariantsStmt != nil {
+		if cerr := q.productHasActiveVariantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing productHasActiveVariantsStmt: %w", cerr)
+		}
+	}
+	if q.promoteDefaultCustomerAddressStmt != nil {
+		if cerr := q.promoteDefaultCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing promoteDefaultCustomerAddressStmt: %w", cerr)
+		}
+	}
+	if q.redeliverWebhookDeliveryStmt != nil {
+		if cerr := q.redeliverWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing redeliverWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.requeueDeadJobStmt != nil {
+		if cerr := q.requeueDeadJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueDeadJobStmt: %w", cerr)
+		}
+	}
+	if q.resetWebhookEndpointFailuresStmt != nil {
+		if cerr := q.resetWebhookEndpointFailuresStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetWebhookEndpointFailuresStmt: %w", cerr)
+		}
+	}
+	if q.retryJobStmt != nil {
+		if cerr := q.retryJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retryJobStmt: %w", cerr)
+		}
+	}
+	if q.setPrimaryProductImageStmt != nil {
+		if cerr := q.setPrimaryProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setPrimaryProductImageStmt: %w", cerr)
+		}
+	}
 	if q.updateCategoryStmt != nil {
 		if cerr := q.updateCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCategoryStmt: %w", cerr)
@@ -224,11 +1463,116 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateCustomerStmt: %w", cerr)
 		}
 	}
+	if q.updateCustomerAddressStmt != nil {
+		if cerr := q.updateCustomerAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCustomerAddressStmt: %w", cerr)
+		}
+	}
+	if q.updateCustomerPasswordStmt != nil {
+		if cerr := q.updateCustomerPasswordStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCustomerPasswordStmt: %w", cerr)
+		}
+	}
+	if q.updateCustomerProfileStmt != nil {
+		if cerr := q.updateCustomerProfileStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCustomerProfileStmt: %w", cerr)
+		}
+	}
+	if q.updateImportedProductStmt != nil {
+		if cerr := q.updateImportedProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateImportedProductStmt: %w", cerr)
+		}
+	}
+	if q.updateOrderItemStmt != nil {
+		if cerr := q.updateOrderItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateOrderItemStmt: %w", cerr)
+		}
+	}
+	if q.updateOrderPaymentStatusStmt != nil {
+		if cerr := q.updateOrderPaymentStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateOrderPaymentStatusStmt: %w", cerr)
+		}
+	}
+	if q.updateOrderStatusStmt != nil {
+		if cerr := q.updateOrderStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateOrderStatusStmt: %w", cerr)
+		}
+	}
+	if q.updateOrderTotalsStmt != nil {
+		if cerr := q.updateOrderTotalsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateOrderTotalsStmt: %w", cerr)
+		}
+	}
+	if q.updatePaymentIntentStatusStmt != nil {
+		if cerr := q.updatePaymentIntentStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePaymentIntentStatusStmt: %w", cerr)
+		}
+	}
+	if q.updatePriceScheduleStateStmt != nil {
+		if cerr := q.updatePriceScheduleStateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePriceScheduleStateStmt: %w", cerr)
+		}
+	}
 	if q.updateProductStmt != nil {
 		if cerr := q.updateProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateProductStmt: %w", cerr)
 		}
 	}
+	if q.updateProductImagePositionStmt != nil {
+		if cerr := q.updateProductImagePositionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductImagePositionStmt: %w", cerr)
+		}
+	}
+	if q.updateProductPriceStmt != nil {
+		if cerr := q.updateProductPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductPriceStmt: %w", cerr)
+		}
+	}
+	if q.updateProductVariantStmt != nil {
+		if cerr := q.updateProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductVariantStmt: %w", cerr)
+		}
+	}
+	if q.updatePromotionStmt != nil {
+		if cerr := q.updatePromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePromotionStmt: %w", cerr)
+		}
+	}
+	if q.updatePromotionRedemptionDiscountStmt != nil {
+		if cerr := q.updatePromotionRedemptionDiscountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePromotionRedemptionDiscountStmt: %w", cerr)
+		}
+	}
+	if q.updateShippingMethodStmt != nil {
+		if cerr := q.updateShippingMethodStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateShippingMethodStmt: %w", cerr)
+		}
+	}
+	if q.updateTaxRuleStmt != nil {
+		if cerr := q.updateTaxRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTaxRuleStmt: %w", cerr)
+		}
+	}
+	if q.updateWebhookDeliveryResultStmt != nil {
+		if cerr := q.updateWebhookDeliveryResultStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookDeliveryResultStmt: %w", cerr)
+		}
+	}
+	if q.updateWebhookEndpointStmt != nil {
+		if cerr := q.updateWebhookEndpointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookEndpointStmt: %w", cerr)
+		}
+	}
+	if q.upsertNotificationPreferencesStmt != nil {
+		if cerr := q.upsertNotificationPreferencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertNotificationPreferencesStmt: %w", cerr)
+		}
+	}
+	if q.useCustomerTokenStmt != nil {
+		if cerr := q.useCustomerTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useCustomerTokenStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -266,63 +1610,399 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                            DBTX
-	tx                            *sql.Tx
-	countProductsStmt             *sql.Stmt
-	createCategoryStmt            *sql.Stmt
-	createCustomerStmt            *sql.Stmt
-	createOrderStmt               *sql.Stmt
-	createOrderItemStmt           *sql.Stmt
-	createProductStmt             *sql.Stmt
-	deleteCategoryStmt            *sql.Stmt
-	deleteCustomerStmt            *sql.Stmt
-	deleteOrderStmt               *sql.Stmt
-	deleteProductStmt             *sql.Stmt
-	getCategoriesStmt             *sql.Stmt
-	getCategoryByIDStmt           *sql.Stmt
-	getCustomerByIDStmt           *sql.Stmt
-	getCustomersStmt              *sql.Stmt
-	getOrderByIDStmt              *sql.Stmt
-	getOrderItemsByOrderIDStmt    *sql.Stmt
-	getOrdersStmt                 *sql.Stmt
-	getProductByIDStmt            *sql.Stmt
-	getProductDashboardReportStmt *sql.Stmt
-	getRecentProductsStmt         *sql.Stmt
-	getTopCustomersStmt           *sql.Stmt
-	listProductsStmt              *sql.Stmt
-	updateCategoryStmt            *sql.Stmt
-	updateCustomerStmt            *sql.Stmt
-	updateProductStmt             *sql.Stmt
+	db                                       DBTX
+	tx                                       *sql.Tx
+	addOrderRefundTotalStmt                  *sql.Stmt
+	anonymiseCustomerStmt                    *sql.Stmt
+	anonymiseOrderShippingAddressesStmt      *sql.Stmt
+	anonymiseOutboxPayloadsStmt              *sql.Stmt
+	anonymiseWebhookDeliveryPayloadsStmt     *sql.Stmt
+	buryJobStmt                              *sql.Stmt
+	cancelPendingPaymentIntentsStmt          *sql.Stmt
+	cancelPriceScheduleStmt                  *sql.Stmt
+	claimWebhookDeliveryStmt                 *sql.Stmt
+	clearDefaultCustomerAddressStmt          *sql.Stmt
+	clearOrderCouponStmt                     *sql.Stmt
+	clearPrimaryProductImageStmt             *sql.Stmt
+	completeJobStmt                          *sql.Stmt
+	countCustomerAddressesStmt               *sql.Stmt
+	countCustomerPromotionRedemptionsStmt    *sql.Stmt
+	countCustomersStmt                       *sql.Stmt
+	countOrdersStmt                          *sql.Stmt
+	countOtherDefaultTaxRulesStmt            *sql.Stmt
+	countOverlappingPriceSchedulesStmt       *sql.Stmt
+	countProductPriceHistoryStmt             *sql.Stmt
+	countProductsStmt                        *sql.Stmt
+	countPromotionsStmt                      *sql.Stmt
+	countWebhookDeliveriesStmt               *sql.Stmt
+	createCategoryStmt                       *sql.Stmt
+	createCustomerStmt                       *sql.Stmt
+	createCustomerAccountStmt                *sql.Stmt
+	createCustomerAddressStmt                *sql.Stmt
+	createCustomerTokenStmt                  *sql.Stmt
+	createJobStmt                            *sql.Stmt
+	createOrderStmt                          *sql.Stmt
+	createOrderItemStmt                      *sql.Stmt
+	createOrderRevisionStmt                  *sql.Stmt
+	createOrderShipmentStmt                  *sql.Stmt
+	createOrderShippingAddressStmt           *sql.Stmt
+	createOutboxEventStmt                    *sql.Stmt
+	createPaymentIntentStmt                  *sql.Stmt
+	createPaymentWebhookEventStmt            *sql.Stmt
+	createProductStmt                        *sql.Stmt
+	createProductImageStmt                   *sql.Stmt
+	createProductPriceHistoryStmt            *sql.Stmt
+	createProductPriceScheduleStmt           *sql.Stmt
+	createProductVariantStmt                 *sql.Stmt
+	createPromotionStmt                      *sql.Stmt
+	createPromotionRedemptionStmt            *sql.Stmt
+	createReturnStmt                         *sql.Stmt
+	createReturnItemStmt                     *sql.Stmt
+	createShippingMethodStmt                 *sql.Stmt
+	createTaxRuleStmt                        *sql.Stmt
+	createWebhookDeliveryStmt                *sql.Stmt
+	createWebhookDeliveryAttemptStmt         *sql.Stmt
+	createWebhookEndpointStmt                *sql.Stmt
+	customerExistsStmt                       *sql.Stmt
+	deactivatePromotionStmt                  *sql.Stmt
+	decrementProductStockStmt                *sql.Stmt
+	decrementProductVariantStockStmt         *sql.Stmt
+	decrementPromotionUsageStmt              *sql.Stmt
+	deleteAllCustomerAddressesStmt           *sql.Stmt
+	deleteAllCustomerTokensStmt              *sql.Stmt
+	deleteCategoryStmt                       *sql.Stmt
+	deleteCompletedJobsBeforeStmt            *sql.Stmt
+	deleteCustomerStmt                       *sql.Stmt
+	deleteCustomerAddressStmt                *sql.Stmt
+	deleteCustomerEmailJobsStmt              *sql.Stmt
+	deleteCustomerTokenStmt                  *sql.Stmt
+	deleteCustomerTokensStmt                 *sql.Stmt
+	deleteOrderStmt                          *sql.Stmt
+	deleteOrderItemStmt                      *sql.Stmt
+	deleteProductStmt                        *sql.Stmt
+	deleteProductImageStmt                   *sql.Stmt
+	deleteProductVariantStmt                 *sql.Stmt
+	deletePromotionRedemptionByOrderStmt     *sql.Stmt
+	deleteShippingMethodStmt                 *sql.Stmt
+	deleteTaxRuleStmt                        *sql.Stmt
+	deleteWebhookEndpointStmt                *sql.Stmt
+	disableFailingWebhookEndpointStmt        *sql.Stmt
+	flagPaymentIntentForRefundStmt           *sql.Stmt
+	getCategoriesStmt                        *sql.Stmt
+	getCategoryByIDStmt                      *sql.Stmt
+	getCustomerAccountByIDStmt               *sql.Stmt
+	getCustomerAddressStmt                   *sql.Stmt
+	getCustomerByIDStmt                      *sql.Stmt
+	getCustomerCredentialsByEmailStmt        *sql.Stmt
+	getCustomerFavouriteCategoriesStmt       *sql.Stmt
+	getCustomerIDByEmailStmt                 *sql.Stmt
+	getCustomerOrderStatsStmt                *sql.Stmt
+	getCustomersStmt                         *sql.Stmt
+	getNextOrderRevisionStmt                 *sql.Stmt
+	getNextProductImagePositionStmt          *sql.Stmt
+	getNotificationPreferencesStmt           *sql.Stmt
+	getOrderByIDStmt                         *sql.Stmt
+	getOrderForReturnStmt                    *sql.Stmt
+	getOrderForUpdateStmt                    *sql.Stmt
+	getOrderItemSnapshotStmt                 *sql.Stmt
+	getOrderItemsByOrderIDStmt               *sql.Stmt
+	getOrderPaymentInfoStmt                  *sql.Stmt
+	getOrderPaymentInfoForUpdateStmt         *sql.Stmt
+	getOrderShipmentStmt                     *sql.Stmt
+	getOrdersStmt                            *sql.Stmt
+	getPaymentIntentByIDStmt                 *sql.Stmt
+	getPaymentIntentByReferenceForUpdateStmt *sql.Stmt
+	getPendingPaymentIntentByOrderStmt       *sql.Stmt
+	getProductByIDStmt                       *sql.Stmt
+	getProductCategoryIDStmt                 *sql.Stmt
+	getProductDashboardReportStmt            *sql.Stmt
+	getProductDimensionsStmt                 *sql.Stmt
+	getProductImageByIDStmt                  *sql.Stmt
+	getProductPriceForUpdateStmt             *sql.Stmt
+	getProductPriceScheduleStmt              *sql.Stmt
+	getProductPriceScheduleForUpdateStmt     *sql.Stmt
+	getProductStockStmt                      *sql.Stmt
+	getProductVariantByIDStmt                *sql.Stmt
+	getProductVariantIsActiveStmt            *sql.Stmt
+	getProductVariantStockStmt               *sql.Stmt
+	getPromotionByCodeForUpdateStmt          *sql.Stmt
+	getPromotionByIDStmt                     *sql.Stmt
+	getRecentProductsStmt                    *sql.Stmt
+	getReturnByIDStmt                        *sql.Stmt
+	getRevenueReportStmt                     *sql.Stmt
+	getShippingMethodByIDStmt                *sql.Stmt
+	getTaxRuleByIDStmt                       *sql.Stmt
+	getTopCustomersStmt                      *sql.Stmt
+	getValidCustomerTokenStmt                *sql.Stmt
+	getWebhookDeliveryStmt                   *sql.Stmt
+	getWebhookEndpointByIDStmt               *sql.Stmt
+	incrementOrderItemReturnedQuantityStmt   *sql.Stmt
+	incrementProductStockStmt                *sql.Stmt
+	incrementProductVariantStockStmt         *sql.Stmt
+	incrementPromotionUsageStmt              *sql.Stmt
+	incrementWebhookEndpointFailuresStmt     *sql.Stmt
+	listActiveShippingMethodsStmt            *sql.Stmt
+	listActiveTaxRulesStmt                   *sql.Stmt
+	listCategoryNamesStmt                    *sql.Stmt
+	listClaimableJobsStmt                    *sql.Stmt
+	listCustomerAddressesStmt                *sql.Stmt
+	listDeadJobsStmt                         *sql.Stmt
+	listDuePriceSchedulesStmt                *sql.Stmt
+	listDueWebhookDeliveriesStmt             *sql.Stmt
+	listOrderRevisionsStmt                   *sql.Stmt
+	listPaymentIntentsByOrderStmt            *sql.Stmt
+	listPendingOutboxEventsStmt              *sql.Stmt
+	listProductImagesByProductIDStmt         *sql.Stmt
+	listProductKeysForImportStmt             *sql.Stmt
+	listProductPriceHistoryStmt              *sql.Stmt
+	listProductPriceSchedulesStmt            *sql.Stmt
+	listProductVariantsByProductIDStmt       *sql.Stmt
+	listProductsStmt                         *sql.Stmt
+	listPromotionsStmt                       *sql.Stmt
+	listReturnItemsByOrderStmt               *sql.Stmt
+	listReturnsByOrderStmt                   *sql.Stmt
+	listShippingMethodsStmt                  *sql.Stmt
+	listTaxRulesStmt                         *sql.Stmt
+	listWebhookDeliveriesStmt                *sql.Stmt
+	listWebhookDeliveryAttemptsStmt          *sql.Stmt
+	listWebhookEndpointsStmt                 *sql.Stmt
+	listWebhookEndpointsForEventStmt         *sql.Stmt
+	lockCustomerStmt                         *sql.Stmt
+	markCustomerEmailVerifiedStmt            *sql.Stmt
+	markJobRunningStmt                       *sql.Stmt
+	markOutboxEventFailedStmt                *sql.Stmt
+	markOutboxEventPublishedStmt             *sql.Stmt
+	markReturnRefundedStmt                   *sql.Stmt
+	productExistsStmt                        *sql.Stmt
+	productHasActiveVariantsStmt             *sql.Stmt
+	promoteDefaultCustomerAddressStmt        *sql.Stmt
+	redeliverWebhookDeliveryStmt             *sql.Stmt
+	requeueDeadJobStmt                       *sql.Stmt
+	resetWebhookEndpointFailuresStmt         *sql.Stmt
+	retryJobStmt                             *sql.Stmt
+	setPrimaryProductImageStmt               *sql.Stmt
+	updateCategoryStmt                       *sql.Stmt
+	updateCustomerStmt                       *sql.Stmt
+	updateCustomerAddressStmt                *sql.Stmt
+	updateCustomerPasswordStmt               *sql.Stmt
+	updateCustomerProfileStmt                *sql.Stmt
+	updateImportedProductStmt                *sql.Stmt
+	updateOrderItemStmt                      *sql.Stmt
+	updateOrderPaymentStatusStmt             *sql.Stmt
+	updateOrderStatusStmt                    *sql.Stmt
+	updateOrderTotalsStmt                    *sql.Stmt
+	updatePaymentIntentStatusStmt            *sql.Stmt
+	updatePriceScheduleStateStmt             *sql.Stmt
+	updateProductStmt                        *sql.Stmt
+	updateProductImagePositionStmt           *sql.Stmt
+	updateProductPriceStmt                   *sql.Stmt
+	updateProductVariantStmt                 *sql.Stmt
+	updatePromotionStmt                      *sql.Stmt
+	updatePromotionRedemptionDiscountStmt    *sql.Stmt
+	updateShippingMethodStmt                 *sql.Stmt
+	updateTaxRuleStmt                        *sql.Stmt
+	updateWebhookDeliveryResultStmt          *sql.Stmt
+	updateWebhookEndpointStmt                *sql.Stmt
+	upsertNotificationPreferencesStmt        *sql.Stmt
+	useCustomerTokenStmt                     *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                            tx,
-		tx:                            tx,
-		countProductsStmt:             q.countProductsStmt,
-		createCategoryStmt:            q.createCategoryStmt,
-		createCustomerStmt:            q.createCustomerStmt,
-		createOrderStmt:               q.createOrderStmt,
-		createOrderItemStmt:           q.createOrderItemStmt,
-		createProductStmt:             q.createProductStmt,
-		deleteCategoryStmt:            q.deleteCategoryStmt,
-		deleteCustomerStmt:            q.deleteCustomerStmt,
-		deleteOrderStmt:               q.deleteOrderStmt,
-		deleteProductStmt:             q.deleteProductStmt,
-		getCategoriesStmt:             q.getCategoriesStmt,
-		getCategoryByIDStmt:           q.getCategoryByIDStmt,
-		getCustomerByIDStmt:           q.getCustomerByIDStmt,
-		getCustomersStmt:              q.getCustomersStmt,
-		getOrderByIDStmt:              q.getOrderByIDStmt,
-		getOrderItemsByOrderIDStmt:    q.getOrderItemsByOrderIDStmt,
-		getOrdersStmt:                 q.getOrdersStmt,
-		getProductByIDStmt:            q.getProductByIDStmt,
-		getProductDashboardReportStmt: q.getProductDashboardReportStmt,
-		getRecentProductsStmt:         q.getRecentProductsStmt,
-		getTopCustomersStmt:           q.getTopCustomersStmt,
-		listProductsStmt:              q.listProductsStmt,
-		updateCategoryStmt:            q.updateCategoryStmt,
-		updateCustomerStmt:            q.updateCustomerStmt,
-		updateProductStmt:             q.updateProductStmt,
+		db:                                       tx,
+		tx:                                       tx,
+		addOrderRefundTotalStmt:                  q.addOrderRefundTotalStmt,
+		anonymiseCustomerStmt:                    q.anonymiseCustomerStmt,
+		anonymiseOrderShippingAddressesStmt:      q.anonymiseOrderShippingAddressesStmt,
+		anonymiseOutboxPayloadsStmt:              q.anonymiseOutboxPayloadsStmt,
+		anonymiseWebhookDeliveryPayloadsStmt:     q.anonymiseWebhookDeliveryPayloadsStmt,
+		buryJobStmt:                              q.buryJobStmt,
+		cancelPendingPaymentIntentsStmt:          q.cancelPendingPaymentIntentsStmt,
+		cancelPriceScheduleStmt:                  q.cancelPriceScheduleStmt,
+		claimWebhookDeliveryStmt:                 q.claimWebhookDeliveryStmt,
+		clearDefaultCustomerAddressStmt:          q.clearDefaultCustomerAddressStmt,
+		clearOrderCouponStmt:                     q.clearOrderCouponStmt,
+		clearPrimaryProductImageStmt:             q.clearPrimaryProductImageStmt,
+		completeJobStmt:                          q.completeJobStmt,
+		countCustomerAddressesStmt:               q.countCustomerAddressesStmt,
+		countCustomerPromotionRedemptionsStmt:    q.countCustomerPromotionRedemptionsStmt,
+		countCustomersStmt:                       q.countCustomersStmt,
+		countOrdersStmt:                          q.countOrdersStmt,
+		countOtherDefaultTaxRulesStmt:            q.countOtherDefaultTaxRulesStmt,
+		countOverlappingPriceSchedulesStmt:       q.countOverlappingPriceSchedulesStmt,
+		countProductPriceHistoryStmt:             q.countProductPriceHistoryStmt,
+		countProductsStmt:                        q.countProductsStmt,
+		countPromotionsStmt:                      q.countPromotionsStmt,
+		countWebhookDeliveriesStmt:               q.countWebhookDeliveriesStmt,
+		createCategoryStmt:                       q.createCategoryStmt,
+		createCustomerStmt:                       q.createCustomerStmt,
+		createCustomerAccountStmt:                q.createCustomerAccountStmt,
+		createCustomerAddressStmt:                q.createCustomerAddressStmt,
+		createCustomerTokenStmt:                  q.createCustomerTokenStmt,
+		createJobStmt:                            q.createJobStmt,
+		createOrderStmt:                          q.createOrderStmt,
+		createOrderItemStmt:                      q.createOrderItemStmt,
+		createOrderRevisionStmt:                  q.createOrderRevisionStmt,
+		createOrderShipmentStmt:                  q.createOrderShipmentStmt,
+		createOrderShippingAddressStmt:           q.createOrderShippingAddressStmt,
+		createOutboxEventStmt:                    q.createOutboxEventStmt,
+		createPaymentIntentStmt:                  q.createPaymentIntentStmt,
+		createPaymentWebhookEventStmt:            q.createPaymentWebhookEventStmt,
+		createProductStmt:                        q.createProductStmt,
+		createProductImageStmt:                   q.createProductImageStmt,
+		createProductPriceHistoryStmt:            q.createProductPriceHistoryStmt,
+		createProductPriceScheduleStmt:           q.createProductPriceScheduleStmt,
+		createProductVariantStmt:                 q.createProductVariantStmt,
+		createPromotionStmt:                      q.createPromotionStmt,
+		createPromotionRedemptionStmt:            q.createPromotionRedemptionStmt,
+		createReturnStmt:                         q.createReturnStmt,
+		createReturnItemStmt:                     q.createReturnItemStmt,
+		createShippingMethodStmt:                 q.createShippingMethodStmt,
+		createTaxRuleStmt:                        q.createTaxRuleStmt,
+		createWebhookDeliveryStmt:                q.createWebhookDeliveryStmt,
+		createWebhookDeliveryAttemptStmt:         q.createWebhookDeliveryAttemptStmt,
+		createWebhookEndpointStmt:                q.createWebhookEndpointStmt,
+		customerExistsStmt:                       q.customerExistsStmt,
+		deactivatePromotionStmt:                  q.deactivatePromotionStmt,
+		decrementProductStockStmt:                q.decrementProductStockStmt,
+		decrementProductVariantStockStmt:         q.decrementProductVariantStockStmt,
+		decrementPromotionUsageStmt:              q.decrementPromotionUsageStmt,
+		deleteAllCustomerAddressesStmt:           q.deleteAllCustomerAddressesStmt,
+		deleteAllCustomerTokensStmt:              q.deleteAllCustomerTokensStmt,
+		deleteCategoryStmt:                       q.deleteCategoryStmt,
+		deleteCompletedJobsBeforeStmt:            q.deleteCompletedJobsBeforeStmt,
+		deleteCustomerStmt:                       q.deleteCustomerStmt,
+		deleteCustomerAddressStmt:                q.deleteCustomerAddressStmt,
+		deleteCustomerEmailJobsStmt:              q.deleteCustomerEmailJobsStmt,
+		deleteCustomerTokenStmt:                  q.deleteCustomerTokenStmt,
+		deleteCustomerTokensStmt:                 q.deleteCustomerTokensStmt,
+		deleteOrderStmt:                          q.deleteOrderStmt,
+		deleteOrderItemStmt:                      q.deleteOrderItemStmt,
+		deleteProductStmt:                        q.deleteProductStmt,
+		deleteProductImageStmt:                   q.deleteProductImageStmt,
+		deleteProductVariantStmt:                 q.deleteProductVariantStmt,
+		deletePromotionRedemptionByOrderStmt:     q.deletePromotionRedemptionByOrderStmt,
+		deleteShippingMethodStmt:                 q.deleteShippingMethodStmt,
+		deleteTaxRuleStmt:                        q.deleteTaxRuleStmt,
+		deleteWebhookEndpointStmt:                q.deleteWebhookEndpointStmt,
+		disableFailingWebhookEndpointStmt:        q.disableFailingWebhookEndpointStmt,
+		flagPaymentIntentForRefundStmt:           q.flagPaymentIntentForRefundStmt,
+		getCategoriesStmt:                        q.getCategoriesStmt,
+		getCategoryByIDStmt:                      q.getCategoryByIDStmt,
+		getCustomerAccountByIDStmt:               q.getCustomerAccountByIDStmt,
+		getCustomerAddressStmt:                   q.getCustomerAddressStmt,
+		getCustomerByIDStmt:                      q.getCustomerByIDStmt,
+		getCustomerCredentialsByEmailStmt:        q.getCustomerCredentialsByEmailStmt,
+		getCustomerFavouriteCategoriesStmt:       q.getCustomerFavouriteCategoriesStmt,
+		getCustomerIDByEmailStmt:                 q.getCustomerIDByEmailStmt,
+		getCustomerOrderStatsStmt:                q.getCustomerOrderStatsStmt,
+		getCustomersStmt:                         q.getCustomersStmt,
+		getNextOrderRevisionStmt:                 q.getNextOrderRevisionStmt,
+		getNextProductImagePositionStmt:          q.getNextProductImagePositionStmt,
+		getNotificationPreferencesStmt:           q.getNotificationPreferencesStmt,
+		getOrderByIDStmt:                         q.getOrderByIDStmt,
+		getOrderForReturnStmt:                    q.getOrderForReturnStmt,
+		getOrderForUpdateStmt:                    q.getOrderForUpdateStmt,
+		getOrderItemSnapshotStmt:                 q.getOrderItemSnapshotStmt,
+		getOrderItemsByOrderIDStmt:               q.getOrderItemsByOrderIDStmt,
+		getOrderPaymentInfoStmt:                  q.getOrderPaymentInfoStmt,
+		getOrderPaymentInfoForUpdateStmt:         q.getOrderPaymentInfoForUpdateStmt,
+		getOrderShipmentStmt:                     q.getOrderShipmentStmt,
+		getOrdersStmt:                            q.getOrdersStmt,
+		getPaymentIntentByIDStmt:                 q.getPaymentIntentByIDStmt,
+		getPaymentIntentByReferenceForUpdateStmt: q.getPaymentIntentByReferenceForUpdateStmt,
+		getPendingPaymentIntentByOrderStmt:       q.getPendingPaymentIntentByOrderStmt,
+		getProductByIDStmt:                       q.getProductByIDStmt,
+		getProductCategoryIDStmt:                 q.getProductCategoryIDStmt,
+		getProductDashboardReportStmt:            q.getProductDashboardReportStmt,
+		getProductDimensionsStmt:                 q.getProductDimensionsStmt,
+		getProductImageByIDStmt:                  q.getProductImageByIDStmt,
+		getProductPriceForUpdateStmt:             q.getProductPriceForUpdateStmt,
+		getProductPriceScheduleStmt:              q.getProductPriceScheduleStmt,
+		getProductPriceScheduleForUpdateStmt:     q.getProductPriceScheduleForUpdateStmt,
+		getProductStockStmt:                      q.getProductStockStmt,
+		getProductVariantByIDStmt:                q.getProductVariantByIDStmt,
+		getProductVariantIsActiveStmt:            q.getProductVariantIsActiveStmt,
+		getProductVariantStockStmt:               q.getProductVariantStockStmt,
+		getPromotionByCodeForUpdateStmt:          q.getPromotionByCodeForUpdateStmt,
+		getPromotionByIDStmt:                     q.getPromotionByIDStmt,
+		getRecentProductsStmt:                    q.getRecentProductsStmt,
+		getReturnByIDStmt:                        q.getReturnByIDStmt,
+		getRevenueReportStmt:                     q.getRevenueReportStmt,
+		getShippingMethodByIDStmt:                q.getShippingMethodByIDStmt,
+		getTaxRuleByIDStmt:                       q.getTaxRuleByIDStmt,
+		getTopCustomersStmt:                      q.getTopCustomersStmt,
+		getValidCustomerTokenStmt:                q.getValidCustomerTokenStmt,
+		getWebhookDeliveryStmt:                   q.getWebhookDeliveryStmt,
+		getWebhookEndpointByIDStmt:               q.getWebhookEndpointByIDStmt,
+		incrementOrderItemReturnedQuantityStmt:   q.incrementOrderItemReturnedQuantityStmt,
+		incrementProductStockStmt:                q.incrementProductStockStmt,
+		incrementProductVariantStockStmt:         q.incrementProductVariantStockStmt,
+		incrementPromotionUsageStmt:              q.incrementPromotionUsageStmt,
+		incrementWebhookEndpointFailuresStmt:     q.incrementWebhookEndpointFailuresStmt,
+		listActiveShippingMethodsStmt:            q.listActiveShippingMethodsStmt,
+		listActiveTaxRulesStmt:                   q.listActiveTaxRulesStmt,
+		listCategoryNamesStmt:                    q.listCategoryNamesStmt,
+		listClaimableJobsStmt:                    q.listClaimableJobsStmt,
+		listCustomerAddressesStmt:                q.listCustomerAddressesStmt,
+		listDeadJobsStmt:                         q.listDeadJobsStmt,
+		listDuePriceSchedulesStmt:                q.listDuePriceSchedulesStmt,
+		listDueWebhookDeliveriesStmt:             q.listDueWebhookDeliveriesStmt,
+		listOrderRevisionsStmt:                   q.listOrderRevisionsStmt,
+		listPaymentIntentsByOrderStmt:            q.listPaymentIntentsByOrderStmt,
+		listPendingOutboxEventsStmt:              q.listPendingOutboxEventsStmt,
+		listProductImagesByProductIDStmt:         q.listProductImagesByProductIDStmt,
+		listProductKeysForImportStmt:             q.listProductKeysForImportStmt,
+		listProductPriceHistoryStmt:              q.listProductPriceHistoryStmt,
+		listProductPriceSchedulesStmt:            q.listProductPriceSchedulesStmt,
+		listProductVariantsByProductIDStmt:       q.listProductVariantsByProductIDStmt,
+		listProductsStmt:                         q.listProductsStmt,
+		listPromotionsStmt:                       q.listPromotionsStmt,
+		listReturnItemsByOrderStmt:               q.listReturnItemsByOrderStmt,
+		listReturnsByOrderStmt:                   q.listReturnsByOrderStmt,
+		listShippingMethodsStmt:                  q.listShippingMethodsStmt,
+		listTaxRulesStmt:                         q.listTaxRulesStmt,
+		listWebhookDeliveriesStmt:                q.listWebhookDeliveriesStmt,
+		listWebhookDeliveryAttemptsStmt:          q.listWebhookDeliveryAttemptsStmt,
+		listWebhookEndpointsStmt:                 q.listWebhookEndpointsStmt,
+		listWebhookEndpointsForEventStmt:         q.listWebhookEndpointsForEventStmt,
+		lockCustomerStmt:                         q.lockCustomerStmt,
+		markCustomerEmailVerifiedStmt:            q.markCustomerEmailVerifiedStmt,
+		markJobRunningStmt:                       q.markJobRunningStmt,
+		markOutboxEventFailedStmt:                q.markOutboxEventFailedStmt,
+		markOutboxEventPublishedStmt:             q.markOutboxEventPublishedStmt,
+		markReturnRefundedStmt:                   q.markReturnRefundedStmt,
+		productExistsStmt:                        q.productExistsStmt,
+		productHasActiveVariantsStmt:             q.productHasActiveVariantsStmt,
+		promoteDefaultCustomerAddressStmt:        q.promoteDefaultCustomerAddressStmt,
+		redeliverWebhookDeliveryStmt:             q.redeliverWebhookDeliveryStmt,
+		requeueDeadJobStmt:                       q.requeueDeadJobStmt,
+		resetWebhookEndpointFailuresStmt:         q.resetWebhookEndpointFailuresStmt,
+		retryJobStmt:                             q.retryJobStmt,
+		setPrimaryProductImageStmt:               q.setPrimaryProductImageStmt,
+		updateCategoryStmt:                       q.updateCategoryStmt,
+		updateCustomerStmt:                       q.updateCustomerStmt,
+		updateCustomerAddressStmt:                q.updateCustomerAddressStmt,
+		updateCustomerPasswordStmt:               q.updateCustomerPasswordStmt,
+		updateCustomerProfileStmt:                q.updateCustomerProfileStmt,
+		updateImportedProductStmt:                q.updateImportedProductStmt,
+		updateOrderItemStmt:                      q.updateOrderItemStmt,
+		updateOrderPaymentStatusStmt:             q.updateOrderPaymentStatusStmt,
+		updateOrderStatusStmt:                    q.updateOrderStatusStmt,
+		updateOrderTotalsStmt:                    q.updateOrderTotalsStmt,
+		updatePaymentIntentStatusStmt:            q.updatePaymentIntentStatusStmt,
+		updatePriceScheduleStateStmt:             q.updatePriceScheduleStateStmt,
+		updateProductStmt:                        q.updateProductStmt,
+		updateProductImagePositionStmt:           q.updateProductImagePositionStmt,
+		updateProductPriceStmt:                   q.updateProductPriceStmt,
+		updateProductVariantStmt:                 q.updateProductVariantStmt,
+		updatePromotionStmt:                      q.updatePromotionStmt,
+		updatePromotionRedemptionDiscountStmt:    q.updatePromotionRedemptionDiscountStmt,
+		updateShippingMethodStmt:                 q.updateShippingMethodStmt,
+		updateTaxRuleStmt:                        q.updateTaxRuleStmt,
+		updateWebhookDeliveryResultStmt:          q.updateWebhookDeliveryResultStmt,
+		updateWebhookEndpointStmt:                q.updateWebhookEndpointStmt,
+		upsertNotificationPreferencesStmt:        q.upsertNotificationPreferencesStmt,
+		useCustomerTokenStmt:                     q.useCustomerTokenStmt,
 	}
 }
@@ -6,6 +6,7 @@ package dbgen
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -21,37 +22,345 @@ type Category struct {
 }
 
 type Customer struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Email           string         `json:"email"`
+	PasswordHash    sql.NullString `json:"password_hash"`
+	EmailVerifiedAt sql.NullTime   `json:"email_verified_at"`
+	AnonymisedAt    sql.NullTime   `json:"anonymised_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
+
+type CustomerAddress struct {
+	ID            string    `json:"id"`
+	CustomerID    string    `json:"customer_id"`
+	Label         string    `json:"label"`
+	RecipientName string    `json:"recipient_name"`
+	Phone         string    `json:"phone"`
+	Street        string    `json:"street"`
+	City          string    `json:"city"`
+	Province      string    `json:"province"`
+	PostalCode    string    `json:"postal_code"`
+	IsDefault     bool      `json:"is_default"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type CustomerToken struct {
+	TokenHash  string       `json:"token_hash"`
+	CustomerID string       `json:"customer_id"`
+	Purpose    string       `json:"purpose"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+	UsedAt     sql.NullTime `json:"used_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type Job struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	Attempts    int32           `json:"attempts"`
+	MaxAttempts int32           `json:"max_attempts"`
+	UniqueKey   sql.NullString  `json:"unique_key"`
+	RunAt       time.Time       `json:"run_at"`
+	LockedUntil sql.NullTime    `json:"locked_until"`
+	LastError   sql.NullString  `json:"last_error"`
+	CompletedAt sql.NullTime    `json:"completed_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type NotificationPreference struct {
+	CustomerID        string    `json:"customer_id"`
+	OrderConfirmation bool      `json:"order_confirmation"`
+	OrderShipped      bool      `json:"order_shipped"`
+	OrderCancelled    bool      `json:"order_cancelled"`
+	UpdatedAt         time.Time `json:"updated_at"`
 }
 
 type Order struct {
+	ID                 string          `json:"id"`
+	CustomerID         string          `json:"customer_id"`
+	Status             string          `json:"status"`
+	TotalQuantity      int32           `json:"total_quantity"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	DiscountTotal      decimal.Decimal `json:"discount_total"`
+	TaxTotal           decimal.Decimal `json:"tax_total"`
+	ShippingTotal      decimal.Decimal `json:"shipping_total"`
+	ShippingMethodID   sql.NullString  `json:"shipping_method_id"`
+	ShippingMethodName sql.NullString  `json:"shipping_method_name"`
+	TotalPrice         decimal.Decimal `json:"total_price"`
+	RefundTotal        decimal.Decimal `json:"refund_total"`
+	PromotionID        sql.NullString  `json:"promotion_id"`
+	CouponCode         sql.NullString  `json:"coupon_code"`
+	PaymentStatus      string          `json:"payment_status"`
+	PaidAt             sql.NullTime    `json:"paid_at"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+type OrderItem struct {
+	ID               string          `json:"id"`
+	OrderID          string          `json:"order_id"`
+	ProductID        sql.NullString  `json:"product_id"`
+	VariantID        sql.NullString  `json:"variant_id"`
+	VariantSku       sql.NullString  `json:"variant_sku"`
+	ProductName      string          `json:"product_name"`
+	Sku              sql.NullString  `json:"sku"`
+	CategoryName     string          `json:"category_name"`
+	Quantity         int32           `json:"quantity"`
+	UnitPrice        decimal.Decimal `json:"unit_price"`
+	DiscountAmount   decimal.Decimal `json:"discount_amount"`
+	PromotionID      sql.NullString  `json:"promotion_id"`
+	TaxRate          decimal.Decimal `json:"tax_rate"`
+	TaxAmount        decimal.Decimal `json:"tax_amount"`
+	TaxInclusive     bool            `json:"tax_inclusive"`
+	LineTotal        decimal.Decimal `json:"line_total"`
+	ReturnedQuantity int32           `json:"returned_quantity"`
+}
+
+type OrderRevision struct {
 	ID            string          `json:"id"`
-	CustomerID    string          `json:"customer_id"`
-	TotalQuantity int32           `json:"total_quantity"`
-	TotalPrice    decimal.Decimal `json:"total_price"`
+	OrderID       string          `json:"order_id"`
+	Revision      int32           `json:"revision"`
+	Changes       json.RawMessage `json:"changes"`
+	PreviousTotal decimal.Decimal `json:"previous_total"`
+	NewTotal      decimal.Decimal `json:"new_total"`
+	Note          sql.NullString  `json:"note"`
 	CreatedAt     time.Time       `json:"created_at"`
 }
 
-type OrderItem struct {
+type OrderShipment struct {
+	OrderID        string    `json:"order_id"`
+	Carrier        string    `json:"carrier"`
+	TrackingNumber string    `json:"tracking_number"`
+	ShippedAt      time.Time `json:"shipped_at"`
+}
+
+type OrderShippingAddress struct {
+	OrderID       string         `json:"order_id"`
+	AddressID     sql.NullString `json:"address_id"`
+	RecipientName string         `json:"recipient_name"`
+	Phone         string         `json:"phone"`
+	Street        string         `json:"street"`
+	City          string         `json:"city"`
+	Province      string         `json:"province"`
+	PostalCode    string         `json:"postal_code"`
+}
+
+type Outbox struct {
+	ID            string          `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	Attempts      int32           `json:"attempts"`
+	LastError     sql.NullString  `json:"last_error"`
+	AvailableAt   time.Time       `json:"available_at"`
+	PublishedAt   sql.NullTime    `json:"published_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type PaymentIntent struct {
+	ID               string          `json:"id"`
+	OrderID          string          `json:"order_id"`
+	Gateway          string          `json:"gateway"`
+	GatewayReference string          `json:"gateway_reference"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+	Status           string          `json:"status"`
+	PaymentUrl       sql.NullString  `json:"payment_url"`
+	FailureReason    sql.NullString  `json:"failure_reason"`
+	RefundReason     sql.NullString  `json:"refund_reason"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+type PaymentWebhookEvent struct {
 	ID        string          `json:"id"`
-	OrderID   string          `json:"order_id"`
-	ProductID string          `json:"product_id"`
-	Quantity  int32           `json:"quantity"`
-	UnitPrice decimal.Decimal `json:"unit_price"`
+	Gateway   string          `json:"gateway"`
+	EventID   string          `json:"event_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type Product struct {
 	ID            string          `json:"id"`
+	Sku           sql.NullString  `json:"sku"`
 	Name          string          `json:"name"`
 	Description   sql.NullString  `json:"description"`
 	Price         decimal.Decimal `json:"price"`
 	CategoryID    string          `json:"category_id"`
 	StockQuantity int32           `json:"stock_quantity"`
+	WeightGrams   int32           `json:"weight_grams"`
+	LengthCm      int32           `json:"length_cm"`
+	WidthCm       int32           `json:"width_cm"`
+	HeightCm      int32           `json:"height_cm"`
 	IsActive      bool            `json:"is_active"`
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 }
+
+type ProductImage struct {
+	ID           string    `json:"id"`
+	ProductID    string    `json:"product_id"`
+	StorageKey   string    `json:"storage_key"`
+	ThumbnailKey string    `json:"thumbnail_key"`
+	Url          string    `json:"url"`
+	ThumbnailUrl string    `json:"thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	Width        int32     `json:"width"`
+	Height       int32     `json:"height"`
+	Position     int32     `json:"position"`
+	IsPrimary    bool      `json:"is_primary"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type ProductPriceHistory struct {
+	ID         string              `json:"id"`
+	ProductID  string              `json:"product_id"`
+	OldPrice   decimal.NullDecimal `json:"old_price"`
+	NewPrice   decimal.Decimal     `json:"new_price"`
+	Source     string              `json:"source"`
+	ScheduleID sql.NullString      `json:"schedule_id"`
+	ChangedAt  time.Time           `json:"changed_at"`
+}
+
+type ProductPriceSchedule struct {
+	ID            string              `json:"id"`
+	ProductID     string              `json:"product_id"`
+	Price         decimal.Decimal     `json:"price"`
+	EffectiveFrom time.Time           `json:"effective_from"`
+	EffectiveTo   sql.NullTime        `json:"effective_to"`
+	Status        string              `json:"status"`
+	PreviousPrice decimal.NullDecimal `json:"previous_price"`
+	AppliedAt     sql.NullTime        `json:"applied_at"`
+	CreatedAt     time.Time           `json:"created_at"`
+}
+
+type ProductVariant struct {
+	ID            string              `json:"id"`
+	ProductID     string              `json:"product_id"`
+	Sku           string              `json:"sku"`
+	Attributes    json.RawMessage     `json:"attributes"`
+	Price         decimal.NullDecimal `json:"price"`
+	StockQuantity int32               `json:"stock_quantity"`
+	IsActive      bool                `json:"is_active"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+type Promotion struct {
+	ID                    string              `json:"id"`
+	Code                  string              `json:"code"`
+	Name                  string              `json:"name"`
+	Type                  string              `json:"type"`
+	Value                 decimal.Decimal     `json:"value"`
+	BuyQuantity           sql.NullInt32       `json:"buy_quantity"`
+	GetQuantity           sql.NullInt32       `json:"get_quantity"`
+	CategoryID            sql.NullString      `json:"category_id"`
+	MinSubtotal           decimal.NullDecimal `json:"min_subtotal"`
+	MaxDiscount           decimal.NullDecimal `json:"max_discount"`
+	UsageLimit            sql.NullInt32       `json:"usage_limit"`
+	UsageLimitPerCustomer sql.NullInt32       `json:"usage_limit_per_customer"`
+	UsedCount             int32               `json:"used_count"`
+	StartsAt              sql.NullTime        `json:"starts_at"`
+	EndsAt                sql.NullTime        `json:"ends_at"`
+	IsActive              bool                `json:"is_active"`
+	CreatedAt             time.Time           `json:"created_at"`
+	UpdatedAt             time.Time           `json:"updated_at"`
+}
+
+type PromotionRedemption struct {
+	ID             string          `json:"id"`
+	PromotionID    string          `json:"promotion_id"`
+	CustomerID     string          `json:"customer_id"`
+	OrderID        string          `json:"order_id"`
+	DiscountAmount decimal.Decimal `json:"discount_amount"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type Return struct {
+	ID           string          `json:"id"`
+	OrderID      string          `json:"order_id"`
+	Status       string          `json:"status"`
+	Reason       sql.NullString  `json:"reason"`
+	RefundAmount decimal.Decimal `json:"refund_amount"`
+	RefundedAt   sql.NullTime    `json:"refunded_at"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+type ReturnItem struct {
+	ID           string          `json:"id"`
+	ReturnID     string          `json:"return_id"`
+	OrderItemID  string          `json:"order_item_id"`
+	Quantity     int32           `json:"quantity"`
+	RefundAmount decimal.Decimal `json:"refund_amount"`
+}
+
+type ShippingMethod struct {
+	ID            string              `json:"id"`
+	Code          string              `json:"code"`
+	Name          string              `json:"name"`
+	RateType      string              `json:"rate_type"`
+	BaseRate      decimal.Decimal     `json:"base_rate"`
+	RatePerKg     decimal.Decimal     `json:"rate_per_kg"`
+	FreeThreshold decimal.NullDecimal `json:"free_threshold"`
+	IsActive      bool                `json:"is_active"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+type TaxRule struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	CategoryID  sql.NullString  `json:"category_id"`
+	Rate        decimal.Decimal `json:"rate"`
+	IsInclusive bool            `json:"is_inclusive"`
+	IsActive    bool            `json:"is_active"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID               string          `json:"id"`
+	EndpointID       string          `json:"endpoint_id"`
+	EventID          string          `json:"event_id"`
+	EventType        string          `json:"event_type"`
+	Payload          json.RawMessage `json:"payload"`
+	Status           string          `json:"status"`
+	Attempts         int32           `json:"attempts"`
+	NextAttemptAt    time.Time       `json:"next_attempt_at"`
+	LastResponseCode sql.NullInt32   `json:"last_response_code"`
+	LastError        sql.NullString  `json:"last_error"`
+	DeliveredAt      sql.NullTime    `json:"delivered_at"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID           string         `json:"id"`
+	DeliveryID   string         `json:"delivery_id"`
+	ResponseCode sql.NullInt32  `json:"response_code"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	DurationMs   int32          `json:"duration_ms"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type WebhookEndpoint struct {
+	ID                  string          `json:"id"`
+	Url                 string          `json:"url"`
+	Description         sql.NullString  `json:"description"`
+	Secret              string          `json:"secret"`
+	EventTypes          json.RawMessage `json:"event_types"`
+	IsActive            bool            `json:"is_active"`
+	ConsecutiveFailures int32           `json:"consecutive_failures"`
+	DisabledReason      sql.NullString  `json:"disabled_reason"`
+	DisabledAt          sql.NullTime    `json:"disabled_at"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
@@ -7,31 +7,168 @@ package dbgen
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
+const anonymiseOrderShippingAddresses = `-- name: AnonymiseOrderShippingAddresses :exec
+UPDATE order_shipping_addresses sa
+JOIN orders o ON o.id = sa.order_id
+SET
+    sa.address_id = NULL,
+    sa.recipient_name = ?,
+    sa.phone = '',
+    sa.street = ''
+WHERE
+    o.customer_id = ?
+`
+
+type AnonymiseOrderShippingAddressesParams struct {
+	RecipientName string `json:"recipient_name"`
+	CustomerID    string `json:"customer_id"`
+}
+
+// Kota, provinsi & kode pos dipertahankan untuk pelaporan pajak & ongkir
+func (q *Queries) AnonymiseOrderShippingAddresses(ctx context.Context, arg AnonymiseOrderShippingAddressesParams) error {
+	_, err := q.exec(ctx, q.anonymiseOrderShippingAddressesStmt, anonymiseOrderShippingAddresses, arg.RecipientName, arg.CustomerID)
+	return err
+}
+
+const clearOrderCoupon = `-- name: ClearOrderCoupon :exec
+UPDATE orders
+SET
+    promotion_id = NULL,
+    coupon_code = NULL
+WHERE
+    id = ?
+`
+
+func (q *Queries) ClearOrderCoupon(ctx context.Context, id string) error {
+	_, err := q.exec(ctx, q.clearOrderCouponStmt, clearOrderCoupon, id)
+	return err
+}
+
+const countOrders = `-- name: CountOrders :one
+SELECT
+    COUNT(*) AS total
+FROM
+    orders o
+WHERE
+    (
+        ? = ''
+        OR o.customer_id = ?
+    )
+    AND (
+        ? = ''
+        OR o.status = ?
+    )
+    AND (
+        ? = ''
+        OR o.payment_status = ?
+    )
+    AND (
+        ? IS NULL
+        OR o.created_at >= ?
+    )
+    AND (
+        ? IS NULL
+        OR o.created_at < ?
+    )
+    AND (
+        ? = 0
+        OR o.total_price >= ?
+    )
+    AND (
+        ? = 0
+        OR o.total_price <= ?
+    )
+    AND (
+        ? = ''
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                order_items fi
+            WHERE
+                fi.order_id = o.id
+                AND fi.product_id = ?
+        )
+    )
+`
+
+type CountOrdersParams struct {
+	CustomerID    string          `json:"customer_id"`
+	Status        string          `json:"status"`
+	PaymentStatus string          `json:"payment_status"`
+	CreatedFrom   sql.NullTime    `json:"created_from"`
+	CreatedTo     sql.NullTime    `json:"created_to"`
+	MinTotal      decimal.Decimal `json:"min_total"`
+	MaxTotal      decimal.Decimal `json:"max_total"`
+	ProductID     string          `json:"product_id"`
+}
+
+func (q *Queries) CountOrders(ctx context.Context, arg CountOrdersParams) (int64, error) {
+	row := q.queryRow(ctx, q.countOrdersStmt, countOrders,
+		arg.CustomerID,
+		arg.CustomerID,
+		arg.Status,
+		arg.Status,
+		arg.PaymentStatus,
+		arg.PaymentStatus,
+		arg.CreatedFrom,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CreatedTo,
+		arg.MinTotal,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.MaxTotal,
+		arg.ProductID,
+		arg.ProductID,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createOrder = `-- name: CreateOrder :exec
 INSERT INTO
     orders (
         id,
         customer_id,
         total_quantity,
+        subtotal,
+        discount_total,
+        tax_total,
+        shipping_total,
+        shipping_method_id,
+        shipping_method_name,
         total_price,
+        promotion_id,
+        coupon_code,
         created_at
     )
 VALUES
-    (?, ?, ?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateOrderParams struct {
-	ID            string          `json:"id"`
-	CustomerID    string          `json:"customer_id"`
-	TotalQuantity int32           `json:"total_quantity"`
-	TotalPrice    decimal.Decimal `json:"total_price"`
-	CreatedAt     time.Time       `json:"created_at"`
+	ID                 string          `json:"id"`
+	CustomerID         string          `json:"customer_id"`
+	TotalQuantity      int32           `json:"total_quantity"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	DiscountTotal      decimal.Decimal `json:"discount_total"`
+	TaxTotal           decimal.Decimal `json:"tax_total"`
+	ShippingTotal      decimal.Decimal `json:"shipping_total"`
+	ShippingMethodID   sql.NullString  `json:"shipping_method_id"`
+	ShippingMethodName sql.NullString  `json:"shipping_method_name"`
+	TotalPrice         decimal.Decimal `json:"total_price"`
+	PromotionID        sql.NullString  `json:"promotion_id"`
+	CouponCode         sql.NullString  `json:"coupon_code"`
+	CreatedAt          time.Time       `json:"created_at"`
 }
 
 // Sqlc Query
@@ -40,7 +177,15 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) error
 		arg.ID,
 		arg.CustomerID,
 		arg.TotalQuantity,
+		arg.Subtotal,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.ShippingTotal,
+		arg.ShippingMethodID,
+		arg.ShippingMethodName,
 		arg.TotalPrice,
+		arg.PromotionID,
+		arg.CouponCode,
 		arg.CreatedAt,
 	)
 	return err
@@ -48,17 +193,45 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) error
 
 const createOrderItem = `-- name: CreateOrderItem :exec
 INSERT INTO
-    order_items (id, order_id, product_id, quantity, unit_price)
+    order_items (
+        id,
+        order_id,
+        product_id,
+        variant_id,
+        variant_sku,
+        product_name,
+        sku,
+        category_name,
+        quantity,
+        unit_price,
+        discount_amount,
+        promotion_id,
+        tax_rate,
+        tax_amount,
+        tax_inclusive,
+        line_total
+    )
 VALUES
-    (?, ?, ?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateOrderItemParams struct {
-	ID        string          `json:"id"`
-	OrderID   string          `json:"order_id"`
-	ProductID string          `json:"product_id"`
-	Quantity  int32           `json:"quantity"`
-	UnitPrice decimal.Decimal `json:"unit_price"`
+	ID             string          `json:"id"`
+	OrderID        string          `json:"order_id"`
+	ProductID      sql.NullString  `json:"product_id"`
+	VariantID      sql.NullString  `json:"variant_id"`
+	VariantSku     sql.NullString  `json:"variant_sku"`
+	ProductName    string          `json:"product_name"`
+	Sku            sql.NullString  `json:"sku"`
+	CategoryName   string          `json:"category_name"`
+	Quantity       int32           `json:"quantity"`
+	UnitPrice      decimal.Decimal `json:"unit_price"`
+	DiscountAmount decimal.Decimal `json:"discount_amount"`
+	PromotionID    sql.NullString  `json:"promotion_id"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	TaxAmount      decimal.Decimal `json:"tax_amount"`
+	TaxInclusive   bool            `json:"tax_inclusive"`
+	LineTotal      decimal.Decimal `json:"line_total"`
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) error {
@@ -66,8 +239,84 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		arg.ID,
 		arg.OrderID,
 		arg.ProductID,
+		arg.VariantID,
+		arg.VariantSku,
+		arg.ProductName,
+		arg.Sku,
+		arg.CategoryName,
 		arg.Quantity,
 		arg.UnitPrice,
+		arg.DiscountAmount,
+		arg.PromotionID,
+		arg.TaxRate,
+		arg.TaxAmount,
+		arg.TaxInclusive,
+		arg.LineTotal,
+	)
+	return err
+}
+
+const createOrderShipment = `-- name: CreateOrderShipment :exec
+INSERT INTO
+    order_shipments (order_id, carrier, tracking_number, shipped_at)
+VALUES
+    (?, ?, ?, ?)
+`
+
+type CreateOrderShipmentParams struct {
+	OrderID        string    `json:"order_id"`
+	Carrier        string    `json:"carrier"`
+	TrackingNumber string    `json:"tracking_number"`
+	ShippedAt      time.Time `json:"shipped_at"`
+}
+
+func (q *Queries) CreateOrderShipment(ctx context.Context, arg CreateOrderShipmentParams) error {
+	_, err := q.exec(ctx, q.createOrderShipmentStmt, createOrderShipment,
+		arg.OrderID,
+		arg.Carrier,
+		arg.TrackingNumber,
+		arg.ShippedAt,
+	)
+	return err
+}
+
+const createOrderShippingAddress = `-- name: CreateOrderShippingAddress :exec
+INSERT INTO
+    order_shipping_addresses (
+        order_id,
+        address_id,
+        recipient_name,
+        phone,
+        street,
+        city,
+        province,
+        postal_code
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateOrderShippingAddressParams struct {
+	OrderID       string         `json:"order_id"`
+	AddressID     sql.NullString `json:"address_id"`
+	RecipientName string         `json:"recipient_name"`
+	Phone         string         `json:"phone"`
+	Street        string         `json:"street"`
+	City          string         `json:"city"`
+	Province      string         `json:"province"`
+	PostalCode    string         `json:"postal_code"`
+}
+
+func (q *Queries) CreateOrderShippingAddress(ctx context.Context, arg CreateOrderShippingAddressParams) error {
+	_, err := q.exec(ctx, q.createOrderShippingAddressStmt, createOrderShippingAddress,
+		arg.OrderID,
+		arg.AddressID,
+		arg.RecipientName,
+		arg.Phone,
+		arg.Street,
+		arg.City,
+		arg.Province,
+		arg.PostalCode,
 	)
 	return err
 }
@@ -83,68 +332,305 @@ func (q *Queries) DeleteOrder(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteOrderItem = `-- name: DeleteOrderItem :exec
+DELETE FROM order_items
+WHERE
+    id = ?
+    AND order_id = ?
+`
+
+type DeleteOrderItemParams struct {
+	ID      string `json:"id"`
+	OrderID string `json:"order_id"`
+}
+
+func (q *Queries) DeleteOrderItem(ctx context.Context, arg DeleteOrderItemParams) error {
+	_, err := q.exec(ctx, q.deleteOrderItemStmt, deleteOrderItem, arg.ID, arg.OrderID)
+	return err
+}
+
 const getOrderByID = `-- name: GetOrderByID :one
 SELECT
     o.id,
+    o.status,
     o.total_quantity,
+    o.subtotal,
+    o.discount_total,
+    o.tax_total,
+    o.shipping_total,
+    o.shipping_method_id,
+    o.shipping_method_name,
     o.total_price,
+    o.refund_total,
+    o.coupon_code,
+    o.payment_status,
+    o.paid_at,
     o.created_at,
     o.customer_id,
     c.name AS customer_name,
     c.email AS customer_email,
     CAST(
-        JSON_ARRAYAGG(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            oi.id,
+                            'product_id',
+                            oi.product_id,
+                            'product_name',
+                            oi.product_name,
+                            'sku',
+                            oi.sku,
+                            'category_name',
+                            oi.category_name,
+                            'variant_id',
+                            oi.variant_id,
+                            'quantity',
+                            oi.quantity,
+                            'unit_price',
+                            oi.unit_price,
+                            'discount_amount',
+                            oi.discount_amount,
+                            'promotion_id',
+                            oi.promotion_id,
+                            'tax_rate',
+                            oi.tax_rate,
+                            'tax_amount',
+                            oi.tax_amount,
+                            'tax_inclusive',
+                            IF (oi.tax_inclusive, CAST('true' AS JSON), CAST('false' AS JSON)),
+                            'line_total',
+                            oi.line_total,
+                            'returned_quantity',
+                            oi.returned_quantity,
+                            'current_product',
+                            IF (
+                                p.id IS NULL,
+                                NULL,
+                                JSON_OBJECT(
+                                    'id',
+                                    p.id,
+                                    'name',
+                                    p.name,
+                                    'sku',
+                                    p.sku,
+                                    'price',
+                                    p.price,
+                                    'category_name',
+                                    pc.name,
+                                    'is_active',
+                                    IF (p.is_active, CAST('true' AS JSON), CAST('false' AS JSON))
+                                )
+                            )
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                order_items oi
+                LEFT JOIN products p ON p.id = oi.product_id
+                LEFT JOIN categories pc ON pc.id = p.category_id
+            WHERE
+                oi.order_id = o.id
+        ) AS JSON
+    ) AS items,
+    CAST(
+        IF (
+            sa.order_id IS NULL,
+            NULL,
             JSON_OBJECT(
-                'id',
-                oi.id,
-                'product_id',
-                p.id,
-                'product_name',
-                p.name,
-                'quantity',
-                oi.quantity,
-                'unit_price',
-                oi.unit_price
+                'address_id',
+                sa.address_id,
+                'recipient_name',
+                sa.recipient_name,
+                'phone',
+                sa.phone,
+                'street',
+                sa.street,
+                'city',
+                sa.city,
+                'province',
+                sa.province,
+                'postal_code',
+                sa.postal_code
             )
         ) AS JSON
-    ) AS items
+    ) AS shipping_address
 FROM
     orders o
     JOIN customers c ON o.customer_id = c.id
-    LEFT JOIN order_items oi ON o.id = oi.order_id
-    LEFT JOIN products p ON oi.product_id = p.id
+    LEFT JOIN order_shipping_addresses sa ON sa.order_id = o.id
 WHERE
     o.id = ?
-GROUP BY
-    o.id,
-    c.id
 LIMIT
     1
 `
 
 type GetOrderByIDRow struct {
-	ID            string          `json:"id"`
-	TotalQuantity int32           `json:"total_quantity"`
-	TotalPrice    decimal.Decimal `json:"total_price"`
-	CreatedAt     time.Time       `json:"created_at"`
-	CustomerID    string          `json:"customer_id"`
-	CustomerName  string          `json:"customer_name"`
-	CustomerEmail string          `json:"customer_email"`
-	Items         json.RawMessage `json:"items"`
+	ID                 string          `json:"id"`
+	Status             string          `json:"status"`
+	TotalQuantity      int32           `json:"total_quantity"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	DiscountTotal      decimal.Decimal `json:"discount_total"`
+	TaxTotal           decimal.Decimal `json:"tax_total"`
+	ShippingTotal      decimal.Decimal `json:"shipping_total"`
+	ShippingMethodID   sql.NullString  `json:"shipping_method_id"`
+	ShippingMethodName sql.NullString  `json:"shipping_method_name"`
+	TotalPrice         decimal.Decimal `json:"total_price"`
+	RefundTotal        decimal.Decimal `json:"refund_total"`
+	CouponCode         sql.NullString  `json:"coupon_code"`
+	PaymentStatus      string          `json:"payment_status"`
+	PaidAt             sql.NullTime    `json:"paid_at"`
+	CreatedAt          time.Time       `json:"created_at"`
+	CustomerID         string          `json:"customer_id"`
+	CustomerName       string          `json:"customer_name"`
+	CustomerEmail      string          `json:"customer_email"`
+	Items              json.RawMessage `json:"items"`
+	ShippingAddress    json.RawMessage `json:"shipping_address"`
 }
 
+// Kolom dan agregasi item harus sama persis dengan GetOrders (satu mapper untuk listing & detail)
 func (q *Queries) GetOrderByID(ctx context.Context, id string) (GetOrderByIDRow, error) {
 	row := q.queryRow(ctx, q.getOrderByIDStmt, getOrderByID, id)
 	var i GetOrderByIDRow
 	err := row.Scan(
 		&i.ID,
+		&i.Status,
 		&i.TotalQuantity,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.ShippingTotal,
+		&i.ShippingMethodID,
+		&i.ShippingMethodName,
 		&i.TotalPrice,
+		&i.RefundTotal,
+		&i.CouponCode,
+		&i.PaymentStatus,
+		&i.PaidAt,
 		&i.CreatedAt,
 		&i.CustomerID,
 		&i.CustomerName,
 		&i.CustomerEmail,
 		&i.Items,
+		&i.ShippingAddress,
+	)
+	return i, err
+}
+
+const getOrderForUpdate = `-- name: GetOrderForUpdate :one
+SELECT
+    id,
+    customer_id,
+    status,
+    total_quantity,
+    subtotal,
+    discount_total,
+    tax_total,
+    shipping_total,
+    shipping_method_id,
+    shipping_method_name,
+    total_price,
+    refund_total,
+    promotion_id,
+    coupon_code,
+    payment_status,
+    paid_at,
+    created_at
+FROM
+    orders
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE
+`
+
+func (q *Queries) GetOrderForUpdate(ctx context.Context, id string) (Order, error) {
+	row := q.queryRow(ctx, q.getOrderForUpdateStmt, getOrderForUpdate, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.Status,
+		&i.TotalQuantity,
+		&i.Subtotal,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.ShippingTotal,
+		&i.ShippingMethodID,
+		&i.ShippingMethodName,
+		&i.TotalPrice,
+		&i.RefundTotal,
+		&i.PromotionID,
+		&i.CouponCode,
+		&i.PaymentStatus,
+		&i.PaidAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOrderItemSnapshot = `-- name: GetOrderItemSnapshot :one
+SELECT
+    p.name AS product_name,
+    COALESCE(v.sku, p.sku) AS sku,
+    CAST(COALESCE(v.price, p.price) AS DECIMAL(15, 2)) AS unit_price,
+    v.sku AS variant_sku,
+    v.id IS NOT NULL AS variant_found,
+    p.category_id,
+    c.name AS category_name,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            product_variants pv
+        WHERE
+            pv.product_id = p.id
+            AND pv.is_active = TRUE
+    ) AS has_active_variants
+FROM
+    products p
+    JOIN categories c ON c.id = p.category_id
+    LEFT JOIN product_variants v ON v.id = ?
+    AND v.product_id = p.id
+WHERE
+    p.id = ?
+LIMIT
+    1
+`
+
+type GetOrderItemSnapshotParams struct {
+	VariantID sql.NullString `json:"variant_id"`
+	ProductID string         `json:"product_id"`
+}
+
+type GetOrderItemSnapshotRow struct {
+	ProductName       string          `json:"product_name"`
+	Sku               sql.NullString  `json:"sku"`
+	UnitPrice         decimal.Decimal `json:"unit_price"`
+	VariantSku        sql.NullString  `json:"variant_sku"`
+	VariantFound      bool            `json:"variant_found"`
+	CategoryID        string          `json:"category_id"`
+	CategoryName      string          `json:"category_name"`
+	HasActiveVariants bool            `json:"has_active_variants"`
+}
+
+// Data produk yang disalin ke order_items; sku & harga varian diutamakan bila item merujuk varian.
+// unit_price adalah harga efektif saat ini, satu-satunya sumber harga item order
+func (q *Queries) GetOrderItemSnapshot(ctx context.Context, arg GetOrderItemSnapshotParams) (GetOrderItemSnapshotRow, error) {
+	row := q.queryRow(ctx, q.getOrderItemSnapshotStmt, getOrderItemSnapshot, arg.VariantID, arg.ProductID)
+	var i GetOrderItemSnapshotRow
+	err := row.Scan(
+		&i.ProductName,
+		&i.Sku,
+		&i.UnitPrice,
+		&i.VariantSku,
+		&i.VariantFound,
+		&i.CategoryID,
+		&i.CategoryName,
+		&i.HasActiveVariants,
 	)
 	return i, err
 }
@@ -154,8 +640,20 @@ SELECT
     id,
     order_id,
     product_id,
+    variant_id,
+    variant_sku,
+    product_name,
+    sku,
+    category_name,
     quantity,
-    unit_price
+    unit_price,
+    discount_amount,
+    promotion_id,
+    tax_rate,
+    tax_amount,
+    tax_inclusive,
+    line_total,
+    returned_quantity
 FROM
     order_items
 WHERE
@@ -175,8 +673,20 @@ func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID string) ([
 			&i.ID,
 			&i.OrderID,
 			&i.ProductID,
+			&i.VariantID,
+			&i.VariantSku,
+			&i.ProductName,
+			&i.Sku,
+			&i.CategoryName,
 			&i.Quantity,
 			&i.UnitPrice,
+			&i.DiscountAmount,
+			&i.PromotionID,
+			&i.TaxRate,
+			&i.TaxAmount,
+			&i.TaxInclusive,
+			&i.LineTotal,
+			&i.ReturnedQuantity,
 		); err != nil {
 			return nil, err
 		}
@@ -191,41 +701,198 @@ func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID string) ([
 	return items, nil
 }
 
+const getOrderShipment = `-- name: GetOrderShipment :one
+SELECT
+    order_id,
+    carrier,
+    tracking_number,
+    shipped_at
+FROM
+    order_shipments
+WHERE
+    order_id = ?
+LIMIT
+    1
+`
+
+func (q *Queries) GetOrderShipment(ctx context.Context, orderID string) (OrderShipment, error) {
+	row := q.queryRow(ctx, q.getOrderShipmentStmt, getOrderShipment, orderID)
+	var i OrderShipment
+	err := row.Scan(
+		&i.OrderID,
+		&i.Carrier,
+		&i.TrackingNumber,
+		&i.ShippedAt,
+	)
+	return i, err
+}
+
 const getOrders = `-- name: GetOrders :many
 SELECT
     o.id,
+    o.status,
     o.total_quantity,
+    o.subtotal,
+    o.discount_total,
+    o.tax_total,
+    o.shipping_total,
+    o.shipping_method_id,
+    o.shipping_method_name,
     o.total_price,
+    o.refund_total,
+    o.coupon_code,
+    o.payment_status,
+    o.paid_at,
     o.created_at,
     o.customer_id,
     c.name AS customer_name,
     c.email AS customer_email,
     CAST(
-        JSON_ARRAYAGG(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            oi.id,
+                            'product_id',
+                            oi.product_id,
+                            'product_name',
+                            oi.product_name,
+                            'sku',
+                            oi.sku,
+                            'category_name',
+                            oi.category_name,
+                            'variant_id',
+                            oi.variant_id,
+                            'quantity',
+                            oi.quantity,
+                            'unit_price',
+                            oi.unit_price,
+                            'discount_amount',
+                            oi.discount_amount,
+                            'promotion_id',
+                            oi.promotion_id,
+                            'tax_rate',
+                            oi.tax_rate,
+                            'tax_amount',
+                            oi.tax_amount,
+                            'tax_inclusive',
+                            IF (oi.tax_inclusive, CAST('true' AS JSON), CAST('false' AS JSON)),
+                            'line_total',
+                            oi.line_total,
+                            'returned_quantity',
+                            oi.returned_quantity,
+                            'current_product',
+                            IF (
+                                p.id IS NULL,
+                                NULL,
+                                JSON_OBJECT(
+                                    'id',
+                                    p.id,
+                                    'name',
+                                    p.name,
+                                    'sku',
+                                    p.sku,
+                                    'price',
+                                    p.price,
+                                    'category_name',
+                                    pc.name,
+                                    'is_active',
+                                    IF (p.is_active, CAST('true' AS JSON), CAST('false' AS JSON))
+                                )
+                            )
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                order_items oi
+                LEFT JOIN products p ON p.id = oi.product_id
+                LEFT JOIN categories pc ON pc.id = p.category_id
+            WHERE
+                oi.order_id = o.id
+        ) AS JSON
+    ) AS items,
+    CAST(
+        IF (
+            sa.order_id IS NULL,
+            NULL,
             JSON_OBJECT(
-                'id',
-                oi.id,
-                'product_id',
-                p.id,
-                'product_name',
-                p.name,
-                'quantity',
-                oi.quantity,
-                'unit_price',
-                oi.unit_price
+                'address_id',
+                sa.address_id,
+                'recipient_name',
+                sa.recipient_name,
+                'phone',
+                sa.phone,
+                'street',
+                sa.street,
+                'city',
+                sa.city,
+                'province',
+                sa.province,
+                'postal_code',
+                sa.postal_code
             )
         ) AS JSON
-    ) AS items
+    ) AS shipping_address
 FROM
     orders o
     JOIN customers c ON o.customer_id = c.id
-    JOIN order_items oi ON o.id = oi.order_id
-    JOIN products p ON oi.product_id = p.id
-GROUP BY
-    o.id,
-    c.id
+    LEFT JOIN order_shipping_addresses sa ON sa.order_id = o.id
+WHERE
+    (
+        ? = ''
+        OR o.customer_id = ?
+    )
+    AND (
+        ? = ''
+        OR o.status = ?
+    )
+    AND (
+        ? = ''
+        OR o.payment_status = ?
+    )
+    AND (
+        ? IS NULL
+        OR o.created_at >= ?
+    )
+    AND (
+        ? IS NULL
+        OR o.created_at < ?
+    )
+    AND (
+        ? = 0
+        OR o.total_price >= ?
+    )
+    AND (
+        ? = 0
+        OR o.total_price <= ?
+    )
+    AND (
+        ? = ''
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                order_items fi
+            WHERE
+                fi.order_id = o.id
+                AND fi.product_id = ?
+        )
+    )
 ORDER BY
-    o.created_at DESC
+    CASE
+        WHEN ? = 'total_asc' THEN o.total_price
+    END ASC,
+    CASE
+        WHEN ? = 'total_desc' THEN o.total_price
+    END DESC,
+    CASE
+        WHEN ? = 'created_asc' THEN o.created_at
+    END ASC,
+    o.created_at DESC,
+    o.id DESC
 LIMIT
     ?
 OFFSET
@@ -233,23 +900,64 @@ OFFSET
 `
 
 type GetOrdersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	CustomerID    string          `json:"customer_id"`
+	Status        string          `json:"status"`
+	PaymentStatus string          `json:"payment_status"`
+	CreatedFrom   sql.NullTime    `json:"created_from"`
+	CreatedTo     sql.NullTime    `json:"created_to"`
+	MinTotal      decimal.Decimal `json:"min_total"`
+	MaxTotal      decimal.Decimal `json:"max_total"`
+	ProductID     string          `json:"product_id"`
+	OrderBy       interface{}     `json:"order_by"`
+	Limit         int32           `json:"limit"`
+	Offset        int32           `json:"offset"`
 }
 
 type GetOrdersRow struct {
-	ID            string          `json:"id"`
-	TotalQuantity int32           `json:"total_quantity"`
-	TotalPrice    decimal.Decimal `json:"total_price"`
-	CreatedAt     time.Time       `json:"created_at"`
-	CustomerID    string          `json:"customer_id"`
-	CustomerName  string          `json:"customer_name"`
-	CustomerEmail string          `json:"customer_email"`
-	Items         json.RawMessage `json:"items"`
+	ID                 string          `json:"id"`
+	Status             string          `json:"status"`
+	TotalQuantity      int32           `json:"total_quantity"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	DiscountTotal      decimal.Decimal `json:"discount_total"`
+	TaxTotal           decimal.Decimal `json:"tax_total"`
+	ShippingTotal      decimal.Decimal `json:"shipping_total"`
+	ShippingMethodID   sql.NullString  `json:"shipping_method_id"`
+	ShippingMethodName sql.NullString  `json:"shipping_method_name"`
+	TotalPrice         decimal.Decimal `json:"total_price"`
+	RefundTotal        decimal.Decimal `json:"refund_total"`
+	CouponCode         sql.NullString  `json:"coupon_code"`
+	PaymentStatus      string          `json:"payment_status"`
+	PaidAt             sql.NullTime    `json:"paid_at"`
+	CreatedAt          time.Time       `json:"created_at"`
+	CustomerID         string          `json:"customer_id"`
+	CustomerName       string          `json:"customer_name"`
+	CustomerEmail      string          `json:"customer_email"`
+	Items              json.RawMessage `json:"items"`
+	ShippingAddress    json.RawMessage `json:"shipping_address"`
 }
 
 func (q *Queries) GetOrders(ctx context.Context, arg GetOrdersParams) ([]GetOrdersRow, error) {
-	rows, err := q.query(ctx, q.getOrdersStmt, getOrders, arg.Limit, arg.Offset)
+	rows, err := q.query(ctx, q.getOrdersStmt, getOrders,
+		arg.CustomerID,
+		arg.CustomerID,
+		arg.Status,
+		arg.Status,
+		arg.PaymentStatus,
+		arg.PaymentStatus,
+		arg.CreatedFrom,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CreatedTo,
+		arg.MinTotal,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.MaxTotal,
+		arg.ProductID,
+		arg.ProductID,
+		arg.OrderBy,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -259,13 +967,25 @@ func (q *Queries) GetOrders(ctx context.Context, arg GetOrdersParams) ([]GetOrde
 		var i GetOrdersRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.Status,
 			&i.TotalQuantity,
+			&i.Subtotal,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.ShippingTotal,
+			&i.ShippingMethodID,
+			&i.ShippingMethodName,
 			&i.TotalPrice,
+			&i.RefundTotal,
+			&i.CouponCode,
+			&i.PaymentStatus,
+			&i.PaidAt,
 			&i.CreatedAt,
 			&i.CustomerID,
 			&i.CustomerName,
 			&i.CustomerEmail,
 			&i.Items,
+			&i.ShippingAddress,
 		); err != nil {
 			return nil, err
 		}
@@ -279,3 +999,99 @@ func (q *Queries) GetOrders(ctx context.Context, arg GetOrdersParams) ([]GetOrde
 	}
 	return items, nil
 }
+
+const updateOrderItem = `-- name: UpdateOrderItem :exec
+UPDATE order_items
+SET
+    quantity = ?,
+    discount_amount = ?,
+    promotion_id = ?,
+    tax_rate = ?,
+    tax_amount = ?,
+    tax_inclusive = ?,
+    line_total = ?
+WHERE
+    id = ?
+    AND order_id = ?
+`
+
+type UpdateOrderItemParams struct {
+	Quantity       int32           `json:"quantity"`
+	DiscountAmount decimal.Decimal `json:"discount_amount"`
+	PromotionID    sql.NullString  `json:"promotion_id"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	TaxAmount      decimal.Decimal `json:"tax_amount"`
+	TaxInclusive   bool            `json:"tax_inclusive"`
+	LineTotal      decimal.Decimal `json:"line_total"`
+	ID             string          `json:"id"`
+	OrderID        string          `json:"order_id"`
+}
+
+func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) error {
+	_, err := q.exec(ctx, q.updateOrderItemStmt, updateOrderItem,
+		arg.Quantity,
+		arg.DiscountAmount,
+		arg.PromotionID,
+		arg.TaxRate,
+		arg.TaxAmount,
+		arg.TaxInclusive,
+		arg.LineTotal,
+		arg.ID,
+		arg.OrderID,
+	)
+	return err
+}
+
+const updateOrderStatus = `-- name: UpdateOrderStatus :exec
+UPDATE orders
+SET
+    status = ?
+WHERE
+    id = ?
+`
+
+type UpdateOrderStatusParams struct {
+	Status string `json:"status"`
+	ID     string `json:"id"`
+}
+
+func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) error {
+	_, err := q.exec(ctx, q.updateOrderStatusStmt, updateOrderStatus, arg.Status, arg.ID)
+	return err
+}
+
+const updateOrderTotals = `-- name: UpdateOrderTotals :exec
+UPDATE orders
+SET
+    total_quantity = ?,
+    subtotal = ?,
+    discount_total = ?,
+    tax_total = ?,
+    shipping_total = ?,
+    total_price = ?
+WHERE
+    id = ?
+`
+
+type UpdateOrderTotalsParams struct {
+	TotalQuantity int32           `json:"total_quantity"`
+	Subtotal      decimal.Decimal `json:"subtotal"`
+	DiscountTotal decimal.Decimal `json:"discount_total"`
+	TaxTotal      decimal.Decimal `json:"tax_total"`
+	ShippingTotal decimal.Decimal `json:"shipping_total"`
+	TotalPrice    decimal.Decimal `json:"total_price"`
+	ID            string          `json:"id"`
+}
+
+func (q *Queries) UpdateOrderTotals(ctx context.Context, arg UpdateOrderTotalsParams) error {
+	_, err := q.exec(ctx, q.updateOrderTotalsStmt, updateOrderTotals,
+		arg.TotalQuantity,
+		arg.Subtotal,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.ShippingTotal,
+		arg.TotalPrice,
+		arg.ID,
+	)
+	return err
+}
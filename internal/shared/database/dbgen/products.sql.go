@@ -8,6 +8,8 @@ package dbgen
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -78,40 +80,77 @@ const createProduct = `-- name: CreateProduct :exec
 INSERT INTO
     products (
         id,
+        sku,
         name,
         description,
         price,
         category_id,
         stock_quantity,
+        weight_grams,
+        length_cm,
+        width_cm,
+        height_cm,
         is_active
     )
 VALUES
-    (?, ?, ?, ?, ?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateProductParams struct {
 	ID            string          `json:"id"`
+	Sku           sql.NullString  `json:"sku"`
 	Name          string          `json:"name"`
 	Description   sql.NullString  `json:"description"`
 	Price         decimal.Decimal `json:"price"`
 	CategoryID    string          `json:"category_id"`
 	StockQuantity int32           `json:"stock_quantity"`
+	WeightGrams   int32           `json:"weight_grams"`
+	LengthCm      int32           `json:"length_cm"`
+	WidthCm       int32           `json:"width_cm"`
+	HeightCm      int32           `json:"height_cm"`
 	IsActive      bool            `json:"is_active"`
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) error {
 	_, err := q.exec(ctx, q.createProductStmt, createProduct,
 		arg.ID,
+		arg.Sku,
 		arg.Name,
 		arg.Description,
 		arg.Price,
 		arg.CategoryID,
 		arg.StockQuantity,
+		arg.WeightGrams,
+		arg.LengthCm,
+		arg.WidthCm,
+		arg.HeightCm,
 		arg.IsActive,
 	)
 	return err
 }
 
+const decrementProductStock = `-- name: DecrementProductStock :execrows
+UPDATE products
+SET
+    stock_quantity = stock_quantity - ?
+WHERE
+    id = ?
+    AND stock_quantity >= ?
+`
+
+type DecrementProductStockParams struct {
+	Quantity int32  `json:"quantity"`
+	ID       string `json:"id"`
+}
+
+func (q *Queries) DecrementProductStock(ctx context.Context, arg DecrementProductStockParams) (int64, error) {
+	result, err := q.exec(ctx, q.decrementProductStockStmt, decrementProductStock, arg.Quantity, arg.ID, arg.Quantity)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteProduct = `-- name: DeleteProduct :exec
 DELETE FROM products
 WHERE
@@ -126,16 +165,98 @@ func (q *Queries) DeleteProduct(ctx context.Context, id string) error {
 const getProductByID = `-- name: GetProductByID :one
 SELECT
     p.id,
+    p.sku,
     p.name,
     p.description,
     p.price,
     p.stock_quantity,
+    p.weight_grams,
+    p.length_cm,
+    p.width_cm,
+    p.height_cm,
     p.is_active,
     p.created_at,
     p.updated_at,
     c.id AS category_id,
     c.name AS category_name,
-    c.description AS category_description
+    c.description AS category_description,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    MIN(COALESCE(v.price, p.price))
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.price
+        ) AS DECIMAL(15, 2)
+    ) AS min_price,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    MAX(COALESCE(v.price, p.price))
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.price
+        ) AS DECIMAL(15, 2)
+    ) AS max_price,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    SUM(v.stock_quantity)
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.stock_quantity
+        ) AS SIGNED
+    ) AS total_stock,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            product_variants v
+        WHERE
+            v.product_id = p.id
+            AND v.is_active = TRUE
+    ) AS variant_count,
+    CAST(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            i.id,
+                            'url',
+                            i.url,
+                            'thumbnail_url',
+                            i.thumbnail_url,
+                            'position',
+                            i.position,
+                            'is_primary',
+                            IF (i.is_primary, CAST('true' AS JSON), CAST('false' AS JSON))
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                product_images i
+            WHERE
+                i.product_id = p.id
+        ) AS JSON
+    ) AS images
 FROM
     products p
     JOIN categories c ON c.id = p.category_id
@@ -147,16 +268,26 @@ LIMIT
 
 type GetProductByIDRow struct {
 	ID                  string          `json:"id"`
+	Sku                 sql.NullString  `json:"sku"`
 	Name                string          `json:"name"`
 	Description         sql.NullString  `json:"description"`
 	Price               decimal.Decimal `json:"price"`
 	StockQuantity       int32           `json:"stock_quantity"`
+	WeightGrams         int32           `json:"weight_grams"`
+	LengthCm            int32           `json:"length_cm"`
+	WidthCm             int32           `json:"width_cm"`
+	HeightCm            int32           `json:"height_cm"`
 	IsActive            bool            `json:"is_active"`
 	CreatedAt           time.Time       `json:"created_at"`
 	UpdatedAt           time.Time       `json:"updated_at"`
 	CategoryID          string          `json:"category_id"`
 	CategoryName        string          `json:"category_name"`
 	CategoryDescription sql.NullString  `json:"category_description"`
+	MinPrice            decimal.Decimal `json:"min_price"`
+	MaxPrice            decimal.Decimal `json:"max_price"`
+	TotalStock          int64           `json:"total_stock"`
+	VariantCount        int64           `json:"variant_count"`
+	Images              json.RawMessage `json:"images"`
 }
 
 func (q *Queries) GetProductByID(ctx context.Context, id string) (GetProductByIDRow, error) {
@@ -164,37 +295,243 @@ func (q *Queries) GetProductByID(ctx context.Context, id string) (GetProductByID
 	var i GetProductByIDRow
 	err := row.Scan(
 		&i.ID,
+		&i.Sku,
 		&i.Name,
 		&i.Description,
 		&i.Price,
 		&i.StockQuantity,
+		&i.WeightGrams,
+		&i.LengthCm,
+		&i.WidthCm,
+		&i.HeightCm,
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CategoryID,
 		&i.CategoryName,
 		&i.CategoryDescription,
+		&i.MinPrice,
+		&i.MaxPrice,
+		&i.TotalStock,
+		&i.VariantCount,
+		&i.Images,
+	)
+	return i, err
+}
+
+const getProductCategoryID = `-- name: GetProductCategoryID :one
+SELECT
+    category_id
+FROM
+    products
+WHERE
+    id = ?
+LIMIT
+    1
+`
+
+func (q *Queries) GetProductCategoryID(ctx context.Context, id string) (string, error) {
+	row := q.queryRow(ctx, q.getProductCategoryIDStmt, getProductCategoryID, id)
+	var category_id string
+	err := row.Scan(&category_id)
+	return category_id, err
+}
+
+const getProductDimensions = `-- name: GetProductDimensions :one
+SELECT
+    weight_grams,
+    length_cm,
+    width_cm,
+    height_cm
+FROM
+    products
+WHERE
+    id = ?
+`
+
+type GetProductDimensionsRow struct {
+	WeightGrams int32 `json:"weight_grams"`
+	LengthCm    int32 `json:"length_cm"`
+	WidthCm     int32 `json:"width_cm"`
+	HeightCm    int32 `json:"height_cm"`
+}
+
+func (q *Queries) GetProductDimensions(ctx context.Context, id string) (GetProductDimensionsRow, error) {
+	row := q.queryRow(ctx, q.getProductDimensionsStmt, getProductDimensions, id)
+	var i GetProductDimensionsRow
+	err := row.Scan(
+		&i.WeightGrams,
+		&i.LengthCm,
+		&i.WidthCm,
+		&i.HeightCm,
 	)
 	return i, err
 }
 
+const getProductStock = `-- name: GetProductStock :one
+SELECT
+    stock_quantity
+FROM
+    products
+WHERE
+    id = ?
+`
+
+func (q *Queries) GetProductStock(ctx context.Context, id string) (int32, error) {
+	row := q.queryRow(ctx, q.getProductStockStmt, getProductStock, id)
+	var stock_quantity int32
+	err := row.Scan(&stock_quantity)
+	return stock_quantity, err
+}
+
+const incrementProductStock = `-- name: IncrementProductStock :exec
+UPDATE products
+SET
+    stock_quantity = stock_quantity + ?
+WHERE
+    id = ?
+`
+
+type IncrementProductStockParams struct {
+	StockQuantity int32  `json:"stock_quantity"`
+	ID            string `json:"id"`
+}
+
+func (q *Queries) IncrementProductStock(ctx context.Context, arg IncrementProductStockParams) error {
+	_, err := q.exec(ctx, q.incrementProductStockStmt, incrementProductStock, arg.StockQuantity, arg.ID)
+	return err
+}
+
+const listProductKeysForImport = `-- name: ListProductKeysForImport :many
+SELECT
+    id,
+    sku
+FROM
+    products
+WHERE
+    id IN (/*SLICE:ids*/?)
+    OR sku IN (/*SLICE:skus*/?)
+`
+
+type ListProductKeysForImportParams struct {
+	Ids  []string         `json:"ids"`
+	Skus []sql.NullString `json:"skus"`
+}
+
+type ListProductKeysForImportRow struct {
+	ID  string         `json:"id"`
+	Sku sql.NullString `json:"sku"`
+}
+
+// Produk yang sudah ada untuk satu chunk import, dicocokkan lewat id atau sku dalam satu query
+func (q *Queries) ListProductKeysForImport(ctx context.Context, arg ListProductKeysForImportParams) ([]ListProductKeysForImportRow, error) {
+	query := listProductKeysForImport
+	var queryParams []interface{}
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	if len(arg.Skus) > 0 {
+		for _, v := range arg.Skus {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:skus*/?", strings.Repeat(",?", len(arg.Skus))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:skus*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductKeysForImportRow
+	for rows.Next() {
+		var i ListProductKeysForImportRow
+		if err := rows.Scan(&i.ID, &i.Sku); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProducts = `-- name: ListProducts :many
 SELECT
     p.id,
+    p.sku,
     p.name,
     p.description,
     p.price,
     p.stock_quantity,
+    p.weight_grams,
+    p.length_cm,
+    p.width_cm,
+    p.height_cm,
     p.is_active,
     p.created_at,
     c.id AS category_id,
     c.name AS category_name,
     c.description AS category_description,
-    CAST(IFNULL (SUM(oi.quantity), 0) AS UNSIGNED) AS total_sold
+    CAST(IFNULL (SUM(oi.quantity), 0) AS UNSIGNED) AS total_sold,
+    CAST(MIN(COALESCE(pv.min_price, p.price)) AS DECIMAL(15, 2)) AS min_price,
+    CAST(MAX(COALESCE(pv.max_price, p.price)) AS DECIMAL(15, 2)) AS max_price,
+    CAST(MAX(COALESCE(pv.total_stock, p.stock_quantity)) AS SIGNED) AS total_stock,
+    CAST(MAX(IFNULL (pv.variant_count, 0)) AS SIGNED) AS variant_count,
+    CAST(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            i.id,
+                            'url',
+                            i.url,
+                            'thumbnail_url',
+                            i.thumbnail_url,
+                            'position',
+                            i.position,
+                            'is_primary',
+                            IF (i.is_primary, CAST('true' AS JSON), CAST('false' AS JSON))
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                product_images i
+            WHERE
+                i.product_id = p.id
+        ) AS JSON
+    ) AS images
 FROM
     products p
     JOIN categories c ON c.id = p.category_id
     LEFT JOIN order_items oi ON oi.product_id = p.id
+    LEFT JOIN (
+        SELECT
+            v.product_id,
+            MIN(COALESCE(v.price, vp.price)) AS min_price,
+            MAX(COALESCE(v.price, vp.price)) AS max_price,
+            SUM(v.stock_quantity) AS total_stock,
+            COUNT(*) AS variant_count
+        FROM
+            product_variants v
+            JOIN products vp ON vp.id = v.product_id
+        WHERE
+            v.is_active = TRUE
+        GROUP BY
+            v.product_id
+    ) pv ON pv.product_id = p.id
 WHERE
     (
         ? = ''
@@ -227,7 +564,8 @@ ORDER BY
     CASE
         WHEN ? = 'sold_desc' THEN IFNULL (SUM(oi.quantity), 0)
     END DESC,
-    p.created_at DESC
+    p.created_at DESC,
+    p.id DESC
 LIMIT
     ?
 OFFSET
@@ -248,16 +586,26 @@ type ListProductsParams struct {
 
 type ListProductsRow struct {
 	ID                  string          `json:"id"`
+	Sku                 sql.NullString  `json:"sku"`
 	Name                string          `json:"name"`
 	Description         sql.NullString  `json:"description"`
 	Price               decimal.Decimal `json:"price"`
 	StockQuantity       int32           `json:"stock_quantity"`
+	WeightGrams         int32           `json:"weight_grams"`
+	LengthCm            int32           `json:"length_cm"`
+	WidthCm             int32           `json:"width_cm"`
+	HeightCm            int32           `json:"height_cm"`
 	IsActive            bool            `json:"is_active"`
 	CreatedAt           time.Time       `json:"created_at"`
 	CategoryID          string          `json:"category_id"`
 	CategoryName        string          `json:"category_name"`
 	CategoryDescription sql.NullString  `json:"category_description"`
 	TotalSold           int64           `json:"total_sold"`
+	MinPrice            decimal.Decimal `json:"min_price"`
+	MaxPrice            decimal.Decimal `json:"max_price"`
+	TotalStock          int64           `json:"total_stock"`
+	VariantCount        int64           `json:"variant_count"`
+	Images              json.RawMessage `json:"images"`
 }
 
 func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]ListProductsRow, error) {
@@ -287,16 +635,26 @@ func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]L
 		var i ListProductsRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.Sku,
 			&i.Name,
 			&i.Description,
 			&i.Price,
 			&i.StockQuantity,
+			&i.WeightGrams,
+			&i.LengthCm,
+			&i.WidthCm,
+			&i.HeightCm,
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.CategoryID,
 			&i.CategoryName,
 			&i.CategoryDescription,
 			&i.TotalSold,
+			&i.MinPrice,
+			&i.MaxPrice,
+			&i.TotalStock,
+			&i.VariantCount,
+			&i.Images,
 		); err != nil {
 			return nil, err
 		}
@@ -311,36 +669,122 @@ func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]L
 	return items, nil
 }
 
+const productExists = `-- name: ProductExists :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            products
+        WHERE
+            id = ?
+    ) AS product_exists
+`
+
+func (q *Queries) ProductExists(ctx context.Context, id string) (bool, error) {
+	row := q.queryRow(ctx, q.productExistsStmt, productExists, id)
+	var product_exists bool
+	err := row.Scan(&product_exists)
+	return product_exists, err
+}
+
+const updateImportedProduct = `-- name: UpdateImportedProduct :exec
+UPDATE products
+SET
+    sku = COALESCE(?, sku),
+    name = ?,
+    description = COALESCE(?, description),
+    price = ?,
+    category_id = ?,
+    stock_quantity = COALESCE(?, stock_quantity),
+    weight_grams = COALESCE(?, weight_grams),
+    length_cm = COALESCE(?, length_cm),
+    width_cm = COALESCE(?, width_cm),
+    height_cm = COALESCE(?, height_cm),
+    is_active = COALESCE(?, is_active)
+WHERE
+    id = ?
+`
+
+type UpdateImportedProductParams struct {
+	Sku           sql.NullString  `json:"sku"`
+	Name          string          `json:"name"`
+	Description   sql.NullString  `json:"description"`
+	Price         decimal.Decimal `json:"price"`
+	CategoryID    string          `json:"category_id"`
+	StockQuantity sql.NullInt32   `json:"stock_quantity"`
+	WeightGrams   sql.NullInt32   `json:"weight_grams"`
+	LengthCm      sql.NullInt32   `json:"length_cm"`
+	WidthCm       sql.NullInt32   `json:"width_cm"`
+	HeightCm      sql.NullInt32   `json:"height_cm"`
+	IsActive      sql.NullBool    `json:"is_active"`
+	ID            string          `json:"id"`
+}
+
+// Kolom opsional yang kosong di file import (NULL) mempertahankan nilai saat ini
+func (q *Queries) UpdateImportedProduct(ctx context.Context, arg UpdateImportedProductParams) error {
+	_, err := q.exec(ctx, q.updateImportedProductStmt, updateImportedProduct,
+		arg.Sku,
+		arg.Name,
+		arg.Description,
+		arg.Price,
+		arg.CategoryID,
+		arg.StockQuantity,
+		arg.WeightGrams,
+		arg.LengthCm,
+		arg.WidthCm,
+		arg.HeightCm,
+		arg.IsActive,
+		arg.ID,
+	)
+	return err
+}
+
 const updateProduct = `-- name: UpdateProduct :exec
 UPDATE products
 SET
+    sku = ?,
     name = ?,
     description = ?,
     price = ?,
     category_id = ?,
     stock_quantity = ?,
+    weight_grams = ?,
+    length_cm = ?,
+    width_cm = ?,
+    height_cm = ?,
     is_active = ?
 WHERE
     id = ?
 `
 
 type UpdateProductParams struct {
+	Sku           sql.NullString  `json:"sku"`
 	Name          string          `json:"name"`
 	Description   sql.NullString  `json:"description"`
 	Price         decimal.Decimal `json:"price"`
 	CategoryID    string          `json:"category_id"`
 	StockQuantity int32           `json:"stock_quantity"`
+	WeightGrams   int32           `json:"weight_grams"`
+	LengthCm      int32           `json:"length_cm"`
+	WidthCm       int32           `json:"width_cm"`
+	HeightCm      int32           `json:"height_cm"`
 	IsActive      bool            `json:"is_active"`
 	ID            string          `json:"id"`
 }
 
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) error {
 	_, err := q.exec(ctx, q.updateProductStmt, updateProduct,
+		arg.Sku,
 		arg.Name,
 		arg.Description,
 		arg.Price,
 		arg.CategoryID,
 		arg.StockQuantity,
+		arg.WeightGrams,
+		arg.LengthCm,
+		arg.WidthCm,
+		arg.HeightCm,
 		arg.IsActive,
 		arg.ID,
 	)
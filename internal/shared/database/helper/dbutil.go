@@ -2,8 +2,12 @@ package helper
 
 import (
 	"database/sql"
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/shopspring/decimal"
 )
 
@@ -13,6 +17,12 @@ import (
 // =======================
 //
 
+// NormalizeEmail menyamakan bentuk email sebelum disimpan atau dicari:
+// tanpa spasi di awal/akhir dan huruf kecil semua, jadi User@x.com == user@x.com
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 func StringValue(s string) string {
 	return s
 }
@@ -31,6 +41,13 @@ func StringToNull(s *string) sql.NullString {
 	return sql.NullString{String: *s, Valid: true}
 }
 
+func NullStringToPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
 //
 // =======================
 // BOOL
@@ -79,6 +96,21 @@ func Int32ToNull(i *int32) sql.NullInt32 {
 	return sql.NullInt32{Int32: *i, Valid: true}
 }
 
+func IntToNullInt32(i *int) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*i), Valid: true}
+}
+
+func NullInt32ToIntPtr(i sql.NullInt32) *int {
+	if !i.Valid {
+		return nil
+	}
+	v := int(i.Int32)
+	return &v
+}
+
 //
 // =======================
 // FLOAT64
@@ -158,3 +190,49 @@ func Float64ToNullDecimal(f *float64) decimal.NullDecimal {
 		Valid:   true,
 	}
 }
+
+func NullDecimalToFloat64Ptr(d decimal.NullDecimal) *float64 {
+	if !d.Valid {
+		return nil
+	}
+	f, _ := d.Decimal.Float64()
+	return &f
+}
+
+//
+// =======================
+// TIME
+// =======================
+//
+
+func TimeToNull(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func NullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+//
+// =======================
+// ERROR
+// =======================
+//
+
+// IsDuplicateKeyError mendeteksi pelanggaran UNIQUE constraint MySQL (error 1062)
+func IsDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+// IsRowReferencedError mendeteksi DELETE/UPDATE yang ditolak foreign key RESTRICT (error 1451)
+func IsRowReferencedError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1451
+}
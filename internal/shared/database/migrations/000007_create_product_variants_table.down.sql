@@ -0,0 +1,5 @@
+ALTER TABLE order_items
+    DROP FOREIGN KEY fk_items_variant,
+    DROP COLUMN variant_id;
+
+DROP TABLE IF EXISTS product_variants;
\ No newline at end of file
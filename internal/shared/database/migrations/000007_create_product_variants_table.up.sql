@@ -0,0 +1,20 @@
+CREATE TABLE
+    product_variants (
+        id CHAR(36) PRIMARY KEY,
+        product_id CHAR(36) NOT NULL,
+        sku VARCHAR(64) NOT NULL UNIQUE,
+        attributes JSON NOT NULL,
+        price DECIMAL(15, 2),
+        stock_quantity INT NOT NULL DEFAULT 0,
+        is_active BOOLEAN NOT NULL DEFAULT TRUE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT fk_product_variants_product FOREIGN KEY (product_id) REFERENCES products (id) ON UPDATE CASCADE ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_product_variants_product_id ON product_variants (product_id);
+
+-- Order item boleh merujuk ke varian (ukuran/warna) tertentu
+ALTER TABLE order_items
+    ADD COLUMN variant_id CHAR(36) NULL AFTER product_id,
+    ADD CONSTRAINT fk_items_variant FOREIGN KEY (variant_id) REFERENCES product_variants (id) ON DELETE SET NULL;
\ No newline at end of file
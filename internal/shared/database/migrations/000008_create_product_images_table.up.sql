@@ -0,0 +1,19 @@
+CREATE TABLE
+    product_images (
+        id CHAR(36) PRIMARY KEY,
+        product_id CHAR(36) NOT NULL,
+        storage_key VARCHAR(255) NOT NULL,
+        thumbnail_key VARCHAR(255) NOT NULL,
+        url VARCHAR(512) NOT NULL,
+        thumbnail_url VARCHAR(512) NOT NULL,
+        content_type VARCHAR(64) NOT NULL,
+        size_bytes BIGINT NOT NULL,
+        width INT NOT NULL,
+        height INT NOT NULL,
+        position INT NOT NULL DEFAULT 0,
+        is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_product_images_product FOREIGN KEY (product_id) REFERENCES products (id) ON UPDATE CASCADE ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_product_images_product_position ON product_images (product_id, position);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE products
+    ADD COLUMN sku VARCHAR(64) NULL AFTER id;
+
+-- SKU opsional, tapi kalau diisi harus unik (dipakai untuk upsert bulk import)
+CREATE UNIQUE INDEX idx_products_sku ON products (sku);
\ No newline at end of file
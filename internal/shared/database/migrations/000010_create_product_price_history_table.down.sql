@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_price_schedules;
+
+DROP TABLE IF EXISTS product_price_history;
\ No newline at end of file
@@ -0,0 +1,34 @@
+CREATE TABLE
+    product_price_history (
+        id CHAR(36) PRIMARY KEY,
+        product_id CHAR(36) NOT NULL,
+        old_price DECIMAL(15, 2),
+        new_price DECIMAL(15, 2) NOT NULL,
+        source VARCHAR(16) NOT NULL,
+        schedule_id CHAR(36),
+        changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_price_history_product FOREIGN KEY (product_id) REFERENCES products (id) ON UPDATE CASCADE ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_price_history_product_changed_at ON product_price_history (product_id, changed_at);
+
+-- Harga terjadwal: pending -> active (punya effective_to) / completed, atau cancelled
+CREATE TABLE
+    product_price_schedules (
+        id CHAR(36) PRIMARY KEY,
+        product_id CHAR(36) NOT NULL,
+        price DECIMAL(15, 2) NOT NULL,
+        effective_from DATETIME NOT NULL,
+        effective_to DATETIME,
+        status VARCHAR(16) NOT NULL DEFAULT 'pending',
+        previous_price DECIMAL(15, 2),
+        applied_at DATETIME,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_price_schedules_product FOREIGN KEY (product_id) REFERENCES products (id) ON UPDATE CASCADE ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_price_schedules_product_id ON product_price_schedules (product_id);
+
+CREATE INDEX idx_price_schedules_status_from ON product_price_schedules (status, effective_from);
+
+CREATE INDEX idx_price_schedules_status_to ON product_price_schedules (status, effective_to);
\ No newline at end of file
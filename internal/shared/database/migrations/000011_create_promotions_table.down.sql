@@ -0,0 +1,15 @@
+ALTER TABLE order_items
+    DROP FOREIGN KEY fk_items_promotion,
+    DROP COLUMN promotion_id,
+    DROP COLUMN discount_amount;
+
+ALTER TABLE orders
+    DROP FOREIGN KEY fk_orders_promotion,
+    DROP COLUMN coupon_code,
+    DROP COLUMN promotion_id,
+    DROP COLUMN discount_total,
+    DROP COLUMN subtotal;
+
+DROP TABLE IF EXISTS promotion_redemptions;
+
+DROP TABLE IF EXISTS promotions;
\ No newline at end of file
@@ -0,0 +1,58 @@
+-- Promosi/kupon. Tipe: percentage, fixed, buy_x_get_y.
+-- category_id opsional membatasi diskon ke item dalam kategori tersebut.
+CREATE TABLE
+    promotions (
+        id CHAR(36) PRIMARY KEY,
+        code VARCHAR(64) NOT NULL UNIQUE,
+        name VARCHAR(150) NOT NULL,
+        type VARCHAR(16) NOT NULL,
+        value DECIMAL(15, 2) NOT NULL DEFAULT 0,
+        buy_quantity INT,
+        get_quantity INT,
+        category_id CHAR(36),
+        min_subtotal DECIMAL(15, 2),
+        max_discount DECIMAL(15, 2),
+        usage_limit INT,
+        usage_limit_per_customer INT,
+        used_count INT NOT NULL DEFAULT 0,
+        starts_at DATETIME,
+        ends_at DATETIME,
+        is_active BOOLEAN NOT NULL DEFAULT TRUE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT fk_promotions_category FOREIGN KEY (category_id) REFERENCES categories (id) ON UPDATE CASCADE ON DELETE RESTRICT
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_promotions_is_active ON promotions (is_active);
+
+CREATE TABLE
+    promotion_redemptions (
+        id CHAR(36) PRIMARY KEY,
+        promotion_id CHAR(36) NOT NULL,
+        customer_id CHAR(36) NOT NULL,
+        order_id CHAR(36) NOT NULL,
+        discount_amount DECIMAL(15, 2) NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_redemptions_promotion FOREIGN KEY (promotion_id) REFERENCES promotions (id) ON DELETE RESTRICT,
+        CONSTRAINT fk_redemptions_customer FOREIGN KEY (customer_id) REFERENCES customers (id),
+        CONSTRAINT fk_redemptions_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_redemptions_promotion_customer ON promotion_redemptions (promotion_id, customer_id);
+
+-- Order menyimpan subtotal, diskon dan total (total_price) secara terpisah
+ALTER TABLE orders
+    ADD COLUMN subtotal DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER total_quantity,
+    ADD COLUMN discount_total DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER subtotal,
+    ADD COLUMN promotion_id CHAR(36) NULL AFTER total_price,
+    ADD COLUMN coupon_code VARCHAR(64) NULL AFTER promotion_id,
+    ADD CONSTRAINT fk_orders_promotion FOREIGN KEY (promotion_id) REFERENCES promotions (id) ON DELETE SET NULL;
+
+UPDATE orders
+SET
+    subtotal = total_price;
+
+ALTER TABLE order_items
+    ADD COLUMN discount_amount DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER unit_price,
+    ADD COLUMN promotion_id CHAR(36) NULL AFTER discount_amount,
+    ADD CONSTRAINT fk_items_promotion FOREIGN KEY (promotion_id) REFERENCES promotions (id) ON DELETE SET NULL;
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE order_items
+    DROP COLUMN line_total,
+    DROP COLUMN tax_inclusive,
+    DROP COLUMN tax_amount,
+    DROP COLUMN tax_rate;
+
+ALTER TABLE orders
+    DROP COLUMN shipping_total,
+    DROP COLUMN tax_total;
+
+DROP TABLE IF EXISTS tax_rules;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- Aturan pajak (PPN). category_id NULL = aturan default untuk kategori tanpa aturan khusus.
+-- is_inclusive: harga produk sudah termasuk pajak (pajak diekstrak), jika tidak pajak ditambahkan.
+CREATE TABLE
+    tax_rules (
+        id CHAR(36) PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        category_id CHAR(36) UNIQUE,
+        rate DECIMAL(5, 2) NOT NULL,
+        is_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+        is_active BOOLEAN NOT NULL DEFAULT TRUE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT fk_tax_rules_category FOREIGN KEY (category_id) REFERENCES categories (id) ON UPDATE CASCADE ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+-- PPN 11% default, harga dianggap sudah termasuk pajak sehingga total order lama tidak berubah
+INSERT INTO
+    tax_rules (id, name, category_id, rate, is_inclusive)
+VALUES
+    ('00000000-0000-7000-8000-000000000011', 'PPN', NULL, 11.00, TRUE);
+
+-- total_price sekarang adalah grand total: subtotal - discount_total + pajak eksklusif + shipping_total
+ALTER TABLE orders
+    ADD COLUMN tax_total DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER discount_total,
+    ADD COLUMN shipping_total DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER tax_total;
+
+-- line_total = (unit_price * quantity) - discount_amount + tax_amount (jika eksklusif)
+ALTER TABLE order_items
+    ADD COLUMN tax_rate DECIMAL(5, 2) NOT NULL DEFAULT 0 AFTER promotion_id,
+    ADD COLUMN tax_amount DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER tax_rate,
+    ADD COLUMN tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE AFTER tax_amount,
+    ADD COLUMN line_total DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER tax_inclusive;
+
+UPDATE order_items
+SET
+    line_total = unit_price * quantity - discount_amount;
\ No newline at end of file
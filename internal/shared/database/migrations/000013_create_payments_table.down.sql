@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    DROP COLUMN paid_at,
+    DROP COLUMN payment_status;
+
+DROP TABLE IF EXISTS payment_webhook_events;
+
+DROP TABLE IF EXISTS payment_intents;
\ No newline at end of file
@@ -0,0 +1,37 @@
+-- Payment intent per order. Status: pending, succeeded, failed, cancelled.
+-- gateway_reference adalah ID intent di sisi gateway, dipakai untuk mencocokkan webhook.
+CREATE TABLE
+    payment_intents (
+        id CHAR(36) PRIMARY KEY,
+        order_id CHAR(36) NOT NULL,
+        gateway VARCHAR(32) NOT NULL,
+        gateway_reference VARCHAR(128) NOT NULL,
+        amount DECIMAL(15, 2) NOT NULL,
+        currency CHAR(3) NOT NULL DEFAULT 'IDR',
+        status VARCHAR(16) NOT NULL DEFAULT 'pending',
+        payment_url VARCHAR(512),
+        failure_reason VARCHAR(255),
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT uq_payment_intents_reference UNIQUE (gateway, gateway_reference),
+        CONSTRAINT fk_payment_intents_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_payment_intents_order_id ON payment_intents (order_id);
+
+-- Setiap event webhook dicatat sekali; UNIQUE (gateway, event_id) membuat pemrosesan idempotent
+CREATE TABLE
+    payment_webhook_events (
+        id CHAR(36) PRIMARY KEY,
+        gateway VARCHAR(32) NOT NULL,
+        event_id VARCHAR(128) NOT NULL,
+        event_type VARCHAR(64) NOT NULL,
+        payload JSON NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT uq_payment_webhook_events UNIQUE (gateway, event_id)
+    ) ENGINE = InnoDB;
+
+-- payment_status: unpaid, paid
+ALTER TABLE orders
+    ADD COLUMN payment_status VARCHAR(16) NOT NULL DEFAULT 'unpaid' AFTER coupon_code,
+    ADD COLUMN paid_at DATETIME NULL AFTER payment_status;
\ No newline at end of file
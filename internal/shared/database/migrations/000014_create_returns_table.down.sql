@@ -0,0 +1,9 @@
+ALTER TABLE orders
+    DROP COLUMN refund_total;
+
+ALTER TABLE order_items
+    DROP COLUMN returned_quantity;
+
+DROP TABLE IF EXISTS return_items;
+
+DROP TABLE IF EXISTS returns;
\ No newline at end of file
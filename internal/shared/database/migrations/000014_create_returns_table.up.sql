@@ -0,0 +1,36 @@
+-- Retur order. Status: refund_pending (order sudah dibayar, dana belum dikembalikan),
+-- refunded (dana sudah dikembalikan), completed (order belum dibayar, tidak ada dana yang dikembalikan)
+CREATE TABLE
+    returns (
+        id CHAR(36) PRIMARY KEY,
+        order_id CHAR(36) NOT NULL,
+        status VARCHAR(16) NOT NULL DEFAULT 'refund_pending',
+        reason VARCHAR(255),
+        refund_amount DECIMAL(15, 2) NOT NULL DEFAULT 0,
+        refunded_at DATETIME NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT fk_returns_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_returns_order_id ON returns (order_id);
+
+-- refund_amount per baris adalah porsi line_total (setelah diskon & pajak) untuk quantity yang diretur
+CREATE TABLE
+    return_items (
+        id CHAR(36) PRIMARY KEY,
+        return_id CHAR(36) NOT NULL,
+        order_item_id CHAR(36) NOT NULL,
+        quantity INT NOT NULL,
+        refund_amount DECIMAL(15, 2) NOT NULL,
+        CONSTRAINT fk_return_items_return FOREIGN KEY (return_id) REFERENCES returns (id) ON DELETE CASCADE,
+        CONSTRAINT fk_return_items_order_item FOREIGN KEY (order_item_id) REFERENCES order_items (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+-- returned_quantity mencegah retur melebihi quantity yang dibeli
+ALTER TABLE order_items
+    ADD COLUMN returned_quantity INT NOT NULL DEFAULT 0 AFTER line_total;
+
+-- Net revenue order = total_price - refund_total
+ALTER TABLE orders
+    ADD COLUMN refund_total DECIMAL(15, 2) NOT NULL DEFAULT 0 AFTER total_price;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS order_revisions;
+
+ALTER TABLE orders
+    DROP COLUMN status;
\ No newline at end of file
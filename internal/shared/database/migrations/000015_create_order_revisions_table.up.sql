@@ -0,0 +1,18 @@
+-- Status fulfilment order; order hanya bisa diedit selama masih pending dan belum dibayar
+ALTER TABLE orders
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'pending' AFTER customer_id;
+
+-- Log perubahan item order. changes berisi daftar {order_item_id, product_id, variant_id, old_quantity, new_quantity}
+CREATE TABLE
+    order_revisions (
+        id CHAR(36) PRIMARY KEY,
+        order_id CHAR(36) NOT NULL,
+        revision INT NOT NULL,
+        changes JSON NOT NULL,
+        previous_total DECIMAL(15, 2) NOT NULL,
+        new_total DECIMAL(15, 2) NOT NULL,
+        note VARCHAR(255),
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT uq_order_revisions UNIQUE (order_id, revision),
+        CONSTRAINT fk_order_revisions_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
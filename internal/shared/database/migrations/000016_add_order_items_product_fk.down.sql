@@ -0,0 +1,14 @@
+ALTER TABLE order_items
+    DROP FOREIGN KEY fk_items_product;
+
+DROP INDEX fk_items_product ON order_items;
+
+-- Item yang produknya terhapus kembali menjadi item yatim tanpa product_id
+UPDATE order_items
+SET
+    product_id = ''
+WHERE
+    product_id IS NULL;
+
+ALTER TABLE order_items
+    MODIFY product_id CHAR(36) NOT NULL;
\ No newline at end of file
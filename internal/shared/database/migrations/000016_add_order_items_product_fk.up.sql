@@ -0,0 +1,16 @@
+-- Tanpa foreign key, item yang produknya terhapus membuat order hilang dari listing.
+-- product_id dibuat nullable: menghapus produk mengosongkan product_id, sedangkan baris item
+-- (beserta retur yang merujuknya) tetap ada dan ditampilkan dari snapshot-nya.
+-- Item yatim yang sudah ada (produknya terhapus lebih dulu) diperlakukan sama.
+ALTER TABLE order_items
+    MODIFY product_id CHAR(36) NULL;
+
+UPDATE order_items oi
+LEFT JOIN products p ON p.id = oi.product_id
+SET
+    oi.product_id = NULL
+WHERE
+    p.id IS NULL;
+
+ALTER TABLE order_items
+    ADD CONSTRAINT fk_items_product FOREIGN KEY (product_id) REFERENCES products (id) ON UPDATE CASCADE ON DELETE SET NULL;
\ No newline at end of file
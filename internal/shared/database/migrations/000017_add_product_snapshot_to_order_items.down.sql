@@ -0,0 +1,4 @@
+ALTER TABLE order_items
+    DROP COLUMN category_name,
+    DROP COLUMN sku,
+    DROP COLUMN product_name;
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- Snapshot data produk saat order dibuat, sehingga rename produk/kategori tidak mengubah order lama.
+-- unit_price per item diisi dari harga efektif varian/produk saat order dibuat; sku berasal dari varian bila item merujuk varian.
+ALTER TABLE order_items
+    ADD COLUMN product_name VARCHAR(150) NOT NULL DEFAULT '' AFTER variant_id,
+    ADD COLUMN sku VARCHAR(64) NULL AFTER product_name,
+    ADD COLUMN category_name VARCHAR(100) NOT NULL DEFAULT '' AFTER sku;
+
+-- Backfill order lama dari data produk saat ini (snapshot terbaik yang masih tersedia)
+UPDATE order_items oi
+JOIN products p ON p.id = oi.product_id
+JOIN categories c ON c.id = p.category_id
+LEFT JOIN product_variants v ON v.id = oi.variant_id
+SET
+    oi.product_name = p.name,
+    oi.sku = COALESCE(v.sku, p.sku),
+    oi.category_name = c.name;
+
+-- Produk item yatim sudah tidak ada; unit_price tetap dari baris itu sendiri, nama diberi penanda
+UPDATE order_items
+SET
+    product_name = 'Produk terhapus'
+WHERE
+    product_id IS NULL;
\ No newline at end of file
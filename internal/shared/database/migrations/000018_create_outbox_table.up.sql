@@ -0,0 +1,20 @@
+-- Transactional outbox: event domain ditulis di transaksi yang sama dengan perubahannya,
+-- lalu dipublikasikan oleh relay (at-least-once). Baris yang gagal dijadwalkan ulang lewat available_at.
+CREATE TABLE
+    outbox (
+        id CHAR(36) PRIMARY KEY,
+        aggregate_type VARCHAR(32) NOT NULL,
+        aggregate_id CHAR(36) NOT NULL,
+        event_type VARCHAR(64) NOT NULL,
+        payload JSON NOT NULL,
+        attempts INT NOT NULL DEFAULT 0,
+        last_error VARCHAR(500),
+        available_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        published_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    ) ENGINE = InnoDB;
+
+-- Relay hanya membaca baris yang belum dipublikasikan & sudah jatuh tempo
+CREATE INDEX idx_outbox_pending ON outbox (published_at, available_at);
+
+CREATE INDEX idx_outbox_aggregate ON outbox (aggregate_type, aggregate_id);
\ No newline at end of file
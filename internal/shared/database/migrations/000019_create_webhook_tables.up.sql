@@ -0,0 +1,52 @@
+-- Endpoint webhook milik partner. event_types berisi daftar jenis event outbox yang dilanggan.
+-- Endpoint dinonaktifkan otomatis saat consecutive_failures mencapai batas.
+CREATE TABLE
+    webhook_endpoints (
+        id CHAR(36) PRIMARY KEY,
+        url VARCHAR(500) NOT NULL,
+        description VARCHAR(255),
+        secret VARCHAR(128) NOT NULL,
+        event_types JSON NOT NULL,
+        is_active BOOLEAN NOT NULL DEFAULT TRUE,
+        consecutive_failures INT NOT NULL DEFAULT 0,
+        disabled_reason VARCHAR(255),
+        disabled_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    ) ENGINE = InnoDB;
+
+-- Satu delivery per (endpoint, event); unique key membuat fan-out ulang dari relay idempotent.
+-- payload adalah body yang dikirim & ditandatangani, disimpan agar sama persis di setiap retry.
+CREATE TABLE
+    webhook_deliveries (
+        id CHAR(36) PRIMARY KEY,
+        endpoint_id CHAR(36) NOT NULL,
+        event_id CHAR(36) NOT NULL,
+        event_type VARCHAR(64) NOT NULL,
+        payload JSON NOT NULL,
+        status VARCHAR(16) NOT NULL DEFAULT 'pending',
+        attempts INT NOT NULL DEFAULT 0,
+        next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        last_response_code INT,
+        last_error VARCHAR(500),
+        delivered_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT uq_webhook_deliveries_event UNIQUE (endpoint_id, event_id),
+        CONSTRAINT fk_webhook_deliveries_endpoint FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+
+-- Log setiap percobaan pengiriman beserta response code dari endpoint
+CREATE TABLE
+    webhook_delivery_attempts (
+        id CHAR(36) PRIMARY KEY,
+        delivery_id CHAR(36) NOT NULL,
+        response_code INT,
+        response_body VARCHAR(1000),
+        error_message VARCHAR(500),
+        duration_ms INT NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_webhook_attempts_delivery FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- Antrean job background yang durable. Job yang gagal dijadwalkan ulang lewat run_at,
+-- dan dipindah ke status 'dead' (dead-letter) setelah max_attempts habis.
+CREATE TABLE
+    jobs (
+        id CHAR(36) PRIMARY KEY,
+        type VARCHAR(100) NOT NULL,
+        payload JSON NOT NULL,
+        status VARCHAR(20) NOT NULL DEFAULT 'pending',
+        attempts INT NOT NULL DEFAULT 0,
+        max_attempts INT NOT NULL DEFAULT 5,
+        -- Mencegah job yang sama (mis. satu jadwal cron) diantrekan dua kali
+        unique_key VARCHAR(191) NULL,
+        run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        -- Job 'running' yang melewati locked_until dianggap ditinggal worker & diambil ulang
+        locked_until TIMESTAMP NULL,
+        last_error VARCHAR(500),
+        completed_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        UNIQUE KEY uq_jobs_unique_key (unique_key)
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_jobs_due ON jobs (status, run_at);
+
+CREATE INDEX idx_jobs_locked ON jobs (status, locked_until);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS order_shipments;
\ No newline at end of file
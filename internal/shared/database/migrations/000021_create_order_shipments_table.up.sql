@@ -0,0 +1,9 @@
+-- Data pengiriman order; order berstatus 'shipped' setelah baris ini dibuat
+CREATE TABLE
+    order_shipments (
+        order_id CHAR(36) PRIMARY KEY,
+        carrier VARCHAR(50) NOT NULL,
+        tracking_number VARCHAR(100) NOT NULL,
+        shipped_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_order_shipments_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
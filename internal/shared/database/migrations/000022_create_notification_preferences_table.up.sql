@@ -0,0 +1,10 @@
+-- Preferensi email per customer; customer tanpa baris di sini menerima semua notifikasi
+CREATE TABLE
+    notification_preferences (
+        customer_id CHAR(36) PRIMARY KEY,
+        order_confirmation BOOLEAN NOT NULL DEFAULT TRUE,
+        order_shipped BOOLEAN NOT NULL DEFAULT TRUE,
+        order_cancelled BOOLEAN NOT NULL DEFAULT TRUE,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT fk_notification_preferences_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE customers
+    DROP COLUMN email_verified_at,
+    DROP COLUMN password_hash;
\ No newline at end of file
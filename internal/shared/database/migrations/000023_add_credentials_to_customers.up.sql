@@ -0,0 +1,5 @@
+-- Kredensial untuk registrasi mandiri. Customer yang dibuat admin tidak punya password
+-- sampai mereka memakai alur reset password.
+ALTER TABLE customers
+    ADD COLUMN password_hash VARCHAR(255) NULL AFTER email,
+    ADD COLUMN email_verified_at TIMESTAMP NULL AFTER password_hash;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Token login, verifikasi email & reset password. Hanya hash SHA-256 yang disimpan;
+-- token mentah hanya diketahui customer.
+CREATE TABLE
+    customer_tokens (
+        token_hash CHAR(64) PRIMARY KEY,
+        customer_id CHAR(36) NOT NULL,
+        purpose VARCHAR(32) NOT NULL,
+        expires_at TIMESTAMP NOT NULL,
+        used_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CONSTRAINT fk_customer_tokens_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_customer_tokens_customer_purpose ON customer_tokens (customer_id, purpose);
\ No newline at end of file
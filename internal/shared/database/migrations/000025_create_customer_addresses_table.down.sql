@@ -0,0 +1 @@
+DROP TABLE IF EXISTS customer_addresses;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- Alamat pengiriman customer. Label unik per customer (mis. "Rumah", "Kantor");
+-- paling banyak satu alamat default, dijaga oleh service.
+CREATE TABLE
+    customer_addresses (
+        id CHAR(36) PRIMARY KEY,
+        customer_id CHAR(36) NOT NULL,
+        label VARCHAR(50) NOT NULL,
+        recipient_name VARCHAR(255) NOT NULL,
+        phone VARCHAR(20) NOT NULL,
+        street VARCHAR(255) NOT NULL,
+        city VARCHAR(100) NOT NULL,
+        province VARCHAR(50) NOT NULL,
+        postal_code CHAR(5) NOT NULL,
+        is_default BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+        CONSTRAINT uq_customer_addresses_label UNIQUE (customer_id, label),
+        CONSTRAINT fk_customer_addresses_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS order_shipping_addresses;
\ No newline at end of file
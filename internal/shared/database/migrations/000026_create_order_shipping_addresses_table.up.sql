@@ -0,0 +1,14 @@
+-- Snapshot alamat tujuan order. address_id hanya referensi ke alamat asal (tanpa FK),
+-- sehingga mengubah atau menghapus alamat customer tidak mengubah order lama.
+CREATE TABLE
+    order_shipping_addresses (
+        order_id CHAR(36) PRIMARY KEY,
+        address_id CHAR(36),
+        recipient_name VARCHAR(255) NOT NULL,
+        phone VARCHAR(20) NOT NULL,
+        street VARCHAR(255) NOT NULL,
+        city VARCHAR(100) NOT NULL,
+        province VARCHAR(50) NOT NULL,
+        postal_code CHAR(5) NOT NULL,
+        CONSTRAINT fk_order_shipping_addresses_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+    ) ENGINE = InnoDB;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE products
+    DROP COLUMN height_cm,
+    DROP COLUMN width_cm,
+    DROP COLUMN length_cm,
+    DROP COLUMN weight_grams;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- Berat (gram) & dimensi (cm) untuk ongkos kirim. 0 berarti belum diisi.
+ALTER TABLE products
+    ADD COLUMN weight_grams INT NOT NULL DEFAULT 0 AFTER stock_quantity,
+    ADD COLUMN length_cm INT NOT NULL DEFAULT 0 AFTER weight_grams,
+    ADD COLUMN width_cm INT NOT NULL DEFAULT 0 AFTER length_cm,
+    ADD COLUMN height_cm INT NOT NULL DEFAULT 0 AFTER width_cm;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    DROP FOREIGN KEY fk_orders_shipping_method,
+    DROP COLUMN shipping_method_name,
+    DROP COLUMN shipping_method_id;
+
+DROP TABLE IF EXISTS shipping_methods;
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- Metode pengiriman. rate_type menentukan cara hitung ongkir:
+--   flat       : base_rate
+--   weight     : base_rate + rate_per_kg x berat tertagih (kg, dibulatkan ke atas, minimal 1 kg)
+--   free_above : gratis, hanya tersedia jika nilai belanja >= free_threshold
+CREATE TABLE
+    shipping_methods (
+        id CHAR(36) PRIMARY KEY,
+        code VARCHAR(50) NOT NULL UNIQUE,
+        name VARCHAR(100) NOT NULL,
+        rate_type VARCHAR(20) NOT NULL,
+        base_rate DECIMAL(15, 2) NOT NULL DEFAULT 0,
+        rate_per_kg DECIMAL(15, 2) NOT NULL DEFAULT 0,
+        free_threshold DECIMAL(15, 2),
+        is_active BOOLEAN NOT NULL DEFAULT TRUE,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    ) ENGINE = InnoDB;
+
+-- Nama metode disalin ke order supaya mengubah/menghapus metode tidak mengubah order lama
+ALTER TABLE orders
+    ADD COLUMN shipping_method_id CHAR(36) NULL AFTER shipping_total,
+    ADD COLUMN shipping_method_name VARCHAR(100) NULL AFTER shipping_method_id,
+    ADD CONSTRAINT fk_orders_shipping_method FOREIGN KEY (shipping_method_id) REFERENCES shipping_methods (id) ON DELETE SET NULL;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE customers
+    DROP COLUMN anonymised_at;
\ No newline at end of file
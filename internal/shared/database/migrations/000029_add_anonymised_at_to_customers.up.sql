@@ -0,0 +1,4 @@
+-- Customer yang dianonimkan (permintaan penghapusan data pribadi) tetap disimpan agar
+-- order-nya utuh untuk keperluan akuntansi; anonymised_at menandai data pribadinya sudah dihapus.
+ALTER TABLE customers
+    ADD COLUMN anonymised_at TIMESTAMP NULL AFTER email_verified_at;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Normalisasi email tidak dapat dikembalikan; hanya tabel laporan yang dihapus
+DROP TABLE IF EXISTS customer_email_duplicates;
\ No newline at end of file
@@ -0,0 +1,58 @@
+-- Email customer kini disimpan dalam huruf kecil tanpa spasi (lihat helper.NormalizeEmail).
+-- Migrasi satu kali ini menormalisasi data lama. Customer yang email-nya bentrok setelah
+-- dinormalisasi (mis. User@x.com & user@x.com) TIDAK diubah, melainkan dilaporkan di tabel
+-- customer_email_duplicates untuk digabungkan (merge) secara manual:
+--   SELECT * FROM customer_email_duplicates ORDER BY normalised_email, customer_created_at;
+-- Setelah semua grup digabungkan, jalankan ulang UPDATE di bawah lalu kosongkan tabel laporan.
+CREATE TABLE
+    customer_email_duplicates (
+        customer_id CHAR(36) PRIMARY KEY,
+        normalised_email VARCHAR(255) NOT NULL,
+        email VARCHAR(255) NOT NULL,
+        order_count INT NOT NULL,
+        customer_created_at TIMESTAMP NOT NULL,
+        detected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    ) ENGINE = InnoDB;
+
+CREATE INDEX idx_customer_email_duplicates_email ON customer_email_duplicates (normalised_email);
+
+INSERT INTO
+    customer_email_duplicates (customer_id, normalised_email, email, order_count, customer_created_at)
+SELECT
+    c.id,
+    d.normalised_email,
+    c.email,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            orders o
+        WHERE
+            o.customer_id = c.id
+    ),
+    c.created_at
+FROM
+    customers c
+    JOIN (
+        SELECT
+            LOWER(TRIM(email)) AS normalised_email
+        FROM
+            customers
+        GROUP BY
+            LOWER(TRIM(email))
+        HAVING
+            COUNT(*) > 1
+    ) d ON d.normalised_email = LOWER(TRIM(c.email));
+
+-- Perbandingan biner agar tetap mendeteksi beda huruf besar/kecil pada collation case-insensitive
+UPDATE customers
+SET
+    email = LOWER(TRIM(email))
+WHERE
+    CAST(email AS BINARY) <> CAST(LOWER(TRIM(email)) AS BINARY)
+    AND id NOT IN (
+        SELECT
+            customer_id
+        FROM
+            customer_email_duplicates
+    );
\ No newline at end of file
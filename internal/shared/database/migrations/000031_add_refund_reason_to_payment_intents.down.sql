@@ -0,0 +1,2 @@
+ALTER TABLE payment_intents
+    DROP COLUMN refund_reason;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Pembayaran yang berhasil di gateway tetapi tidak bisa melunasi order (intent sudah dibatalkan,
+-- order dibatalkan, atau nominal tidak lagi sama dengan total order) diberi refund_reason
+-- agar dananya dikembalikan secara manual
+ALTER TABLE payment_intents
+    ADD COLUMN refund_reason VARCHAR(255) NULL AFTER failure_reason;
\ No newline at end of file
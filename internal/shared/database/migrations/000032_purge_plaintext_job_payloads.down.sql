@@ -0,0 +1,2 @@
+-- Job & token yang dihapus tidak dapat dikembalikan
+DO 0;
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- Email akun yang sudah diantrekan menyimpan token mentah di payload job. Job tersebut dihapus dan
+-- token verifikasi/reset yang belum dipakai dicabut; customer cukup meminta kirim ulang.
+DELETE FROM jobs
+WHERE
+    type = 'notification.send_account_email';
+
+DELETE FROM customer_tokens
+WHERE
+    purpose IN ('email_verification', 'password_reset')
+    AND used_at IS NULL;
+
+-- Job selesai tidak lagi menyimpan payload (lihat CompleteJob)
+UPDATE jobs
+SET
+    payload = JSON_OBJECT()
+WHERE
+    status = 'completed';
\ No newline at end of file
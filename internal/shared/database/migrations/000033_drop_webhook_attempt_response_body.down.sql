@@ -0,0 +1,2 @@
+ALTER TABLE webhook_delivery_attempts
+    ADD COLUMN response_body VARCHAR(1000) AFTER response_code;
\ No newline at end of file
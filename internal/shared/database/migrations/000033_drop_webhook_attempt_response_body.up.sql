@@ -0,0 +1,4 @@
+-- Body response endpoint tidak lagi disimpan: URL endpoint bisa diarahkan ke layanan internal
+-- dan isi balasannya akan terbaca lewat log delivery. Hanya status code yang dicatat.
+ALTER TABLE webhook_delivery_attempts
+    DROP COLUMN response_body;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE order_items
+    DROP COLUMN variant_sku;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- Snapshot sku varian saat order dibuat. variant_id di-SET NULL saat varian dihapus;
+-- variant_sku yang terisi dengan variant_id NULL menandai item yang variannya sudah tidak ada.
+ALTER TABLE order_items
+    ADD COLUMN variant_sku VARCHAR(64) NULL AFTER variant_id;
+
+UPDATE order_items oi
+JOIN product_variants v ON v.id = oi.variant_id
+SET
+    oi.variant_sku = v.sku;
+
+-- Item lama yang variannya sudah terhapus: snapshot sku berasal dari varian bila berbeda dari sku produk
+UPDATE order_items oi
+JOIN products p ON p.id = oi.product_id
+SET
+    oi.variant_sku = oi.sku
+WHERE
+    oi.variant_id IS NULL
+    AND oi.sku IS NOT NULL
+    AND NOT (oi.sku <=> p.sku);
\ No newline at end of file
@@ -2,18 +2,28 @@
 INSERT INTO categories (
     id,
     name,
-    description
+    description,
+    is_active,
+    created_at,
+    updated_at
 ) VALUES (
-    ?, ?, ?
+    ?, ?, ?, ?, ?, ?
 );
 
 -- name: GetCategories :many
 SELECT
-    id,
-    name,
-    description
-FROM categories
-ORDER BY name ASC
+    c.id,
+    c.name,
+    c.description,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    COUNT(p.id) AS product_count,
+    CAST(IFNULL(SUM(p.is_active), 0) AS SIGNED) AS active_product_count
+FROM categories c
+LEFT JOIN products p ON p.category_id = c.id
+GROUP BY c.id
+ORDER BY c.name ASC
 LIMIT
     ?
 OFFSET
@@ -21,20 +31,35 @@ OFFSET
 
 -- name: GetCategoryByID :one
 SELECT
-    id,
-    name,
-    description
-FROM categories
-WHERE id = ?
+    c.id,
+    c.name,
+    c.description,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    COUNT(p.id) AS product_count,
+    CAST(IFNULL(SUM(p.is_active), 0) AS SIGNED) AS active_product_count
+FROM categories c
+LEFT JOIN products p ON p.category_id = c.id
+WHERE c.id = ?
+GROUP BY c.id
 LIMIT 1;
 
 -- name: UpdateCategory :exec
 UPDATE categories
 SET
     name = ?,
-    description = ?
+    description = ?,
+    is_active = ?
 WHERE id = ?;
 
 -- name: DeleteCategory :exec
 DELETE FROM categories
 WHERE id = ?;
+
+-- name: ListCategoryNames :many
+SELECT
+    id,
+    name
+FROM
+    categories;
\ No newline at end of file
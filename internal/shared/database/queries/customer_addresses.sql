@@ -0,0 +1,117 @@
+-- name: CreateCustomerAddress :exec
+INSERT INTO
+    customer_addresses (
+        id,
+        customer_id,
+        label,
+        recipient_name,
+        phone,
+        street,
+        city,
+        province,
+        postal_code,
+        is_default,
+        created_at,
+        updated_at
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListCustomerAddresses :many
+SELECT
+    id,
+    customer_id,
+    label,
+    recipient_name,
+    phone,
+    street,
+    city,
+    province,
+    postal_code,
+    is_default,
+    created_at,
+    updated_at
+FROM
+    customer_addresses
+WHERE
+    customer_id = ?
+ORDER BY
+    is_default DESC,
+    created_at ASC;
+
+-- name: GetCustomerAddress :one
+SELECT
+    id,
+    customer_id,
+    label,
+    recipient_name,
+    phone,
+    street,
+    city,
+    province,
+    postal_code,
+    is_default,
+    created_at,
+    updated_at
+FROM
+    customer_addresses
+WHERE
+    id = ?
+    AND customer_id = ?
+LIMIT
+    1;
+
+-- name: CountCustomerAddresses :one
+SELECT
+    COUNT(*)
+FROM
+    customer_addresses
+WHERE
+    customer_id = ?;
+
+-- name: UpdateCustomerAddress :exec
+UPDATE customer_addresses
+SET
+    label = ?,
+    recipient_name = ?,
+    phone = ?,
+    street = ?,
+    city = ?,
+    province = ?,
+    postal_code = ?,
+    is_default = ?,
+    updated_at = ?
+WHERE
+    id = ?
+    AND customer_id = ?;
+
+-- name: DeleteCustomerAddress :execrows
+DELETE FROM customer_addresses
+WHERE
+    id = ?
+    AND customer_id = ?;
+
+-- name: ClearDefaultCustomerAddress :exec
+UPDATE customer_addresses
+SET
+    is_default = FALSE
+WHERE
+    customer_id = ?
+    AND is_default = TRUE;
+
+-- name: PromoteDefaultCustomerAddress :exec
+-- Menjadikan alamat tertua sebagai default setelah alamat default dihapus
+UPDATE customer_addresses
+SET
+    is_default = TRUE
+WHERE
+    customer_id = ?
+ORDER BY
+    created_at ASC
+LIMIT
+    1;
+
+-- name: DeleteAllCustomerAddresses :execrows
+DELETE FROM customer_addresses
+WHERE
+    customer_id = ?;
\ No newline at end of file
@@ -0,0 +1,49 @@
+-- name: CreateCustomerToken :exec
+INSERT INTO
+    customer_tokens (token_hash, customer_id, purpose, expires_at)
+VALUES
+    (?, ?, ?, ?);
+
+-- name: GetValidCustomerToken :one
+SELECT
+    token_hash,
+    customer_id,
+    purpose,
+    expires_at,
+    used_at,
+    created_at
+FROM
+    customer_tokens
+WHERE
+    token_hash = sqlc.arg(token_hash)
+    AND purpose = sqlc.arg(purpose)
+    AND used_at IS NULL
+    AND expires_at > sqlc.arg(now)
+LIMIT
+    1;
+
+-- name: UseCustomerToken :execrows
+-- Token sekali pakai: hanya request pertama yang mendapat 1 baris ter-update
+UPDATE customer_tokens
+SET
+    used_at = sqlc.arg(now)
+WHERE
+    token_hash = sqlc.arg(token_hash)
+    AND used_at IS NULL
+    AND expires_at > sqlc.arg(now);
+
+-- name: DeleteCustomerToken :exec
+DELETE FROM customer_tokens
+WHERE
+    token_hash = ?;
+
+-- name: DeleteCustomerTokens :exec
+DELETE FROM customer_tokens
+WHERE
+    customer_id = ?
+    AND purpose = ?;
+
+-- name: DeleteAllCustomerTokens :exec
+DELETE FROM customer_tokens
+WHERE
+    customer_id = ?;
\ No newline at end of file
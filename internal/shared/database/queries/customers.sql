@@ -5,25 +5,126 @@ VALUES
     (?, ?, ?, ?);
 
 -- name: GetCustomers :many
+-- total_spent dihitung sama seperti ringkasan customer: total_price dikurangi refund retur, tanpa order yang dibatalkan
 SELECT
-    id,
-    name,
-    email,
-    created_at
+    c.id,
+    c.name,
+    c.email,
+    c.created_at,
+    CAST(IFNULL (s.order_count, 0) AS SIGNED) AS order_count,
+    CAST(IFNULL (s.total_spent, 0) AS DECIMAL(15, 2)) AS total_spent
 FROM
-    customers
+    customers c
+    LEFT JOIN (
+        SELECT
+            customer_id,
+            COUNT(*) AS order_count,
+            SUM(total_price - refund_total) AS total_spent
+        FROM
+            orders
+        WHERE
+            status <> 'cancelled'
+        GROUP BY
+            customer_id
+    ) s ON s.customer_id = c.id
+WHERE
+    (
+        sqlc.arg ('search') = ''
+        OR c.name LIKE CONCAT (sqlc.arg ('search'), '%')
+        OR c.email LIKE CONCAT (sqlc.arg ('search'), '%')
+    )
+    AND (
+        sqlc.narg ('created_from') IS NULL
+        OR c.created_at >= sqlc.narg ('created_from')
+    )
+    AND (
+        sqlc.narg ('created_to') IS NULL
+        OR c.created_at < sqlc.narg ('created_to')
+    )
+    AND (
+        sqlc.narg ('min_spent') IS NULL
+        OR IFNULL (s.total_spent, 0) >= sqlc.narg ('min_spent')
+    )
+    AND (
+        sqlc.narg ('max_spent') IS NULL
+        OR IFNULL (s.total_spent, 0) <= sqlc.narg ('max_spent')
+    )
 ORDER BY
-    created_at DESC
+    CASE
+        WHEN sqlc.arg ('order_by') = 'name_asc' THEN c.name
+    END ASC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'name_desc' THEN c.name
+    END DESC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'created_asc' THEN c.created_at
+    END ASC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'total_spent_asc' THEN IFNULL (s.total_spent, 0)
+    END ASC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'total_spent_desc' THEN IFNULL (s.total_spent, 0)
+    END DESC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'order_count_asc' THEN IFNULL (s.order_count, 0)
+    END ASC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'order_count_desc' THEN IFNULL (s.order_count, 0)
+    END DESC,
+    c.created_at DESC,
+    c.id DESC
 LIMIT
     ?
 OFFSET
     ?;
 
+-- name: CountCustomers :one
+SELECT
+    COUNT(*) AS total
+FROM
+    customers c
+    LEFT JOIN (
+        SELECT
+            customer_id,
+            COUNT(*) AS order_count,
+            SUM(total_price - refund_total) AS total_spent
+        FROM
+            orders
+        WHERE
+            status <> 'cancelled'
+        GROUP BY
+            customer_id
+    ) s ON s.customer_id = c.id
+WHERE
+    (
+        sqlc.arg ('search') = ''
+        OR c.name LIKE CONCAT (sqlc.arg ('search'), '%')
+        OR c.email LIKE CONCAT (sqlc.arg ('search'), '%')
+    )
+    AND (
+        sqlc.narg ('created_from') IS NULL
+        OR c.created_at >= sqlc.narg ('created_from')
+    )
+    AND (
+        sqlc.narg ('created_to') IS NULL
+        OR c.created_at < sqlc.narg ('created_to')
+    )
+    AND (
+        sqlc.narg ('min_spent') IS NULL
+        OR IFNULL (s.total_spent, 0) >= sqlc.narg ('min_spent')
+    )
+    AND (
+        sqlc.narg ('max_spent') IS NULL
+        OR IFNULL (s.total_spent, 0) <= sqlc.narg ('max_spent')
+    );
+
 -- name: GetCustomerByID :one
 SELECT
     id,
     name,
     email,
+    email_verified_at,
+    anonymised_at,
     created_at
 FROM
     customers
@@ -32,6 +133,16 @@ WHERE
 LIMIT
     1;
 
+-- name: GetCustomerIDByEmail :one
+SELECT
+    id
+FROM
+    customers
+WHERE
+    email = ?
+LIMIT
+    1;
+
 -- name: UpdateCustomer :exec
 UPDATE customers
 SET
@@ -50,14 +161,158 @@ SELECT
     c.id,
     c.name,
     c.email,
-    CAST(SUM(o.total_price) AS DECIMAL(10, 2)) as total_spent,
+    CAST(SUM(o.total_price - o.refund_total) AS DECIMAL(10, 2)) as total_spent,
     COUNT(o.id) as total_orders
 FROM
     customers c
     JOIN orders o ON c.id = o.customer_id
+WHERE
+    o.status <> 'cancelled'
 GROUP BY
     c.id
 ORDER BY
     total_spent DESC
 LIMIT
-    ?;
\ No newline at end of file
+    ?;
+
+-- name: CustomerExists :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            customers
+        WHERE
+            id = ?
+    ) AS customer_exists;
+
+-- name: GetCustomerOrderStats :one
+-- Order yang dibatalkan tidak dihitung sebagai pembelian
+SELECT
+    COUNT(*) AS order_count,
+    CAST(IFNULL (SUM(total_price - refund_total), 0) AS DECIMAL(15, 2)) AS lifetime_spend,
+    MIN(created_at) AS first_order_at,
+    MAX(created_at) AS last_order_at
+FROM
+    orders
+WHERE
+    customer_id = ?
+    AND status <> 'cancelled';
+
+-- name: GetCustomerFavouriteCategories :many
+-- Kategori favorit dari snapshot category_name di order_items, diurutkan dari jumlah item bersih (setelah retur);
+-- total_spent sudah dikurangi refund retur per baris
+SELECT
+    oi.category_name,
+    CAST(SUM(oi.quantity - oi.returned_quantity) AS SIGNED) AS total_quantity,
+    CAST(SUM(oi.line_total - IFNULL(ri.refunded, 0)) AS DECIMAL(15, 2)) AS total_spent,
+    COUNT(DISTINCT o.id) AS order_count
+FROM
+    orders o
+    JOIN order_items oi ON oi.order_id = o.id
+    LEFT JOIN (
+        SELECT
+            order_item_id,
+            SUM(refund_amount) AS refunded
+        FROM
+            return_items
+        GROUP BY
+            order_item_id
+    ) ri ON ri.order_item_id = oi.id
+WHERE
+    o.customer_id = ?
+    AND o.status <> 'cancelled'
+GROUP BY
+    oi.category_name
+HAVING
+    total_quantity > 0
+ORDER BY
+    total_quantity DESC,
+    total_spent DESC,
+    oi.category_name ASC
+LIMIT
+    ?;
+
+-- name: CreateCustomerAccount :exec
+INSERT INTO
+    customers (id, name, email, password_hash, created_at)
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: GetCustomerCredentialsByEmail :one
+SELECT
+    id,
+    name,
+    email,
+    password_hash,
+    email_verified_at,
+    created_at
+FROM
+    customers
+WHERE
+    email = ?
+LIMIT
+    1;
+
+-- name: GetCustomerAccountByID :one
+SELECT
+    id,
+    name,
+    email,
+    email_verified_at,
+    created_at
+FROM
+    customers
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: UpdateCustomerProfile :exec
+-- Mengganti email membatalkan status verifikasi; email_verified_at dievaluasi sebelum email diubah
+UPDATE customers
+SET
+    email_verified_at = IF(email = sqlc.arg(email), email_verified_at, NULL),
+    name = sqlc.arg(name),
+    email = sqlc.arg(email)
+WHERE
+    id = sqlc.arg(id);
+
+-- name: UpdateCustomerPassword :exec
+UPDATE customers
+SET
+    password_hash = ?
+WHERE
+    id = ?;
+
+-- name: MarkCustomerEmailVerified :exec
+UPDATE customers
+SET
+    email_verified_at = ?
+WHERE
+    id = ?
+    AND email_verified_at IS NULL;
+
+-- name: LockCustomer :one
+-- Mengunci baris customer agar perubahan alamat default untuk satu customer berjalan berurutan
+SELECT
+    id
+FROM
+    customers
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE;
+
+-- name: AnonymiseCustomer :execrows
+-- Menghapus data pribadi customer; 0 baris berarti customer sudah dianonimkan sebelumnya
+UPDATE customers
+SET
+    name = ?,
+    email = ?,
+    password_hash = NULL,
+    email_verified_at = NULL,
+    anonymised_at = ?
+WHERE
+    id = ?
+    AND anonymised_at IS NULL;
\ No newline at end of file
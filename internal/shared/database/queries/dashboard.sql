@@ -13,4 +13,16 @@ FROM
     products
 ORDER BY 
     created_at DESC
-LIMIT ?;
\ No newline at end of file
+LIMIT ?;
+
+-- name: GetRevenueReport :one
+-- Order yang dibatalkan tidak pernah menjadi pendapatan
+SELECT
+    COUNT(*) AS total_orders,
+    CAST(IFNULL(SUM(total_price), 0) AS DECIMAL(15, 2)) AS gross_revenue,
+    CAST(IFNULL(SUM(refund_total), 0) AS DECIMAL(15, 2)) AS total_refunds,
+    CAST(IFNULL(SUM(total_price - refund_total), 0) AS DECIMAL(15, 2)) AS net_revenue
+FROM
+    orders
+WHERE
+    status <> 'cancelled';
\ No newline at end of file
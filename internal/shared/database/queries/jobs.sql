@@ -0,0 +1,154 @@
+-- name: CreateJob :execrows
+-- INSERT IGNORE: 0 baris berarti unique_key sudah pernah diantrekan
+INSERT IGNORE INTO
+    jobs (
+        id,
+        type,
+        payload,
+        max_attempts,
+        unique_key,
+        run_at
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: ListClaimableJobs :many
+-- SKIP LOCKED agar beberapa worker bisa mengambil job bersamaan tanpa bentrok
+SELECT
+    id,
+    type,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    unique_key,
+    run_at,
+    locked_until,
+    last_error,
+    completed_at,
+    created_at,
+    updated_at
+FROM
+    jobs
+WHERE
+    (
+        status = 'pending'
+        AND run_at <= sqlc.arg(now)
+    )
+    OR (
+        status = 'running'
+        AND locked_until <= sqlc.arg(now)
+    )
+ORDER BY
+    run_at,
+    id
+LIMIT
+    ? FOR UPDATE SKIP LOCKED;
+
+-- name: MarkJobRunning :exec
+UPDATE jobs
+SET
+    status = 'running',
+    attempts = attempts + 1,
+    locked_until = ?
+WHERE
+    id = ?;
+
+-- name: CompleteJob :exec
+-- Payload dikosongkan agar data rahasia/pribadi (token, email) tidak tertinggal setelah job selesai
+UPDATE jobs
+SET
+    status = 'completed',
+    payload = JSON_OBJECT(),
+    locked_until = NULL,
+    last_error = NULL,
+    completed_at = ?
+WHERE
+    id = ?;
+
+-- name: RetryJob :exec
+UPDATE jobs
+SET
+    status = 'pending',
+    locked_until = NULL,
+    last_error = ?,
+    run_at = ?
+WHERE
+    id = ?;
+
+-- name: BuryJob :exec
+UPDATE jobs
+SET
+    status = 'dead',
+    locked_until = NULL,
+    last_error = ?
+WHERE
+    id = ?;
+
+-- name: ListDeadJobs :many
+SELECT
+    id,
+    type,
+    payload,
+    status,
+    attempts,
+    max_attempts,
+    unique_key,
+    run_at,
+    locked_until,
+    last_error,
+    completed_at,
+    created_at,
+    updated_at
+FROM
+    jobs
+WHERE
+    status = 'dead'
+ORDER BY
+    updated_at DESC,
+    id DESC
+LIMIT
+    ?
+OFFSET
+    ?;
+
+-- name: RequeueDeadJob :execrows
+-- Memberi jatah percobaan baru pada job di dead-letter
+UPDATE jobs
+SET
+    status = 'pending',
+    attempts = 0,
+    last_error = NULL,
+    run_at = ?
+WHERE
+    id = ?
+    AND status = 'dead';
+
+-- name: DeleteCompletedJobsBefore :execrows
+DELETE FROM jobs
+WHERE
+    status = 'completed'
+    AND completed_at < ?
+LIMIT
+    ?;
+
+-- name: DeleteCustomerEmailJobs :execrows
+-- Menghapus email akun & email order customer yang belum selesai (termasuk dead-letter);
+-- job selesai tidak ikut cocok karena payload-nya sudah dikosongkan
+DELETE FROM jobs
+WHERE
+    (
+        type = sqlc.arg(account_email_type)
+        AND payload ->> '$.customer_id' = sqlc.arg(customer_id)
+    )
+    OR (
+        type = sqlc.arg(order_email_type)
+        AND payload ->> '$.order_id' IN (
+            SELECT
+                id
+            FROM
+                orders
+            WHERE
+                customer_id = sqlc.arg(customer_id)
+        )
+    );
\ No newline at end of file
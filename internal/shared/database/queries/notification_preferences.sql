@@ -0,0 +1,28 @@
+-- name: GetNotificationPreferences :one
+SELECT
+    customer_id,
+    order_confirmation,
+    order_shipped,
+    order_cancelled,
+    updated_at
+FROM
+    notification_preferences
+WHERE
+    customer_id = ?
+LIMIT
+    1;
+
+-- name: UpsertNotificationPreferences :exec
+INSERT INTO
+    notification_preferences (
+        customer_id,
+        order_confirmation,
+        order_shipped,
+        order_cancelled
+    )
+VALUES
+    (?, ?, ?, ?) ON DUPLICATE KEY
+UPDATE
+    order_confirmation = VALUES(order_confirmation),
+    order_shipped = VALUES(order_shipped),
+    order_cancelled = VALUES(order_cancelled);
\ No newline at end of file
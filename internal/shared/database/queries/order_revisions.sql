@@ -0,0 +1,38 @@
+-- name: CreateOrderRevision :exec
+INSERT INTO
+    order_revisions (
+        id,
+        order_id,
+        revision,
+        changes,
+        previous_total,
+        new_total,
+        note
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetNextOrderRevision :one
+SELECT
+    CAST(IFNULL(MAX(revision), 0) + 1 AS SIGNED) AS next_revision
+FROM
+    order_revisions
+WHERE
+    order_id = ?;
+
+-- name: ListOrderRevisions :many
+SELECT
+    id,
+    order_id,
+    revision,
+    changes,
+    previous_total,
+    new_total,
+    note,
+    created_at
+FROM
+    order_revisions
+WHERE
+    order_id = ?
+ORDER BY
+    revision DESC;
\ No newline at end of file
@@ -5,93 +5,377 @@ INSERT INTO
         id,
         customer_id,
         total_quantity,
+        subtotal,
+        discount_total,
+        tax_total,
+        shipping_total,
+        shipping_method_id,
+        shipping_method_name,
         total_price,
+        promotion_id,
+        coupon_code,
         created_at
     )
 VALUES
-    (?, ?, ?, ?, ?);
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: CreateOrderItem :exec
 INSERT INTO
-    order_items (id, order_id, product_id, quantity, unit_price)
+    order_items (
+        id,
+        order_id,
+        product_id,
+        variant_id,
+        variant_sku,
+        product_name,
+        sku,
+        category_name,
+        quantity,
+        unit_price,
+        discount_amount,
+        promotion_id,
+        tax_rate,
+        tax_amount,
+        tax_inclusive,
+        line_total
+    )
 VALUES
-    (?, ?, ?, ?, ?);
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetOrders :many
 SELECT
     o.id,
+    o.status,
     o.total_quantity,
+    o.subtotal,
+    o.discount_total,
+    o.tax_total,
+    o.shipping_total,
+    o.shipping_method_id,
+    o.shipping_method_name,
     o.total_price,
+    o.refund_total,
+    o.coupon_code,
+    o.payment_status,
+    o.paid_at,
     o.created_at,
     o.customer_id,
     c.name AS customer_name,
     c.email AS customer_email,
     CAST(
-        JSON_ARRAYAGG(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            oi.id,
+                            'product_id',
+                            oi.product_id,
+                            'product_name',
+                            oi.product_name,
+                            'sku',
+                            oi.sku,
+                            'category_name',
+                            oi.category_name,
+                            'variant_id',
+                            oi.variant_id,
+                            'quantity',
+                            oi.quantity,
+                            'unit_price',
+                            oi.unit_price,
+                            'discount_amount',
+                            oi.discount_amount,
+                            'promotion_id',
+                            oi.promotion_id,
+                            'tax_rate',
+                            oi.tax_rate,
+                            'tax_amount',
+                            oi.tax_amount,
+                            'tax_inclusive',
+                            IF (oi.tax_inclusive, CAST('true' AS JSON), CAST('false' AS JSON)),
+                            'line_total',
+                            oi.line_total,
+                            'returned_quantity',
+                            oi.returned_quantity,
+                            'current_product',
+                            IF (
+                                p.id IS NULL,
+                                NULL,
+                                JSON_OBJECT(
+                                    'id',
+                                    p.id,
+                                    'name',
+                                    p.name,
+                                    'sku',
+                                    p.sku,
+                                    'price',
+                                    p.price,
+                                    'category_name',
+                                    pc.name,
+                                    'is_active',
+                                    IF (p.is_active, CAST('true' AS JSON), CAST('false' AS JSON))
+                                )
+                            )
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                order_items oi
+                LEFT JOIN products p ON p.id = oi.product_id
+                LEFT JOIN categories pc ON pc.id = p.category_id
+            WHERE
+                oi.order_id = o.id
+        ) AS JSON
+    ) AS items,
+    CAST(
+        IF (
+            sa.order_id IS NULL,
+            NULL,
             JSON_OBJECT(
-                'id',
-                oi.id,
-                'product_id',
-                p.id,
-                'product_name',
-                p.name,
-                'quantity',
-                oi.quantity,
-                'unit_price',
-                oi.unit_price
+                'address_id',
+                sa.address_id,
+                'recipient_name',
+                sa.recipient_name,
+                'phone',
+                sa.phone,
+                'street',
+                sa.street,
+                'city',
+                sa.city,
+                'province',
+                sa.province,
+                'postal_code',
+                sa.postal_code
             )
         ) AS JSON
-    ) AS items
+    ) AS shipping_address
 FROM
     orders o
     JOIN customers c ON o.customer_id = c.id
-    JOIN order_items oi ON o.id = oi.order_id
-    JOIN products p ON oi.product_id = p.id
-GROUP BY
-    o.id,
-    c.id
+    LEFT JOIN order_shipping_addresses sa ON sa.order_id = o.id
+WHERE
+    (
+        sqlc.arg ('customer_id') = ''
+        OR o.customer_id = sqlc.arg ('customer_id')
+    )
+    AND (
+        sqlc.arg ('status') = ''
+        OR o.status = sqlc.arg ('status')
+    )
+    AND (
+        sqlc.arg ('payment_status') = ''
+        OR o.payment_status = sqlc.arg ('payment_status')
+    )
+    AND (
+        sqlc.narg ('created_from') IS NULL
+        OR o.created_at >= sqlc.narg ('created_from')
+    )
+    AND (
+        sqlc.narg ('created_to') IS NULL
+        OR o.created_at < sqlc.narg ('created_to')
+    )
+    AND (
+        sqlc.arg ('min_total') = 0
+        OR o.total_price >= sqlc.arg ('min_total')
+    )
+    AND (
+        sqlc.arg ('max_total') = 0
+        OR o.total_price <= sqlc.arg ('max_total')
+    )
+    AND (
+        sqlc.arg ('product_id') = ''
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                order_items fi
+            WHERE
+                fi.order_id = o.id
+                AND fi.product_id = sqlc.arg ('product_id')
+        )
+    )
 ORDER BY
-    o.created_at DESC
+    CASE
+        WHEN sqlc.arg ('order_by') = 'total_asc' THEN o.total_price
+    END ASC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'total_desc' THEN o.total_price
+    END DESC,
+    CASE
+        WHEN sqlc.arg ('order_by') = 'created_asc' THEN o.created_at
+    END ASC,
+    o.created_at DESC,
+    o.id DESC
 LIMIT
     ?
 OFFSET
     ?;
 
+-- name: CountOrders :one
+SELECT
+    COUNT(*) AS total
+FROM
+    orders o
+WHERE
+    (
+        sqlc.arg ('customer_id') = ''
+        OR o.customer_id = sqlc.arg ('customer_id')
+    )
+    AND (
+        sqlc.arg ('status') = ''
+        OR o.status = sqlc.arg ('status')
+    )
+    AND (
+        sqlc.arg ('payment_status') = ''
+        OR o.payment_status = sqlc.arg ('payment_status')
+    )
+    AND (
+        sqlc.narg ('created_from') IS NULL
+        OR o.created_at >= sqlc.narg ('created_from')
+    )
+    AND (
+        sqlc.narg ('created_to') IS NULL
+        OR o.created_at < sqlc.narg ('created_to')
+    )
+    AND (
+        sqlc.arg ('min_total') = 0
+        OR o.total_price >= sqlc.arg ('min_total')
+    )
+    AND (
+        sqlc.arg ('max_total') = 0
+        OR o.total_price <= sqlc.arg ('max_total')
+    )
+    AND (
+        sqlc.arg ('product_id') = ''
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                order_items fi
+            WHERE
+                fi.order_id = o.id
+                AND fi.product_id = sqlc.arg ('product_id')
+        )
+    );
+
 -- name: GetOrderByID :one
+-- Kolom dan agregasi item harus sama persis dengan GetOrders (satu mapper untuk listing & detail)
 SELECT
     o.id,
+    o.status,
     o.total_quantity,
+    o.subtotal,
+    o.discount_total,
+    o.tax_total,
+    o.shipping_total,
+    o.shipping_method_id,
+    o.shipping_method_name,
     o.total_price,
+    o.refund_total,
+    o.coupon_code,
+    o.payment_status,
+    o.paid_at,
     o.created_at,
     o.customer_id,
     c.name AS customer_name,
     c.email AS customer_email,
     CAST(
-        JSON_ARRAYAGG(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            oi.id,
+                            'product_id',
+                            oi.product_id,
+                            'product_name',
+                            oi.product_name,
+                            'sku',
+                            oi.sku,
+                            'category_name',
+                            oi.category_name,
+                            'variant_id',
+                            oi.variant_id,
+                            'quantity',
+                            oi.quantity,
+                            'unit_price',
+                            oi.unit_price,
+                            'discount_amount',
+                            oi.discount_amount,
+                            'promotion_id',
+                            oi.promotion_id,
+                            'tax_rate',
+                            oi.tax_rate,
+                            'tax_amount',
+                            oi.tax_amount,
+                            'tax_inclusive',
+                            IF (oi.tax_inclusive, CAST('true' AS JSON), CAST('false' AS JSON)),
+                            'line_total',
+                            oi.line_total,
+                            'returned_quantity',
+                            oi.returned_quantity,
+                            'current_product',
+                            IF (
+                                p.id IS NULL,
+                                NULL,
+                                JSON_OBJECT(
+                                    'id',
+                                    p.id,
+                                    'name',
+                                    p.name,
+                                    'sku',
+                                    p.sku,
+                                    'price',
+                                    p.price,
+                                    'category_name',
+                                    pc.name,
+                                    'is_active',
+                                    IF (p.is_active, CAST('true' AS JSON), CAST('false' AS JSON))
+                                )
+                            )
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                order_items oi
+                LEFT JOIN products p ON p.id = oi.product_id
+                LEFT JOIN categories pc ON pc.id = p.category_id
+            WHERE
+                oi.order_id = o.id
+        ) AS JSON
+    ) AS items,
+    CAST(
+        IF (
+            sa.order_id IS NULL,
+            NULL,
             JSON_OBJECT(
-                'id',
-                oi.id,
-                'product_id',
-                p.id,
-                'product_name',
-                p.name,
-                'quantity',
-                oi.quantity,
-                'unit_price',
-                oi.unit_price
+                'address_id',
+                sa.address_id,
+                'recipient_name',
+                sa.recipient_name,
+                'phone',
+                sa.phone,
+                'street',
+                sa.street,
+                'city',
+                sa.city,
+                'province',
+                sa.province,
+                'postal_code',
+                sa.postal_code
             )
         ) AS JSON
-    ) AS items
+    ) AS shipping_address
 FROM
     orders o
     JOIN customers c ON o.customer_id = c.id
-    LEFT JOIN order_items oi ON o.id = oi.order_id
-    LEFT JOIN products p ON oi.product_id = p.id
+    LEFT JOIN order_shipping_addresses sa ON sa.order_id = o.id
 WHERE
     o.id = ?
-GROUP BY
-    o.id,
-    c.id
 LIMIT
     1;
 
@@ -100,14 +384,175 @@ SELECT
     id,
     order_id,
     product_id,
+    variant_id,
+    variant_sku,
+    product_name,
+    sku,
+    category_name,
     quantity,
-    unit_price
+    unit_price,
+    discount_amount,
+    promotion_id,
+    tax_rate,
+    tax_amount,
+    tax_inclusive,
+    line_total,
+    returned_quantity
 FROM
     order_items
 WHERE
     order_id = ?;
 
+-- name: GetOrderItemSnapshot :one
+-- Data produk yang disalin ke order_items; sku & harga varian diutamakan bila item merujuk varian.
+-- unit_price adalah harga efektif saat ini, satu-satunya sumber harga item order
+SELECT
+    p.name AS product_name,
+    COALESCE(v.sku, p.sku) AS sku,
+    CAST(COALESCE(v.price, p.price) AS DECIMAL(15, 2)) AS unit_price,
+    v.sku AS variant_sku,
+    v.id IS NOT NULL AS variant_found,
+    p.category_id,
+    c.name AS category_name,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            product_variants pv
+        WHERE
+            pv.product_id = p.id
+            AND pv.is_active = TRUE
+    ) AS has_active_variants
+FROM
+    products p
+    JOIN categories c ON c.id = p.category_id
+    LEFT JOIN product_variants v ON v.id = sqlc.narg ('variant_id')
+    AND v.product_id = p.id
+WHERE
+    p.id = sqlc.arg ('product_id')
+LIMIT
+    1;
+
+-- name: GetOrderForUpdate :one
+SELECT
+    id,
+    customer_id,
+    status,
+    total_quantity,
+    subtotal,
+    discount_total,
+    tax_total,
+    shipping_total,
+    shipping_method_id,
+    shipping_method_name,
+    total_price,
+    refund_total,
+    promotion_id,
+    coupon_code,
+    payment_status,
+    paid_at,
+    created_at
+FROM
+    orders
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE;
+
+-- name: UpdateOrderTotals :exec
+UPDATE orders
+SET
+    total_quantity = ?,
+    subtotal = ?,
+    discount_total = ?,
+    tax_total = ?,
+    shipping_total = ?,
+    total_price = ?
+WHERE
+    id = ?;
+
+-- name: ClearOrderCoupon :exec
+UPDATE orders
+SET
+    promotion_id = NULL,
+    coupon_code = NULL
+WHERE
+    id = ?;
+
+-- name: UpdateOrderItem :exec
+UPDATE order_items
+SET
+    quantity = ?,
+    discount_amount = ?,
+    promotion_id = ?,
+    tax_rate = ?,
+    tax_amount = ?,
+    tax_inclusive = ?,
+    line_total = ?
+WHERE
+    id = ?
+    AND order_id = ?;
+
+-- name: DeleteOrderItem :exec
+DELETE FROM order_items
+WHERE
+    id = ?
+    AND order_id = ?;
+
 -- name: DeleteOrder :exec
 DELETE FROM orders
 WHERE
-    id = ?;
\ No newline at end of file
+    id = ?;
+
+-- name: UpdateOrderStatus :exec
+UPDATE orders
+SET
+    status = ?
+WHERE
+    id = ?;
+
+-- name: CreateOrderShipment :exec
+INSERT INTO
+    order_shipments (order_id, carrier, tracking_number, shipped_at)
+VALUES
+    (?, ?, ?, ?);
+
+-- name: CreateOrderShippingAddress :exec
+INSERT INTO
+    order_shipping_addresses (
+        order_id,
+        address_id,
+        recipient_name,
+        phone,
+        street,
+        city,
+        province,
+        postal_code
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetOrderShipment :one
+SELECT
+    order_id,
+    carrier,
+    tracking_number,
+    shipped_at
+FROM
+    order_shipments
+WHERE
+    order_id = ?
+LIMIT
+    1;
+
+-- name: AnonymiseOrderShippingAddresses :exec
+-- Kota, provinsi & kode pos dipertahankan untuk pelaporan pajak & ongkir
+UPDATE order_shipping_addresses sa
+JOIN orders o ON o.id = sa.order_id
+SET
+    sa.address_id = NULL,
+    sa.recipient_name = ?,
+    sa.phone = '',
+    sa.street = ''
+WHERE
+    o.customer_id = ?;
\ No newline at end of file
@@ -0,0 +1,62 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO
+    outbox (
+        id,
+        aggregate_type,
+        aggregate_id,
+        event_type,
+        payload
+    )
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: ListPendingOutboxEvents :many
+-- SKIP LOCKED agar beberapa relay bisa berjalan bersamaan tanpa memproses event yang sama
+SELECT
+    id,
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload,
+    attempts,
+    last_error,
+    available_at,
+    published_at,
+    created_at
+FROM
+    outbox
+WHERE
+    published_at IS NULL
+    AND available_at <= sqlc.arg(now)
+ORDER BY
+    created_at,
+    id
+LIMIT
+    ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox
+SET
+    published_at = ?,
+    last_error = NULL
+WHERE
+    id = ?;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    last_error = ?,
+    available_at = ?
+WHERE
+    id = ?;
+
+-- name: AnonymiseOutboxPayloads :exec
+-- JSON_REPLACE hanya mengganti key yang sudah ada, jadi event tanpa name/email tidak berubah
+UPDATE outbox
+SET
+    payload = JSON_REPLACE(payload, '$.name', sqlc.arg(name), '$.email', sqlc.arg(email))
+WHERE
+    aggregate_type = sqlc.arg(aggregate_type)
+    AND aggregate_id = sqlc.arg(aggregate_id);
\ No newline at end of file
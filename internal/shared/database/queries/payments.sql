@@ -0,0 +1,167 @@
+-- name: CreatePaymentIntent :exec
+INSERT INTO
+    payment_intents (
+        id,
+        order_id,
+        gateway,
+        gateway_reference,
+        amount,
+        currency,
+        status,
+        payment_url
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetPaymentIntentByID :one
+SELECT
+    id,
+    order_id,
+    gateway,
+    gateway_reference,
+    amount,
+    currency,
+    status,
+    payment_url,
+    failure_reason,
+    refund_reason,
+    created_at,
+    updated_at
+FROM
+    payment_intents
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: GetPaymentIntentByReferenceForUpdate :one
+SELECT
+    id,
+    order_id,
+    gateway,
+    gateway_reference,
+    amount,
+    currency,
+    status,
+    payment_url,
+    failure_reason,
+    refund_reason,
+    created_at,
+    updated_at
+FROM
+    payment_intents
+WHERE
+    gateway = ?
+    AND gateway_reference = ?
+FOR UPDATE;
+
+-- name: GetPendingPaymentIntentByOrder :one
+SELECT
+    id,
+    order_id,
+    gateway,
+    gateway_reference,
+    amount,
+    currency,
+    status,
+    payment_url,
+    failure_reason,
+    refund_reason,
+    created_at,
+    updated_at
+FROM
+    payment_intents
+WHERE
+    order_id = ?
+    AND status = 'pending'
+ORDER BY
+    created_at DESC
+LIMIT
+    1;
+
+-- name: ListPaymentIntentsByOrder :many
+SELECT
+    id,
+    order_id,
+    gateway,
+    gateway_reference,
+    amount,
+    currency,
+    status,
+    payment_url,
+    failure_reason,
+    refund_reason,
+    created_at,
+    updated_at
+FROM
+    payment_intents
+WHERE
+    order_id = ?
+ORDER BY
+    created_at DESC;
+
+-- name: UpdatePaymentIntentStatus :exec
+UPDATE payment_intents
+SET
+    status = ?,
+    failure_reason = ?
+WHERE
+    id = ?;
+
+-- name: CancelPendingPaymentIntents :execrows
+-- Intent pending dibatalkan saat total order berubah atau order dibatalkan
+UPDATE payment_intents
+SET
+    status = 'cancelled',
+    failure_reason = sqlc.arg ('reason')
+WHERE
+    order_id = sqlc.arg ('order_id')
+    AND status = 'pending';
+
+-- name: FlagPaymentIntentForRefund :exec
+UPDATE payment_intents
+SET
+    refund_reason = ?
+WHERE
+    id = ?;
+
+-- name: CreatePaymentWebhookEvent :exec
+INSERT INTO
+    payment_webhook_events (id, gateway, event_id, event_type, payload)
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: GetOrderPaymentInfo :one
+SELECT
+    id,
+    status,
+    total_price,
+    payment_status
+FROM
+    orders
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: GetOrderPaymentInfoForUpdate :one
+-- Mengunci order agar total yang dibandingkan dengan nominal intent tidak berubah di tengah jalan
+SELECT
+    id,
+    status,
+    total_price,
+    payment_status
+FROM
+    orders
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE;
+
+-- name: UpdateOrderPaymentStatus :exec
+UPDATE orders
+SET
+    payment_status = ?,
+    paid_at = ?
+WHERE
+    id = ?;
\ No newline at end of file
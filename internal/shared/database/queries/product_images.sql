@@ -0,0 +1,77 @@
+-- name: CreateProductImage :exec
+INSERT INTO
+    product_images (
+        id,
+        product_id,
+        storage_key,
+        thumbnail_key,
+        url,
+        thumbnail_url,
+        content_type,
+        size_bytes,
+        width,
+        height,
+        position,
+        is_primary
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetProductImageByID :one
+SELECT
+    *
+FROM
+    product_images
+WHERE
+    id = ?
+    AND product_id = ?
+LIMIT
+    1;
+
+-- name: ListProductImagesByProductID :many
+SELECT
+    *
+FROM
+    product_images
+WHERE
+    product_id = ?
+ORDER BY
+    position ASC,
+    created_at ASC;
+
+-- name: GetNextProductImagePosition :one
+SELECT
+    CAST(IFNULL (MAX(position) + 1, 0) AS SIGNED) AS next_position
+FROM
+    product_images
+WHERE
+    product_id = ?;
+
+-- name: UpdateProductImagePosition :exec
+UPDATE product_images
+SET
+    position = ?
+WHERE
+    id = ?
+    AND product_id = ?;
+
+-- name: ClearPrimaryProductImage :exec
+UPDATE product_images
+SET
+    is_primary = FALSE
+WHERE
+    product_id = ?;
+
+-- name: SetPrimaryProductImage :exec
+UPDATE product_images
+SET
+    is_primary = TRUE
+WHERE
+    id = ?
+    AND product_id = ?;
+
+-- name: DeleteProductImage :exec
+DELETE FROM product_images
+WHERE
+    id = ?
+    AND product_id = ?;
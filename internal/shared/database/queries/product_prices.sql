@@ -0,0 +1,186 @@
+-- name: GetProductPriceForUpdate :one
+SELECT
+    price
+FROM
+    products
+WHERE
+    id = ?
+FOR UPDATE;
+
+-- name: UpdateProductPrice :exec
+UPDATE products
+SET
+    price = ?
+WHERE
+    id = ?;
+
+-- name: CreateProductPriceHistory :exec
+INSERT INTO
+    product_price_history (
+        id,
+        product_id,
+        old_price,
+        new_price,
+        source,
+        schedule_id
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: ListProductPriceHistory :many
+SELECT
+    id,
+    product_id,
+    old_price,
+    new_price,
+    source,
+    schedule_id,
+    changed_at
+FROM
+    product_price_history
+WHERE
+    product_id = ?
+ORDER BY
+    changed_at DESC,
+    id DESC
+LIMIT
+    ?
+OFFSET
+    ?;
+
+-- name: CountProductPriceHistory :one
+SELECT
+    COUNT(*) AS total
+FROM
+    product_price_history
+WHERE
+    product_id = ?;
+
+-- name: CreateProductPriceSchedule :exec
+INSERT INTO
+    product_price_schedules (
+        id,
+        product_id,
+        price,
+        effective_from,
+        effective_to,
+        status
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: GetProductPriceSchedule :one
+SELECT
+    id,
+    product_id,
+    price,
+    effective_from,
+    effective_to,
+    status,
+    previous_price,
+    applied_at,
+    created_at
+FROM
+    product_price_schedules
+WHERE
+    id = ?
+    AND product_id = ?
+LIMIT
+    1;
+
+-- name: GetProductPriceScheduleForUpdate :one
+SELECT
+    id,
+    product_id,
+    price,
+    effective_from,
+    effective_to,
+    status,
+    previous_price,
+    applied_at,
+    created_at
+FROM
+    product_price_schedules
+WHERE
+    id = ?
+FOR UPDATE;
+
+-- name: ListProductPriceSchedules :many
+SELECT
+    id,
+    product_id,
+    price,
+    effective_from,
+    effective_to,
+    status,
+    previous_price,
+    applied_at,
+    created_at
+FROM
+    product_price_schedules
+WHERE
+    product_id = ?
+ORDER BY
+    effective_from DESC;
+
+-- name: CountOverlappingPriceSchedules :one
+SELECT
+    COUNT(*) AS total
+FROM
+    product_price_schedules
+WHERE
+    product_id = sqlc.arg ('product_id')
+    AND status IN ('pending', 'active')
+    AND (
+        effective_to IS NULL
+        OR effective_to > sqlc.arg ('new_from')
+    )
+    AND (
+        sqlc.narg ('new_to') IS NULL
+        OR effective_from < sqlc.narg ('new_to')
+    );
+
+-- name: CancelPriceSchedule :execrows
+UPDATE product_price_schedules
+SET
+    status = 'cancelled'
+WHERE
+    id = ?
+    AND product_id = ?
+    AND status = 'pending';
+
+-- name: ListDuePriceSchedules :many
+SELECT
+    id,
+    product_id,
+    price,
+    effective_from,
+    effective_to,
+    status,
+    previous_price,
+    applied_at,
+    created_at
+FROM
+    product_price_schedules
+WHERE
+    (
+        status = 'pending'
+        AND effective_from <= sqlc.arg ('now')
+    )
+    OR (
+        status = 'active'
+        AND effective_to <= sqlc.arg ('now')
+    )
+ORDER BY
+    effective_from ASC
+LIMIT
+    ?;
+
+-- name: UpdatePriceScheduleState :exec
+UPDATE product_price_schedules
+SET
+    status = ?,
+    previous_price = ?,
+    applied_at = ?
+WHERE
+    id = ?;
\ No newline at end of file
@@ -0,0 +1,118 @@
+-- name: CreateProductVariant :exec
+INSERT INTO
+    product_variants (
+        id,
+        product_id,
+        sku,
+        attributes,
+        price,
+        stock_quantity,
+        is_active
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetProductVariantByID :one
+SELECT
+    v.id,
+    v.product_id,
+    v.sku,
+    v.attributes,
+    v.price,
+    CAST(COALESCE(v.price, p.price) AS DECIMAL(15, 2)) AS effective_price,
+    v.stock_quantity,
+    v.is_active,
+    v.created_at,
+    v.updated_at
+FROM
+    product_variants v
+    JOIN products p ON p.id = v.product_id
+WHERE
+    v.id = ?
+    AND v.product_id = ?
+LIMIT
+    1;
+
+-- name: ListProductVariantsByProductID :many
+SELECT
+    v.id,
+    v.product_id,
+    v.sku,
+    v.attributes,
+    v.price,
+    CAST(COALESCE(v.price, p.price) AS DECIMAL(15, 2)) AS effective_price,
+    v.stock_quantity,
+    v.is_active,
+    v.created_at,
+    v.updated_at
+FROM
+    product_variants v
+    JOIN products p ON p.id = v.product_id
+WHERE
+    v.product_id = ?
+ORDER BY
+    v.created_at ASC;
+
+-- name: UpdateProductVariant :exec
+UPDATE product_variants
+SET
+    sku = ?,
+    attributes = ?,
+    price = ?,
+    stock_quantity = ?,
+    is_active = ?
+WHERE
+    id = ?
+    AND product_id = ?;
+
+-- name: DeleteProductVariant :exec
+DELETE FROM product_variants
+WHERE
+    id = ?
+    AND product_id = ?;
+
+-- name: DecrementProductVariantStock :execrows
+UPDATE product_variants
+SET
+    stock_quantity = stock_quantity - sqlc.arg ('quantity')
+WHERE
+    id = sqlc.arg ('id')
+    AND product_id = sqlc.arg ('product_id')
+    AND is_active = TRUE
+    AND stock_quantity >= sqlc.arg ('quantity');
+
+-- name: IncrementProductVariantStock :exec
+UPDATE product_variants
+SET
+    stock_quantity = stock_quantity + ?
+WHERE
+    id = ?
+    AND product_id = ?;
+
+-- name: GetProductVariantStock :one
+SELECT
+    stock_quantity
+FROM
+    product_variants
+WHERE
+    id = ?;
+
+-- name: GetProductVariantIsActive :one
+SELECT
+    is_active
+FROM
+    product_variants
+WHERE
+    id = ?;
+
+-- name: ProductHasActiveVariants :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            product_variants
+        WHERE
+            product_id = ?
+            AND is_active = TRUE
+    ) AS has_active_variants;
\ No newline at end of file
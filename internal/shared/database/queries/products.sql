@@ -2,29 +2,116 @@
 INSERT INTO
     products (
         id,
+        sku,
         name,
         description,
         price,
         category_id,
         stock_quantity,
+        weight_grams,
+        length_cm,
+        width_cm,
+        height_cm,
         is_active
     )
 VALUES
-    (?, ?, ?, ?, ?, ?, ?);
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetProductByID :one
 SELECT
     p.id,
+    p.sku,
     p.name,
     p.description,
     p.price,
     p.stock_quantity,
+    p.weight_grams,
+    p.length_cm,
+    p.width_cm,
+    p.height_cm,
     p.is_active,
     p.created_at,
     p.updated_at,
     c.id AS category_id,
     c.name AS category_name,
-    c.description AS category_description
+    c.description AS category_description,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    MIN(COALESCE(v.price, p.price))
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.price
+        ) AS DECIMAL(15, 2)
+    ) AS min_price,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    MAX(COALESCE(v.price, p.price))
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.price
+        ) AS DECIMAL(15, 2)
+    ) AS max_price,
+    CAST(
+        COALESCE(
+            (
+                SELECT
+                    SUM(v.stock_quantity)
+                FROM
+                    product_variants v
+                WHERE
+                    v.product_id = p.id
+                    AND v.is_active = TRUE
+            ),
+            p.stock_quantity
+        ) AS SIGNED
+    ) AS total_stock,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            product_variants v
+        WHERE
+            v.product_id = p.id
+            AND v.is_active = TRUE
+    ) AS variant_count,
+    CAST(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            i.id,
+                            'url',
+                            i.url,
+                            'thumbnail_url',
+                            i.thumbnail_url,
+                            'position',
+                            i.position,
+                            'is_primary',
+                            IF (i.is_primary, CAST('true' AS JSON), CAST('false' AS JSON))
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                product_images i
+            WHERE
+                i.product_id = p.id
+        ) AS JSON
+    ) AS images
 FROM
     products p
     JOIN categories c ON c.id = p.category_id
@@ -36,20 +123,70 @@ LIMIT
 -- name: ListProducts :many
 SELECT
     p.id,
+    p.sku,
     p.name,
     p.description,
     p.price,
     p.stock_quantity,
+    p.weight_grams,
+    p.length_cm,
+    p.width_cm,
+    p.height_cm,
     p.is_active,
     p.created_at,
     c.id AS category_id,
     c.name AS category_name,
     c.description AS category_description,
-    CAST(IFNULL (SUM(oi.quantity), 0) AS UNSIGNED) AS total_sold
+    CAST(IFNULL (SUM(oi.quantity), 0) AS UNSIGNED) AS total_sold,
+    CAST(MIN(COALESCE(pv.min_price, p.price)) AS DECIMAL(15, 2)) AS min_price,
+    CAST(MAX(COALESCE(pv.max_price, p.price)) AS DECIMAL(15, 2)) AS max_price,
+    CAST(MAX(COALESCE(pv.total_stock, p.stock_quantity)) AS SIGNED) AS total_stock,
+    CAST(MAX(IFNULL (pv.variant_count, 0)) AS SIGNED) AS variant_count,
+    CAST(
+        (
+            SELECT
+                COALESCE(
+                    JSON_ARRAYAGG(
+                        JSON_OBJECT(
+                            'id',
+                            i.id,
+                            'url',
+                            i.url,
+                            'thumbnail_url',
+                            i.thumbnail_url,
+                            'position',
+                            i.position,
+                            'is_primary',
+                            IF (i.is_primary, CAST('true' AS JSON), CAST('false' AS JSON))
+                        )
+                    ),
+                    JSON_ARRAY ()
+                )
+            FROM
+                product_images i
+            WHERE
+                i.product_id = p.id
+        ) AS JSON
+    ) AS images
 FROM
     products p
     JOIN categories c ON c.id = p.category_id
     LEFT JOIN order_items oi ON oi.product_id = p.id
+    LEFT JOIN (
+        SELECT
+            v.product_id,
+            MIN(COALESCE(v.price, vp.price)) AS min_price,
+            MAX(COALESCE(v.price, vp.price)) AS max_price,
+            SUM(v.stock_quantity) AS total_stock,
+            COUNT(*) AS variant_count
+        FROM
+            product_variants v
+            JOIN products vp ON vp.id = v.product_id
+        WHERE
+            v.is_active = TRUE
+        GROUP BY
+            v.product_id
+    ) pv ON pv.product_id = p.id
 WHERE
     (
         sqlc.arg ('search_name') = ''
@@ -82,7 +219,8 @@ ORDER BY
     CASE
         WHEN sqlc.arg ('order_by') = 'sold_desc' THEN IFNULL (SUM(oi.quantity), 0)
     END DESC,
-    p.created_at DESC
+    p.created_at DESC,
+    p.id DESC
 LIMIT
     ?
 OFFSET
@@ -122,16 +260,105 @@ WHERE
 -- name: UpdateProduct :exec
 UPDATE products
 SET
+    sku = ?,
     name = ?,
     description = ?,
     price = ?,
     category_id = ?,
     stock_quantity = ?,
+    weight_grams = ?,
+    length_cm = ?,
+    width_cm = ?,
+    height_cm = ?,
     is_active = ?
 WHERE
     id = ?;
 
+-- name: UpdateImportedProduct :exec
+-- Kolom opsional yang kosong di file import (NULL) mempertahankan nilai saat ini
+UPDATE products
+SET
+    sku = COALESCE(sqlc.narg ('sku'), sku),
+    name = sqlc.arg ('name'),
+    description = COALESCE(sqlc.narg ('description'), description),
+    price = sqlc.arg ('price'),
+    category_id = sqlc.arg ('category_id'),
+    stock_quantity = COALESCE(sqlc.narg ('stock_quantity'), stock_quantity),
+    weight_grams = COALESCE(sqlc.narg ('weight_grams'), weight_grams),
+    length_cm = COALESCE(sqlc.narg ('length_cm'), length_cm),
+    width_cm = COALESCE(sqlc.narg ('width_cm'), width_cm),
+    height_cm = COALESCE(sqlc.narg ('height_cm'), height_cm),
+    is_active = COALESCE(sqlc.narg ('is_active'), is_active)
+WHERE
+    id = sqlc.arg ('id');
+
 -- name: DeleteProduct :exec
 DELETE FROM products
+WHERE
+    id = ?;
+
+-- name: DecrementProductStock :execrows
+UPDATE products
+SET
+    stock_quantity = stock_quantity - sqlc.arg ('quantity')
+WHERE
+    id = sqlc.arg ('id')
+    AND stock_quantity >= sqlc.arg ('quantity');
+
+-- name: IncrementProductStock :exec
+UPDATE products
+SET
+    stock_quantity = stock_quantity + ?
+WHERE
+    id = ?;
+
+-- name: ProductExists :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            products
+        WHERE
+            id = ?
+    ) AS product_exists;
+
+-- name: ListProductKeysForImport :many
+-- Produk yang sudah ada untuk satu chunk import, dicocokkan lewat id atau sku dalam satu query
+SELECT
+    id,
+    sku
+FROM
+    products
+WHERE
+    id IN (sqlc.slice ('ids'))
+    OR sku IN (sqlc.slice ('skus'));
+
+-- name: GetProductCategoryID :one
+SELECT
+    category_id
+FROM
+    products
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: GetProductStock :one
+SELECT
+    stock_quantity
+FROM
+    products
+WHERE
+    id = ?;
+
+-- name: GetProductDimensions :one
+SELECT
+    weight_grams,
+    length_cm,
+    width_cm,
+    height_cm
+FROM
+    products
 WHERE
     id = ?;
\ No newline at end of file
@@ -0,0 +1,191 @@
+-- name: CreatePromotion :exec
+INSERT INTO
+    promotions (
+        id,
+        code,
+        name,
+        type,
+        value,
+        buy_quantity,
+        get_quantity,
+        category_id,
+        min_subtotal,
+        max_discount,
+        usage_limit,
+        usage_limit_per_customer,
+        starts_at,
+        ends_at,
+        is_active
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetPromotionByID :one
+SELECT
+    id,
+    code,
+    name,
+    type,
+    value,
+    buy_quantity,
+    get_quantity,
+    category_id,
+    min_subtotal,
+    max_discount,
+    usage_limit,
+    usage_limit_per_customer,
+    used_count,
+    starts_at,
+    ends_at,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    promotions
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: GetPromotionByCodeForUpdate :one
+SELECT
+    id,
+    code,
+    name,
+    type,
+    value,
+    buy_quantity,
+    get_quantity,
+    category_id,
+    min_subtotal,
+    max_discount,
+    usage_limit,
+    usage_limit_per_customer,
+    used_count,
+    starts_at,
+    ends_at,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    promotions
+WHERE
+    code = ?
+FOR UPDATE;
+
+-- name: ListPromotions :many
+SELECT
+    id,
+    code,
+    name,
+    type,
+    value,
+    buy_quantity,
+    get_quantity,
+    category_id,
+    min_subtotal,
+    max_discount,
+    usage_limit,
+    usage_limit_per_customer,
+    used_count,
+    starts_at,
+    ends_at,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    promotions
+ORDER BY
+    created_at DESC,
+    id DESC
+LIMIT
+    ?
+OFFSET
+    ?;
+
+-- name: CountPromotions :one
+SELECT
+    COUNT(*) AS total
+FROM
+    promotions;
+
+-- name: UpdatePromotion :exec
+UPDATE promotions
+SET
+    code = ?,
+    name = ?,
+    type = ?,
+    value = ?,
+    buy_quantity = ?,
+    get_quantity = ?,
+    category_id = ?,
+    min_subtotal = ?,
+    max_discount = ?,
+    usage_limit = ?,
+    usage_limit_per_customer = ?,
+    starts_at = ?,
+    ends_at = ?,
+    is_active = ?
+WHERE
+    id = ?;
+
+-- name: DeactivatePromotion :execrows
+UPDATE promotions
+SET
+    is_active = FALSE
+WHERE
+    id = ?;
+
+-- name: IncrementPromotionUsage :execrows
+-- Kuota global dicek & dinaikkan secara atomik di dalam transaksi order
+UPDATE promotions
+SET
+    used_count = used_count + 1
+WHERE
+    id = ?
+    AND (
+        usage_limit IS NULL
+        OR used_count < usage_limit
+    );
+
+-- name: CountCustomerPromotionRedemptions :one
+SELECT
+    COUNT(*) AS total
+FROM
+    promotion_redemptions
+WHERE
+    promotion_id = ?
+    AND customer_id = ?;
+
+-- name: CreatePromotionRedemption :exec
+INSERT INTO
+    promotion_redemptions (
+        id,
+        promotion_id,
+        customer_id,
+        order_id,
+        discount_amount
+    )
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: UpdatePromotionRedemptionDiscount :exec
+UPDATE promotion_redemptions
+SET
+    discount_amount = ?
+WHERE
+    order_id = ?;
+
+-- name: DeletePromotionRedemptionByOrder :exec
+DELETE FROM promotion_redemptions
+WHERE
+    order_id = ?;
+
+-- name: DecrementPromotionUsage :exec
+-- Mengembalikan kuota saat kupon dilepas dari order
+UPDATE promotions
+SET
+    used_count = used_count - 1
+WHERE
+    id = ?
+    AND used_count > 0;
\ No newline at end of file
@@ -0,0 +1,96 @@
+-- name: GetOrderForReturn :one
+SELECT
+    id,
+    status,
+    payment_status
+FROM
+    orders
+WHERE
+    id = ?
+LIMIT
+    1 FOR UPDATE;
+
+-- name: IncrementOrderItemReturnedQuantity :execrows
+UPDATE order_items
+SET
+    returned_quantity = returned_quantity + sqlc.arg ('quantity')
+WHERE
+    id = sqlc.arg ('id')
+    AND order_id = sqlc.arg ('order_id')
+    AND quantity - returned_quantity >= sqlc.arg ('quantity');
+
+-- name: AddOrderRefundTotal :exec
+UPDATE orders
+SET
+    refund_total = refund_total + ?
+WHERE
+    id = ?;
+
+-- name: CreateReturn :exec
+INSERT INTO
+    returns (id, order_id, status, reason, refund_amount)
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: CreateReturnItem :exec
+INSERT INTO
+    return_items (id, return_id, order_item_id, quantity, refund_amount)
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: GetReturnByID :one
+SELECT
+    id,
+    order_id,
+    status,
+    reason,
+    refund_amount,
+    refunded_at,
+    created_at,
+    updated_at
+FROM
+    returns
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: ListReturnsByOrder :many
+SELECT
+    id,
+    order_id,
+    status,
+    reason,
+    refund_amount,
+    refunded_at,
+    created_at,
+    updated_at
+FROM
+    returns
+WHERE
+    order_id = ?
+ORDER BY
+    created_at DESC;
+
+-- name: ListReturnItemsByOrder :many
+SELECT
+    ri.id,
+    ri.return_id,
+    ri.order_item_id,
+    ri.quantity,
+    ri.refund_amount
+FROM
+    return_items ri
+    JOIN returns r ON ri.return_id = r.id
+WHERE
+    r.order_id = ?;
+
+-- name: MarkReturnRefunded :execrows
+UPDATE returns
+SET
+    status = 'refunded',
+    refunded_at = ?
+WHERE
+    id = ?
+    AND order_id = ?
+    AND status = 'refund_pending';
\ No newline at end of file
@@ -0,0 +1,87 @@
+-- name: CreateShippingMethod :exec
+INSERT INTO
+    shipping_methods (
+        id,
+        code,
+        name,
+        rate_type,
+        base_rate,
+        rate_per_kg,
+        free_threshold,
+        is_active
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetShippingMethodByID :one
+SELECT
+    id,
+    code,
+    name,
+    rate_type,
+    base_rate,
+    rate_per_kg,
+    free_threshold,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    shipping_methods
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: ListShippingMethods :many
+SELECT
+    id,
+    code,
+    name,
+    rate_type,
+    base_rate,
+    rate_per_kg,
+    free_threshold,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    shipping_methods
+ORDER BY
+    name;
+
+-- name: ListActiveShippingMethods :many
+SELECT
+    id,
+    code,
+    name,
+    rate_type,
+    base_rate,
+    rate_per_kg,
+    free_threshold,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    shipping_methods
+WHERE
+    is_active = TRUE
+ORDER BY
+    name;
+
+-- name: UpdateShippingMethod :exec
+UPDATE shipping_methods
+SET
+    code = ?,
+    name = ?,
+    rate_type = ?,
+    base_rate = ?,
+    rate_per_kg = ?,
+    free_threshold = ?,
+    is_active = ?
+WHERE
+    id = ?;
+
+-- name: DeleteShippingMethod :execrows
+DELETE FROM shipping_methods
+WHERE
+    id = ?;
\ No newline at end of file
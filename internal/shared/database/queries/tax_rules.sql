@@ -0,0 +1,85 @@
+-- name: CreateTaxRule :exec
+INSERT INTO
+    tax_rules (
+        id,
+        name,
+        category_id,
+        rate,
+        is_inclusive,
+        is_active
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: GetTaxRuleByID :one
+SELECT
+    id,
+    name,
+    category_id,
+    rate,
+    is_inclusive,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    tax_rules
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: ListTaxRules :many
+SELECT
+    id,
+    name,
+    category_id,
+    rate,
+    is_inclusive,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    tax_rules
+ORDER BY
+    category_id IS NOT NULL,
+    name;
+
+-- name: ListActiveTaxRules :many
+SELECT
+    id,
+    name,
+    category_id,
+    rate,
+    is_inclusive,
+    is_active,
+    created_at,
+    updated_at
+FROM
+    tax_rules
+WHERE
+    is_active = TRUE;
+
+-- name: CountOtherDefaultTaxRules :one
+SELECT
+    COUNT(*) AS total
+FROM
+    tax_rules
+WHERE
+    category_id IS NULL
+    AND id <> ?;
+
+-- name: UpdateTaxRule :exec
+UPDATE tax_rules
+SET
+    name = ?,
+    category_id = ?,
+    rate = ?,
+    is_inclusive = ?,
+    is_active = ?
+WHERE
+    id = ?;
+
+-- name: DeleteTaxRule :execrows
+DELETE FROM tax_rules
+WHERE
+    id = ?;
\ No newline at end of file
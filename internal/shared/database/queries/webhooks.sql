@@ -0,0 +1,288 @@
+-- name: CreateWebhookEndpoint :exec
+INSERT INTO
+    webhook_endpoints (
+        id,
+        url,
+        description,
+        secret,
+        event_types,
+        is_active
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: GetWebhookEndpointByID :one
+SELECT
+    id,
+    url,
+    description,
+    secret,
+    event_types,
+    is_active,
+    consecutive_failures,
+    disabled_reason,
+    disabled_at,
+    created_at,
+    updated_at
+FROM
+    webhook_endpoints
+WHERE
+    id = ?
+LIMIT
+    1;
+
+-- name: ListWebhookEndpoints :many
+SELECT
+    id,
+    url,
+    description,
+    secret,
+    event_types,
+    is_active,
+    consecutive_failures,
+    disabled_reason,
+    disabled_at,
+    created_at,
+    updated_at
+FROM
+    webhook_endpoints
+ORDER BY
+    created_at DESC,
+    id DESC;
+
+-- name: ListWebhookEndpointsForEvent :many
+SELECT
+    id,
+    url,
+    description,
+    secret,
+    event_types,
+    is_active,
+    consecutive_failures,
+    disabled_reason,
+    disabled_at,
+    created_at,
+    updated_at
+FROM
+    webhook_endpoints
+WHERE
+    is_active = TRUE
+    AND JSON_CONTAINS(event_types, JSON_QUOTE(sqlc.arg(event_type)));
+
+-- name: UpdateWebhookEndpoint :exec
+UPDATE webhook_endpoints
+SET
+    url = ?,
+    description = ?,
+    secret = ?,
+    event_types = ?,
+    is_active = ?,
+    consecutive_failures = ?,
+    disabled_reason = ?,
+    disabled_at = ?
+WHERE
+    id = ?;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE
+    id = ?;
+
+-- name: ResetWebhookEndpointFailures :exec
+UPDATE webhook_endpoints
+SET
+    consecutive_failures = 0
+WHERE
+    id = ?;
+
+-- name: IncrementWebhookEndpointFailures :exec
+UPDATE webhook_endpoints
+SET
+    consecutive_failures = consecutive_failures + 1
+WHERE
+    id = ?;
+
+-- name: DisableFailingWebhookEndpoint :execrows
+-- Hanya menonaktifkan endpoint yang masih aktif & sudah mencapai batas kegagalan beruntun
+UPDATE webhook_endpoints
+SET
+    is_active = FALSE,
+    disabled_reason = sqlc.arg(disabled_reason),
+    disabled_at = sqlc.arg(disabled_at)
+WHERE
+    id = sqlc.arg(id)
+    AND is_active = TRUE
+    AND consecutive_failures >= sqlc.arg(max_failures);
+
+-- name: CreateWebhookDelivery :exec
+-- INSERT IGNORE: event yang sama bisa dipublikasikan ulang oleh relay (at-least-once)
+INSERT IGNORE INTO
+    webhook_deliveries (
+        id,
+        endpoint_id,
+        event_id,
+        event_type,
+        payload,
+        next_attempt_at
+    )
+VALUES
+    (?, ?, ?, ?, ?, ?);
+
+-- name: ListDueWebhookDeliveries :many
+SELECT
+    d.id,
+    d.endpoint_id,
+    d.event_type,
+    d.payload,
+    d.attempts,
+    e.url,
+    e.secret
+FROM
+    webhook_deliveries d
+    JOIN webhook_endpoints e ON e.id = d.endpoint_id
+WHERE
+    d.status = 'pending'
+    AND d.next_attempt_at <= sqlc.arg(now)
+    AND e.is_active = TRUE
+ORDER BY
+    d.next_attempt_at,
+    d.id
+LIMIT
+    ?;
+
+-- name: ClaimWebhookDelivery :execrows
+-- Memundurkan next_attempt_at selama pengiriman sehingga worker lain melewati delivery ini
+UPDATE webhook_deliveries
+SET
+    next_attempt_at = sqlc.arg(lease_until)
+WHERE
+    id = sqlc.arg(id)
+    AND status = 'pending'
+    AND next_attempt_at <= sqlc.arg(now);
+
+-- name: UpdateWebhookDeliveryResult :exec
+UPDATE webhook_deliveries
+SET
+    status = ?,
+    attempts = ?,
+    next_attempt_at = ?,
+    last_response_code = ?,
+    last_error = ?,
+    delivered_at = ?
+WHERE
+    id = ?;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO
+    webhook_delivery_attempts (
+        id,
+        delivery_id,
+        response_code,
+        error_message,
+        duration_ms
+    )
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: ListWebhookDeliveries :many
+SELECT
+    id,
+    endpoint_id,
+    event_id,
+    event_type,
+    payload,
+    status,
+    attempts,
+    next_attempt_at,
+    last_response_code,
+    last_error,
+    delivered_at,
+    created_at,
+    updated_at
+FROM
+    webhook_deliveries
+WHERE
+    endpoint_id = sqlc.arg(endpoint_id)
+    AND (
+        sqlc.arg(status) = ''
+        OR status = sqlc.arg(status)
+    )
+ORDER BY
+    created_at DESC,
+    id DESC
+LIMIT
+    ?
+OFFSET
+    ?;
+
+-- name: CountWebhookDeliveries :one
+SELECT
+    COUNT(*) AS total
+FROM
+    webhook_deliveries
+WHERE
+    endpoint_id = sqlc.arg(endpoint_id)
+    AND (
+        sqlc.arg(status) = ''
+        OR status = sqlc.arg(status)
+    );
+
+-- name: GetWebhookDelivery :one
+SELECT
+    id,
+    endpoint_id,
+    event_id,
+    event_type,
+    payload,
+    status,
+    attempts,
+    next_attempt_at,
+    last_response_code,
+    last_error,
+    delivered_at,
+    created_at,
+    updated_at
+FROM
+    webhook_deliveries
+WHERE
+    id = ?
+    AND endpoint_id = ?
+LIMIT
+    1;
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT
+    id,
+    delivery_id,
+    response_code,
+    error_message,
+    duration_ms,
+    created_at
+FROM
+    webhook_delivery_attempts
+WHERE
+    delivery_id = ?
+ORDER BY
+    created_at DESC,
+    id DESC;
+
+-- name: RedeliverWebhookDelivery :exec
+-- Pengiriman ulang manual memberi jatah retry baru
+UPDATE webhook_deliveries
+SET
+    status = 'pending',
+    attempts = 0,
+    next_attempt_at = ?
+WHERE
+    id = ?;
+
+-- name: AnonymiseWebhookDeliveryPayloads :exec
+-- Delivery tidak menyimpan aggregate, jadi dicocokkan lewat event outbox asalnya;
+-- JSON_REPLACE hanya mengganti key yang sudah ada di data event
+UPDATE webhook_deliveries d
+    JOIN outbox o ON o.id = d.event_id
+SET
+    d.payload = JSON_REPLACE(d.payload, '$.data.name', sqlc.arg(name), '$.data.email', sqlc.arg(email))
+WHERE
+    o.aggregate_type = sqlc.arg(aggregate_type)
+    AND o.aggregate_id = sqlc.arg(aggregate_id);
\ No newline at end of file
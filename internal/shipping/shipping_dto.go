@@ -0,0 +1,47 @@
+package shipping
+
+import "time"
+
+// MethodRequest dipakai untuk create maupun update metode pengiriman.
+// base_rate dipakai flat & weight, rate_per_kg hanya weight, free_threshold hanya free_above.
+type MethodRequest struct {
+	Code          string   `json:"code" binding:"required,max=50"`
+	Name          string   `json:"name" binding:"required,max=100"`
+	RateType      string   `json:"rate_type" binding:"required,oneof=flat weight free_above"`
+	BaseRate      float64  `json:"base_rate" binding:"gte=0"`
+	RatePerKg     float64  `json:"rate_per_kg" binding:"gte=0"`
+	FreeThreshold *float64 `json:"free_threshold" binding:"omitempty,gt=0"`
+	IsActive      *bool    `json:"is_active"`
+}
+
+type MethodResponse struct {
+	ID            string    `json:"id"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	RateType      string    `json:"rate_type"`
+	BaseRate      float64   `json:"base_rate"`
+	RatePerKg     float64   `json:"rate_per_kg"`
+	FreeThreshold *float64  `json:"free_threshold"`
+	IsActive      bool      `json:"is_active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type QuoteRequest struct {
+	CustomerID string `json:"customer_id" binding:"required"`
+}
+
+type QuoteOption struct {
+	MethodID string  `json:"method_id"` // Dikirim sebagai shipping_method_id saat checkout
+	Code     string  `json:"code"`
+	Name     string  `json:"name"`
+	RateType string  `json:"rate_type"`
+	Cost     float64 `json:"cost"`
+}
+
+type QuoteResponse struct {
+	CustomerID  string        `json:"customer_id"`
+	WeightGrams int64         `json:"weight_grams"` // Berat tertagih: maksimum berat aktual & volumetrik per unit
+	Subtotal    float64       `json:"subtotal"`     // Nilai belanja sebelum kupon
+	Options     []QuoteOption `json:"options"`      // Hanya metode yang berlaku, termurah lebih dulu
+}
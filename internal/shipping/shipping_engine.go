@@ -0,0 +1,92 @@
+package shipping
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Jenis tarif metode pengiriman
+const (
+	RateTypeFlat      = "flat"
+	RateTypeWeight    = "weight"
+	RateTypeFreeAbove = "free_above"
+)
+
+// VolumetricDivisor: berat volumetrik (kg) = panjang x lebar x tinggi (cm) / 6000,
+// pembagi yang umum dipakai kurir domestik
+const VolumetricDivisor = 6000
+
+// Item adalah satu baris barang yang dikirim
+type Item struct {
+	ProductID string
+	Quantity  int
+}
+
+// Parcel adalah ringkasan kiriman yang menentukan ongkir
+type Parcel struct {
+	WeightGrams int64           // Berat tertagih seluruh item
+	Value       decimal.Decimal // Nilai belanja, dibandingkan dengan free_threshold
+}
+
+// DimensionSource mengambil berat & dimensi produk; dipenuhi repository shipping maupun order
+type DimensionSource interface {
+	GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error)
+}
+
+// BillableWeight mengembalikan berat tertagih satu unit produk dalam gram,
+// yaitu yang lebih besar antara berat aktual dan berat volumetrik
+func BillableWeight(d dbgen.GetProductDimensionsRow) int64 {
+	volume := int64(d.LengthCm) * int64(d.WidthCm) * int64(d.HeightCm)
+	volumetric := (volume*1000 + VolumetricDivisor - 1) / VolumetricDivisor
+	return max(int64(d.WeightGrams), volumetric)
+}
+
+// Weigh menjumlahkan berat tertagih seluruh item; dimensi tiap produk hanya diambil sekali
+func Weigh(ctx context.Context, src DimensionSource, items []Item) (int64, error) {
+	perUnit := map[string]int64{}
+	var total int64
+
+	for _, item := range items {
+		weight, ok := perUnit[item.ProductID]
+		if !ok {
+			dims, err := src.GetProductDimensions(ctx, item.ProductID)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return 0, fmt.Errorf("product %s not found", item.ProductID)
+				}
+				return 0, err
+			}
+			weight = BillableWeight(dims)
+			perUnit[item.ProductID] = weight
+		}
+		total += weight * int64(item.Quantity)
+	}
+
+	return total, nil
+}
+
+// Cost menghitung ongkir parcel dengan metode m. ok false jika metode tidak berlaku
+// untuk parcel ini, yaitu free_above dengan nilai belanja di bawah threshold.
+func Cost(m dbgen.ShippingMethod, p Parcel) (cost decimal.Decimal, ok bool) {
+	switch m.RateType {
+	case RateTypeFlat:
+		return m.BaseRate, true
+	case RateTypeWeight:
+		return m.BaseRate.Add(m.RatePerKg.Mul(decimal.NewFromInt(chargeableKg(p.WeightGrams)))), true
+	case RateTypeFreeAbove:
+		if m.FreeThreshold.Valid && p.Value.GreaterThanOrEqual(m.FreeThreshold.Decimal) {
+			return decimal.Zero, true
+		}
+	}
+	return decimal.Zero, false
+}
+
+// chargeableKg membulatkan berat ke atas per kg dengan minimal 1 kg
+func chargeableKg(grams int64) int64 {
+	return max(1, (grams+999)/1000)
+}
@@ -0,0 +1,106 @@
+package shipping_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockShipping "assignment-ptes-achmad-rifai/internal/shipping/mocks"
+)
+
+func TestBillableWeight(t *testing.T) {
+	t.Run("actual_weight_heavier", func(t *testing.T) {
+		w := shipping.BillableWeight(dbgen.GetProductDimensionsRow{WeightGrams: 1200, LengthCm: 10, WidthCm: 10, HeightCm: 10})
+
+		assert.Equal(t, int64(1200), w)
+	})
+
+	t.Run("volumetric_weight_heavier", func(t *testing.T) {
+		// 40 x 30 x 20 / 6000 = 4 kg
+		w := shipping.BillableWeight(dbgen.GetProductDimensionsRow{WeightGrams: 500, LengthCm: 40, WidthCm: 30, HeightCm: 20})
+
+		assert.Equal(t, int64(4000), w)
+	})
+
+	t.Run("volumetric_rounds_up_to_gram", func(t *testing.T) {
+		w := shipping.BillableWeight(dbgen.GetProductDimensionsRow{LengthCm: 1, WidthCm: 1, HeightCm: 1})
+
+		assert.Equal(t, int64(1), w)
+	})
+
+	t.Run("no_dimensions", func(t *testing.T) {
+		assert.Zero(t, shipping.BillableWeight(dbgen.GetProductDimensionsRow{}))
+	})
+}
+
+func TestWeigh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sums_quantity_and_caches_products", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockShipping.NewMockRepository(ctrl)
+
+		repo.EXPECT().GetProductDimensions(ctx, "p1").Return(dbgen.GetProductDimensionsRow{WeightGrams: 300}, nil).Times(1)
+		repo.EXPECT().GetProductDimensions(ctx, "p2").Return(dbgen.GetProductDimensionsRow{WeightGrams: 1000}, nil)
+
+		w, err := shipping.Weigh(ctx, repo, []shipping.Item{
+			{ProductID: "p1", Quantity: 2},
+			{ProductID: "p2", Quantity: 1},
+			{ProductID: "p1", Quantity: 1}, // varian lain dari produk yang sama
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1900), w)
+	})
+
+	t.Run("product_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockShipping.NewMockRepository(ctrl)
+
+		repo.EXPECT().GetProductDimensions(ctx, "gone").Return(dbgen.GetProductDimensionsRow{}, sql.ErrNoRows)
+
+		_, err := shipping.Weigh(ctx, repo, []shipping.Item{{ProductID: "gone", Quantity: 1}})
+
+		assert.ErrorContains(t, err, "product gone not found")
+	})
+}
+
+func TestCost(t *testing.T) {
+	flat := dbgen.ShippingMethod{RateType: shipping.RateTypeFlat, BaseRate: decimal.NewFromInt(15000)}
+	weight := dbgen.ShippingMethod{RateType: shipping.RateTypeWeight, BaseRate: decimal.NewFromInt(2000), RatePerKg: decimal.NewFromInt(9000)}
+	free := dbgen.ShippingMethod{
+		RateType:      shipping.RateTypeFreeAbove,
+		FreeThreshold: decimal.NullDecimal{Decimal: decimal.NewFromInt(250000), Valid: true},
+	}
+
+	cases := []struct {
+		name   string
+		method dbgen.ShippingMethod
+		parcel shipping.Parcel
+		cost   string
+		ok     bool
+	}{
+		{"flat_ignores_weight", flat, shipping.Parcel{WeightGrams: 9000}, "15000", true},
+		{"weight_minimum_one_kg", weight, shipping.Parcel{WeightGrams: 0}, "11000", true},
+		{"weight_rounds_up_per_kg", weight, shipping.Parcel{WeightGrams: 2100}, "29000", true},
+		{"weight_exact_kg", weight, shipping.Parcel{WeightGrams: 2000}, "20000", true},
+		{"free_at_threshold", free, shipping.Parcel{Value: decimal.NewFromInt(250000)}, "0", true},
+		{"free_below_threshold", free, shipping.Parcel{Value: decimal.NewFromInt(249999)}, "0", false},
+		{"unknown_rate_type", dbgen.ShippingMethod{RateType: "bogus"}, shipping.Parcel{}, "0", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cost, ok := shipping.Cost(tc.method, tc.parcel)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.cost, cost.String())
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package shipping
+
+import "errors"
+
+var (
+	ErrMethodNotFound    = errors.New("shipping method not found")
+	ErrDuplicateCode     = errors.New("shipping method code already exists")
+	ErrInvalidMethod     = errors.New("invalid shipping method")
+	ErrMethodUnavailable = errors.New("shipping method is not available for this order")
+	ErrCartEmpty         = errors.New("cart has no items that can be shipped")
+)
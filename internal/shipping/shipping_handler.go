@@ -0,0 +1,162 @@
+package shipping
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Create a shipping method
+// @Description  Create a shipping method. rate_type flat charges base_rate; weight charges base_rate + rate_per_kg per billable kg (rounded up, minimum 1 kg); free_above is free once the order value reaches free_threshold
+// @Tags         shipping
+// @Accept       json
+// @Produce      json
+// @Param        request  body      MethodRequest  true  "Shipping Method Request"
+// @Success      201      {object}  MethodResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Code already exists"
+// @Router       /shipping/methods [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req MethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create shipping method")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List shipping methods
+// @Description  Retrieve all shipping methods, including inactive ones
+// @Tags         shipping
+// @Produce      json
+// @Success      200      {array}   MethodResponse
+// @Router       /shipping/methods [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch shipping methods", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get shipping method
+// @Description  Retrieve a single shipping method
+// @Tags         shipping
+// @Produce      json
+// @Param        id       path      string  true  "Shipping Method ID"
+// @Success      200      {object}  MethodResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /shipping/methods/{id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get shipping method")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Update godoc
+// @Summary      Update shipping method
+// @Description  Replace a shipping method; orders already placed keep their recorded shipping cost
+// @Tags         shipping
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string         true  "Shipping Method ID"
+// @Param        request  body      MethodRequest  true  "Shipping Method Request"
+// @Success      200      {object}  MethodResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Code already exists"
+// @Router       /shipping/methods/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req MethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update shipping method")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete shipping method
+// @Description  Delete a shipping method; orders placed with it keep the method name and cost
+// @Tags         shipping
+// @Produce      json
+// @Param        id       path      string  true  "Shipping Method ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string
+// @Router       /shipping/methods/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete shipping method")
+		return
+	}
+	response.Success(c, http.StatusOK, "Shipping method deleted successfully", nil)
+}
+
+// Quote godoc
+// @Summary      Quote shipping for a cart
+// @Description  Calculate the shipping cost of a customer's cart for every active method that applies, cheapest first. Billable weight per unit is the larger of the actual weight and the volumetric weight (L x W x H / 6000)
+// @Tags         shipping
+// @Accept       json
+// @Produce      json
+// @Param        request  body      QuoteRequest  true  "Quote Request"
+// @Success      200      {object}  QuoteResponse
+// @Failure      400      {object}  map[string]string "Cart is empty"
+// @Router       /shipping/quote [post]
+func (h *Handler) Quote(c *gin.Context) {
+	var req QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Quote(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "QUOTE_ERROR", "Failed to quote shipping")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrMethodNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrDuplicateCode):
+		response.Error(c, http.StatusConflict, "DUPLICATE_CODE", err.Error(), nil)
+	case errors.Is(err, ErrInvalidMethod):
+		response.Error(c, http.StatusBadRequest, "INVALID_SHIPPING_METHOD", err.Error(), nil)
+	case errors.Is(err, ErrCartEmpty):
+		response.Error(c, http.StatusBadRequest, "CART_EMPTY", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,145 @@
+package shipping_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/shipping"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeShippingService struct {
+	CreateFn  func(ctx context.Context, req shipping.MethodRequest) (shipping.MethodResponse, error)
+	ListFn    func(ctx context.Context) ([]shipping.MethodResponse, error)
+	GetByIDFn func(ctx context.Context, id string) (shipping.MethodResponse, error)
+	UpdateFn  func(ctx context.Context, id string, req shipping.MethodRequest) (shipping.MethodResponse, error)
+	DeleteFn  func(ctx context.Context, id string) error
+	QuoteFn   func(ctx context.Context, req shipping.QuoteRequest) (shipping.QuoteResponse, error)
+}
+
+func (f *fakeShippingService) Create(ctx context.Context, req shipping.MethodRequest) (shipping.MethodResponse, error) {
+	return f.CreateFn(ctx, req)
+}
+
+func (f *fakeShippingService) List(ctx context.Context) ([]shipping.MethodResponse, error) {
+	return f.ListFn(ctx)
+}
+
+func (f *fakeShippingService) GetByID(ctx context.Context, id string) (shipping.MethodResponse, error) {
+	return f.GetByIDFn(ctx, id)
+}
+
+func (f *fakeShippingService) Update(ctx context.Context, id string, req shipping.MethodRequest) (shipping.MethodResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+
+func (f *fakeShippingService) Delete(ctx context.Context, id string) error {
+	return f.DeleteFn(ctx, id)
+}
+
+func (f *fakeShippingService) Quote(ctx context.Context, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+	return f.QuoteFn(ctx, req)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter(svc shipping.Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	shipping.RegisterRoutes(r.Group(""), shipping.NewHandler(svc))
+	return r
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"code":"jne-reg","name":"JNE Reguler","rate_type":"weight","rate_per_kg":9000}`, nil, http.StatusCreated},
+		{"unknown rate type", `{"code":"x","name":"X","rate_type":"distance"}`, nil, http.StatusBadRequest},
+		{"negative base rate", `{"code":"x","name":"X","rate_type":"flat","base_rate":-1}`, nil, http.StatusBadRequest},
+		{"invalid config", `{"code":"x","name":"X","rate_type":"free_above"}`, shipping.ErrInvalidMethod, http.StatusBadRequest},
+		{"duplicate code", `{"code":"x","name":"X","rate_type":"flat"}`, shipping.ErrDuplicateCode, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeShippingService{
+				CreateFn: func(ctx context.Context, req shipping.MethodRequest) (shipping.MethodResponse, error) {
+					if tc.err != nil {
+						return shipping.MethodResponse{}, tc.err
+					}
+					return shipping.MethodResponse{ID: "ship-1", Code: req.Code}, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/shipping/methods", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			setupTestRouter(svc).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Quote(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"customer_id":"cust-1"}`, nil, http.StatusOK},
+		{"missing customer", `{}`, nil, http.StatusBadRequest},
+		{"empty cart", `{"customer_id":"cust-1"}`, shipping.ErrCartEmpty, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeShippingService{
+				QuoteFn: func(ctx context.Context, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+					assert.Equal(t, "cust-1", req.CustomerID)
+					return shipping.QuoteResponse{
+						CustomerID: req.CustomerID,
+						Options:    []shipping.QuoteOption{{MethodID: "ship-1", Cost: 9000}},
+					}, tc.err
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/shipping/quote", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			setupTestRouter(svc).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+			if tc.code == http.StatusOK {
+				assert.Contains(t, w.Body.String(), `"method_id":"ship-1"`)
+			}
+		})
+	}
+}
+
+func TestHandler_Delete_NotFound(t *testing.T) {
+	svc := &fakeShippingService{
+		DeleteFn: func(ctx context.Context, id string) error {
+			return shipping.ErrMethodNotFound
+		},
+	}
+
+	w := httptest.NewRecorder()
+	setupTestRouter(svc).ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/shipping/methods/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,53 @@
+package shipping
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=shipping_repo.go -destination=mocks/shipping_repo_mock.go -package=mock
+type Repository interface {
+	Create(ctx context.Context, params dbgen.CreateShippingMethodParams) error
+	GetByID(ctx context.Context, id string) (dbgen.ShippingMethod, error)
+	List(ctx context.Context) ([]dbgen.ShippingMethod, error)
+	ListActive(ctx context.Context) ([]dbgen.ShippingMethod, error)
+	Update(ctx context.Context, params dbgen.UpdateShippingMethodParams) error
+	Delete(ctx context.Context, id string) (int64, error)
+	GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateShippingMethodParams) error {
+	return r.q.CreateShippingMethod(ctx, params)
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (dbgen.ShippingMethod, error) {
+	return r.q.GetShippingMethodByID(ctx, id)
+}
+
+func (r *repository) List(ctx context.Context) ([]dbgen.ShippingMethod, error) {
+	return r.q.ListShippingMethods(ctx)
+}
+
+func (r *repository) ListActive(ctx context.Context) ([]dbgen.ShippingMethod, error) {
+	return r.q.ListActiveShippingMethods(ctx)
+}
+
+func (r *repository) Update(ctx context.Context, params dbgen.UpdateShippingMethodParams) error {
+	return r.q.UpdateShippingMethod(ctx, params)
+}
+
+func (r *repository) Delete(ctx context.Context, id string) (int64, error) {
+	return r.q.DeleteShippingMethod(ctx, id)
+}
+
+func (r *repository) GetProductDimensions(ctx context.Context, productID string) (dbgen.GetProductDimensionsRow, error) {
+	return r.q.GetProductDimensions(ctx, productID)
+}
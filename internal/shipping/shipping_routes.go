@@ -0,0 +1,17 @@
+package shipping
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	shipping := r.Group("/shipping")
+	{
+		shipping.POST("/quote", handler.Quote)
+
+		methods := shipping.Group("/methods")
+		methods.POST("", handler.Create)
+		methods.GET("", handler.GetAll)
+		methods.GET("/:id", handler.GetByID)
+		methods.PUT("/:id", handler.Update)
+		methods.DELETE("/:id", handler.Delete)
+	}
+}
@@ -0,0 +1,240 @@
+package shipping
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockgen -source=shipping_service.go -destination=mocks/shipping_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, req MethodRequest) (MethodResponse, error)
+	List(ctx context.Context) ([]MethodResponse, error)
+	GetByID(ctx context.Context, id string) (MethodResponse, error)
+	Update(ctx context.Context, id string, req MethodRequest) (MethodResponse, error)
+	Delete(ctx context.Context, id string) error
+	Quote(ctx context.Context, req QuoteRequest) (QuoteResponse, error)
+}
+
+// Cart adalah isi keranjang yang bisa dikirim beserta nilai belanjanya
+type Cart struct {
+	Items    []Item
+	Subtotal decimal.Decimal
+}
+
+// CartReader membaca keranjang customer, dipenuhi oleh cart.Service. Interface-nya ada
+// di sini karena cart bergantung pada order, sedangkan order memakai package ini.
+type CartReader interface {
+	ShippingCart(ctx context.Context, customerID string) (Cart, error)
+}
+
+type service struct {
+	repo  Repository
+	carts CartReader
+}
+
+func NewService(repo Repository, carts CartReader) Service {
+	return &service{repo: repo, carts: carts}
+}
+
+func (s *service) Create(ctx context.Context, req MethodRequest) (MethodResponse, error) {
+	req, err := normalize(req)
+	if err != nil {
+		return MethodResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return MethodResponse{}, err
+	}
+	id := newUUID.String()
+
+	params := dbgen.CreateShippingMethodParams{
+		ID:            id,
+		Code:          req.Code,
+		Name:          req.Name,
+		RateType:      req.RateType,
+		BaseRate:      helper.Float64ToDecimal(req.BaseRate),
+		RatePerKg:     helper.Float64ToDecimal(req.RatePerKg),
+		FreeThreshold: helper.Float64ToNullDecimal(req.FreeThreshold),
+		IsActive:      helper.BoolPtrValue(req.IsActive, true),
+	}
+
+	if err := s.repo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return MethodResponse{}, ErrDuplicateCode
+		}
+		return MethodResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *service) List(ctx context.Context) ([]MethodResponse, error) {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]MethodResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapToResponse(r))
+	}
+
+	return res, nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (MethodResponse, error) {
+	row, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return MethodResponse{}, ErrMethodNotFound
+		}
+		return MethodResponse{}, err
+	}
+
+	return mapToResponse(row), nil
+}
+
+func (s *service) Update(ctx context.Context, id string, req MethodRequest) (MethodResponse, error) {
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return MethodResponse{}, err
+	}
+	req, err := normalize(req)
+	if err != nil {
+		return MethodResponse{}, err
+	}
+
+	params := dbgen.UpdateShippingMethodParams{
+		Code:          req.Code,
+		Name:          req.Name,
+		RateType:      req.RateType,
+		BaseRate:      helper.Float64ToDecimal(req.BaseRate),
+		RatePerKg:     helper.Float64ToDecimal(req.RatePerKg),
+		FreeThreshold: helper.Float64ToNullDecimal(req.FreeThreshold),
+		IsActive:      helper.BoolPtrValue(req.IsActive, true),
+		ID:            id,
+	}
+
+	if err := s.repo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return MethodResponse{}, ErrDuplicateCode
+		}
+		return MethodResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// Delete menghapus metode; order lama tetap menyimpan nama metode & ongkirnya
+func (s *service) Delete(ctx context.Context, id string) error {
+	affected, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrMethodNotFound
+	}
+	return nil
+}
+
+// Quote menghitung ongkir keranjang customer untuk setiap metode aktif yang berlaku.
+// Item keranjang yang bermasalah (produk nonaktif, stok kurang) tidak ikut dihitung.
+func (s *service) Quote(ctx context.Context, req QuoteRequest) (QuoteResponse, error) {
+	cart, err := s.carts.ShippingCart(ctx, req.CustomerID)
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+	if len(cart.Items) == 0 {
+		return QuoteResponse{}, ErrCartEmpty
+	}
+
+	weight, err := Weigh(ctx, s.repo, cart.Items)
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+
+	methods, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+
+	parcel := Parcel{WeightGrams: weight, Value: cart.Subtotal}
+	res := QuoteResponse{
+		CustomerID:  req.CustomerID,
+		WeightGrams: weight,
+		Subtotal:    helper.DecimalToFloat64(cart.Subtotal),
+		Options:     make([]QuoteOption, 0, len(methods)),
+	}
+	for _, m := range methods {
+		cost, ok := Cost(m, parcel)
+		if !ok {
+			continue
+		}
+		res.Options = append(res.Options, QuoteOption{
+			MethodID: m.ID,
+			Code:     m.Code,
+			Name:     m.Name,
+			RateType: m.RateType,
+			Cost:     helper.DecimalToFloat64(cost),
+		})
+	}
+	sort.SliceStable(res.Options, func(i, j int) bool {
+		return res.Options[i].Cost < res.Options[j].Cost
+	})
+
+	return res, nil
+}
+
+// normalize merapikan code dan memastikan field tarif sesuai rate_type
+func normalize(req MethodRequest) (MethodRequest, error) {
+	req.Code = strings.ToLower(strings.TrimSpace(req.Code))
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Code == "" || req.Name == "" {
+		return req, fmt.Errorf("%w: code and name must not be blank", ErrInvalidMethod)
+	}
+
+	switch req.RateType {
+	case RateTypeWeight:
+		if req.RatePerKg <= 0 {
+			return req, fmt.Errorf("%w: rate_per_kg must be greater than 0 for weight rates", ErrInvalidMethod)
+		}
+	case RateTypeFreeAbove:
+		if req.FreeThreshold == nil {
+			return req, fmt.Errorf("%w: free_threshold is required for free_above rates", ErrInvalidMethod)
+		}
+		if req.BaseRate != 0 || req.RatePerKg != 0 {
+			return req, fmt.Errorf("%w: free_above rates do not use base_rate or rate_per_kg", ErrInvalidMethod)
+		}
+	}
+	if req.RateType != RateTypeWeight && req.RatePerKg != 0 {
+		return req, fmt.Errorf("%w: rate_per_kg only applies to weight rates", ErrInvalidMethod)
+	}
+	if req.RateType != RateTypeFreeAbove && req.FreeThreshold != nil {
+		return req, fmt.Errorf("%w: free_threshold only applies to free_above rates", ErrInvalidMethod)
+	}
+
+	return req, nil
+}
+
+func mapToResponse(m dbgen.ShippingMethod) MethodResponse {
+	return MethodResponse{
+		ID:            m.ID,
+		Code:          m.Code,
+		Name:          m.Name,
+		RateType:      m.RateType,
+		BaseRate:      helper.DecimalToFloat64(m.BaseRate),
+		RatePerKg:     helper.DecimalToFloat64(m.RatePerKg),
+		FreeThreshold: helper.NullDecimalToFloat64Ptr(m.FreeThreshold),
+		IsActive:      m.IsActive,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
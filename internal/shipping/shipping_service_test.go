@@ -0,0 +1,183 @@
+package shipping_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shipping"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockShipping "assignment-ptes-achmad-rifai/internal/shipping/mocks"
+)
+
+// fakeCarts mengembalikan keranjang yang sama untuk semua customer
+type fakeCarts struct {
+	cart shipping.Cart
+}
+
+func (f *fakeCarts) ShippingCart(ctx context.Context, customerID string) (shipping.Cart, error) {
+	return f.cart, nil
+}
+
+func setupServiceTest(t *testing.T, cart shipping.Cart) (shipping.Service, *mockShipping.MockRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockShipping.NewMockRepository(ctrl)
+
+	return shipping.NewService(repo, &fakeCarts{cart: cart}), repo
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_normalizes_code", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, shipping.Cart{})
+
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateShippingMethodParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateShippingMethodParams) error {
+				assert.NotEmpty(t, p.ID)
+				assert.Equal(t, "jne-reg", p.Code)
+				assert.Equal(t, shipping.RateTypeWeight, p.RateType)
+				assert.Equal(t, "9000", p.RatePerKg.String())
+				assert.False(t, p.FreeThreshold.Valid)
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.ShippingMethod{
+			ID:        "ship-1",
+			Code:      "jne-reg",
+			Name:      "JNE Reguler",
+			RateType:  shipping.RateTypeWeight,
+			RatePerKg: decimal.NewFromInt(9000),
+			IsActive:  true,
+		}, nil)
+
+		res, err := svc.Create(ctx, shipping.MethodRequest{
+			Code: " JNE-REG ", Name: "JNE Reguler", RateType: shipping.RateTypeWeight, RatePerKg: 9000,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ship-1", res.ID)
+		assert.Equal(t, float64(9000), res.RatePerKg)
+		assert.Nil(t, res.FreeThreshold)
+	})
+
+	invalid := []struct {
+		name string
+		req  shipping.MethodRequest
+	}{
+		{"weight_without_rate", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeWeight}},
+		{"free_above_without_threshold", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeFreeAbove}},
+		{"free_above_with_base_rate", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeFreeAbove, BaseRate: 1000, FreeThreshold: floatPtr(100000)}},
+		{"flat_with_threshold", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeFlat, FreeThreshold: floatPtr(100000)}},
+		{"flat_with_rate_per_kg", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeFlat, RatePerKg: 5000}},
+		{"blank_code", shipping.MethodRequest{Code: "  ", Name: "A", RateType: shipping.RateTypeFlat}},
+	}
+	for _, tc := range invalid {
+		t.Run("error_"+tc.name, func(t *testing.T) {
+			svc, _ := setupServiceTest(t, shipping.Cart{})
+
+			_, err := svc.Create(ctx, tc.req)
+
+			assert.ErrorIs(t, err, shipping.ErrInvalidMethod)
+		})
+	}
+
+	t.Run("error_duplicate_code", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, shipping.Cart{})
+
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062})
+
+		_, err := svc.Create(ctx, shipping.MethodRequest{Code: "pickup", Name: "Ambil di toko", RateType: shipping.RateTypeFlat})
+
+		assert.ErrorIs(t, err, shipping.ErrDuplicateCode)
+	})
+}
+
+func TestService_Update_NotFound(t *testing.T) {
+	svc, repo := setupServiceTest(t, shipping.Cart{})
+
+	repo.EXPECT().GetByID(gomock.Any(), "missing").Return(dbgen.ShippingMethod{}, sql.ErrNoRows)
+
+	_, err := svc.Update(context.Background(), "missing", shipping.MethodRequest{Code: "a", Name: "A", RateType: shipping.RateTypeFlat})
+
+	assert.ErrorIs(t, err, shipping.ErrMethodNotFound)
+}
+
+func TestService_Delete_NotFound(t *testing.T) {
+	svc, repo := setupServiceTest(t, shipping.Cart{})
+
+	repo.EXPECT().Delete(gomock.Any(), "missing").Return(int64(0), nil)
+
+	assert.ErrorIs(t, svc.Delete(context.Background(), "missing"), shipping.ErrMethodNotFound)
+}
+
+func TestService_Quote(t *testing.T) {
+	ctx := context.Background()
+
+	methods := []dbgen.ShippingMethod{
+		{ID: "m-flat", Code: "flat", Name: "Flat", RateType: shipping.RateTypeFlat, BaseRate: decimal.NewFromInt(20000), IsActive: true},
+		{ID: "m-kg", Code: "kg", Name: "Per Kg", RateType: shipping.RateTypeWeight, RatePerKg: decimal.NewFromInt(8000), IsActive: true},
+		{
+			ID: "m-free", Code: "free", Name: "Gratis", RateType: shipping.RateTypeFreeAbove, IsActive: true,
+			FreeThreshold: decimal.NullDecimal{Decimal: decimal.NewFromInt(300000), Valid: true},
+		},
+	}
+
+	t.Run("applicable_methods_cheapest_first", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, shipping.Cart{
+			Items:    []shipping.Item{{ProductID: "p1", Quantity: 3}},
+			Subtotal: decimal.NewFromInt(150000),
+		})
+
+		repo.EXPECT().GetProductDimensions(gomock.Any(), "p1").Return(dbgen.GetProductDimensionsRow{WeightGrams: 400}, nil)
+		repo.EXPECT().ListActive(gomock.Any()).Return(methods, nil)
+
+		res, err := svc.Quote(ctx, shipping.QuoteRequest{CustomerID: "cust-1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1200), res.WeightGrams)
+		assert.Equal(t, float64(150000), res.Subtotal)
+		if assert.Len(t, res.Options, 2, "free_above di bawah threshold tidak ditawarkan") {
+			assert.Equal(t, "m-kg", res.Options[0].MethodID)
+			assert.Equal(t, float64(16000), res.Options[0].Cost)
+			assert.Equal(t, "m-flat", res.Options[1].MethodID)
+		}
+	})
+
+	t.Run("free_shipping_above_threshold", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, shipping.Cart{
+			Items:    []shipping.Item{{ProductID: "p1", Quantity: 1}},
+			Subtotal: decimal.NewFromInt(300000),
+		})
+
+		repo.EXPECT().GetProductDimensions(gomock.Any(), "p1").Return(dbgen.GetProductDimensionsRow{}, nil)
+		repo.EXPECT().ListActive(gomock.Any()).Return(methods, nil)
+
+		res, err := svc.Quote(ctx, shipping.QuoteRequest{CustomerID: "cust-1"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Options, 3)
+		assert.Equal(t, "m-free", res.Options[0].MethodID)
+		assert.Zero(t, res.Options[0].Cost)
+	})
+
+	t.Run("error_empty_cart", func(t *testing.T) {
+		svc, _ := setupServiceTest(t, shipping.Cart{})
+
+		_, err := svc.Quote(ctx, shipping.QuoteRequest{CustomerID: "cust-1"})
+
+		assert.ErrorIs(t, err, shipping.ErrCartEmpty)
+	})
+}
@@ -0,0 +1,24 @@
+package tax
+
+import "time"
+
+// TaxRuleRequest dipakai untuk create maupun update aturan pajak.
+// Tanpa category_id aturan menjadi default untuk kategori yang tidak punya aturan khusus.
+type TaxRuleRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	CategoryID  *string `json:"category_id"`
+	Rate        float64 `json:"rate" binding:"gte=0,lte=100"` // Persen, mis. 11 untuk PPN 11%
+	IsInclusive *bool   `json:"is_inclusive"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+type TaxRuleResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	CategoryID  *string   `json:"category_id"`
+	Rate        float64   `json:"rate"`
+	IsInclusive bool      `json:"is_inclusive"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
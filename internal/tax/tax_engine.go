@@ -0,0 +1,76 @@
+package tax
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// Resolver memilih aturan pajak untuk sebuah kategori dari daftar aturan aktif
+type Resolver struct {
+	byCategory map[string]dbgen.TaxRule
+	fallback   *dbgen.TaxRule
+}
+
+func NewResolver(rules []dbgen.TaxRule) Resolver {
+	r := Resolver{byCategory: map[string]dbgen.TaxRule{}}
+	for _, rule := range rules {
+		if !rule.IsActive {
+			continue
+		}
+		if rule.CategoryID.Valid {
+			r.byCategory[rule.CategoryID.String] = rule
+			continue
+		}
+		fallback := rule
+		r.fallback = &fallback
+	}
+	return r
+}
+
+// NeedsCategory bernilai true jika ada aturan khusus kategori,
+// sehingga pemanggil perlu mencari kategori setiap produk
+func (r Resolver) NeedsCategory() bool {
+	return len(r.byCategory) > 0
+}
+
+// RuleFor mengembalikan aturan kategori, atau aturan default jika tidak ada.
+// Tanpa aturan sama sekali hasilnya aturan kosong (rate 0, tidak dikenai pajak).
+func (r Resolver) RuleFor(categoryID string) dbgen.TaxRule {
+	if rule, ok := r.byCategory[categoryID]; ok {
+		return rule
+	}
+	if r.fallback != nil {
+		return *r.fallback
+	}
+	return dbgen.TaxRule{}
+}
+
+// Line adalah hasil perhitungan pajak untuk satu baris order
+type Line struct {
+	Rate      decimal.Decimal
+	Inclusive bool
+	Tax       decimal.Decimal
+	Total     decimal.Decimal // Jumlah yang dibayar untuk baris ini
+}
+
+// Compute menghitung pajak dari nilai baris setelah diskon (net).
+// Inklusif: pajak diekstrak dari net dan total = net. Eksklusif: pajak ditambahkan ke net.
+func Compute(net decimal.Decimal, rule dbgen.TaxRule) Line {
+	line := Line{Rate: rule.Rate, Inclusive: rule.IsInclusive, Total: net}
+	if !rule.Rate.IsPositive() || !net.IsPositive() {
+		line.Tax = decimal.Zero
+		return line
+	}
+
+	if rule.IsInclusive {
+		line.Tax = net.Mul(rule.Rate).Div(hundred.Add(rule.Rate)).Round(2)
+		return line
+	}
+
+	line.Tax = net.Mul(rule.Rate).Div(hundred).Round(2)
+	line.Total = net.Add(line.Tax)
+	return line
+}
@@ -0,0 +1,61 @@
+package tax_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/tax"
+	"database/sql"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	t.Run("exclusive_adds_tax", func(t *testing.T) {
+		line := tax.Compute(decimal.NewFromInt(100000), dbgen.TaxRule{Rate: decimal.NewFromInt(11)})
+
+		assert.Equal(t, "11000", line.Tax.String())
+		assert.Equal(t, "111000", line.Total.String())
+		assert.False(t, line.Inclusive)
+	})
+
+	t.Run("inclusive_extracts_tax", func(t *testing.T) {
+		line := tax.Compute(decimal.NewFromInt(111000), dbgen.TaxRule{Rate: decimal.NewFromInt(11), IsInclusive: true})
+
+		assert.Equal(t, "11000", line.Tax.String())
+		assert.Equal(t, "111000", line.Total.String())
+		assert.True(t, line.Inclusive)
+	})
+
+	t.Run("inclusive_rounds_to_cents", func(t *testing.T) {
+		line := tax.Compute(decimal.NewFromInt(1000), dbgen.TaxRule{Rate: decimal.NewFromInt(11), IsInclusive: true})
+
+		assert.Equal(t, "99.1", line.Tax.String())
+	})
+
+	t.Run("no_rule", func(t *testing.T) {
+		line := tax.Compute(decimal.NewFromInt(5000), dbgen.TaxRule{})
+
+		assert.True(t, line.Tax.IsZero())
+		assert.Equal(t, "5000", line.Total.String())
+	})
+}
+
+func TestResolver(t *testing.T) {
+	rules := []dbgen.TaxRule{
+		{ID: "default", Rate: decimal.NewFromInt(11), IsActive: true},
+		{ID: "books", CategoryID: sql.NullString{String: "cat-books", Valid: true}, Rate: decimal.Zero, IsActive: true},
+		{ID: "off", CategoryID: sql.NullString{String: "cat-off", Valid: true}, Rate: decimal.NewFromInt(20), IsActive: false},
+	}
+
+	r := tax.NewResolver(rules)
+
+	assert.True(t, r.NeedsCategory())
+	assert.Equal(t, "books", r.RuleFor("cat-books").ID)
+	assert.Equal(t, "default", r.RuleFor("cat-off").ID)
+	assert.Equal(t, "default", r.RuleFor("").ID)
+
+	empty := tax.NewResolver(nil)
+	assert.False(t, empty.NeedsCategory())
+	assert.True(t, empty.RuleFor("x").Rate.IsZero())
+}
@@ -0,0 +1,9 @@
+package tax
+
+import "errors"
+
+var (
+	ErrTaxRuleNotFound  = errors.New("tax rule not found")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrDuplicateRule    = errors.New("a tax rule for this category already exists")
+)
@@ -0,0 +1,134 @@
+package tax
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Create a tax rule
+// @Description  Create a tax rule for a category, or the default rule when category_id is omitted. Prices can be tax-inclusive or tax-exclusive
+// @Tags         tax-rules
+// @Accept       json
+// @Produce      json
+// @Param        request  body      TaxRuleRequest  true  "Tax Rule Request"
+// @Success      201      {object}  TaxRuleResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Category not found"
+// @Failure      409      {object}  map[string]string "Rule for this category already exists"
+// @Router       /tax-rules [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req TaxRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create tax rule")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List tax rules
+// @Description  Retrieve all tax rules, default rule first
+// @Tags         tax-rules
+// @Produce      json
+// @Success      200      {array}   TaxRuleResponse
+// @Router       /tax-rules [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch tax rules", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get tax rule
+// @Description  Retrieve a single tax rule
+// @Tags         tax-rules
+// @Produce      json
+// @Param        id       path      string  true  "Tax Rule ID"
+// @Success      200      {object}  TaxRuleResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /tax-rules/{id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get tax rule")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Update godoc
+// @Summary      Update tax rule
+// @Description  Replace a tax rule; orders already placed keep their recorded tax
+// @Tags         tax-rules
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string          true  "Tax Rule ID"
+// @Param        request  body      TaxRuleRequest  true  "Tax Rule Request"
+// @Success      200      {object}  TaxRuleResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Rule for this category already exists"
+// @Router       /tax-rules/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req TaxRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update tax rule")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete tax rule
+// @Description  Delete a tax rule; products in its category fall back to the default rule
+// @Tags         tax-rules
+// @Produce      json
+// @Param        id       path      string  true  "Tax Rule ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string
+// @Router       /tax-rules/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete tax rule")
+		return
+	}
+	response.Success(c, http.StatusOK, "Tax rule deleted successfully", nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrTaxRuleNotFound), errors.Is(err, ErrCategoryNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrDuplicateRule):
+		response.Error(c, http.StatusConflict, "DUPLICATE_RULE", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,91 @@
+package tax_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/tax"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeTaxService struct {
+	CreateFn  func(ctx context.Context, req tax.TaxRuleRequest) (tax.TaxRuleResponse, error)
+	ListFn    func(ctx context.Context) ([]tax.TaxRuleResponse, error)
+	GetByIDFn func(ctx context.Context, id string) (tax.TaxRuleResponse, error)
+	UpdateFn  func(ctx context.Context, id string, req tax.TaxRuleRequest) (tax.TaxRuleResponse, error)
+	DeleteFn  func(ctx context.Context, id string) error
+}
+
+func (f *fakeTaxService) Create(ctx context.Context, req tax.TaxRuleRequest) (tax.TaxRuleResponse, error) {
+	return f.CreateFn(ctx, req)
+}
+
+func (f *fakeTaxService) List(ctx context.Context) ([]tax.TaxRuleResponse, error) {
+	return f.ListFn(ctx)
+}
+
+func (f *fakeTaxService) GetByID(ctx context.Context, id string) (tax.TaxRuleResponse, error) {
+	return f.GetByIDFn(ctx, id)
+}
+
+func (f *fakeTaxService) Update(ctx context.Context, id string, req tax.TaxRuleRequest) (tax.TaxRuleResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+
+func (f *fakeTaxService) Delete(ctx context.Context, id string) error {
+	return f.DeleteFn(ctx, id)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"name":"PPN","rate":11,"is_inclusive":true}`, nil, http.StatusCreated},
+		{"rate out of range", `{"name":"PPN","rate":120}`, nil, http.StatusBadRequest},
+		{"duplicate rule", `{"name":"PPN","rate":11}`, tax.ErrDuplicateRule, http.StatusConflict},
+		{"category not found", `{"name":"PPN","rate":11,"category_id":"x"}`, tax.ErrCategoryNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeTaxService{
+				CreateFn: func(ctx context.Context, req tax.TaxRuleRequest) (tax.TaxRuleResponse, error) {
+					if tc.err != nil {
+						return tax.TaxRuleResponse{}, tc.err
+					}
+					return tax.TaxRuleResponse{ID: "rule-1", Name: req.Name, Rate: req.Rate}, nil
+				},
+			}
+
+			r := setupTestRouter()
+			r.POST("/tax-rules", tax.NewHandler(svc).Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/tax-rules", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package tax
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=tax_repo.go -destination=mocks/tax_repo_mock.go -package=mock
+type Repository interface {
+	Create(ctx context.Context, params dbgen.CreateTaxRuleParams) error
+	GetByID(ctx context.Context, id string) (dbgen.TaxRule, error)
+	List(ctx context.Context) ([]dbgen.TaxRule, error)
+	CountOtherDefaults(ctx context.Context, id string) (int64, error)
+	Update(ctx context.Context, params dbgen.UpdateTaxRuleParams) error
+	Delete(ctx context.Context, id string) (int64, error)
+	CategoryExists(ctx context.Context, id string) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateTaxRuleParams) error {
+	return r.q.CreateTaxRule(ctx, params)
+}
+
+func (r *repository) GetByID(ctx context.Context, id string) (dbgen.TaxRule, error) {
+	return r.q.GetTaxRuleByID(ctx, id)
+}
+
+func (r *repository) List(ctx context.Context) ([]dbgen.TaxRule, error) {
+	return r.q.ListTaxRules(ctx)
+}
+
+func (r *repository) CountOtherDefaults(ctx context.Context, id string) (int64, error) {
+	return r.q.CountOtherDefaultTaxRules(ctx, id)
+}
+
+func (r *repository) Update(ctx context.Context, params dbgen.UpdateTaxRuleParams) error {
+	return r.q.UpdateTaxRule(ctx, params)
+}
+
+func (r *repository) Delete(ctx context.Context, id string) (int64, error) {
+	return r.q.DeleteTaxRule(ctx, id)
+}
+
+// CategoryExists mengembalikan sql.ErrNoRows jika kategori tidak ditemukan
+func (r *repository) CategoryExists(ctx context.Context, id string) error {
+	_, err := r.q.GetCategoryByID(ctx, id)
+	return err
+}
@@ -0,0 +1,14 @@
+package tax
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	rules := r.Group("/tax-rules")
+	{
+		rules.POST("", handler.Create)
+		rules.GET("", handler.GetAll)
+		rules.GET("/:id", handler.GetByID)
+		rules.PUT("/:id", handler.Update)
+		rules.DELETE("/:id", handler.Delete)
+	}
+}
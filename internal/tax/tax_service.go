@@ -0,0 +1,157 @@
+package tax
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=tax_service.go -destination=mocks/tax_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, req TaxRuleRequest) (TaxRuleResponse, error)
+	List(ctx context.Context) ([]TaxRuleResponse, error)
+	GetByID(ctx context.Context, id string) (TaxRuleResponse, error)
+	Update(ctx context.Context, id string, req TaxRuleRequest) (TaxRuleResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Create(ctx context.Context, req TaxRuleRequest) (TaxRuleResponse, error) {
+	if err := s.validate(ctx, "", req); err != nil {
+		return TaxRuleResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return TaxRuleResponse{}, err
+	}
+	id := newUUID.String()
+
+	params := dbgen.CreateTaxRuleParams{
+		ID:          id,
+		Name:        req.Name,
+		CategoryID:  helper.StringToNull(req.CategoryID),
+		Rate:        helper.Float64ToDecimal(req.Rate),
+		IsInclusive: helper.BoolPtrValue(req.IsInclusive, false),
+		IsActive:    helper.BoolPtrValue(req.IsActive, true),
+	}
+
+	if err := s.repo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return TaxRuleResponse{}, ErrDuplicateRule
+		}
+		return TaxRuleResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *service) List(ctx context.Context) ([]TaxRuleResponse, error) {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]TaxRuleResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapToResponse(r))
+	}
+
+	return res, nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (TaxRuleResponse, error) {
+	row, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TaxRuleResponse{}, ErrTaxRuleNotFound
+		}
+		return TaxRuleResponse{}, err
+	}
+
+	return mapToResponse(row), nil
+}
+
+func (s *service) Update(ctx context.Context, id string, req TaxRuleRequest) (TaxRuleResponse, error) {
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return TaxRuleResponse{}, err
+	}
+	if err := s.validate(ctx, id, req); err != nil {
+		return TaxRuleResponse{}, err
+	}
+
+	params := dbgen.UpdateTaxRuleParams{
+		Name:        req.Name,
+		CategoryID:  helper.StringToNull(req.CategoryID),
+		Rate:        helper.Float64ToDecimal(req.Rate),
+		IsInclusive: helper.BoolPtrValue(req.IsInclusive, false),
+		IsActive:    helper.BoolPtrValue(req.IsActive, true),
+		ID:          id,
+	}
+
+	if err := s.repo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return TaxRuleResponse{}, ErrDuplicateRule
+		}
+		return TaxRuleResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	affected, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrTaxRuleNotFound
+	}
+	return nil
+}
+
+// validate memastikan kategori ada; aturan default (tanpa kategori) hanya boleh satu
+// karena UNIQUE di MySQL tidak berlaku untuk NULL
+func (s *service) validate(ctx context.Context, id string, req TaxRuleRequest) error {
+	if req.CategoryID != nil {
+		if err := s.repo.CategoryExists(ctx, *req.CategoryID); err != nil {
+			if err == sql.ErrNoRows {
+				return ErrCategoryNotFound
+			}
+			return err
+		}
+		return nil
+	}
+
+	others, err := s.repo.CountOtherDefaults(ctx, id)
+	if err != nil {
+		return err
+	}
+	if others > 0 {
+		return ErrDuplicateRule
+	}
+	return nil
+}
+
+func mapToResponse(r dbgen.TaxRule) TaxRuleResponse {
+	return TaxRuleResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		CategoryID:  helper.NullStringToPtr(r.CategoryID),
+		Rate:        helper.DecimalToFloat64(r.Rate),
+		IsInclusive: r.IsInclusive,
+		IsActive:    r.IsActive,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
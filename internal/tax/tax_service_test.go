@@ -0,0 +1,113 @@
+package tax_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/tax"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockTax "assignment-ptes-achmad-rifai/internal/tax/mocks"
+)
+
+func setupServiceTest(t *testing.T) (tax.Service, *mockTax.MockRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockTax.NewMockRepository(ctrl)
+
+	return tax.NewService(repo), repo
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_category_rule", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		categoryID := "cat-1"
+		inclusive := true
+
+		repo.EXPECT().CategoryExists(gomock.Any(), categoryID).Return(nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateTaxRuleParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateTaxRuleParams) error {
+				assert.NotEmpty(t, p.ID)
+				assert.Equal(t, "cat-1", p.CategoryID.String)
+				assert.Equal(t, "11", p.Rate.String())
+				assert.True(t, p.IsInclusive)
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.TaxRule{
+			ID:          "rule-1",
+			Name:        "PPN",
+			CategoryID:  sql.NullString{String: categoryID, Valid: true},
+			Rate:        decimal.NewFromInt(11),
+			IsInclusive: true,
+			IsActive:    true,
+		}, nil)
+
+		res, err := svc.Create(ctx, tax.TaxRuleRequest{Name: "PPN", CategoryID: &categoryID, Rate: 11, IsInclusive: &inclusive})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(11), res.Rate)
+		assert.Equal(t, &categoryID, res.CategoryID)
+	})
+
+	t.Run("error_second_default_rule", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().CountOtherDefaults(gomock.Any(), "").Return(int64(1), nil)
+
+		_, err := svc.Create(ctx, tax.TaxRuleRequest{Name: "PPN", Rate: 11})
+
+		assert.ErrorIs(t, err, tax.ErrDuplicateRule)
+	})
+
+	t.Run("error_duplicate_category_rule", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		categoryID := "cat-1"
+		repo.EXPECT().CategoryExists(gomock.Any(), categoryID).Return(nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&mysql.MySQLError{Number: 1062})
+
+		_, err := svc.Create(ctx, tax.TaxRuleRequest{Name: "PPN", CategoryID: &categoryID, Rate: 11})
+
+		assert.ErrorIs(t, err, tax.ErrDuplicateRule)
+	})
+
+	t.Run("error_category_not_found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		categoryID := "missing"
+		repo.EXPECT().CategoryExists(gomock.Any(), categoryID).Return(sql.ErrNoRows)
+
+		_, err := svc.Create(ctx, tax.TaxRuleRequest{Name: "PPN", CategoryID: &categoryID, Rate: 11})
+
+		assert.ErrorIs(t, err, tax.ErrCategoryNotFound)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+		repo.EXPECT().Delete(gomock.Any(), "rule-1").Return(int64(1), nil)
+
+		assert.NoError(t, svc.Delete(ctx, "rule-1"))
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+		repo.EXPECT().Delete(gomock.Any(), "missing").Return(int64(0), nil)
+
+		assert.ErrorIs(t, svc.Delete(ctx, "missing"), tax.ErrTaxRuleNotFound)
+	})
+}
@@ -0,0 +1,32 @@
+package variant
+
+import "time"
+
+type CreateVariantRequest struct {
+	SKU           string            `json:"sku" binding:"required"`
+	Attributes    map[string]string `json:"attributes" binding:"required"`
+	Price         *float64          `json:"price" binding:"omitempty,gt=0"`
+	StockQuantity int               `json:"stock_quantity" binding:"gte=0"`
+	IsActive      *bool             `json:"is_active"`
+}
+
+type UpdateVariantRequest struct {
+	SKU           string            `json:"sku" binding:"required"`
+	Attributes    map[string]string `json:"attributes" binding:"required"`
+	Price         *float64          `json:"price" binding:"omitempty,gt=0"`
+	StockQuantity int               `json:"stock_quantity" binding:"gte=0"`
+	IsActive      *bool             `json:"is_active"`
+}
+
+type VariantResponse struct {
+	ID             string            `json:"id"`
+	ProductID      string            `json:"product_id"`
+	SKU            string            `json:"sku"`
+	Attributes     map[string]string `json:"attributes"`
+	Price          *float64          `json:"price"`           // Override harga, null = ikut harga produk
+	EffectivePrice float64           `json:"effective_price"` // Harga yang berlaku untuk varian ini
+	StockQuantity  int               `json:"stock_quantity"`
+	IsActive       bool              `json:"is_active"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
@@ -0,0 +1,9 @@
+package variant
+
+import "errors"
+
+var (
+	ErrProductNotFound = errors.New("product not found")
+	ErrVariantNotFound = errors.New("variant not found")
+	ErrDuplicateSKU    = errors.New("sku already exists")
+)
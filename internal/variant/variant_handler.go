@@ -0,0 +1,140 @@
+package variant
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Create a product variant
+// @Description  Add a size/colour SKU with its own stock and optional price override to a product
+// @Tags         variants
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                true  "Product ID"
+// @Param        request  body      CreateVariantRequest  true  "Variant Request"
+// @Success      201      {object}  VariantResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Product not found"
+// @Failure      409      {object}  map[string]string "SKU already exists"
+// @Router       /products/{id}/variants [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req CreateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create variant")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List product variants
+// @Description  Retrieve all variants of a product
+// @Tags         variants
+// @Produce      json
+// @Param        id       path      string  true  "Product ID"
+// @Success      200      {array}   VariantResponse
+// @Failure      404      {object}  map[string]string "Product not found"
+// @Router       /products/{id}/variants [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch variants")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get product variant
+// @Description  Retrieve a single variant of a product
+// @Tags         variants
+// @Produce      json
+// @Param        id          path      string  true  "Product ID"
+// @Param        variant_id  path      string  true  "Variant ID"
+// @Success      200      {object}  VariantResponse
+// @Failure      404      {object}  map[string]string "Variant not found"
+// @Router       /products/{id}/variants/{variant_id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"), c.Param("variant_id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get variant")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Update godoc
+// @Summary      Update product variant
+// @Description  Update SKU, attributes, price override or stock of a variant
+// @Tags         variants
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string                true  "Product ID"
+// @Param        variant_id  path      string                true  "Variant ID"
+// @Param        request     body      UpdateVariantRequest  true  "Update Request Body"
+// @Success      200      {object}  VariantResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string "Variant not found"
+// @Failure      409      {object}  map[string]string "SKU already exists"
+// @Router       /products/{id}/variants/{variant_id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req UpdateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), c.Param("variant_id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update variant")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete product variant
+// @Description  Remove a variant from a product
+// @Tags         variants
+// @Produce      json
+// @Param        id          path      string  true  "Product ID"
+// @Param        variant_id  path      string  true  "Variant ID"
+// @Success      204      {object}  nil
+// @Failure      404      {object}  map[string]string "Variant not found"
+// @Router       /products/{id}/variants/{variant_id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id"), c.Param("variant_id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete variant")
+		return
+	}
+	response.Success(c, http.StatusOK, "Variant deleted successfully", nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrProductNotFound), errors.Is(err, ErrVariantNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrDuplicateSKU):
+		response.Error(c, http.StatusConflict, "DUPLICATE_SKU", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
@@ -0,0 +1,154 @@
+package variant_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"assignment-ptes-achmad-rifai/internal/variant"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeVariantService struct {
+	CreateFn  func(ctx context.Context, productID string, req variant.CreateVariantRequest) (variant.VariantResponse, error)
+	ListFn    func(ctx context.Context, productID string) ([]variant.VariantResponse, error)
+	GetByIDFn func(ctx context.Context, productID, id string) (variant.VariantResponse, error)
+	UpdateFn  func(ctx context.Context, productID, id string, req variant.UpdateVariantRequest) (variant.VariantResponse, error)
+	DeleteFn  func(ctx context.Context, productID, id string) error
+}
+
+func (f *fakeVariantService) Create(ctx context.Context, productID string, req variant.CreateVariantRequest) (variant.VariantResponse, error) {
+	return f.CreateFn(ctx, productID, req)
+}
+func (f *fakeVariantService) List(ctx context.Context, productID string) ([]variant.VariantResponse, error) {
+	return f.ListFn(ctx, productID)
+}
+func (f *fakeVariantService) GetByID(ctx context.Context, productID, id string) (variant.VariantResponse, error) {
+	return f.GetByIDFn(ctx, productID, id)
+}
+func (f *fakeVariantService) Update(ctx context.Context, productID, id string, req variant.UpdateVariantRequest) (variant.VariantResponse, error) {
+	return f.UpdateFn(ctx, productID, id, req)
+}
+func (f *fakeVariantService) Delete(ctx context.Context, productID, id string) error {
+	return f.DeleteFn(ctx, productID, id)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter(h *variant.Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	variant.RegisterRoutes(r.Group(""), h)
+	return r
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	body, _ := json.Marshal(variant.CreateVariantRequest{
+		SKU:        "KAOS-M",
+		Attributes: map[string]string{"size": "M"},
+	})
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeVariantService{
+			CreateFn: func(ctx context.Context, productID string, req variant.CreateVariantRequest) (variant.VariantResponse, error) {
+				assert.Equal(t, "prod-1", productID)
+				return variant.VariantResponse{ID: "var-1", SKU: req.SKU}, nil
+			},
+		}
+		r := setupTestRouter(variant.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/variants", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("validation error - missing sku", func(t *testing.T) {
+		r := setupTestRouter(variant.NewHandler(&fakeVariantService{}))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/variants", bytes.NewReader([]byte(`{"attributes":{}}`)))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("duplicate sku", func(t *testing.T) {
+		svc := &fakeVariantService{
+			CreateFn: func(ctx context.Context, productID string, req variant.CreateVariantRequest) (variant.VariantResponse, error) {
+				return variant.VariantResponse{}, variant.ErrDuplicateSKU
+			},
+		}
+		r := setupTestRouter(variant.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodPost, "/products/prod-1/variants", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestHandler_GetAll(t *testing.T) {
+	t.Run("product not found", func(t *testing.T) {
+		svc := &fakeVariantService{
+			ListFn: func(ctx context.Context, productID string) ([]variant.VariantResponse, error) {
+				return nil, variant.ErrProductNotFound
+			},
+		}
+		r := setupTestRouter(variant.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/none/variants", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandler_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeVariantService{
+			GetByIDFn: func(ctx context.Context, productID, id string) (variant.VariantResponse, error) {
+				assert.Equal(t, "prod-1", productID)
+				assert.Equal(t, "var-1", id)
+				return variant.VariantResponse{ID: id}, nil
+			},
+		}
+		r := setupTestRouter(variant.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodGet, "/products/prod-1/variants/var-1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Run("service error", func(t *testing.T) {
+		svc := &fakeVariantService{
+			DeleteFn: func(ctx context.Context, productID, id string) error {
+				return errors.New("db error")
+			},
+		}
+		r := setupTestRouter(variant.NewHandler(svc))
+
+		req := httptest.NewRequest(http.MethodDelete, "/products/prod-1/variants/var-1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,54 @@
+package variant
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+)
+
+//go:generate mockgen -source=variant_repo.go -destination=mocks/variant_repo_mock.go -package=mock
+type Repository interface {
+	ProductExists(ctx context.Context, productID string) (bool, error)
+	Create(ctx context.Context, params dbgen.CreateProductVariantParams) error
+	GetByID(ctx context.Context, params dbgen.GetProductVariantByIDParams) (dbgen.GetProductVariantByIDRow, error)
+	ListByProductID(ctx context.Context, productID string) ([]dbgen.ListProductVariantsByProductIDRow, error)
+	Update(ctx context.Context, params dbgen.UpdateProductVariantParams) error
+	Delete(ctx context.Context, params dbgen.DeleteProductVariantParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) ProductExists(ctx context.Context, productID string) (bool, error) {
+	return r.q.ProductExists(ctx, productID)
+}
+
+func (r *repository) Create(ctx context.Context, params dbgen.CreateProductVariantParams) error {
+	return r.q.CreateProductVariant(ctx, params)
+}
+
+func (r *repository) GetByID(
+	ctx context.Context,
+	params dbgen.GetProductVariantByIDParams,
+) (dbgen.GetProductVariantByIDRow, error) {
+	return r.q.GetProductVariantByID(ctx, params)
+}
+
+func (r *repository) ListByProductID(
+	ctx context.Context,
+	productID string,
+) ([]dbgen.ListProductVariantsByProductIDRow, error) {
+	return r.q.ListProductVariantsByProductID(ctx, productID)
+}
+
+func (r *repository) Update(ctx context.Context, params dbgen.UpdateProductVariantParams) error {
+	return r.q.UpdateProductVariant(ctx, params)
+}
+
+func (r *repository) Delete(ctx context.Context, params dbgen.DeleteProductVariantParams) error {
+	return r.q.DeleteProductVariant(ctx, params)
+}
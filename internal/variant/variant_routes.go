@@ -0,0 +1,14 @@
+package variant
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	variants := r.Group("/products/:id/variants")
+	{
+		variants.POST("", handler.Create)
+		variants.GET("", handler.GetAll)
+		variants.GET("/:variant_id", handler.GetByID)
+		variants.PUT("/:variant_id", handler.Update)
+		variants.DELETE("/:variant_id", handler.Delete)
+	}
+}
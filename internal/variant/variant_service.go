@@ -0,0 +1,202 @@
+package variant
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=variant_service.go -destination=mocks/variant_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, productID string, req CreateVariantRequest) (VariantResponse, error)
+	List(ctx context.Context, productID string) ([]VariantResponse, error)
+	GetByID(ctx context.Context, productID, id string) (VariantResponse, error)
+	Update(ctx context.Context, productID, id string, req UpdateVariantRequest) (VariantResponse, error)
+	Delete(ctx context.Context, productID, id string) error
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Create(
+	ctx context.Context,
+	productID string,
+	req CreateVariantRequest,
+) (VariantResponse, error) {
+	if err := s.ensureProduct(ctx, productID); err != nil {
+		return VariantResponse{}, err
+	}
+
+	attributes, err := json.Marshal(req.Attributes)
+	if err != nil {
+		return VariantResponse{}, err
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return VariantResponse{}, err
+	}
+	id := newUUID.String()
+
+	params := dbgen.CreateProductVariantParams{
+		ID:            id,
+		ProductID:     productID,
+		Sku:           req.SKU,
+		Attributes:    attributes,
+		Price:         helper.Float64ToNullDecimal(req.Price),
+		StockQuantity: int32(req.StockQuantity),
+		IsActive:      helper.BoolPtrValue(req.IsActive, true),
+	}
+
+	if err := s.repo.Create(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return VariantResponse{}, ErrDuplicateSKU
+		}
+		return VariantResponse{}, err
+	}
+
+	// Ambil ulang agar effective_price dan timestamp sesuai DB
+	return s.GetByID(ctx, productID, id)
+}
+
+func (s *service) List(ctx context.Context, productID string) ([]VariantResponse, error) {
+	if err := s.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]VariantResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapListToResponse(r))
+	}
+	return res, nil
+}
+
+func (s *service) GetByID(ctx context.Context, productID, id string) (VariantResponse, error) {
+	row, err := s.repo.GetByID(ctx, dbgen.GetProductVariantByIDParams{
+		ID:        id,
+		ProductID: productID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return VariantResponse{}, ErrVariantNotFound
+		}
+		return VariantResponse{}, err
+	}
+
+	return mapDetailToResponse(row), nil
+}
+
+func (s *service) Update(
+	ctx context.Context,
+	productID, id string,
+	req UpdateVariantRequest,
+) (VariantResponse, error) {
+	// Check existence
+	current, err := s.GetByID(ctx, productID, id)
+	if err != nil {
+		return VariantResponse{}, err
+	}
+
+	attributes, err := json.Marshal(req.Attributes)
+	if err != nil {
+		return VariantResponse{}, err
+	}
+
+	params := dbgen.UpdateProductVariantParams{
+		ID:            id,
+		ProductID:     productID,
+		Sku:           req.SKU,
+		Attributes:    attributes,
+		Price:         helper.Float64ToNullDecimal(req.Price),
+		StockQuantity: int32(req.StockQuantity),
+		// is_active yang tidak dikirim mempertahankan status saat ini
+		IsActive: helper.BoolPtrValue(req.IsActive, current.IsActive),
+	}
+
+	if err := s.repo.Update(ctx, params); err != nil {
+		if helper.IsDuplicateKeyError(err) {
+			return VariantResponse{}, ErrDuplicateSKU
+		}
+		return VariantResponse{}, err
+	}
+
+	return s.GetByID(ctx, productID, id)
+}
+
+func (s *service) Delete(ctx context.Context, productID, id string) error {
+	if _, err := s.GetByID(ctx, productID, id); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, dbgen.DeleteProductVariantParams{
+		ID:        id,
+		ProductID: productID,
+	})
+}
+
+func (s *service) ensureProduct(ctx context.Context, productID string) error {
+	exists, err := s.repo.ProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProductNotFound
+	}
+	return nil
+}
+
+func decodeAttributes(id string, raw json.RawMessage) map[string]string {
+	attributes := map[string]string{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &attributes); err != nil {
+			log.Printf("error unmarshal attributes for variant %s: %v", id, err)
+		}
+	}
+	return attributes
+}
+
+func mapListToResponse(r dbgen.ListProductVariantsByProductIDRow) VariantResponse {
+	return VariantResponse{
+		ID:             r.ID,
+		ProductID:      r.ProductID,
+		SKU:            r.Sku,
+		Attributes:     decodeAttributes(r.ID, r.Attributes),
+		Price:          helper.NullDecimalToFloat64Ptr(r.Price),
+		EffectivePrice: helper.DecimalToFloat64(r.EffectivePrice),
+		StockQuantity:  int(r.StockQuantity),
+		IsActive:       r.IsActive,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func mapDetailToResponse(r dbgen.GetProductVariantByIDRow) VariantResponse {
+	return VariantResponse{
+		ID:             r.ID,
+		ProductID:      r.ProductID,
+		SKU:            r.Sku,
+		Attributes:     decodeAttributes(r.ID, r.Attributes),
+		Price:          helper.NullDecimalToFloat64Ptr(r.Price),
+		EffectivePrice: helper.DecimalToFloat64(r.EffectivePrice),
+		StockQuantity:  int(r.StockQuantity),
+		IsActive:       r.IsActive,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
@@ -0,0 +1,220 @@
+package variant_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/variant"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockVariant "assignment-ptes-achmad-rifai/internal/variant/mocks"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupServiceTest(t *testing.T) (variant.Service, *mockVariant.MockRepository) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockVariant.NewMockRepository(ctrl)
+	svc := variant.NewService(repo)
+
+	return svc, repo
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	productID := "prod-1"
+	price := 125000.0
+	req := variant.CreateVariantRequest{
+		SKU:           "KAOS-HITAM-L",
+		Attributes:    map[string]string{"size": "L", "colour": "hitam"},
+		Price:         &price,
+		StockQuantity: 12,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateProductVariantParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateProductVariantParams) error {
+				assert.NotEmpty(t, p.ID)
+				assert.Equal(t, productID, p.ProductID)
+				assert.Equal(t, "KAOS-HITAM-L", p.Sku)
+				assert.JSONEq(t, `{"size":"L","colour":"hitam"}`, string(p.Attributes))
+				assert.True(t, p.Price.Valid)
+				assert.Equal(t, int32(12), p.StockQuantity)
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			Return(dbgen.GetProductVariantByIDRow{
+				ID:             "var-1",
+				ProductID:      productID,
+				Sku:            "KAOS-HITAM-L",
+				Attributes:     json.RawMessage(`{"size":"L","colour":"hitam"}`),
+				Price:          decimal.NullDecimal{Decimal: decimal.NewFromFloat(price), Valid: true},
+				EffectivePrice: decimal.NewFromFloat(price),
+				StockQuantity:  12,
+				IsActive:       true,
+			}, nil)
+
+		res, err := svc.Create(ctx, productID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "L", res.Attributes["size"])
+		assert.Equal(t, price, *res.Price)
+		assert.Equal(t, price, res.EffectivePrice)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(false, nil)
+
+		_, err := svc.Create(ctx, productID, req)
+
+		assert.ErrorIs(t, err, variant.ErrProductNotFound)
+	})
+
+	t.Run("duplicate sku", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Return(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+
+		_, err := svc.Create(ctx, productID, req)
+
+		assert.ErrorIs(t, err, variant.ErrDuplicateSKU)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	ctx := context.Background()
+	productID := "prod-1"
+
+	t.Run("success - price falls back to product", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().ListByProductID(ctx, productID).Return([]dbgen.ListProductVariantsByProductIDRow{
+			{ID: "var-1", ProductID: productID, Sku: "A", EffectivePrice: decimal.NewFromInt(90000)},
+			{ID: "var-2", ProductID: productID, Sku: "B", EffectivePrice: decimal.NewFromInt(95000),
+				Price: decimal.NullDecimal{Decimal: decimal.NewFromInt(95000), Valid: true}},
+		}, nil)
+
+		res, err := svc.List(ctx, productID)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Nil(t, res[0].Price)
+		assert.Equal(t, float64(90000), res[0].EffectivePrice)
+		assert.NotNil(t, res[0].Attributes)
+		assert.Equal(t, float64(95000), *res[1].Price)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().ProductExists(ctx, productID).Return(true, nil)
+		repo.EXPECT().ListByProductID(ctx, productID).Return(nil, errors.New("db error"))
+
+		_, err := svc.List(ctx, productID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().
+			GetByID(ctx, dbgen.GetProductVariantByIDParams{ID: "var-x", ProductID: "prod-1"}).
+			Return(dbgen.GetProductVariantByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.GetByID(ctx, "prod-1", "var-x")
+
+		assert.ErrorIs(t, err, variant.ErrVariantNotFound)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	req := variant.UpdateVariantRequest{
+		SKU:           "KAOS-HITAM-XL",
+		Attributes:    map[string]string{"size": "XL"},
+		StockQuantity: 3,
+	}
+
+	t.Run("success - clears price override", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.GetProductVariantByIDRow{ID: "var-1"}, nil).Times(2)
+		repo.EXPECT().
+			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateProductVariantParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateProductVariantParams) error {
+				assert.Equal(t, "var-1", p.ID)
+				assert.Equal(t, "prod-1", p.ProductID)
+				assert.False(t, p.Price.Valid)
+				return nil
+			})
+
+		_, err := svc.Update(ctx, "prod-1", "var-1", req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - omitted is_active keeps inactive variant inactive", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.GetProductVariantByIDRow{ID: "var-1", IsActive: false}, nil).Times(2)
+		repo.EXPECT().
+			Update(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateProductVariantParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateProductVariantParams) error {
+				assert.False(t, p.IsActive)
+				return nil
+			})
+
+		_, err := svc.Update(ctx, "prod-1", "var-1", req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.GetProductVariantByIDRow{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, "prod-1", "var-x", req)
+
+		assert.ErrorIs(t, err, variant.ErrVariantNotFound)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo := setupServiceTest(t)
+
+		repo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(dbgen.GetProductVariantByIDRow{ID: "var-1"}, nil)
+		repo.EXPECT().Delete(ctx, dbgen.DeleteProductVariantParams{ID: "var-1", ProductID: "prod-1"}).Return(nil)
+
+		err := svc.Delete(ctx, "prod-1", "var-1")
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,334 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_repo.go -destination=mocks/webhook_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	dbgen "assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	webhook "assignment-ptes-achmad-rifai/internal/webhook"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDelivery mocks base method.
+func (m *MockRepository) ClaimDelivery(ctx context.Context, params dbgen.ClaimWebhookDeliveryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDelivery", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDelivery indicates an expected call of ClaimDelivery.
+func (mr *MockRepositoryMockRecorder) ClaimDelivery(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDelivery", reflect.TypeOf((*MockRepository)(nil).ClaimDelivery), ctx, params)
+}
+
+// CountDeliveries mocks base method.
+func (m *MockRepository) CountDeliveries(ctx context.Context, params dbgen.CountWebhookDeliveriesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockRepositoryMockRecorder) CountDeliveries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockRepository)(nil).CountDeliveries), ctx, params)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockRepository) CreateDelivery(ctx context.Context, params dbgen.CreateWebhookDeliveryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockRepositoryMockRecorder) CreateDelivery(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockRepository)(nil).CreateDelivery), ctx, params)
+}
+
+// CreateDeliveryAttempt mocks base method.
+func (m *MockRepository) CreateDeliveryAttempt(ctx context.Context, params dbgen.CreateWebhookDeliveryAttemptParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveryAttempt", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveryAttempt indicates an expected call of CreateDeliveryAttempt.
+func (mr *MockRepositoryMockRecorder) CreateDeliveryAttempt(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveryAttempt", reflect.TypeOf((*MockRepository)(nil).CreateDeliveryAttempt), ctx, params)
+}
+
+// CreateEndpoint mocks base method.
+func (m *MockRepository) CreateEndpoint(ctx context.Context, params dbgen.CreateWebhookEndpointParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpoint", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEndpoint indicates an expected call of CreateEndpoint.
+func (mr *MockRepositoryMockRecorder) CreateEndpoint(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpoint", reflect.TypeOf((*MockRepository)(nil).CreateEndpoint), ctx, params)
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockRepository) DeleteEndpoint(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockRepositoryMockRecorder) DeleteEndpoint(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockRepository)(nil).DeleteEndpoint), ctx, id)
+}
+
+// DisableFailingEndpoint mocks base method.
+func (m *MockRepository) DisableFailingEndpoint(ctx context.Context, params dbgen.DisableFailingWebhookEndpointParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableFailingEndpoint", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableFailingEndpoint indicates an expected call of DisableFailingEndpoint.
+func (mr *MockRepositoryMockRecorder) DisableFailingEndpoint(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableFailingEndpoint", reflect.TypeOf((*MockRepository)(nil).DisableFailingEndpoint), ctx, params)
+}
+
+// GetDelivery mocks base method.
+func (m *MockRepository) GetDelivery(ctx context.Context, params dbgen.GetWebhookDeliveryParams) (dbgen.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, params)
+	ret0, _ := ret[0].(dbgen.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockRepositoryMockRecorder) GetDelivery(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockRepository)(nil).GetDelivery), ctx, params)
+}
+
+// GetEndpointByID mocks base method.
+func (m *MockRepository) GetEndpointByID(ctx context.Context, id string) (dbgen.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointByID", ctx, id)
+	ret0, _ := ret[0].(dbgen.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndpointByID indicates an expected call of GetEndpointByID.
+func (mr *MockRepositoryMockRecorder) GetEndpointByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointByID", reflect.TypeOf((*MockRepository)(nil).GetEndpointByID), ctx, id)
+}
+
+// IncrementEndpointFailures mocks base method.
+func (m *MockRepository) IncrementEndpointFailures(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementEndpointFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementEndpointFailures indicates an expected call of IncrementEndpointFailures.
+func (mr *MockRepositoryMockRecorder) IncrementEndpointFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementEndpointFailures", reflect.TypeOf((*MockRepository)(nil).IncrementEndpointFailures), ctx, id)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockRepository) ListDeliveries(ctx context.Context, params dbgen.ListWebhookDeliveriesParams) ([]dbgen.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, params)
+	ret0, _ := ret[0].([]dbgen.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockRepositoryMockRecorder) ListDeliveries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockRepository)(nil).ListDeliveries), ctx, params)
+}
+
+// ListDeliveryAttempts mocks base method.
+func (m *MockRepository) ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]dbgen.WebhookDeliveryAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveryAttempts", ctx, deliveryID)
+	ret0, _ := ret[0].([]dbgen.WebhookDeliveryAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveryAttempts indicates an expected call of ListDeliveryAttempts.
+func (mr *MockRepositoryMockRecorder) ListDeliveryAttempts(ctx, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveryAttempts", reflect.TypeOf((*MockRepository)(nil).ListDeliveryAttempts), ctx, deliveryID)
+}
+
+// ListDueDeliveries mocks base method.
+func (m *MockRepository) ListDueDeliveries(ctx context.Context, params dbgen.ListDueWebhookDeliveriesParams) ([]dbgen.ListDueWebhookDeliveriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueDeliveries", ctx, params)
+	ret0, _ := ret[0].([]dbgen.ListDueWebhookDeliveriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueDeliveries indicates an expected call of ListDueDeliveries.
+func (mr *MockRepositoryMockRecorder) ListDueDeliveries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueDeliveries", reflect.TypeOf((*MockRepository)(nil).ListDueDeliveries), ctx, params)
+}
+
+// ListEndpoints mocks base method.
+func (m *MockRepository) ListEndpoints(ctx context.Context) ([]dbgen.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpoints", ctx)
+	ret0, _ := ret[0].([]dbgen.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpoints indicates an expected call of ListEndpoints.
+func (mr *MockRepositoryMockRecorder) ListEndpoints(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpoints", reflect.TypeOf((*MockRepository)(nil).ListEndpoints), ctx)
+}
+
+// ListEndpointsForEvent mocks base method.
+func (m *MockRepository) ListEndpointsForEvent(ctx context.Context, eventType string) ([]dbgen.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointsForEvent", ctx, eventType)
+	ret0, _ := ret[0].([]dbgen.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpointsForEvent indicates an expected call of ListEndpointsForEvent.
+func (mr *MockRepositoryMockRecorder) ListEndpointsForEvent(ctx, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointsForEvent", reflect.TypeOf((*MockRepository)(nil).ListEndpointsForEvent), ctx, eventType)
+}
+
+// RedeliverDelivery mocks base method.
+func (m *MockRepository) RedeliverDelivery(ctx context.Context, params dbgen.RedeliverWebhookDeliveryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeliverDelivery", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeliverDelivery indicates an expected call of RedeliverDelivery.
+func (mr *MockRepositoryMockRecorder) RedeliverDelivery(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeliverDelivery", reflect.TypeOf((*MockRepository)(nil).RedeliverDelivery), ctx, params)
+}
+
+// ResetEndpointFailures mocks base method.
+func (m *MockRepository) ResetEndpointFailures(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetEndpointFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetEndpointFailures indicates an expected call of ResetEndpointFailures.
+func (mr *MockRepositoryMockRecorder) ResetEndpointFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetEndpointFailures", reflect.TypeOf((*MockRepository)(nil).ResetEndpointFailures), ctx, id)
+}
+
+// UpdateDeliveryResult mocks base method.
+func (m *MockRepository) UpdateDeliveryResult(ctx context.Context, params dbgen.UpdateWebhookDeliveryResultParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeliveryResult", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeliveryResult indicates an expected call of UpdateDeliveryResult.
+func (mr *MockRepositoryMockRecorder) UpdateDeliveryResult(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeliveryResult", reflect.TypeOf((*MockRepository)(nil).UpdateDeliveryResult), ctx, params)
+}
+
+// UpdateEndpoint mocks base method.
+func (m *MockRepository) UpdateEndpoint(ctx context.Context, params dbgen.UpdateWebhookEndpointParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpoint", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEndpoint indicates an expected call of UpdateEndpoint.
+func (mr *MockRepositoryMockRecorder) UpdateEndpoint(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpoint", reflect.TypeOf((*MockRepository)(nil).UpdateEndpoint), ctx, params)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) webhook.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(webhook.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultDispatchInterval adalah jeda antar polling delivery yang jatuh tempo
+const DefaultDispatchInterval = 5 * time.Second
+
+// Dispatcher mengirim delivery webhook di background
+type Dispatcher struct {
+	service  Service
+	interval time.Duration
+}
+
+func NewDispatcher(service Service, interval time.Duration) *Dispatcher {
+	if interval <= 0 {
+		interval = DefaultDispatchInterval
+	}
+	return &Dispatcher{service: service, interval: interval}
+}
+
+// Run memproses delivery sekali saat start lalu setiap interval, sampai ctx dibatalkan
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) tick(ctx context.Context) {
+	// Batch penuh berarti kemungkinan masih ada antrean, lanjutkan tanpa menunggu ticker
+	for ctx.Err() == nil {
+		delivered, failed, err := d.service.DeliverDue(ctx, time.Now())
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("webhook dispatcher: %v", err)
+			}
+			return
+		}
+		if failed > 0 {
+			log.Printf("webhook dispatcher: %d delivery(s) failed, will retry", failed)
+		}
+		if delivered+failed < DeliveryBatchSize {
+			return
+		}
+	}
+}
+
+// sink menjadikan service webhook tujuan relay outbox
+type sink struct {
+	service Service
+}
+
+// NewSink membuat outbox.Sink yang mengantrekan delivery untuk setiap event
+func NewSink(service Service) outbox.Sink {
+	return &sink{service: service}
+}
+
+func (s *sink) Publish(ctx context.Context, msg outbox.Message) error {
+	return s.service.Enqueue(ctx, msg)
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EndpointRequest dipakai untuk create maupun update endpoint.
+// Secret dibuat otomatis jika kosong saat create, dan dipertahankan jika kosong saat update.
+type EndpointRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=500"`
+	Description *string  `json:"description" binding:"omitempty,max=255"`
+	EventTypes  []string `json:"event_types" binding:"required,min=1"`
+	Secret      *string  `json:"secret" binding:"omitempty,min=16,max=128"`
+	IsActive    *bool    `json:"is_active"`
+}
+
+type DeliveryListParams struct {
+	Page     int    `form:"page"`
+	PageSize int    `form:"page_size"`
+	Status   string `form:"status"` // Kosong berarti semua status
+}
+
+type EndpointResponse struct {
+	ID                  string     `json:"id"`
+	URL                 string     `json:"url"`
+	Description         *string    `json:"description"`
+	EventTypes          []string   `json:"event_types"`
+	Secret              string     `json:"secret,omitempty"` // Hanya dikembalikan saat create
+	IsActive            bool       `json:"is_active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledReason      *string    `json:"disabled_reason"`
+	DisabledAt          *time.Time `json:"disabled_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type DeliveryResponse struct {
+	ID               string                    `json:"id"`
+	EndpointID       string                    `json:"endpoint_id"`
+	EventID          string                    `json:"event_id"`
+	EventType        string                    `json:"event_type"`
+	Status           string                    `json:"status"`
+	Attempts         int                       `json:"attempts"`
+	NextAttemptAt    time.Time                 `json:"next_attempt_at"`
+	LastResponseCode *int                      `json:"last_response_code"`
+	LastError        *string                   `json:"last_error"`
+	DeliveredAt      *time.Time                `json:"delivered_at"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	UpdatedAt        time.Time                 `json:"updated_at"`
+	Payload          json.RawMessage           `json:"payload,omitempty" swaggertype:"object"` // Hanya pada detail
+	AttemptLog       []DeliveryAttemptResponse `json:"attempt_log,omitempty"`                  // Hanya pada detail, terbaru dulu
+}
+
+type DeliveryAttemptResponse struct {
+	ID           string    `json:"id"`
+	ResponseCode *int      `json:"response_code"`
+	Error        *string   `json:"error"`
+	DurationMs   int       `json:"duration_ms"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Envelope adalah body JSON yang dikirim ke endpoint. ID sama dengan ID event outbox,
+// jadi penerima bisa dedup saat event dikirim lebih dari sekali.
+type Envelope struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
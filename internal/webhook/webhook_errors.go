@@ -0,0 +1,17 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrEndpointNotFound = errors.New("webhook endpoint not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrEndpointDisabled = errors.New("webhook endpoint is disabled")
+
+	// Detail validasi (url, event type) dibungkus dengan fmt.Errorf("%w: ...")
+	ErrInvalidEndpoint = errors.New("invalid webhook endpoint")
+
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// ErrForbiddenAddress: endpoint mengarah ke alamat internal (loopback, privat, link-local)
+	ErrForbiddenAddress = errors.New("webhook endpoint resolves to a forbidden address")
+)
@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"assignment-ptes-achmad-rifai/internal/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Create godoc
+// @Summary      Register a webhook endpoint
+// @Description  Subscribe an endpoint URL to domain events. The signing secret is generated when omitted and only returned in this response.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request  body      EndpointRequest  true  "Endpoint Request"
+// @Success      201      {object}  EndpointResponse
+// @Failure      400      {object}  map[string]string
+// @Router       /webhooks [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req EndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err, "CREATE_ERROR", "Failed to create webhook endpoint")
+		return
+	}
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GetAll godoc
+// @Summary      List webhook endpoints
+// @Description  Retrieve all registered webhook endpoints, including auto-disabled ones
+// @Tags         webhooks
+// @Produce      json
+// @Success      200      {array}   EndpointResponse
+// @Router       /webhooks [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Failed to fetch webhook endpoints", err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GetByID godoc
+// @Summary      Get webhook endpoint
+// @Description  Retrieve a single webhook endpoint with its failure counter
+// @Tags         webhooks
+// @Produce      json
+// @Param        id       path      string  true  "Endpoint ID"
+// @Success      200      {object}  EndpointResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /webhooks/{id} [get]
+func (h *Handler) GetByID(c *gin.Context) {
+	res, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get webhook endpoint")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Update godoc
+// @Summary      Update webhook endpoint
+// @Description  Replace an endpoint's URL and subscriptions. Setting is_active to true re-enables an auto-disabled endpoint; the secret is kept when omitted.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string           true  "Endpoint ID"
+// @Param        request  body      EndpointRequest  true  "Endpoint Request"
+// @Success      200      {object}  EndpointResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Router       /webhooks/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req EndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		handleError(c, err, "UPDATE_ERROR", "Failed to update webhook endpoint")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete godoc
+// @Summary      Delete webhook endpoint
+// @Description  Delete an endpoint together with its delivery log
+// @Tags         webhooks
+// @Produce      json
+// @Param        id       path      string  true  "Endpoint ID"
+// @Success      200      {object}  nil
+// @Failure      404      {object}  map[string]string
+// @Router       /webhooks/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		handleError(c, err, "DELETE_ERROR", "Failed to delete webhook endpoint")
+		return
+	}
+	response.Success(c, http.StatusOK, "Webhook endpoint deleted successfully", nil)
+}
+
+// ListDeliveries godoc
+// @Summary      List webhook deliveries
+// @Description  Retrieve the delivery log of an endpoint, newest first
+// @Tags         webhooks
+// @Produce      json
+// @Param        id         path     string  true   "Endpoint ID"
+// @Param        status     query    string  false  "Filter by status (pending, delivered, failed)"
+// @Param        page       query    int     false  "Page number"
+// @Param        page_size  query    int     false  "Items per page"
+// @Success      200      {array}   DeliveryResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Router       /webhooks/{id}/deliveries [get]
+func (h *Handler) ListDeliveries(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	status := c.Query("status")
+	switch status {
+	case "", DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusFailed:
+	default:
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid status filter", nil)
+		return
+	}
+
+	res, total, err := h.service.ListDeliveries(c.Request.Context(), c.Param("id"), DeliveryListParams{
+		Page:     page,
+		PageSize: pageSize,
+		Status:   status,
+	})
+	if err != nil {
+		handleError(c, err, "FETCH_ERROR", "Failed to fetch webhook deliveries")
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, &response.PaginationMeta{
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	})
+}
+
+// GetDelivery godoc
+// @Summary      Get webhook delivery
+// @Description  Retrieve a delivery with its payload and the response code of every attempt
+// @Tags         webhooks
+// @Produce      json
+// @Param        id           path      string  true  "Endpoint ID"
+// @Param        delivery_id  path      string  true  "Delivery ID"
+// @Success      200      {object}  DeliveryResponse
+// @Failure      404      {object}  map[string]string
+// @Router       /webhooks/{id}/deliveries/{delivery_id} [get]
+func (h *Handler) GetDelivery(c *gin.Context) {
+	res, err := h.service.GetDelivery(c.Request.Context(), c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		handleError(c, err, "GET_ERROR", "Failed to get webhook delivery")
+		return
+	}
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Redeliver godoc
+// @Summary      Redeliver webhook
+// @Description  Queue a delivery to be sent again immediately with a fresh retry budget
+// @Tags         webhooks
+// @Produce      json
+// @Param        id           path      string  true  "Endpoint ID"
+// @Param        delivery_id  path      string  true  "Delivery ID"
+// @Success      202      {object}  DeliveryResponse
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string "Endpoint disabled"
+// @Router       /webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *Handler) Redeliver(c *gin.Context) {
+	res, err := h.service.Redeliver(c.Request.Context(), c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		handleError(c, err, "REDELIVER_ERROR", "Failed to redeliver webhook")
+		return
+	}
+	response.Success(c, http.StatusAccepted, res, nil)
+}
+
+func handleError(c *gin.Context, err error, code, message string) {
+	switch {
+	case errors.Is(err, ErrEndpointNotFound), errors.Is(err, ErrDeliveryNotFound):
+		response.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+	case errors.Is(err, ErrInvalidEndpoint):
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+	case errors.Is(err, ErrEndpointDisabled):
+		response.Error(c, http.StatusConflict, "ENDPOINT_DISABLED", err.Error(), nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
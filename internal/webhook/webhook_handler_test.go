@@ -0,0 +1,195 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/webhook"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeWebhookService struct {
+	CreateFn         func(ctx context.Context, req webhook.EndpointRequest) (webhook.EndpointResponse, error)
+	ListFn           func(ctx context.Context) ([]webhook.EndpointResponse, error)
+	GetByIDFn        func(ctx context.Context, id string) (webhook.EndpointResponse, error)
+	UpdateFn         func(ctx context.Context, id string, req webhook.EndpointRequest) (webhook.EndpointResponse, error)
+	DeleteFn         func(ctx context.Context, id string) error
+	ListDeliveriesFn func(ctx context.Context, endpointID string, p webhook.DeliveryListParams) ([]webhook.DeliveryResponse, int64, error)
+	GetDeliveryFn    func(ctx context.Context, endpointID, deliveryID string) (webhook.DeliveryResponse, error)
+	RedeliverFn      func(ctx context.Context, endpointID, deliveryID string) (webhook.DeliveryResponse, error)
+}
+
+func (f *fakeWebhookService) Create(ctx context.Context, req webhook.EndpointRequest) (webhook.EndpointResponse, error) {
+	return f.CreateFn(ctx, req)
+}
+
+func (f *fakeWebhookService) List(ctx context.Context) ([]webhook.EndpointResponse, error) {
+	return f.ListFn(ctx)
+}
+
+func (f *fakeWebhookService) GetByID(ctx context.Context, id string) (webhook.EndpointResponse, error) {
+	return f.GetByIDFn(ctx, id)
+}
+
+func (f *fakeWebhookService) Update(ctx context.Context, id string, req webhook.EndpointRequest) (webhook.EndpointResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+
+func (f *fakeWebhookService) Delete(ctx context.Context, id string) error {
+	return f.DeleteFn(ctx, id)
+}
+
+func (f *fakeWebhookService) ListDeliveries(ctx context.Context, endpointID string, p webhook.DeliveryListParams) ([]webhook.DeliveryResponse, int64, error) {
+	return f.ListDeliveriesFn(ctx, endpointID, p)
+}
+
+func (f *fakeWebhookService) GetDelivery(ctx context.Context, endpointID, deliveryID string) (webhook.DeliveryResponse, error) {
+	return f.GetDeliveryFn(ctx, endpointID, deliveryID)
+}
+
+func (f *fakeWebhookService) Redeliver(ctx context.Context, endpointID, deliveryID string) (webhook.DeliveryResponse, error) {
+	return f.RedeliverFn(ctx, endpointID, deliveryID)
+}
+
+func (f *fakeWebhookService) Enqueue(ctx context.Context, msg outbox.Message) error {
+	return nil
+}
+
+func (f *fakeWebhookService) DeliverDue(ctx context.Context, now time.Time) (int, int, error) {
+	return 0, 0, nil
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+// ==================== TESTS ====================
+
+func TestHandler_Create(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+		code int
+	}{
+		{"success", `{"url":"https://example.com/hooks","event_types":["OrderPlaced"]}`, nil, http.StatusCreated},
+		{"missing event types", `{"url":"https://example.com/hooks","event_types":[]}`, nil, http.StatusBadRequest},
+		{"invalid url", `{"url":"not a url","event_types":["OrderPlaced"]}`, nil, http.StatusBadRequest},
+		{"unknown event type", `{"url":"https://example.com/hooks","event_types":["Bogus"]}`, webhook.ErrInvalidEndpoint, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeWebhookService{
+				CreateFn: func(ctx context.Context, req webhook.EndpointRequest) (webhook.EndpointResponse, error) {
+					if tc.err != nil {
+						return webhook.EndpointResponse{}, tc.err
+					}
+					return webhook.EndpointResponse{ID: "wh-1", URL: req.URL, Secret: "whsec_x"}, nil
+				},
+			}
+
+			r := setupTestRouter()
+			webhook.RegisterRoutes(r.Group(""), webhook.NewHandler(svc))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Delete_NotFound(t *testing.T) {
+	svc := &fakeWebhookService{
+		DeleteFn: func(ctx context.Context, id string) error {
+			return webhook.ErrEndpointNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	webhook.RegisterRoutes(r.Group(""), webhook.NewHandler(svc))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/webhooks/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_ListDeliveries(t *testing.T) {
+	t.Run("passes_filter_and_pagination", func(t *testing.T) {
+		svc := &fakeWebhookService{
+			ListDeliveriesFn: func(ctx context.Context, endpointID string, p webhook.DeliveryListParams) ([]webhook.DeliveryResponse, int64, error) {
+				assert.Equal(t, "wh-1", endpointID)
+				assert.Equal(t, webhook.DeliveryStatusFailed, p.Status)
+				assert.Equal(t, 2, p.Page)
+				return []webhook.DeliveryResponse{{ID: "del-1"}}, 11, nil
+			},
+		}
+
+		r := setupTestRouter()
+		webhook.RegisterRoutes(r.Group(""), webhook.NewHandler(svc))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/webhooks/wh-1/deliveries?status=failed&page=2", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalPages":2`)
+	})
+
+	t.Run("invalid_status", func(t *testing.T) {
+		r := setupTestRouter()
+		webhook.RegisterRoutes(r.Group(""), webhook.NewHandler(&fakeWebhookService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/webhooks/wh-1/deliveries?status=bogus", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandler_Redeliver(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"success", nil, http.StatusAccepted},
+		{"delivery not found", webhook.ErrDeliveryNotFound, http.StatusNotFound},
+		{"endpoint disabled", webhook.ErrEndpointDisabled, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeWebhookService{
+				RedeliverFn: func(ctx context.Context, endpointID, deliveryID string) (webhook.DeliveryResponse, error) {
+					assert.Equal(t, "wh-1", endpointID)
+					assert.Equal(t, "del-1", deliveryID)
+					return webhook.DeliveryResponse{ID: deliveryID, Status: webhook.DeliveryStatusPending}, tc.err
+				},
+			}
+
+			r := setupTestRouter()
+			webhook.RegisterRoutes(r.Group(""), webhook.NewHandler(svc))
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhooks/wh-1/deliveries/del-1/redeliver", nil))
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
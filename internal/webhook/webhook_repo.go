@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"context"
+	"database/sql"
+)
+
+//go:generate mockgen -source=webhook_repo.go -destination=mocks/webhook_repo_mock.go -package=mock
+type Repository interface {
+	// Transaction helpers
+	WithTx(tx dbgen.DBTX) Repository
+
+	// Endpoint
+	CreateEndpoint(ctx context.Context, params dbgen.CreateWebhookEndpointParams) error
+	GetEndpointByID(ctx context.Context, id string) (dbgen.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context) ([]dbgen.WebhookEndpoint, error)
+	ListEndpointsForEvent(ctx context.Context, eventType string) ([]dbgen.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, params dbgen.UpdateWebhookEndpointParams) error
+	DeleteEndpoint(ctx context.Context, id string) (int64, error)
+	ResetEndpointFailures(ctx context.Context, id string) error
+	IncrementEndpointFailures(ctx context.Context, id string) error
+	DisableFailingEndpoint(ctx context.Context, params dbgen.DisableFailingWebhookEndpointParams) (int64, error)
+
+	// Delivery
+	CreateDelivery(ctx context.Context, params dbgen.CreateWebhookDeliveryParams) error
+	ListDueDeliveries(ctx context.Context, params dbgen.ListDueWebhookDeliveriesParams) ([]dbgen.ListDueWebhookDeliveriesRow, error)
+	ClaimDelivery(ctx context.Context, params dbgen.ClaimWebhookDeliveryParams) (int64, error)
+	UpdateDeliveryResult(ctx context.Context, params dbgen.UpdateWebhookDeliveryResultParams) error
+	CreateDeliveryAttempt(ctx context.Context, params dbgen.CreateWebhookDeliveryAttemptParams) error
+	ListDeliveries(ctx context.Context, params dbgen.ListWebhookDeliveriesParams) ([]dbgen.WebhookDelivery, error)
+	CountDeliveries(ctx context.Context, params dbgen.CountWebhookDeliveriesParams) (int64, error)
+	GetDelivery(ctx context.Context, params dbgen.GetWebhookDeliveryParams) (dbgen.WebhookDelivery, error)
+	ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]dbgen.WebhookDeliveryAttempt, error)
+	RedeliverDelivery(ctx context.Context, params dbgen.RedeliverWebhookDeliveryParams) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			q: r.q.WithTx(sqlTx),
+		}
+	}
+
+	return r
+}
+
+func (r *repository) CreateEndpoint(ctx context.Context, params dbgen.CreateWebhookEndpointParams) error {
+	return r.q.CreateWebhookEndpoint(ctx, params)
+}
+
+func (r *repository) GetEndpointByID(ctx context.Context, id string) (dbgen.WebhookEndpoint, error) {
+	return r.q.GetWebhookEndpointByID(ctx, id)
+}
+
+func (r *repository) ListEndpoints(ctx context.Context) ([]dbgen.WebhookEndpoint, error) {
+	return r.q.ListWebhookEndpoints(ctx)
+}
+
+func (r *repository) ListEndpointsForEvent(ctx context.Context, eventType string) ([]dbgen.WebhookEndpoint, error) {
+	return r.q.ListWebhookEndpointsForEvent(ctx, eventType)
+}
+
+func (r *repository) UpdateEndpoint(ctx context.Context, params dbgen.UpdateWebhookEndpointParams) error {
+	return r.q.UpdateWebhookEndpoint(ctx, params)
+}
+
+func (r *repository) DeleteEndpoint(ctx context.Context, id string) (int64, error) {
+	return r.q.DeleteWebhookEndpoint(ctx, id)
+}
+
+func (r *repository) ResetEndpointFailures(ctx context.Context, id string) error {
+	return r.q.ResetWebhookEndpointFailures(ctx, id)
+}
+
+func (r *repository) IncrementEndpointFailures(ctx context.Context, id string) error {
+	return r.q.IncrementWebhookEndpointFailures(ctx, id)
+}
+
+func (r *repository) DisableFailingEndpoint(ctx context.Context, params dbgen.DisableFailingWebhookEndpointParams) (int64, error) {
+	return r.q.DisableFailingWebhookEndpoint(ctx, params)
+}
+
+func (r *repository) CreateDelivery(ctx context.Context, params dbgen.CreateWebhookDeliveryParams) error {
+	return r.q.CreateWebhookDelivery(ctx, params)
+}
+
+func (r *repository) ListDueDeliveries(ctx context.Context, params dbgen.ListDueWebhookDeliveriesParams) ([]dbgen.ListDueWebhookDeliveriesRow, error) {
+	return r.q.ListDueWebhookDeliveries(ctx, params)
+}
+
+func (r *repository) ClaimDelivery(ctx context.Context, params dbgen.ClaimWebhookDeliveryParams) (int64, error) {
+	return r.q.ClaimWebhookDelivery(ctx, params)
+}
+
+func (r *repository) UpdateDeliveryResult(ctx context.Context, params dbgen.UpdateWebhookDeliveryResultParams) error {
+	return r.q.UpdateWebhookDeliveryResult(ctx, params)
+}
+
+func (r *repository) CreateDeliveryAttempt(ctx context.Context, params dbgen.CreateWebhookDeliveryAttemptParams) error {
+	return r.q.CreateWebhookDeliveryAttempt(ctx, params)
+}
+
+func (r *repository) ListDeliveries(ctx context.Context, params dbgen.ListWebhookDeliveriesParams) ([]dbgen.WebhookDelivery, error) {
+	return r.q.ListWebhookDeliveries(ctx, params)
+}
+
+func (r *repository) CountDeliveries(ctx context.Context, params dbgen.CountWebhookDeliveriesParams) (int64, error) {
+	return r.q.CountWebhookDeliveries(ctx, params)
+}
+
+func (r *repository) GetDelivery(ctx context.Context, params dbgen.GetWebhookDeliveryParams) (dbgen.WebhookDelivery, error) {
+	return r.q.GetWebhookDelivery(ctx, params)
+}
+
+func (r *repository) ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]dbgen.WebhookDeliveryAttempt, error) {
+	return r.q.ListWebhookDeliveryAttempts(ctx, deliveryID)
+}
+
+func (r *repository) RedeliverDelivery(ctx context.Context, params dbgen.RedeliverWebhookDeliveryParams) error {
+	return r.q.RedeliverWebhookDelivery(ctx, params)
+}
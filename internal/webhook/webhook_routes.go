@@ -0,0 +1,19 @@
+package webhook
+
+import "github.com/gin-gonic/gin"
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	webhooks := r.Group("/webhooks")
+	{
+		webhooks.POST("", handler.Create)
+		webhooks.GET("", handler.GetAll)
+		webhooks.GET("/:id", handler.GetByID)
+		webhooks.PUT("/:id", handler.Update)
+		webhooks.DELETE("/:id", handler.Delete)
+
+		// Log delivery & pengiriman ulang manual
+		webhooks.GET("/:id/deliveries", handler.ListDeliveries)
+		webhooks.GET("/:id/deliveries/:delivery_id", handler.GetDelivery)
+		webhooks.POST("/:id/deliveries/:delivery_id/redeliver", handler.Redeliver)
+	}
+}
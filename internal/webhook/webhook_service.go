@@ -0,0 +1,573 @@
+package webhook
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/shared/database/helper"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status delivery
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+)
+
+// Header tambahan pada setiap pengiriman
+const (
+	DeliveryHeader = "X-Webhook-ID"
+	EventHeader    = "X-Webhook-Event"
+)
+
+// MaxDeliveryAttempts adalah jumlah percobaan sebelum delivery ditandai failed
+const MaxDeliveryAttempts = 8
+
+// MaxConsecutiveFailures adalah jumlah kegagalan beruntun sebelum endpoint dinonaktifkan otomatis
+const MaxConsecutiveFailures = 15
+
+// DeliveryBatchSize membatasi jumlah delivery yang diproses per putaran dispatcher
+const DeliveryBatchSize = 50
+
+// Backoff retry: 30s, 1m, 2m, ... maksimal 6 jam
+const (
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+)
+
+// deliveryLease harus lebih lama dari timeout HTTP client agar delivery tidak dikirim ganda
+const deliveryLease = time.Minute
+
+const defaultClientTimeout = 10 * time.Second
+
+// maxErrorLength sesuai kolom webhook_delivery_attempts.error_message & webhook_deliveries.last_error
+const maxErrorLength = 500
+
+const autoDisableReason = "disabled after too many consecutive delivery failures"
+
+//go:generate mockgen -source=webhook_service.go -destination=mocks/webhook_service_mock.go -package=mock
+type Service interface {
+	Create(ctx context.Context, req EndpointRequest) (EndpointResponse, error)
+	List(ctx context.Context) ([]EndpointResponse, error)
+	GetByID(ctx context.Context, id string) (EndpointResponse, error)
+	Update(ctx context.Context, id string, req EndpointRequest) (EndpointResponse, error)
+	Delete(ctx context.Context, id string) error
+
+	ListDeliveries(ctx context.Context, endpointID string, params DeliveryListParams) ([]DeliveryResponse, int64, error)
+	GetDelivery(ctx context.Context, endpointID, deliveryID string) (DeliveryResponse, error)
+	Redeliver(ctx context.Context, endpointID, deliveryID string) (DeliveryResponse, error)
+
+	// Enqueue membuat delivery untuk setiap endpoint aktif yang berlangganan event
+	Enqueue(ctx context.Context, msg outbox.Message) error
+	// DeliverDue mengirim satu batch delivery yang jatuh tempo
+	DeliverDue(ctx context.Context, now time.Time) (delivered int, failed int, err error)
+}
+
+type service struct {
+	db     *sql.DB
+	repo   Repository
+	client *http.Client
+}
+
+// NewService membuat service webhook; client nil memakai NewHTTPClient dengan timeout default
+func NewService(db *sql.DB, repo Repository, client *http.Client) Service {
+	if client == nil {
+		client = NewHTTPClient(defaultClientTimeout)
+	}
+	return &service{db: db, repo: repo, client: client}
+}
+
+func (s *service) Create(ctx context.Context, req EndpointRequest) (EndpointResponse, error) {
+	eventTypes, err := validate(req)
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+
+	secret := helper.StringPtrValue(req.Secret)
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			return EndpointResponse{}, err
+		}
+	}
+
+	newUUID, err := uuid.NewV7()
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+	id := newUUID.String()
+
+	if err := s.repo.CreateEndpoint(ctx, dbgen.CreateWebhookEndpointParams{
+		ID:          id,
+		Url:         req.URL,
+		Description: helper.StringToNull(req.Description),
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		IsActive:    helper.BoolPtrValue(req.IsActive, true),
+	}); err != nil {
+		return EndpointResponse{}, err
+	}
+
+	res, err := s.GetByID(ctx, id)
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+	res.Secret = secret
+	return res, nil
+}
+
+func (s *service) List(ctx context.Context) ([]EndpointResponse, error) {
+	rows, err := s.repo.ListEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]EndpointResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapEndpointToResponse(r))
+	}
+	return res, nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (EndpointResponse, error) {
+	row, err := s.getEndpoint(ctx, id)
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+	return mapEndpointToResponse(row), nil
+}
+
+// Update mengganti konfigurasi endpoint. Mengaktifkan kembali endpoint mereset hitungan kegagalan.
+func (s *service) Update(ctx context.Context, id string, req EndpointRequest) (EndpointResponse, error) {
+	current, err := s.getEndpoint(ctx, id)
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+	eventTypes, err := validate(req)
+	if err != nil {
+		return EndpointResponse{}, err
+	}
+
+	params := dbgen.UpdateWebhookEndpointParams{
+		Url:                 req.URL,
+		Description:         helper.StringToNull(req.Description),
+		Secret:              current.Secret,
+		EventTypes:          eventTypes,
+		IsActive:            helper.BoolPtrValue(req.IsActive, current.IsActive),
+		ConsecutiveFailures: current.ConsecutiveFailures,
+		DisabledReason:      current.DisabledReason,
+		DisabledAt:          current.DisabledAt,
+		ID:                  id,
+	}
+	if req.Secret != nil && *req.Secret != "" {
+		params.Secret = *req.Secret
+	}
+	if params.IsActive {
+		params.ConsecutiveFailures = 0
+		params.DisabledReason = sql.NullString{}
+		params.DisabledAt = sql.NullTime{}
+	}
+
+	if err := s.repo.UpdateEndpoint(ctx, params); err != nil {
+		return EndpointResponse{}, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// Delete menghapus endpoint beserta log delivery-nya (ON DELETE CASCADE)
+func (s *service) Delete(ctx context.Context, id string) error {
+	affected, err := s.repo.DeleteEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrEndpointNotFound
+	}
+	return nil
+}
+
+func (s *service) ListDeliveries(ctx context.Context, endpointID string, p DeliveryListParams) ([]DeliveryResponse, int64, error) {
+	if _, err := s.getEndpoint(ctx, endpointID); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.repo.ListDeliveries(ctx, dbgen.ListWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		Status:     p.Status,
+		Limit:      int32(p.PageSize),
+		Offset:     int32((p.Page - 1) * p.PageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountDeliveries(ctx, dbgen.CountWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		Status:     p.Status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]DeliveryResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapDeliveryToResponse(r))
+	}
+	return res, total, nil
+}
+
+// GetDelivery mengembalikan delivery beserta payload dan log setiap percobaan
+func (s *service) GetDelivery(ctx context.Context, endpointID, deliveryID string) (DeliveryResponse, error) {
+	row, err := s.repo.GetDelivery(ctx, dbgen.GetWebhookDeliveryParams{ID: deliveryID, EndpointID: endpointID})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return DeliveryResponse{}, ErrDeliveryNotFound
+		}
+		return DeliveryResponse{}, err
+	}
+
+	attempts, err := s.repo.ListDeliveryAttempts(ctx, deliveryID)
+	if err != nil {
+		return DeliveryResponse{}, err
+	}
+
+	res := mapDeliveryToResponse(row)
+	res.Payload = row.Payload
+	res.AttemptLog = make([]DeliveryAttemptResponse, 0, len(attempts))
+	for _, a := range attempts {
+		res.AttemptLog = append(res.AttemptLog, DeliveryAttemptResponse{
+			ID:           a.ID,
+			ResponseCode: helper.NullInt32ToIntPtr(a.ResponseCode),
+			Error:        helper.NullStringToPtr(a.ErrorMessage),
+			DurationMs:   int(a.DurationMs),
+			CreatedAt:    a.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// Redeliver menjadwalkan ulang delivery (termasuk yang sudah delivered/failed) untuk segera dikirim
+func (s *service) Redeliver(ctx context.Context, endpointID, deliveryID string) (DeliveryResponse, error) {
+	endpoint, err := s.getEndpoint(ctx, endpointID)
+	if err != nil {
+		return DeliveryResponse{}, err
+	}
+	if !endpoint.IsActive {
+		return DeliveryResponse{}, ErrEndpointDisabled
+	}
+
+	if _, err := s.repo.GetDelivery(ctx, dbgen.GetWebhookDeliveryParams{ID: deliveryID, EndpointID: endpointID}); err != nil {
+		if err == sql.ErrNoRows {
+			return DeliveryResponse{}, ErrDeliveryNotFound
+		}
+		return DeliveryResponse{}, err
+	}
+
+	if err := s.repo.RedeliverDelivery(ctx, dbgen.RedeliverWebhookDeliveryParams{
+		NextAttemptAt: time.Now().UTC(),
+		ID:            deliveryID,
+	}); err != nil {
+		return DeliveryResponse{}, err
+	}
+
+	return s.GetDelivery(ctx, endpointID, deliveryID)
+}
+
+func (s *service) Enqueue(ctx context.Context, msg outbox.Message) error {
+	endpoints, err := s.repo.ListEndpointsForEvent(ctx, msg.EventType)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(Envelope{
+		ID:        msg.ID,
+		Type:      msg.EventType,
+		CreatedAt: msg.OccurredAt.UTC(),
+		Data:      msg.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, e := range endpoints {
+		newUUID, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		// Duplikat (endpoint_id, event_id) diabaikan, jadi aman saat relay mengirim ulang event
+		if err := s.repo.CreateDelivery(ctx, dbgen.CreateWebhookDeliveryParams{
+			ID:            newUUID.String(),
+			EndpointID:    e.ID,
+			EventID:       msg.ID,
+			EventType:     msg.EventType,
+			Payload:       body,
+			NextAttemptAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) DeliverDue(ctx context.Context, now time.Time) (delivered int, failed int, err error) {
+	now = now.UTC()
+
+	due, err := s.repo.ListDueDeliveries(ctx, dbgen.ListDueWebhookDeliveriesParams{
+		Now:   now,
+		Limit: DeliveryBatchSize,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, d := range due {
+		// Klaim dulu agar dispatcher lain tidak mengirim delivery yang sama
+		claimed, err := s.repo.ClaimDelivery(ctx, dbgen.ClaimWebhookDeliveryParams{
+			LeaseUntil: now.Add(deliveryLease),
+			ID:         d.ID,
+			Now:        now,
+		})
+		if err != nil {
+			return delivered, failed, err
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		result := s.send(ctx, d, now)
+		if err := s.recordResult(ctx, d, result, now); err != nil {
+			return delivered, failed, err
+		}
+		if result.ok() {
+			delivered++
+		} else {
+			failed++
+		}
+	}
+
+	return delivered, failed, nil
+}
+
+// attemptResult adalah hasil satu percobaan HTTP
+type attemptResult struct {
+	code     int
+	err      error
+	duration time.Duration
+}
+
+func (r attemptResult) ok() bool {
+	return r.err == nil && r.code >= 200 && r.code < 300
+}
+
+func (r attemptResult) errorMessage() sql.NullString {
+	switch {
+	case r.err != nil:
+		return sql.NullString{String: truncate(r.err.Error(), maxErrorLength), Valid: true}
+	case !r.ok():
+		return sql.NullString{String: fmt.Sprintf("unexpected status code %d", r.code), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+func (s *service) send(ctx context.Context, d dbgen.ListDueWebhookDeliveriesRow, now time.Time) attemptResult {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return attemptResult{err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(EventHeader, d.EventType)
+	req.Header.Set(SignatureHeader, signPayload([]byte(d.Secret), d.Payload, now))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return attemptResult{err: err, duration: time.Since(start)}
+	}
+	// Body response sengaja tidak disimpan agar endpoint tidak bisa dipakai membaca layanan lain
+	resp.Body.Close()
+
+	return attemptResult{code: resp.StatusCode, duration: time.Since(start)}
+}
+
+// recordResult menyimpan log percobaan, status delivery, dan hitungan kegagalan endpoint dalam satu transaksi
+func (s *service) recordResult(ctx context.Context, d dbgen.ListDueWebhookDeliveriesRow, r attemptResult, now time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	txRepo := s.repo.WithTx(tx)
+
+	attemptUUID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	responseCode := sql.NullInt32{Int32: int32(r.code), Valid: r.code != 0}
+	if err := txRepo.CreateDeliveryAttempt(ctx, dbgen.CreateWebhookDeliveryAttemptParams{
+		ID:           attemptUUID.String(),
+		DeliveryID:   d.ID,
+		ResponseCode: responseCode,
+		ErrorMessage: r.errorMessage(),
+		DurationMs:   int32(r.duration.Milliseconds()),
+	}); err != nil {
+		return err
+	}
+
+	attempts := d.Attempts + 1
+	params := dbgen.UpdateWebhookDeliveryResultParams{
+		Status:           DeliveryStatusPending,
+		Attempts:         attempts,
+		NextAttemptAt:    now.Add(RetryDelay(int(attempts))),
+		LastResponseCode: responseCode,
+		LastError:        r.errorMessage(),
+		ID:               d.ID,
+	}
+
+	if r.ok() {
+		params.Status = DeliveryStatusDelivered
+		params.NextAttemptAt = now
+		params.DeliveredAt = sql.NullTime{Time: now, Valid: true}
+		if err := txRepo.ResetEndpointFailures(ctx, d.EndpointID); err != nil {
+			return err
+		}
+	} else {
+		if attempts >= MaxDeliveryAttempts {
+			params.Status = DeliveryStatusFailed
+			params.NextAttemptAt = now
+		}
+		if err := txRepo.IncrementEndpointFailures(ctx, d.EndpointID); err != nil {
+			return err
+		}
+		if _, err := txRepo.DisableFailingEndpoint(ctx, dbgen.DisableFailingWebhookEndpointParams{
+			DisabledReason: sql.NullString{String: autoDisableReason, Valid: true},
+			DisabledAt:     sql.NullTime{Time: now, Valid: true},
+			ID:             d.EndpointID,
+			MaxFailures:    MaxConsecutiveFailures,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := txRepo.UpdateDeliveryResult(ctx, params); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *service) getEndpoint(ctx context.Context, id string) (dbgen.WebhookEndpoint, error) {
+	row, err := s.repo.GetEndpointByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return dbgen.WebhookEndpoint{}, ErrEndpointNotFound
+		}
+		return dbgen.WebhookEndpoint{}, err
+	}
+	return row, nil
+}
+
+// validate memeriksa skema & host URL serta jenis event, lalu mengembalikan event_types dalam bentuk JSON
+func validate(req EndpointRequest) (json.RawMessage, error) {
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) url", ErrInvalidEndpoint)
+	}
+	if isInternalHost(u) {
+		return nil, fmt.Errorf("%w: url must not point to a loopback, private or link-local address", ErrInvalidEndpoint)
+	}
+
+	seen := make(map[string]bool, len(req.EventTypes))
+	eventTypes := make([]string, 0, len(req.EventTypes))
+	for _, t := range req.EventTypes {
+		if !outbox.IsKnownEvent(t) {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidEndpoint, t)
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		eventTypes = append(eventTypes, t)
+	}
+
+	return json.Marshal(eventTypes)
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// RetryDelay mengembalikan jeda sebelum percobaan berikutnya setelah attempt kegagalan (dimulai dari 1)
+func RetryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func truncate(s string, max int) string {
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
+
+func mapEndpointToResponse(e dbgen.WebhookEndpoint) EndpointResponse {
+	var eventTypes []string
+	_ = json.Unmarshal(e.EventTypes, &eventTypes)
+
+	return EndpointResponse{
+		ID:                  e.ID,
+		URL:                 e.Url,
+		Description:         helper.NullStringToPtr(e.Description),
+		EventTypes:          eventTypes,
+		IsActive:            e.IsActive,
+		ConsecutiveFailures: int(e.ConsecutiveFailures),
+		DisabledReason:      helper.NullStringToPtr(e.DisabledReason),
+		DisabledAt:          helper.NullTimeToPtr(e.DisabledAt),
+		CreatedAt:           e.CreatedAt,
+		UpdatedAt:           e.UpdatedAt,
+	}
+}
+
+func mapDeliveryToResponse(d dbgen.WebhookDelivery) DeliveryResponse {
+	return DeliveryResponse{
+		ID:               d.ID,
+		EndpointID:       d.EndpointID,
+		EventID:          d.EventID,
+		EventType:        d.EventType,
+		Status:           d.Status,
+		Attempts:         int(d.Attempts),
+		NextAttemptAt:    d.NextAttemptAt,
+		LastResponseCode: helper.NullInt32ToIntPtr(d.LastResponseCode),
+		LastError:        helper.NullStringToPtr(d.LastError),
+		DeliveredAt:      helper.NullTimeToPtr(d.DeliveredAt),
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
@@ -0,0 +1,504 @@
+package webhook_test
+
+import (
+	"assignment-ptes-achmad-rifai/internal/outbox"
+	"assignment-ptes-achmad-rifai/internal/shared/database/dbgen"
+	"assignment-ptes-achmad-rifai/internal/webhook"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockWebhook "assignment-ptes-achmad-rifai/internal/webhook/mocks"
+)
+
+const testSecret = "whsec_test_secret_value"
+
+func setupServiceTest(t *testing.T) (webhook.Service, *mockWebhook.MockRepository, sqlmock.Sqlmock) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	repo := mockWebhook.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	// Receiver httptest berjalan di 127.0.0.1 yang ditolak client default (NewHTTPClient)
+	return webhook.NewService(db, repo, &http.Client{Timeout: time.Second}), repo, mock
+}
+
+// receiver adalah endpoint httptest yang mencatat request dan membalas dengan status tertentu
+type receiver struct {
+	*httptest.Server
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{status: status}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		w.WriteHeader(r.status)
+		w.Write([]byte("ack"))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func dueDelivery(url string, attempts int32) dbgen.ListDueWebhookDeliveriesRow {
+	return dbgen.ListDueWebhookDeliveriesRow{
+		ID:         "del-1",
+		EndpointID: "wh-1",
+		EventType:  outbox.EventOrderPlaced,
+		Payload:    json.RawMessage(`{"id":"evt-1","type":"OrderPlaced","data":{"order_id":"o1"}}`),
+		Attempts:   attempts,
+		Url:        url,
+		Secret:     testSecret,
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_generates_secret", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().
+			CreateEndpoint(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateWebhookEndpointParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWebhookEndpointParams) error {
+				assert.True(t, strings.HasPrefix(p.Secret, "whsec_"))
+				assert.JSONEq(t, `["OrderPlaced","OrderCancelled"]`, string(p.EventTypes))
+				assert.True(t, p.IsActive)
+				return nil
+			})
+		repo.EXPECT().GetEndpointByID(gomock.Any(), gomock.Any()).Return(dbgen.WebhookEndpoint{
+			ID:         "wh-1",
+			Url:        "https://example.com/hooks",
+			EventTypes: json.RawMessage(`["OrderPlaced","OrderCancelled"]`),
+			IsActive:   true,
+		}, nil)
+
+		res, err := svc.Create(ctx, webhook.EndpointRequest{
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{outbox.EventOrderPlaced, outbox.EventOrderCancelled, outbox.EventOrderPlaced},
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Secret, "secret hanya dikembalikan saat create")
+		assert.Equal(t, []string{"OrderPlaced", "OrderCancelled"}, res.EventTypes)
+	})
+
+	t.Run("unknown_event_type", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		_, err := svc.Create(ctx, webhook.EndpointRequest{
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{"OrderRefunded"},
+		})
+
+		assert.ErrorIs(t, err, webhook.ErrInvalidEndpoint)
+	})
+
+	t.Run("non_http_url", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		_, err := svc.Create(ctx, webhook.EndpointRequest{
+			URL:        "ftp://example.com/hooks",
+			EventTypes: []string{outbox.EventOrderPlaced},
+		})
+
+		assert.ErrorIs(t, err, webhook.ErrInvalidEndpoint)
+	})
+
+	for _, url := range []string{
+		"http://localhost:6379",
+		"http://api.localhost/hooks",
+		"http://127.0.0.1:8080/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.5/hooks",
+		"http://[::1]/hooks",
+		"http://[::ffff:192.168.1.1]/hooks",
+	} {
+		t.Run("internal_host_"+url, func(t *testing.T) {
+			svc, _, _ := setupServiceTest(t)
+
+			_, err := svc.Create(ctx, webhook.EndpointRequest{URL: url, EventTypes: []string{outbox.EventOrderPlaced}})
+
+			assert.ErrorIs(t, err, webhook.ErrInvalidEndpoint)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reactivate_resets_failures_and_keeps_secret", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		current := dbgen.WebhookEndpoint{
+			ID:                  "wh-1",
+			Url:                 "https://example.com/hooks",
+			Secret:              testSecret,
+			EventTypes:          json.RawMessage(`["OrderPlaced"]`),
+			IsActive:            false,
+			ConsecutiveFailures: webhook.MaxConsecutiveFailures,
+			DisabledReason:      sql.NullString{String: "too many failures", Valid: true},
+			DisabledAt:          sql.NullTime{Time: time.Now(), Valid: true},
+		}
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "wh-1").Return(current, nil)
+		repo.EXPECT().
+			UpdateEndpoint(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateWebhookEndpointParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateWebhookEndpointParams) error {
+				assert.Equal(t, testSecret, p.Secret)
+				assert.True(t, p.IsActive)
+				assert.Zero(t, p.ConsecutiveFailures)
+				assert.False(t, p.DisabledReason.Valid)
+				assert.False(t, p.DisabledAt.Valid)
+				return nil
+			})
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "wh-1").Return(dbgen.WebhookEndpoint{ID: "wh-1", IsActive: true}, nil)
+
+		active := true
+		res, err := svc.Update(ctx, "wh-1", webhook.EndpointRequest{
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{outbox.EventOrderPlaced},
+			IsActive:   &active,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsActive)
+		assert.Empty(t, res.Secret)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "missing").Return(dbgen.WebhookEndpoint{}, sql.ErrNoRows)
+
+		_, err := svc.Update(ctx, "missing", webhook.EndpointRequest{
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{outbox.EventOrderPlaced},
+		})
+
+		assert.ErrorIs(t, err, webhook.ErrEndpointNotFound)
+	})
+}
+
+func TestService_Delete_NotFound(t *testing.T) {
+	svc, repo, _ := setupServiceTest(t)
+
+	repo.EXPECT().DeleteEndpoint(gomock.Any(), "missing").Return(int64(0), nil)
+
+	assert.ErrorIs(t, svc.Delete(context.Background(), "missing"), webhook.ErrEndpointNotFound)
+}
+
+func TestService_Enqueue(t *testing.T) {
+	ctx := context.Background()
+	msg := outbox.Message{
+		ID:         "evt-1",
+		EventType:  outbox.EventOrderPlaced,
+		Payload:    json.RawMessage(`{"order_id":"o1"}`),
+		OccurredAt: time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("creates_delivery_per_subscribed_endpoint", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().ListEndpointsForEvent(gomock.Any(), outbox.EventOrderPlaced).Return([]dbgen.WebhookEndpoint{
+			{ID: "wh-1"}, {ID: "wh-2"},
+		}, nil)
+
+		var endpoints []string
+		repo.EXPECT().
+			CreateDelivery(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateWebhookDeliveryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWebhookDeliveryParams) error {
+				endpoints = append(endpoints, p.EndpointID)
+				assert.Equal(t, "evt-1", p.EventID)
+				assert.JSONEq(t, `{"id":"evt-1","type":"OrderPlaced","created_at":"2025-01-10T08:00:00Z","data":{"order_id":"o1"}}`, string(p.Payload))
+				return nil
+			}).Times(2)
+
+		assert.NoError(t, svc.Enqueue(ctx, msg))
+		assert.Equal(t, []string{"wh-1", "wh-2"}, endpoints)
+	})
+
+	t.Run("no_subscribers", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().ListEndpointsForEvent(gomock.Any(), outbox.EventOrderPlaced).Return(nil, nil)
+
+		assert.NoError(t, svc.Enqueue(ctx, msg))
+	})
+}
+
+func TestService_DeliverDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("success_signs_payload_and_resets_failures", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		recv := newReceiver(t, http.StatusOK)
+		d := dueDelivery(recv.URL, 0)
+
+		repo.EXPECT().ListDueDeliveries(gomock.Any(), gomock.Any()).Return([]dbgen.ListDueWebhookDeliveriesRow{d}, nil)
+		repo.EXPECT().ClaimDelivery(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		mock.ExpectBegin()
+		repo.EXPECT().
+			CreateDeliveryAttempt(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateWebhookDeliveryAttemptParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWebhookDeliveryAttemptParams) error {
+				assert.Equal(t, int32(http.StatusOK), p.ResponseCode.Int32)
+				assert.False(t, p.ErrorMessage.Valid)
+				return nil
+			})
+		repo.EXPECT().ResetEndpointFailures(gomock.Any(), "wh-1").Return(nil)
+		repo.EXPECT().
+			UpdateDeliveryResult(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateWebhookDeliveryResultParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateWebhookDeliveryResultParams) error {
+				assert.Equal(t, webhook.DeliveryStatusDelivered, p.Status)
+				assert.Equal(t, int32(1), p.Attempts)
+				assert.True(t, p.DeliveredAt.Valid)
+				return nil
+			})
+		mock.ExpectCommit()
+
+		delivered, failed, err := svc.DeliverDue(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		assert.Equal(t, 0, failed)
+		if assert.Len(t, recv.requests, 1) {
+			req := recv.requests[0]
+			assert.Equal(t, "del-1", req.Header.Get(webhook.DeliveryHeader))
+			assert.Equal(t, outbox.EventOrderPlaced, req.Header.Get(webhook.EventHeader))
+			assert.Equal(t, string(d.Payload), string(recv.bodies[0]))
+			assert.NoError(t, webhook.VerifySignature([]byte(testSecret), recv.bodies[0], req.Header.Get(webhook.SignatureHeader), now))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failure_schedules_retry_with_backoff", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		recv := newReceiver(t, http.StatusInternalServerError)
+
+		repo.EXPECT().ListDueDeliveries(gomock.Any(), gomock.Any()).Return([]dbgen.ListDueWebhookDeliveriesRow{dueDelivery(recv.URL, 2)}, nil)
+		repo.EXPECT().ClaimDelivery(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		mock.ExpectBegin()
+		repo.EXPECT().CreateDeliveryAttempt(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().IncrementEndpointFailures(gomock.Any(), "wh-1").Return(nil)
+		repo.EXPECT().
+			DisableFailingEndpoint(gomock.Any(), gomock.AssignableToTypeOf(dbgen.DisableFailingWebhookEndpointParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.DisableFailingWebhookEndpointParams) (int64, error) {
+				assert.Equal(t, int32(webhook.MaxConsecutiveFailures), p.MaxFailures)
+				return 0, nil
+			})
+		repo.EXPECT().
+			UpdateDeliveryResult(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateWebhookDeliveryResultParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateWebhookDeliveryResultParams) error {
+				assert.Equal(t, webhook.DeliveryStatusPending, p.Status)
+				assert.Equal(t, int32(3), p.Attempts)
+				assert.Equal(t, now.Add(webhook.RetryDelay(3)), p.NextAttemptAt)
+				assert.Equal(t, int32(http.StatusInternalServerError), p.LastResponseCode.Int32)
+				assert.Contains(t, p.LastError.String, "500")
+				return nil
+			})
+		mock.ExpectCommit()
+
+		delivered, failed, err := svc.DeliverDue(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, delivered)
+		assert.Equal(t, 1, failed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("last_attempt_marks_failed", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		recv := newReceiver(t, http.StatusGone)
+
+		repo.EXPECT().ListDueDeliveries(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListDueWebhookDeliveriesRow{dueDelivery(recv.URL, webhook.MaxDeliveryAttempts-1)}, nil)
+		repo.EXPECT().ClaimDelivery(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		mock.ExpectBegin()
+		repo.EXPECT().CreateDeliveryAttempt(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().IncrementEndpointFailures(gomock.Any(), "wh-1").Return(nil)
+		repo.EXPECT().DisableFailingEndpoint(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().
+			UpdateDeliveryResult(gomock.Any(), gomock.AssignableToTypeOf(dbgen.UpdateWebhookDeliveryResultParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateWebhookDeliveryResultParams) error {
+				assert.Equal(t, webhook.DeliveryStatusFailed, p.Status)
+				assert.Equal(t, int32(webhook.MaxDeliveryAttempts), p.Attempts)
+				return nil
+			})
+		mock.ExpectCommit()
+
+		_, failed, err := svc.DeliverDue(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, failed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("connection_error_records_attempt_without_code", func(t *testing.T) {
+		svc, repo, mock := setupServiceTest(t)
+		recv := newReceiver(t, http.StatusOK)
+		recv.Close()
+
+		repo.EXPECT().ListDueDeliveries(gomock.Any(), gomock.Any()).Return([]dbgen.ListDueWebhookDeliveriesRow{dueDelivery(recv.URL, 0)}, nil)
+		repo.EXPECT().ClaimDelivery(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		mock.ExpectBegin()
+		repo.EXPECT().
+			CreateDeliveryAttempt(gomock.Any(), gomock.AssignableToTypeOf(dbgen.CreateWebhookDeliveryAttemptParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWebhookDeliveryAttemptParams) error {
+				assert.False(t, p.ResponseCode.Valid)
+				assert.True(t, p.ErrorMessage.Valid)
+				return nil
+			})
+		repo.EXPECT().IncrementEndpointFailures(gomock.Any(), "wh-1").Return(nil)
+		repo.EXPECT().DisableFailingEndpoint(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().UpdateDeliveryResult(gomock.Any(), gomock.Any()).Return(nil)
+		mock.ExpectCommit()
+
+		_, failed, err := svc.DeliverDue(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, failed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips_delivery_claimed_elsewhere", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+		recv := newReceiver(t, http.StatusOK)
+
+		repo.EXPECT().ListDueDeliveries(gomock.Any(), gomock.Any()).Return([]dbgen.ListDueWebhookDeliveriesRow{dueDelivery(recv.URL, 0)}, nil)
+		repo.EXPECT().ClaimDelivery(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+		delivered, failed, err := svc.DeliverDue(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Zero(t, delivered+failed)
+		assert.Empty(t, recv.requests)
+	})
+}
+
+func TestService_Redeliver(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "wh-1").Return(dbgen.WebhookEndpoint{ID: "wh-1", IsActive: true}, nil)
+		repo.EXPECT().GetDelivery(gomock.Any(), dbgen.GetWebhookDeliveryParams{ID: "del-1", EndpointID: "wh-1"}).
+			Return(dbgen.WebhookDelivery{ID: "del-1", Status: webhook.DeliveryStatusFailed}, nil)
+		repo.EXPECT().
+			RedeliverDelivery(gomock.Any(), gomock.AssignableToTypeOf(dbgen.RedeliverWebhookDeliveryParams{})).
+			DoAndReturn(func(_ context.Context, p dbgen.RedeliverWebhookDeliveryParams) error {
+				assert.Equal(t, "del-1", p.ID)
+				return nil
+			})
+		repo.EXPECT().GetDelivery(gomock.Any(), gomock.Any()).
+			Return(dbgen.WebhookDelivery{ID: "del-1", Status: webhook.DeliveryStatusPending}, nil)
+		repo.EXPECT().ListDeliveryAttempts(gomock.Any(), "del-1").Return([]dbgen.WebhookDeliveryAttempt{
+			{ID: "att-1", ResponseCode: sql.NullInt32{Int32: 500, Valid: true}},
+		}, nil)
+
+		res, err := svc.Redeliver(ctx, "wh-1", "del-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, webhook.DeliveryStatusPending, res.Status)
+		if assert.Len(t, res.AttemptLog, 1) {
+			assert.Equal(t, 500, *res.AttemptLog[0].ResponseCode)
+		}
+	})
+
+	t.Run("endpoint_disabled", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "wh-1").Return(dbgen.WebhookEndpoint{ID: "wh-1", IsActive: false}, nil)
+
+		_, err := svc.Redeliver(ctx, "wh-1", "del-1")
+
+		assert.ErrorIs(t, err, webhook.ErrEndpointDisabled)
+	})
+
+	t.Run("delivery_not_found", func(t *testing.T) {
+		svc, repo, _ := setupServiceTest(t)
+
+		repo.EXPECT().GetEndpointByID(gomock.Any(), "wh-1").Return(dbgen.WebhookEndpoint{ID: "wh-1", IsActive: true}, nil)
+		repo.EXPECT().GetDelivery(gomock.Any(), gomock.Any()).Return(dbgen.WebhookDelivery{}, sql.ErrNoRows)
+
+		_, err := svc.Redeliver(ctx, "wh-1", "missing")
+
+		assert.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+	})
+}
+
+func TestSink_Publish(t *testing.T) {
+	svc, repo, _ := setupServiceTest(t)
+
+	repo.EXPECT().ListEndpointsForEvent(gomock.Any(), outbox.EventStockDepleted).Return(nil, errors.New("db down"))
+
+	err := webhook.NewSink(svc).Publish(context.Background(), outbox.Message{ID: "evt-1", EventType: outbox.EventStockDepleted})
+
+	assert.Error(t, err, "error diteruskan agar relay mencoba ulang event")
+}
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Now()
+
+	assert.ErrorIs(t, webhook.VerifySignature([]byte(testSecret), []byte("{}"), "garbage", now), webhook.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.VerifySignature([]byte(testSecret), []byte("{}"), "t=1,v1=abc", now), webhook.ErrInvalidSignature)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhook.RetryDelay(1))
+	assert.Equal(t, 2*time.Minute, webhook.RetryDelay(3))
+	assert.Equal(t, 6*time.Hour, webhook.RetryDelay(30))
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Run("refuses_internal_address_at_dial", func(t *testing.T) {
+		recv := newReceiver(t, http.StatusOK)
+
+		resp, err := webhook.NewHTTPClient(time.Second).Post(recv.URL, "application/json", strings.NewReader(`{}`))
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		assert.ErrorIs(t, err, webhook.ErrForbiddenAddress)
+		assert.Empty(t, recv.requests)
+	})
+
+	t.Run("does_not_follow_redirects", func(t *testing.T) {
+		target := newReceiver(t, http.StatusOK)
+		redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+		t.Cleanup(redirect.Close)
+
+		client := webhook.NewHTTPClient(time.Second)
+		client.Transport = http.DefaultTransport // httptest berjalan di loopback
+		resp, err := client.Post(redirect.URL, "application/json", strings.NewReader(`{}`))
+
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Empty(t, target.requests)
+	})
+}
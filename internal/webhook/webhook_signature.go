@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader berisi "t=<unix timestamp>,v1=<hex HMAC-SHA256 dari "<t>.<payload>">"
+const SignatureHeader = "X-Webhook-Signature"
+
+// SignatureTolerance membatasi umur timestamp signature untuk mencegah replay
+const SignatureTolerance = 5 * time.Minute
+
+func signPayload(secret, payload []byte, ts time.Time) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, computeSignature(secret, t, payload))
+}
+
+func computeSignature(secret []byte, t string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature memvalidasi header signature di sisi penerima; dipakai juga oleh test
+func VerifySignature(secret, payload []byte, header string, now time.Time) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	if t == "" || v1 == "" {
+		return fmt.Errorf("%w: malformed signature header", ErrInvalidSignature)
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp", ErrInvalidSignature)
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > SignatureTolerance || age < -SignatureTolerance {
+		return fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	if !hmac.Equal([]byte(v1), []byte(computeSignature(secret, t, payload))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}